@@ -24,6 +24,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
@@ -96,6 +97,26 @@ func Test_Commands(t *testing.T) {
 		assert.Equal(t, "unsupported isb service type", err.Error())
 	})
 
+	t.Run("SideInputs", func(t *testing.T) {
+		for _, cmd := range []*cobra.Command{NewSideInputsManagerCommand(), NewSideInputsInitCommand(), NewSideInputsSynchronizerCommand()} {
+			assert.True(t, cmd.HasLocalFlags())
+			assert.Equal(t, "string", cmd.Flag("side-inputs-store").Value.Type())
+			cmd.SetArgs([]string{"--isbsvc-type=jetstream"})
+			err := cmd.Execute()
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "side inputs store is not specified")
+			cmd.SetArgs([]string{"--isbsvc-type=redis", "--side-inputs-store=test-ns-test-pl"})
+			err = cmd.Execute()
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "side inputs are only supported with JetStream")
+		}
+		cmd := NewSideInputsManagerCommand()
+		cmd.SetArgs([]string{"--side-inputs-store=test-ns-test-pl"})
+		err := cmd.Execute()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), dfv1.EnvSideInputObject+"\" is not defined")
+	})
+
 	t.Run("Controller", func(t *testing.T) {
 		cmd := NewControllerCommand()
 		assert.Equal(t, "controller", cmd.Use)
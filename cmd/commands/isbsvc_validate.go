@@ -35,9 +35,10 @@ import (
 func NewISBSvcValidateCommand() *cobra.Command {
 
 	var (
-		isbSvcType string
-		buffers    []string
-		buckets    []string
+		isbSvcType      string
+		buffers         []string
+		buckets         []string
+		sideInputsStore string
 	)
 
 	command := &cobra.Command{
@@ -66,7 +67,7 @@ func NewISBSvcValidateCommand() *cobra.Command {
 				return fmt.Errorf("unsupported isb service type")
 			}
 			_ = wait.ExponentialBackoffWithContext(ctx, sharedutil.DefaultRetryBackoff, func() (bool, error) {
-				if err = isbsClient.ValidateBuffersAndBuckets(ctx, buffers, buckets); err != nil {
+				if err = isbsClient.ValidateBuffersAndBuckets(ctx, buffers, buckets, sideInputsStore); err != nil {
 					logger.Infow("Buffers and buckets might have not been created yet, will retry if the limit is not reached", zap.Error(err))
 					return false, nil
 				}
@@ -83,5 +84,6 @@ func NewISBSvcValidateCommand() *cobra.Command {
 	command.Flags().StringVar(&isbSvcType, "isbsvc-type", "", "ISB Service type, e.g. jetstream")
 	command.Flags().StringSliceVar(&buffers, "buffers", []string{}, "Buffers to validate") // --buffers=a,b, --buffers=c
 	command.Flags().StringSliceVar(&buckets, "buckets", []string{}, "Buckets to validate") // --buckets=xxa,xxb --buckets=xxc
+	command.Flags().StringVar(&sideInputsStore, "side-inputs-store", "", "Name of the side inputs store to validate")
 	return command
 }
@@ -45,4 +45,7 @@ func init() {
 	rootCmd.AddCommand(NewDaemonServerCommand())
 	rootCmd.AddCommand(NewServerCommand())
 	rootCmd.AddCommand(NewServerInitCommand())
+	rootCmd.AddCommand(NewSideInputsManagerCommand())
+	rootCmd.AddCommand(NewSideInputsInitCommand())
+	rootCmd.AddCommand(NewSideInputsSynchronizerCommand())
 }
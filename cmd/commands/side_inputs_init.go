@@ -0,0 +1,60 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sideinputs/synchronizer"
+)
+
+func NewSideInputsInitCommand() *cobra.Command {
+	var (
+		isbSvcType      string
+		sideInputsStore string
+		sideInputs      []string
+	)
+	command := &cobra.Command{
+		Use:   "side-inputs-init",
+		Short: "Wait for the side inputs to be available and write them to the side inputs volume",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateSideInputsStoreFlags(dfv1.ISBSvcType(isbSvcType), sideInputsStore); err != nil {
+				cmd.HelpFunc()(cmd, args)
+				return err
+			}
+			logger := logging.NewLogger().Named("side-inputs-init")
+			ctx := logging.WithLogger(signals.SetupSignalHandler(), logger)
+			kv, closeFn, err := getSideInputsStore(ctx, sideInputsStore)
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+			if err := synchronizer.NewSideInputsSynchronizer(kv, sideInputs, dfv1.PathSideInputsMount).WaitForSideInputs(ctx); err != nil {
+				return err
+			}
+			logger.Info("All the side inputs are ready")
+			return nil
+		},
+	}
+	command.Flags().StringVar(&isbSvcType, "isbsvc-type", "jetstream", "ISB Service type, e.g. jetstream")
+	command.Flags().StringVar(&sideInputsStore, "side-inputs-store", "", "Name of the side inputs store")
+	command.Flags().StringSliceVar(&sideInputs, "side-inputs", []string{}, "Side inputs to wait for") // --side-inputs=a,b --side-inputs=c
+	return command
+}
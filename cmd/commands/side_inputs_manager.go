@@ -0,0 +1,146 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	sideinputpb "github.com/numaproj/numaflow/pkg/apis/proto/sideinput"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+	"github.com/numaproj/numaflow/pkg/sideinputs/builtin"
+	"github.com/numaproj/numaflow/pkg/sideinputs/manager"
+)
+
+func NewSideInputsManagerCommand() *cobra.Command {
+	var (
+		isbSvcType      string
+		sideInputsStore string
+	)
+	command := &cobra.Command{
+		Use:   "side-inputs-manager",
+		Short: "Start a side inputs manager",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateSideInputsStoreFlags(dfv1.ISBSvcType(isbSvcType), sideInputsStore); err != nil {
+				cmd.HelpFunc()(cmd, args)
+				return err
+			}
+			logger := logging.NewLogger().Named("side-inputs-manager")
+			sideInput, err := decodeSideInput()
+			if err != nil {
+				return fmt.Errorf("failed to decode the side input spec: %v", err)
+			}
+			ctx := logging.WithLogger(signals.SetupSignalHandler(), logger)
+			kv, closeFn, err := getSideInputsStore(ctx, sideInputsStore)
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+			if x := sideInput.Builtin; x != nil {
+				client, err := builtin.New(*x)
+				if err != nil {
+					return fmt.Errorf("failed to create the builtin generator of the side input, %w", err)
+				}
+				return manager.NewSideInputsManager(sideInput, kv, client).Start(ctx)
+			}
+			maxMessageSize := sharedutil.LookupEnvIntOr(dfv1.EnvGRPCMaxMessageSize, dfv1.DefaultGRPCMaxMessageSize)
+			conn, err := grpc.Dial("unix://"+manager.SideInputSocketPath,
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMessageSize), grpc.MaxCallSendMsgSize(maxMessageSize)))
+			if err != nil {
+				return fmt.Errorf("failed to connect to the side input container, %w", err)
+			}
+			defer func() {
+				_ = conn.Close()
+			}()
+			return manager.NewSideInputsManager(sideInput, kv, sideinputpb.NewSideInputClient(conn)).Start(ctx)
+		},
+	}
+	command.Flags().StringVar(&isbSvcType, "isbsvc-type", "jetstream", "ISB Service type, e.g. jetstream")
+	command.Flags().StringVar(&sideInputsStore, "side-inputs-store", "", "Name of the side inputs store")
+	return command
+}
+
+func decodeSideInput() (*dfv1.SideInput, error) {
+	encodedSideInput, defined := os.LookupEnv(dfv1.EnvSideInputObject)
+	if !defined {
+		return nil, fmt.Errorf("environment %q is not defined", dfv1.EnvSideInputObject)
+	}
+	decodedBytes, err := base64.StdEncoding.DecodeString(encodedSideInput)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode encoded side input object, error: %w", err)
+	}
+	sideInput := &dfv1.SideInput{}
+	if err = json.Unmarshal(decodedBytes, sideInput); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal side input object, error: %w", err)
+	}
+	return sideInput, nil
+}
+
+func validateSideInputsStoreFlags(isbSvcType dfv1.ISBSvcType, sideInputsStore string) error {
+	if isbSvcType != dfv1.ISBSvcTypeJetStream {
+		return fmt.Errorf("unsupported isb service type %q, side inputs are only supported with JetStream", isbSvcType)
+	}
+	if len(sideInputsStore) == 0 {
+		return fmt.Errorf("side inputs store is not specified")
+	}
+	return nil
+}
+
+// getSideInputsStore returns the kv of the side inputs store, it waits until the store is created. The returned
+// function closes the connection.
+func getSideInputsStore(ctx context.Context, sideInputsStore string) (nats.KeyValue, func(), error) {
+	log := logging.FromContext(ctx)
+	conn, err := jsclient.NewInClusterJetStreamClient().Connect(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get an in-cluster nats connection, %w", err)
+	}
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("failed to get a js context from nats connection, %w", err)
+	}
+	bucket := isbsvc.JetStreamSideInputsStoreKVName(sideInputsStore)
+	for {
+		kv, err := js.KeyValue(bucket)
+		if err == nil {
+			return kv, conn.Close, nil
+		}
+		log.Infow("Side inputs store might have not been created yet, waiting...", zap.String("bucket", bucket), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			conn.Close()
+			return nil, nil, ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
@@ -66,3 +66,7 @@ gen-protoc(){
 }
 
 gen-protoc pkg/apis/proto/daemon/daemon.proto
+gen-protoc pkg/apis/proto/ingest/ingest.proto
+gen-protoc pkg/apis/proto/mapbatch/mapbatch.proto
+gen-protoc pkg/apis/proto/sideinput/sideinput.proto
+gen-protoc pkg/apis/proto/udsink/udsink.proto
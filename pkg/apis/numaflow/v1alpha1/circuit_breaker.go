@@ -0,0 +1,48 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CircuitBreaker opens after a number of consecutive failures, and stays open for a while, before letting a trial
+// call through to decide whether to close it.
+type CircuitBreaker struct {
+	// FailureThreshold is the number of consecutive failures to open the circuit breaker, defaults to 5.
+	// +optional
+	FailureThreshold *uint32 `json:"failureThreshold,omitempty" protobuf:"varint,1,opt,name=failureThreshold"`
+	// OpenDuration is how long the circuit breaker stays open before letting a trial call through, defaults to 30s.
+	// +optional
+	OpenDuration *metav1.Duration `json:"openDuration,omitempty" protobuf:"bytes,2,opt,name=openDuration"`
+}
+
+func (cb CircuitBreaker) GetFailureThreshold() int {
+	if cb.FailureThreshold == nil {
+		return 5
+	}
+	return int(*cb.FailureThreshold)
+}
+
+func (cb CircuitBreaker) GetOpenDuration() time.Duration {
+	if cb.OpenDuration == nil {
+		return 30 * time.Second
+	}
+	return cb.OpenDuration.Duration
+}
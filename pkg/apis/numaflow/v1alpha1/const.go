@@ -38,6 +38,8 @@ const (
 	KeyPipelineName     = "numaflow.numaproj.io/pipeline-name"
 	KeyVertexName       = "numaflow.numaproj.io/vertex-name"
 	KeyReplica          = "numaflow.numaproj.io/replica"
+	KeyReplicas         = "numaflow.numaproj.io/replicas" // number of replicas of the vertex, only on the pods of rate limited sinks
+	KeySideInputName    = "numaflow.numaproj.io/side-input-name"
 	KeyDefaultContainer = "kubectl.kubernetes.io/default-container"
 
 	// ID key in the header of sources like http
@@ -67,6 +69,10 @@ const (
 	CtrUdf           = "udf"
 	CtrUdsink        = "udsink"
 	CtrUdtransformer = "transformer"
+	CtrUdSideInput   = "udsi"
+
+	CtrInitSideInputs    = "init-side-inputs"
+	CtrSideInputsWatcher = "side-inputs-synchronizer"
 
 	// components
 	ComponentISBSvc = "isbsvc"
@@ -74,6 +80,8 @@ const (
 	ComponentVertex = "vertex"
 	ComponentJob    = "job"
 
+	ComponentSideInputManager = "side-inputs-manager"
+
 	// controllers
 	ControllerISBSvc   = "isbsvc-controller"
 	ControllerPipeline = "pipeline-controller"
@@ -88,6 +96,7 @@ const (
 	EnvReplica                        = "NUMAFLOW_REPLICA"
 	EnvVertexObject                   = "NUMAFLOW_VERTEX_OBJECT"
 	EnvPipelineObject                 = "NUMAFLOW_PIPELINE_OBJECT"
+	EnvSideInputObject                = "NUMAFLOW_SIDE_INPUT_OBJECT"
 	EnvImage                          = "NUMAFLOW_IMAGE"
 	EnvImagePullPolicy                = "NUMAFLOW_IMAGE_PULL_POLICY"
 	EnvISBSvcRedisSentinelURL         = "NUMAFLOW_ISBSVC_REDIS_SENTINEL_URL"
@@ -117,6 +126,8 @@ const (
 	VertexMetricsPortName = "metrics"
 	VertexHTTPSPort       = 8443
 	VertexHTTPSPortName   = "https"
+	VertexGRPCPort        = 8444
+	VertexGRPCPortName    = "grpc"
 	DaemonServicePort     = 4327
 
 	DefaultRequeueAfter = 10 * time.Second
@@ -143,6 +154,27 @@ const (
 	// PVC mount path for PBQ
 	PathPBQMount = "/var/numaflow/pbq"
 
+	// Mount path of the volume for file sources
+	PathFileSourceMount = "/var/numaflow/files"
+
+	// Mount path of the volume for file sinks
+	PathFileSinkMount = "/var/numaflow/sink-files"
+	// Mount path of the volume for file sinks used as fallback sinks
+	PathFallbackFileSinkMount = "/var/numaflow/fallback-sink-files"
+
+	// Mount path of the downward API volume of the pod information, e.g. the number of replicas of the vertex
+	PathPodInfoMount = "/var/numaflow/podinfo"
+	// File of the number of replicas of the vertex in the pod information volume
+	PodInfoReplicasFile = "replicas"
+
+	// Mount path of the volume with the WebAssembly module
+	PathWasmMount = "/var/numaflow/wasm"
+	// File of the WebAssembly module in the volume, if the module is stored in a ConfigMap
+	WasmConfigMapModuleFile = "module.wasm"
+
+	// Mount path of the volume with the values of the Side Inputs, each Side Input is a file named after it
+	PathSideInputsMount = "/var/numaflow/side-inputs"
+
 	// Default persistent store options
 	DefaultStoreSyncDuration  = 2 * time.Second        // Default sync duration for pbq
 	DefaultStoreMaxBufferSize = 100000                 // Default buffer size for pbq in bytes
@@ -156,6 +188,10 @@ const (
 
 	// UDF map streaming
 	MapUdfStreamKey = "numaflow.numaproj.io/map-stream"
+	// UDF map batch
+	MapUdfBatchKey = "numaflow.numaproj.io/map-batch"
+	// UD sink typed status
+	UDSinkStatusKey = "numaflow.numaproj.io/sink-status"
 )
 
 var (
@@ -53,7 +53,8 @@ func (ct *ContainerTemplate) ApplyToContainer(c *corev1.Container) {
 // ApplyToNumaflowContainers updates any numa or init containers with the values from the ContainerTemplate
 func (ct *ContainerTemplate) ApplyToNumaflowContainers(containers []corev1.Container) {
 	for i := range containers {
-		if containers[i].Name == CtrMain || containers[i].Name == CtrInit {
+		switch containers[i].Name {
+		case CtrMain, CtrInit, CtrInitSideInputs, CtrSideInputsWatcher:
 			ct.ApplyToContainer(&containers[i])
 		}
 	}
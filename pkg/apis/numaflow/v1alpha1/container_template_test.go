@@ -76,8 +76,10 @@ func Test_ApplyToNumaflowContainers(t *testing.T) {
 	cs := []corev1.Container{
 		{Name: CtrMain},
 		{Name: "nono"},
+		{Name: CtrSideInputsWatcher},
 	}
 	testContainerTemplate.ApplyToNumaflowContainers(cs)
 	assert.Equal(t, testContainerTemplate.Resources, cs[0].Resources)
 	assert.NotEqual(t, testContainerTemplate.Resources, cs[1].Resources)
+	assert.Equal(t, testContainerTemplate.Resources, cs[2].Resources)
 }
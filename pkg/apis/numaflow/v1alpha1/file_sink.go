@@ -0,0 +1,165 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum=none;gzip;zstd
+type FileCompression string
+
+const (
+	FileCompressionNone FileCompression = "none"
+	FileCompressionGzip FileCompression = "gzip"
+	FileCompressionZstd FileCompression = "zstd"
+)
+
+// +kubebuilder:validation:Enum=ndjson;parquet
+type FileSinkFormat string
+
+const (
+	FileSinkFormatNDJSON  FileSinkFormat = "ndjson"
+	FileSinkFormatParquet FileSinkFormat = "parquet"
+)
+
+// FileSink writes the messages as newline-delimited JSON or Parquet files to a volume, in the directories partitioned
+// by the event time. The files are written with the ".inprogress" suffix, and finalized when the watermark of the
+// vertex passes the end of the partition.
+type FileSink struct {
+	// VolumeName is the name of the volume in the vertex "volumes" where the files are written, it's mounted to the
+	// main container of the vertex pods.
+	VolumeName string `json:"volumeName" protobuf:"bytes,1,opt,name=volumeName"`
+	// Path is the directory relative to the root of the volume where the files are written, defaults to the root.
+	// +optional
+	Path string `json:"path,omitempty" protobuf:"bytes,2,opt,name=path"`
+	// Compression of the files, "none", "gzip" or "zstd", defaults to "none". For Parquet files, it's the compression
+	// codec of the columns.
+	// +kubebuilder:default=none
+	// +optional
+	Compression FileCompression `json:"compression,omitempty" protobuf:"bytes,3,opt,name=compression,casttype=FileCompression"`
+	// PartitionDuration is the event time length of each partition, defaults to 1h.
+	// +optional
+	PartitionDuration *metav1.Duration `json:"partitionDuration,omitempty" protobuf:"bytes,4,opt,name=partitionDuration"`
+	// PartitionFormat is the Go time layout used to format the start time (in UTC) of a partition as its directory,
+	// defaults to "dt=2006-01-02/hr=15".
+	// +optional
+	PartitionFormat string `json:"partitionFormat,omitempty" protobuf:"bytes,5,opt,name=partitionFormat"`
+	// MaxFileSize is the size after which a file is rolled, defaults to 128Mi.
+	// +optional
+	MaxFileSize *apiresource.Quantity `json:"maxFileSize,omitempty" protobuf:"bytes,6,opt,name=maxFileSize"`
+	// RollInterval is the duration after which a file is rolled, defaults to 10m.
+	// +optional
+	RollInterval *metav1.Duration `json:"rollInterval,omitempty" protobuf:"bytes,7,opt,name=rollInterval"`
+	// Format of the files, "ndjson" or "parquet", defaults to "ndjson".
+	// +kubebuilder:default=ndjson
+	// +optional
+	Format FileSinkFormat `json:"format,omitempty" protobuf:"bytes,8,opt,name=format,casttype=FileSinkFormat"`
+	// Parquet options, used when the format is "parquet".
+	// +optional
+	Parquet *ParquetOptions `json:"parquet,omitempty" protobuf:"bytes,9,opt,name=parquet"`
+}
+
+// ParquetOptions is used to write the JSON messages as Parquet files. The top level fields of the messages are the
+// columns, the nested objects and arrays are written as JSON strings.
+type ParquetOptions struct {
+	// Schema is a JSON schema of the messages, whose top level properties are used as the columns.
+	// If not specified, the columns are inferred from the messages.
+	// +optional
+	Schema string `json:"schema,omitempty" protobuf:"bytes,1,opt,name=schema"`
+	// InferSchemaSampleSize is the number of messages used to infer the columns when the schema is not specified,
+	// defaults to 100.
+	// +optional
+	InferSchemaSampleSize *uint32 `json:"inferSchemaSampleSize,omitempty" protobuf:"varint,2,opt,name=inferSchemaSampleSize"`
+	// RowGroupSize is the size of the row groups, defaults to 64Mi.
+	// +optional
+	RowGroupSize *apiresource.Quantity `json:"rowGroupSize,omitempty" protobuf:"bytes,3,opt,name=rowGroupSize"`
+}
+
+func (po *ParquetOptions) GetInferSchemaSampleSize() int {
+	if po == nil || po.InferSchemaSampleSize == nil {
+		return 100
+	}
+	return int(*po.InferSchemaSampleSize)
+}
+
+func (po *ParquetOptions) GetRowGroupSize() int64 {
+	if po == nil || po.RowGroupSize == nil {
+		return 64 * 1024 * 1024
+	}
+	return po.RowGroupSize.Value()
+}
+
+func (fs FileSink) GetCompression() FileCompression {
+	if fs.Compression == "" {
+		return FileCompressionNone
+	}
+	return fs.Compression
+}
+
+func (fs FileSink) GetFormat() FileSinkFormat {
+	if fs.Format == "" {
+		return FileSinkFormatNDJSON
+	}
+	return fs.Format
+}
+
+func (fs FileSink) GetPartitionDuration() time.Duration {
+	if fs.PartitionDuration == nil {
+		return time.Hour
+	}
+	return fs.PartitionDuration.Duration
+}
+
+func (fs FileSink) GetPartitionFormat() string {
+	if fs.PartitionFormat == "" {
+		return "dt=2006-01-02/hr=15"
+	}
+	return fs.PartitionFormat
+}
+
+func (fs FileSink) GetMaxFileSize() int64 {
+	if fs.MaxFileSize == nil {
+		return 128 * 1024 * 1024
+	}
+	return fs.MaxFileSize.Value()
+}
+
+func (fs FileSink) GetRollInterval() time.Duration {
+	if fs.RollInterval == nil {
+		return 10 * time.Minute
+	}
+	return fs.RollInterval.Duration
+}
+
+// GetFileExtension returns the extension of the files, e.g. ".ndjson.gz".
+func (fs FileSink) GetFileExtension() string {
+	if fs.GetFormat() == FileSinkFormatParquet {
+		return ".parquet"
+	}
+	switch fs.GetCompression() {
+	case FileCompressionGzip:
+		return ".ndjson.gz"
+	case FileCompressionZstd:
+		return ".ndjson.zst"
+	default:
+		return ".ndjson"
+	}
+}
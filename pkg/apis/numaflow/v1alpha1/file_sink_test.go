@@ -0,0 +1,64 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFileSink_Defaults(t *testing.T) {
+	fs := FileSink{}
+	assert.Equal(t, FileCompressionNone, fs.GetCompression())
+	assert.Equal(t, time.Hour, fs.GetPartitionDuration())
+	assert.Equal(t, "dt=2006-01-02/hr=15", fs.GetPartitionFormat())
+	assert.Equal(t, int64(128*1024*1024), fs.GetMaxFileSize())
+	assert.Equal(t, 10*time.Minute, fs.GetRollInterval())
+	assert.Equal(t, ".ndjson", fs.GetFileExtension())
+	assert.Equal(t, FileSinkFormatNDJSON, fs.GetFormat())
+	assert.Equal(t, 100, fs.Parquet.GetInferSchemaSampleSize())
+	assert.Equal(t, int64(64*1024*1024), fs.Parquet.GetRowGroupSize())
+}
+
+func TestFileSink_Getters(t *testing.T) {
+	size := apiresource.MustParse("1Mi")
+	fs := FileSink{
+		Compression:       FileCompressionZstd,
+		PartitionDuration: &metav1.Duration{Duration: time.Minute},
+		PartitionFormat:   "2006/01/02",
+		MaxFileSize:       &size,
+		RollInterval:      &metav1.Duration{Duration: time.Second},
+	}
+	assert.Equal(t, time.Minute, fs.GetPartitionDuration())
+	assert.Equal(t, "2006/01/02", fs.GetPartitionFormat())
+	assert.Equal(t, int64(1024*1024), fs.GetMaxFileSize())
+	assert.Equal(t, time.Second, fs.GetRollInterval())
+	assert.Equal(t, ".ndjson.zst", fs.GetFileExtension())
+	fs.Compression = FileCompressionGzip
+	assert.Equal(t, ".ndjson.gz", fs.GetFileExtension())
+	fs.Format = FileSinkFormatParquet
+	assert.Equal(t, ".parquet", fs.GetFileExtension())
+	sampleSize := uint32(10)
+	rowGroupSize := apiresource.MustParse("1Mi")
+	fs.Parquet = &ParquetOptions{InferSchemaSampleSize: &sampleSize, RowGroupSize: &rowGroupSize}
+	assert.Equal(t, 10, fs.Parquet.GetInferSchemaSampleSize())
+	assert.Equal(t, int64(1024*1024), fs.Parquet.GetRowGroupSize())
+}
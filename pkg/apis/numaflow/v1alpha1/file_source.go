@@ -0,0 +1,95 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum=lines;json
+type FileFormat string
+
+const (
+	// FileFormatLines reads each line of the files as a message.
+	FileFormatLines FileFormat = "lines"
+	// FileFormatJSON reads each JSON value of the files as a message, the values could be newline-delimited or span multiple lines.
+	FileFormatJSON FileFormat = "json"
+)
+
+// FileSource reads the files matching a glob pattern from a volume.
+type FileSource struct {
+	// VolumeName is the name of the volume in the vertex "volumes" which contains the files, it's mounted to the
+	// main container of the vertex pods.
+	VolumeName string `json:"volumeName" protobuf:"bytes,1,opt,name=volumeName"`
+	// Path is a glob pattern of the files to read, relative to the root of the volume, e.g. "logs/*.log".
+	Path string `json:"path" protobuf:"bytes,2,opt,name=path"`
+	// Format of the files, either "lines" or "json", defaults to "lines".
+	// +kubebuilder:default=lines
+	// +optional
+	Format FileFormat `json:"format,omitempty" protobuf:"bytes,3,opt,name=format,casttype=FileFormat"`
+	// Follow keeps watching the files for appended data, like "tail -f", defaults to true.
+	// If false, each file is read once.
+	// +optional
+	Follow *bool `json:"follow,omitempty" protobuf:"varint,4,opt,name=follow"`
+	// PollInterval is the interval to look for new, rotated or appended files, defaults to 1s.
+	// +optional
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty" protobuf:"bytes,5,opt,name=pollInterval"`
+	// CheckpointPath is the path of the file, relative to the root of the volume, where the read offsets are persisted
+	// so that the reading resumes after restarts. Defaults to ".numaflow-{pipeline}-{vertex}.checkpoint" in the volume.
+	// +optional
+	CheckpointPath string `json:"checkpointPath,omitempty" protobuf:"bytes,6,opt,name=checkpointPath"`
+	// EventTime extracts the event time from the messages, the modification time of the file is used if not specified.
+	// +optional
+	EventTime *FileEventTime `json:"eventTime,omitempty" protobuf:"bytes,7,opt,name=eventTime"`
+}
+
+// FileEventTime is used to extract the event time from the messages.
+type FileEventTime struct {
+	// Expression to extract the event time string from the message payload, e.g. `json(payload).metadata.time`.
+	Expression string `json:"expression" protobuf:"bytes,1,opt,name=expression"`
+	// Format is the layout of the event time string, the format is detected automatically if not specified.
+	// +optional
+	Format string `json:"format,omitempty" protobuf:"bytes,2,opt,name=format"`
+}
+
+func (fs FileSource) GetFormat() FileFormat {
+	if fs.Format == "" {
+		return FileFormatLines
+	}
+	return fs.Format
+}
+
+func (fs FileSource) IsFollowing() bool {
+	return fs.Follow == nil || *fs.Follow
+}
+
+func (fs FileSource) GetPollInterval() time.Duration {
+	if fs.PollInterval == nil {
+		return time.Second
+	}
+	return fs.PollInterval.Duration
+}
+
+// GetCheckpointPath returns the path of the checkpoint file, relative to the root of the volume.
+func (fs FileSource) GetCheckpointPath(pipelineName, vertexName string) string {
+	if fs.CheckpointPath != "" {
+		return fs.CheckpointPath
+	}
+	return ".numaflow-" + pipelineName + "-" + vertexName + ".checkpoint"
+}
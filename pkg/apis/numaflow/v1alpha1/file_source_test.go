@@ -0,0 +1,47 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFileSource_Defaults(t *testing.T) {
+	fs := FileSource{}
+	assert.Equal(t, FileFormatLines, fs.GetFormat())
+	assert.True(t, fs.IsFollowing())
+	assert.Equal(t, time.Second, fs.GetPollInterval())
+	assert.Equal(t, ".numaflow-p-v.checkpoint", fs.GetCheckpointPath("p", "v"))
+}
+
+func TestFileSource_Getters(t *testing.T) {
+	follow := false
+	fs := FileSource{
+		Format:         FileFormatJSON,
+		Follow:         &follow,
+		PollInterval:   &metav1.Duration{Duration: 5 * time.Second},
+		CheckpointPath: "state/checkpoint",
+	}
+	assert.Equal(t, FileFormatJSON, fs.GetFormat())
+	assert.False(t, fs.IsFollowing())
+	assert.Equal(t, 5*time.Second, fs.GetPollInterval())
+	assert.Equal(t, "state/checkpoint", fs.GetCheckpointPath("p", "v"))
+}
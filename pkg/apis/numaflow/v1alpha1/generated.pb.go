@@ -76,10 +76,38 @@ func (m *AbstractPodTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AbstractPodTemplate proto.InternalMessageInfo
 
+func (m *AbstractSink) Reset()      { *m = AbstractSink{} }
+func (*AbstractSink) ProtoMessage() {}
+func (*AbstractSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{1}
+}
+func (m *AbstractSink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AbstractSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AbstractSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbstractSink.Merge(m, src)
+}
+func (m *AbstractSink) XXX_Size() int {
+	return m.Size()
+}
+func (m *AbstractSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbstractSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbstractSink proto.InternalMessageInfo
+
 func (m *AbstractVertex) Reset()      { *m = AbstractVertex{} }
 func (*AbstractVertex) ProtoMessage() {}
 func (*AbstractVertex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{1}
+	return fileDescriptor_9d0d1b17d3865563, []int{2}
 }
 func (m *AbstractVertex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -107,7 +135,7 @@ var xxx_messageInfo_AbstractVertex proto.InternalMessageInfo
 func (m *Authorization) Reset()      { *m = Authorization{} }
 func (*Authorization) ProtoMessage() {}
 func (*Authorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{2}
+	return fileDescriptor_9d0d1b17d3865563, []int{3}
 }
 func (m *Authorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -135,7 +163,7 @@ var xxx_messageInfo_Authorization proto.InternalMessageInfo
 func (m *BasicAuth) Reset()      { *m = BasicAuth{} }
 func (*BasicAuth) ProtoMessage() {}
 func (*BasicAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{3}
+	return fileDescriptor_9d0d1b17d3865563, []int{4}
 }
 func (m *BasicAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -163,7 +191,7 @@ var xxx_messageInfo_BasicAuth proto.InternalMessageInfo
 func (m *Blackhole) Reset()      { *m = Blackhole{} }
 func (*Blackhole) ProtoMessage() {}
 func (*Blackhole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{4}
+	return fileDescriptor_9d0d1b17d3865563, []int{5}
 }
 func (m *Blackhole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +219,7 @@ var xxx_messageInfo_Blackhole proto.InternalMessageInfo
 func (m *BufferServiceConfig) Reset()      { *m = BufferServiceConfig{} }
 func (*BufferServiceConfig) ProtoMessage() {}
 func (*BufferServiceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{5}
+	return fileDescriptor_9d0d1b17d3865563, []int{6}
 }
 func (m *BufferServiceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -216,10 +244,38 @@ func (m *BufferServiceConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BufferServiceConfig proto.InternalMessageInfo
 
+func (m *CircuitBreaker) Reset()      { *m = CircuitBreaker{} }
+func (*CircuitBreaker) ProtoMessage() {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{7}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
 func (m *CombinedEdge) Reset()      { *m = CombinedEdge{} }
 func (*CombinedEdge) ProtoMessage() {}
 func (*CombinedEdge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{6}
+	return fileDescriptor_9d0d1b17d3865563, []int{8}
 }
 func (m *CombinedEdge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -247,7 +303,7 @@ var xxx_messageInfo_CombinedEdge proto.InternalMessageInfo
 func (m *Container) Reset()      { *m = Container{} }
 func (*Container) ProtoMessage() {}
 func (*Container) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{7}
+	return fileDescriptor_9d0d1b17d3865563, []int{9}
 }
 func (m *Container) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +331,7 @@ var xxx_messageInfo_Container proto.InternalMessageInfo
 func (m *ContainerTemplate) Reset()      { *m = ContainerTemplate{} }
 func (*ContainerTemplate) ProtoMessage() {}
 func (*ContainerTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{8}
+	return fileDescriptor_9d0d1b17d3865563, []int{10}
 }
 func (m *ContainerTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -303,7 +359,7 @@ var xxx_messageInfo_ContainerTemplate proto.InternalMessageInfo
 func (m *DaemonTemplate) Reset()      { *m = DaemonTemplate{} }
 func (*DaemonTemplate) ProtoMessage() {}
 func (*DaemonTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{9}
+	return fileDescriptor_9d0d1b17d3865563, []int{11}
 }
 func (m *DaemonTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -331,7 +387,7 @@ var xxx_messageInfo_DaemonTemplate proto.InternalMessageInfo
 func (m *Edge) Reset()      { *m = Edge{} }
 func (*Edge) ProtoMessage() {}
 func (*Edge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{10}
+	return fileDescriptor_9d0d1b17d3865563, []int{12}
 }
 func (m *Edge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -356,10 +412,94 @@ func (m *Edge) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Edge proto.InternalMessageInfo
 
+func (m *FileEventTime) Reset()      { *m = FileEventTime{} }
+func (*FileEventTime) ProtoMessage() {}
+func (*FileEventTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{13}
+}
+func (m *FileEventTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FileEventTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FileEventTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileEventTime.Merge(m, src)
+}
+func (m *FileEventTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *FileEventTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileEventTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileEventTime proto.InternalMessageInfo
+
+func (m *FileSink) Reset()      { *m = FileSink{} }
+func (*FileSink) ProtoMessage() {}
+func (*FileSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{14}
+}
+func (m *FileSink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FileSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FileSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileSink.Merge(m, src)
+}
+func (m *FileSink) XXX_Size() int {
+	return m.Size()
+}
+func (m *FileSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileSink proto.InternalMessageInfo
+
+func (m *FileSource) Reset()      { *m = FileSource{} }
+func (*FileSource) ProtoMessage() {}
+func (*FileSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{15}
+}
+func (m *FileSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FileSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FileSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileSource.Merge(m, src)
+}
+func (m *FileSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *FileSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileSource proto.InternalMessageInfo
+
 func (m *FixedWindow) Reset()      { *m = FixedWindow{} }
 func (*FixedWindow) ProtoMessage() {}
 func (*FixedWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{11}
+	return fileDescriptor_9d0d1b17d3865563, []int{16}
 }
 func (m *FixedWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,7 +527,7 @@ var xxx_messageInfo_FixedWindow proto.InternalMessageInfo
 func (m *ForwardConditions) Reset()      { *m = ForwardConditions{} }
 func (*ForwardConditions) ProtoMessage() {}
 func (*ForwardConditions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{12}
+	return fileDescriptor_9d0d1b17d3865563, []int{17}
 }
 func (m *ForwardConditions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,7 +555,7 @@ var xxx_messageInfo_ForwardConditions proto.InternalMessageInfo
 func (m *Function) Reset()      { *m = Function{} }
 func (*Function) ProtoMessage() {}
 func (*Function) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{13}
+	return fileDescriptor_9d0d1b17d3865563, []int{18}
 }
 func (m *Function) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -440,10 +580,38 @@ func (m *Function) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Function proto.InternalMessageInfo
 
+func (m *GRPCSource) Reset()      { *m = GRPCSource{} }
+func (*GRPCSource) ProtoMessage() {}
+func (*GRPCSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{19}
+}
+func (m *GRPCSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GRPCSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GRPCSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GRPCSource.Merge(m, src)
+}
+func (m *GRPCSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *GRPCSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_GRPCSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GRPCSource proto.InternalMessageInfo
+
 func (m *GSSAPI) Reset()      { *m = GSSAPI{} }
 func (*GSSAPI) ProtoMessage() {}
 func (*GSSAPI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{14}
+	return fileDescriptor_9d0d1b17d3865563, []int{20}
 }
 func (m *GSSAPI) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -471,7 +639,7 @@ var xxx_messageInfo_GSSAPI proto.InternalMessageInfo
 func (m *GeneratorSource) Reset()      { *m = GeneratorSource{} }
 func (*GeneratorSource) ProtoMessage() {}
 func (*GeneratorSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{15}
+	return fileDescriptor_9d0d1b17d3865563, []int{21}
 }
 func (m *GeneratorSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,7 +667,7 @@ var xxx_messageInfo_GeneratorSource proto.InternalMessageInfo
 func (m *GetDaemonDeploymentReq) Reset()      { *m = GetDaemonDeploymentReq{} }
 func (*GetDaemonDeploymentReq) ProtoMessage() {}
 func (*GetDaemonDeploymentReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{16}
+	return fileDescriptor_9d0d1b17d3865563, []int{22}
 }
 func (m *GetDaemonDeploymentReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,7 +695,7 @@ var xxx_messageInfo_GetDaemonDeploymentReq proto.InternalMessageInfo
 func (m *GetJetStreamServiceSpecReq) Reset()      { *m = GetJetStreamServiceSpecReq{} }
 func (*GetJetStreamServiceSpecReq) ProtoMessage() {}
 func (*GetJetStreamServiceSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{17}
+	return fileDescriptor_9d0d1b17d3865563, []int{23}
 }
 func (m *GetJetStreamServiceSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,7 +723,7 @@ var xxx_messageInfo_GetJetStreamServiceSpecReq proto.InternalMessageInfo
 func (m *GetJetStreamStatefulSetSpecReq) Reset()      { *m = GetJetStreamStatefulSetSpecReq{} }
 func (*GetJetStreamStatefulSetSpecReq) ProtoMessage() {}
 func (*GetJetStreamStatefulSetSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{18}
+	return fileDescriptor_9d0d1b17d3865563, []int{24}
 }
 func (m *GetJetStreamStatefulSetSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,7 +751,7 @@ var xxx_messageInfo_GetJetStreamStatefulSetSpecReq proto.InternalMessageInfo
 func (m *GetRedisServiceSpecReq) Reset()      { *m = GetRedisServiceSpecReq{} }
 func (*GetRedisServiceSpecReq) ProtoMessage() {}
 func (*GetRedisServiceSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{19}
+	return fileDescriptor_9d0d1b17d3865563, []int{25}
 }
 func (m *GetRedisServiceSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +779,7 @@ var xxx_messageInfo_GetRedisServiceSpecReq proto.InternalMessageInfo
 func (m *GetRedisStatefulSetSpecReq) Reset()      { *m = GetRedisStatefulSetSpecReq{} }
 func (*GetRedisStatefulSetSpecReq) ProtoMessage() {}
 func (*GetRedisStatefulSetSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{20}
+	return fileDescriptor_9d0d1b17d3865563, []int{26}
 }
 func (m *GetRedisStatefulSetSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -636,10 +804,38 @@ func (m *GetRedisStatefulSetSpecReq) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetRedisStatefulSetSpecReq proto.InternalMessageInfo
 
+func (m *GetSideInputDeploymentReq) Reset()      { *m = GetSideInputDeploymentReq{} }
+func (*GetSideInputDeploymentReq) ProtoMessage() {}
+func (*GetSideInputDeploymentReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{27}
+}
+func (m *GetSideInputDeploymentReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSideInputDeploymentReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GetSideInputDeploymentReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSideInputDeploymentReq.Merge(m, src)
+}
+func (m *GetSideInputDeploymentReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSideInputDeploymentReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSideInputDeploymentReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSideInputDeploymentReq proto.InternalMessageInfo
+
 func (m *GetVertexPodSpecReq) Reset()      { *m = GetVertexPodSpecReq{} }
 func (*GetVertexPodSpecReq) ProtoMessage() {}
 func (*GetVertexPodSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{21}
+	return fileDescriptor_9d0d1b17d3865563, []int{28}
 }
 func (m *GetVertexPodSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,7 +863,7 @@ var xxx_messageInfo_GetVertexPodSpecReq proto.InternalMessageInfo
 func (m *GroupBy) Reset()      { *m = GroupBy{} }
 func (*GroupBy) ProtoMessage() {}
 func (*GroupBy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{22}
+	return fileDescriptor_9d0d1b17d3865563, []int{29}
 }
 func (m *GroupBy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -692,10 +888,38 @@ func (m *GroupBy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GroupBy proto.InternalMessageInfo
 
+func (m *HTTPSink) Reset()      { *m = HTTPSink{} }
+func (*HTTPSink) ProtoMessage() {}
+func (*HTTPSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{30}
+}
+func (m *HTTPSink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPSink.Merge(m, src)
+}
+func (m *HTTPSink) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPSink proto.InternalMessageInfo
+
 func (m *HTTPSource) Reset()      { *m = HTTPSource{} }
 func (*HTTPSource) ProtoMessage() {}
 func (*HTTPSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{23}
+	return fileDescriptor_9d0d1b17d3865563, []int{31}
 }
 func (m *HTTPSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -723,7 +947,7 @@ var xxx_messageInfo_HTTPSource proto.InternalMessageInfo
 func (m *InterStepBufferService) Reset()      { *m = InterStepBufferService{} }
 func (*InterStepBufferService) ProtoMessage() {}
 func (*InterStepBufferService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{24}
+	return fileDescriptor_9d0d1b17d3865563, []int{32}
 }
 func (m *InterStepBufferService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +975,7 @@ var xxx_messageInfo_InterStepBufferService proto.InternalMessageInfo
 func (m *InterStepBufferServiceList) Reset()      { *m = InterStepBufferServiceList{} }
 func (*InterStepBufferServiceList) ProtoMessage() {}
 func (*InterStepBufferServiceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{25}
+	return fileDescriptor_9d0d1b17d3865563, []int{33}
 }
 func (m *InterStepBufferServiceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -779,7 +1003,7 @@ var xxx_messageInfo_InterStepBufferServiceList proto.InternalMessageInfo
 func (m *InterStepBufferServiceSpec) Reset()      { *m = InterStepBufferServiceSpec{} }
 func (*InterStepBufferServiceSpec) ProtoMessage() {}
 func (*InterStepBufferServiceSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{26}
+	return fileDescriptor_9d0d1b17d3865563, []int{34}
 }
 func (m *InterStepBufferServiceSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -807,7 +1031,7 @@ var xxx_messageInfo_InterStepBufferServiceSpec proto.InternalMessageInfo
 func (m *InterStepBufferServiceStatus) Reset()      { *m = InterStepBufferServiceStatus{} }
 func (*InterStepBufferServiceStatus) ProtoMessage() {}
 func (*InterStepBufferServiceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{27}
+	return fileDescriptor_9d0d1b17d3865563, []int{35}
 }
 func (m *InterStepBufferServiceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +1059,7 @@ var xxx_messageInfo_InterStepBufferServiceStatus proto.InternalMessageInfo
 func (m *JetStreamBufferService) Reset()      { *m = JetStreamBufferService{} }
 func (*JetStreamBufferService) ProtoMessage() {}
 func (*JetStreamBufferService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{28}
+	return fileDescriptor_9d0d1b17d3865563, []int{36}
 }
 func (m *JetStreamBufferService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -863,7 +1087,7 @@ var xxx_messageInfo_JetStreamBufferService proto.InternalMessageInfo
 func (m *JetStreamConfig) Reset()      { *m = JetStreamConfig{} }
 func (*JetStreamConfig) ProtoMessage() {}
 func (*JetStreamConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{29}
+	return fileDescriptor_9d0d1b17d3865563, []int{37}
 }
 func (m *JetStreamConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,7 +1115,7 @@ var xxx_messageInfo_JetStreamConfig proto.InternalMessageInfo
 func (m *JobTemplate) Reset()      { *m = JobTemplate{} }
 func (*JobTemplate) ProtoMessage() {}
 func (*JobTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{30}
+	return fileDescriptor_9d0d1b17d3865563, []int{38}
 }
 func (m *JobTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -919,7 +1143,7 @@ var xxx_messageInfo_JobTemplate proto.InternalMessageInfo
 func (m *KafkaSink) Reset()      { *m = KafkaSink{} }
 func (*KafkaSink) ProtoMessage() {}
 func (*KafkaSink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{31}
+	return fileDescriptor_9d0d1b17d3865563, []int{39}
 }
 func (m *KafkaSink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -947,7 +1171,7 @@ var xxx_messageInfo_KafkaSink proto.InternalMessageInfo
 func (m *KafkaSource) Reset()      { *m = KafkaSource{} }
 func (*KafkaSource) ProtoMessage() {}
 func (*KafkaSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{32}
+	return fileDescriptor_9d0d1b17d3865563, []int{40}
 }
 func (m *KafkaSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -972,10 +1196,38 @@ func (m *KafkaSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KafkaSource proto.InternalMessageInfo
 
+func (m *KeyDistribution) Reset()      { *m = KeyDistribution{} }
+func (*KeyDistribution) ProtoMessage() {}
+func (*KeyDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{41}
+}
+func (m *KeyDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KeyDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyDistribution.Merge(m, src)
+}
+func (m *KeyDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyDistribution proto.InternalMessageInfo
+
 func (m *Lifecycle) Reset()      { *m = Lifecycle{} }
 func (*Lifecycle) ProtoMessage() {}
 func (*Lifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{33}
+	return fileDescriptor_9d0d1b17d3865563, []int{42}
 }
 func (m *Lifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1003,7 +1255,7 @@ var xxx_messageInfo_Lifecycle proto.InternalMessageInfo
 func (m *Log) Reset()      { *m = Log{} }
 func (*Log) ProtoMessage() {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{34}
+	return fileDescriptor_9d0d1b17d3865563, []int{43}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1031,7 +1283,7 @@ var xxx_messageInfo_Log proto.InternalMessageInfo
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{35}
+	return fileDescriptor_9d0d1b17d3865563, []int{44}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1059,7 +1311,7 @@ var xxx_messageInfo_Metadata proto.InternalMessageInfo
 func (m *NativeRedis) Reset()      { *m = NativeRedis{} }
 func (*NativeRedis) ProtoMessage() {}
 func (*NativeRedis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{36}
+	return fileDescriptor_9d0d1b17d3865563, []int{45}
 }
 func (m *NativeRedis) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,7 +1339,7 @@ var xxx_messageInfo_NativeRedis proto.InternalMessageInfo
 func (m *NatsAuth) Reset()      { *m = NatsAuth{} }
 func (*NatsAuth) ProtoMessage() {}
 func (*NatsAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{37}
+	return fileDescriptor_9d0d1b17d3865563, []int{46}
 }
 func (m *NatsAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1115,7 +1367,7 @@ var xxx_messageInfo_NatsAuth proto.InternalMessageInfo
 func (m *NatsSource) Reset()      { *m = NatsSource{} }
 func (*NatsSource) ProtoMessage() {}
 func (*NatsSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{38}
+	return fileDescriptor_9d0d1b17d3865563, []int{47}
 }
 func (m *NatsSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1143,7 +1395,7 @@ var xxx_messageInfo_NatsSource proto.InternalMessageInfo
 func (m *PBQStorage) Reset()      { *m = PBQStorage{} }
 func (*PBQStorage) ProtoMessage() {}
 func (*PBQStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{39}
+	return fileDescriptor_9d0d1b17d3865563, []int{48}
 }
 func (m *PBQStorage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1168,10 +1420,38 @@ func (m *PBQStorage) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PBQStorage proto.InternalMessageInfo
 
+func (m *ParquetOptions) Reset()      { *m = ParquetOptions{} }
+func (*ParquetOptions) ProtoMessage() {}
+func (*ParquetOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{49}
+}
+func (m *ParquetOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParquetOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ParquetOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParquetOptions.Merge(m, src)
+}
+func (m *ParquetOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParquetOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParquetOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParquetOptions proto.InternalMessageInfo
+
 func (m *PersistenceStrategy) Reset()      { *m = PersistenceStrategy{} }
 func (*PersistenceStrategy) ProtoMessage() {}
 func (*PersistenceStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{40}
+	return fileDescriptor_9d0d1b17d3865563, []int{50}
 }
 func (m *PersistenceStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1199,7 +1479,7 @@ var xxx_messageInfo_PersistenceStrategy proto.InternalMessageInfo
 func (m *Pipeline) Reset()      { *m = Pipeline{} }
 func (*Pipeline) ProtoMessage() {}
 func (*Pipeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{41}
+	return fileDescriptor_9d0d1b17d3865563, []int{51}
 }
 func (m *Pipeline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1227,7 +1507,7 @@ var xxx_messageInfo_Pipeline proto.InternalMessageInfo
 func (m *PipelineLimits) Reset()      { *m = PipelineLimits{} }
 func (*PipelineLimits) ProtoMessage() {}
 func (*PipelineLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{42}
+	return fileDescriptor_9d0d1b17d3865563, []int{52}
 }
 func (m *PipelineLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1255,7 +1535,7 @@ var xxx_messageInfo_PipelineLimits proto.InternalMessageInfo
 func (m *PipelineList) Reset()      { *m = PipelineList{} }
 func (*PipelineList) ProtoMessage() {}
 func (*PipelineList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{43}
+	return fileDescriptor_9d0d1b17d3865563, []int{53}
 }
 func (m *PipelineList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1283,7 +1563,7 @@ var xxx_messageInfo_PipelineList proto.InternalMessageInfo
 func (m *PipelineSpec) Reset()      { *m = PipelineSpec{} }
 func (*PipelineSpec) ProtoMessage() {}
 func (*PipelineSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{44}
+	return fileDescriptor_9d0d1b17d3865563, []int{54}
 }
 func (m *PipelineSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1311,7 +1591,7 @@ var xxx_messageInfo_PipelineSpec proto.InternalMessageInfo
 func (m *PipelineStatus) Reset()      { *m = PipelineStatus{} }
 func (*PipelineStatus) ProtoMessage() {}
 func (*PipelineStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{45}
+	return fileDescriptor_9d0d1b17d3865563, []int{55}
 }
 func (m *PipelineStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1339,7 +1619,7 @@ var xxx_messageInfo_PipelineStatus proto.InternalMessageInfo
 func (m *RedisBufferService) Reset()      { *m = RedisBufferService{} }
 func (*RedisBufferService) ProtoMessage() {}
 func (*RedisBufferService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{46}
+	return fileDescriptor_9d0d1b17d3865563, []int{56}
 }
 func (m *RedisBufferService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1367,7 +1647,7 @@ var xxx_messageInfo_RedisBufferService proto.InternalMessageInfo
 func (m *RedisConfig) Reset()      { *m = RedisConfig{} }
 func (*RedisConfig) ProtoMessage() {}
 func (*RedisConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{47}
+	return fileDescriptor_9d0d1b17d3865563, []int{57}
 }
 func (m *RedisConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1395,7 +1675,7 @@ var xxx_messageInfo_RedisConfig proto.InternalMessageInfo
 func (m *RedisSettings) Reset()      { *m = RedisSettings{} }
 func (*RedisSettings) ProtoMessage() {}
 func (*RedisSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{48}
+	return fileDescriptor_9d0d1b17d3865563, []int{58}
 }
 func (m *RedisSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1423,7 +1703,7 @@ var xxx_messageInfo_RedisSettings proto.InternalMessageInfo
 func (m *RedisStreamsSource) Reset()      { *m = RedisStreamsSource{} }
 func (*RedisStreamsSource) ProtoMessage() {}
 func (*RedisStreamsSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{49}
+	return fileDescriptor_9d0d1b17d3865563, []int{59}
 }
 func (m *RedisStreamsSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1448,10 +1728,66 @@ func (m *RedisStreamsSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RedisStreamsSource proto.InternalMessageInfo
 
+func (m *RemoteUDF) Reset()      { *m = RemoteUDF{} }
+func (*RemoteUDF) ProtoMessage() {}
+func (*RemoteUDF) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{60}
+}
+func (m *RemoteUDF) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteUDF) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RemoteUDF) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteUDF.Merge(m, src)
+}
+func (m *RemoteUDF) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteUDF) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteUDF.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteUDF proto.InternalMessageInfo
+
+func (m *S3Sink) Reset()      { *m = S3Sink{} }
+func (*S3Sink) ProtoMessage() {}
+func (*S3Sink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{61}
+}
+func (m *S3Sink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *S3Sink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *S3Sink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_S3Sink.Merge(m, src)
+}
+func (m *S3Sink) XXX_Size() int {
+	return m.Size()
+}
+func (m *S3Sink) XXX_DiscardUnknown() {
+	xxx_messageInfo_S3Sink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_S3Sink proto.InternalMessageInfo
+
 func (m *SASL) Reset()      { *m = SASL{} }
 func (*SASL) ProtoMessage() {}
 func (*SASL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{50}
+	return fileDescriptor_9d0d1b17d3865563, []int{62}
 }
 func (m *SASL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1479,7 +1815,7 @@ var xxx_messageInfo_SASL proto.InternalMessageInfo
 func (m *SASLPlain) Reset()      { *m = SASLPlain{} }
 func (*SASLPlain) ProtoMessage() {}
 func (*SASLPlain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{51}
+	return fileDescriptor_9d0d1b17d3865563, []int{63}
 }
 func (m *SASLPlain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1504,10 +1840,66 @@ func (m *SASLPlain) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SASLPlain proto.InternalMessageInfo
 
+func (m *SQLArg) Reset()      { *m = SQLArg{} }
+func (*SQLArg) ProtoMessage() {}
+func (*SQLArg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{64}
+}
+func (m *SQLArg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQLArg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SQLArg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLArg.Merge(m, src)
+}
+func (m *SQLArg) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLArg) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLArg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLArg proto.InternalMessageInfo
+
+func (m *SQLSink) Reset()      { *m = SQLSink{} }
+func (*SQLSink) ProtoMessage() {}
+func (*SQLSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{65}
+}
+func (m *SQLSink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQLSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SQLSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLSink.Merge(m, src)
+}
+func (m *SQLSink) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLSink proto.InternalMessageInfo
+
 func (m *Scale) Reset()      { *m = Scale{} }
 func (*Scale) ProtoMessage() {}
 func (*Scale) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{52}
+	return fileDescriptor_9d0d1b17d3865563, []int{66}
 }
 func (m *Scale) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1532,10 +1924,122 @@ func (m *Scale) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Scale proto.InternalMessageInfo
 
+func (m *SchemaRegistry) Reset()      { *m = SchemaRegistry{} }
+func (*SchemaRegistry) ProtoMessage() {}
+func (*SchemaRegistry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{67}
+}
+func (m *SchemaRegistry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchemaRegistry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SchemaRegistry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaRegistry.Merge(m, src)
+}
+func (m *SchemaRegistry) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchemaRegistry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaRegistry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchemaRegistry proto.InternalMessageInfo
+
+func (m *SideInput) Reset()      { *m = SideInput{} }
+func (*SideInput) ProtoMessage() {}
+func (*SideInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{68}
+}
+func (m *SideInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SideInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SideInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SideInput.Merge(m, src)
+}
+func (m *SideInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *SideInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_SideInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SideInput proto.InternalMessageInfo
+
+func (m *SideInputBuiltin) Reset()      { *m = SideInputBuiltin{} }
+func (*SideInputBuiltin) ProtoMessage() {}
+func (*SideInputBuiltin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{69}
+}
+func (m *SideInputBuiltin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SideInputBuiltin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SideInputBuiltin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SideInputBuiltin.Merge(m, src)
+}
+func (m *SideInputBuiltin) XXX_Size() int {
+	return m.Size()
+}
+func (m *SideInputBuiltin) XXX_DiscardUnknown() {
+	xxx_messageInfo_SideInputBuiltin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SideInputBuiltin proto.InternalMessageInfo
+
+func (m *SideInputTrigger) Reset()      { *m = SideInputTrigger{} }
+func (*SideInputTrigger) ProtoMessage() {}
+func (*SideInputTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{70}
+}
+func (m *SideInputTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SideInputTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SideInputTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SideInputTrigger.Merge(m, src)
+}
+func (m *SideInputTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *SideInputTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_SideInputTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SideInputTrigger proto.InternalMessageInfo
+
 func (m *Sink) Reset()      { *m = Sink{} }
 func (*Sink) ProtoMessage() {}
 func (*Sink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{53}
+	return fileDescriptor_9d0d1b17d3865563, []int{71}
 }
 func (m *Sink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1560,10 +2064,66 @@ func (m *Sink) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Sink proto.InternalMessageInfo
 
+func (m *SinkBatching) Reset()      { *m = SinkBatching{} }
+func (*SinkBatching) ProtoMessage() {}
+func (*SinkBatching) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{72}
+}
+func (m *SinkBatching) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SinkBatching) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SinkBatching) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SinkBatching.Merge(m, src)
+}
+func (m *SinkBatching) XXX_Size() int {
+	return m.Size()
+}
+func (m *SinkBatching) XXX_DiscardUnknown() {
+	xxx_messageInfo_SinkBatching.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SinkBatching proto.InternalMessageInfo
+
+func (m *SinkRateLimit) Reset()      { *m = SinkRateLimit{} }
+func (*SinkRateLimit) ProtoMessage() {}
+func (*SinkRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{73}
+}
+func (m *SinkRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SinkRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SinkRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SinkRateLimit.Merge(m, src)
+}
+func (m *SinkRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SinkRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SinkRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SinkRateLimit proto.InternalMessageInfo
+
 func (m *SlidingWindow) Reset()      { *m = SlidingWindow{} }
 func (*SlidingWindow) ProtoMessage() {}
 func (*SlidingWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{54}
+	return fileDescriptor_9d0d1b17d3865563, []int{74}
 }
 func (m *SlidingWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1591,7 +2151,7 @@ var xxx_messageInfo_SlidingWindow proto.InternalMessageInfo
 func (m *Source) Reset()      { *m = Source{} }
 func (*Source) ProtoMessage() {}
 func (*Source) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{55}
+	return fileDescriptor_9d0d1b17d3865563, []int{75}
 }
 func (m *Source) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1619,7 +2179,7 @@ var xxx_messageInfo_Source proto.InternalMessageInfo
 func (m *Status) Reset()      { *m = Status{} }
 func (*Status) ProtoMessage() {}
 func (*Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{56}
+	return fileDescriptor_9d0d1b17d3865563, []int{76}
 }
 func (m *Status) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1647,7 +2207,7 @@ var xxx_messageInfo_Status proto.InternalMessageInfo
 func (m *TLS) Reset()      { *m = TLS{} }
 func (*TLS) ProtoMessage() {}
 func (*TLS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{57}
+	return fileDescriptor_9d0d1b17d3865563, []int{77}
 }
 func (m *TLS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1675,7 +2235,7 @@ var xxx_messageInfo_TLS proto.InternalMessageInfo
 func (m *TagConditions) Reset()      { *m = TagConditions{} }
 func (*TagConditions) ProtoMessage() {}
 func (*TagConditions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{58}
+	return fileDescriptor_9d0d1b17d3865563, []int{78}
 }
 func (m *TagConditions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1703,7 +2263,7 @@ var xxx_messageInfo_TagConditions proto.InternalMessageInfo
 func (m *Templates) Reset()      { *m = Templates{} }
 func (*Templates) ProtoMessage() {}
 func (*Templates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{59}
+	return fileDescriptor_9d0d1b17d3865563, []int{79}
 }
 func (m *Templates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1731,7 +2291,7 @@ var xxx_messageInfo_Templates proto.InternalMessageInfo
 func (m *Transformer) Reset()      { *m = Transformer{} }
 func (*Transformer) ProtoMessage() {}
 func (*Transformer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{60}
+	return fileDescriptor_9d0d1b17d3865563, []int{80}
 }
 func (m *Transformer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1759,7 +2319,7 @@ var xxx_messageInfo_Transformer proto.InternalMessageInfo
 func (m *UDF) Reset()      { *m = UDF{} }
 func (*UDF) ProtoMessage() {}
 func (*UDF) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{61}
+	return fileDescriptor_9d0d1b17d3865563, []int{81}
 }
 func (m *UDF) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1787,7 +2347,7 @@ var xxx_messageInfo_UDF proto.InternalMessageInfo
 func (m *UDSink) Reset()      { *m = UDSink{} }
 func (*UDSink) ProtoMessage() {}
 func (*UDSink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{62}
+	return fileDescriptor_9d0d1b17d3865563, []int{82}
 }
 func (m *UDSink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1815,7 +2375,7 @@ var xxx_messageInfo_UDSink proto.InternalMessageInfo
 func (m *UDTransformer) Reset()      { *m = UDTransformer{} }
 func (*UDTransformer) ProtoMessage() {}
 func (*UDTransformer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{63}
+	return fileDescriptor_9d0d1b17d3865563, []int{83}
 }
 func (m *UDTransformer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1843,7 +2403,7 @@ var xxx_messageInfo_UDTransformer proto.InternalMessageInfo
 func (m *Vertex) Reset()      { *m = Vertex{} }
 func (*Vertex) ProtoMessage() {}
 func (*Vertex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{64}
+	return fileDescriptor_9d0d1b17d3865563, []int{84}
 }
 func (m *Vertex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1871,7 +2431,7 @@ var xxx_messageInfo_Vertex proto.InternalMessageInfo
 func (m *VertexInstance) Reset()      { *m = VertexInstance{} }
 func (*VertexInstance) ProtoMessage() {}
 func (*VertexInstance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{65}
+	return fileDescriptor_9d0d1b17d3865563, []int{85}
 }
 func (m *VertexInstance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1899,7 +2459,7 @@ var xxx_messageInfo_VertexInstance proto.InternalMessageInfo
 func (m *VertexLimits) Reset()      { *m = VertexLimits{} }
 func (*VertexLimits) ProtoMessage() {}
 func (*VertexLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{66}
+	return fileDescriptor_9d0d1b17d3865563, []int{86}
 }
 func (m *VertexLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1927,7 +2487,7 @@ var xxx_messageInfo_VertexLimits proto.InternalMessageInfo
 func (m *VertexList) Reset()      { *m = VertexList{} }
 func (*VertexList) ProtoMessage() {}
 func (*VertexList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{67}
+	return fileDescriptor_9d0d1b17d3865563, []int{87}
 }
 func (m *VertexList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1955,7 +2515,7 @@ var xxx_messageInfo_VertexList proto.InternalMessageInfo
 func (m *VertexSpec) Reset()      { *m = VertexSpec{} }
 func (*VertexSpec) ProtoMessage() {}
 func (*VertexSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{68}
+	return fileDescriptor_9d0d1b17d3865563, []int{88}
 }
 func (m *VertexSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1983,7 +2543,7 @@ var xxx_messageInfo_VertexSpec proto.InternalMessageInfo
 func (m *VertexStatus) Reset()      { *m = VertexStatus{} }
 func (*VertexStatus) ProtoMessage() {}
 func (*VertexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{69}
+	return fileDescriptor_9d0d1b17d3865563, []int{89}
 }
 func (m *VertexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2008,10 +2568,38 @@ func (m *VertexStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VertexStatus proto.InternalMessageInfo
 
+func (m *Wasm) Reset()      { *m = Wasm{} }
+func (*Wasm) ProtoMessage() {}
+func (*Wasm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{90}
+}
+func (m *Wasm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Wasm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Wasm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Wasm.Merge(m, src)
+}
+func (m *Wasm) XXX_Size() int {
+	return m.Size()
+}
+func (m *Wasm) XXX_DiscardUnknown() {
+	xxx_messageInfo_Wasm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Wasm proto.InternalMessageInfo
+
 func (m *Watermark) Reset()      { *m = Watermark{} }
 func (*Watermark) ProtoMessage() {}
 func (*Watermark) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{70}
+	return fileDescriptor_9d0d1b17d3865563, []int{91}
 }
 func (m *Watermark) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2039,7 +2627,7 @@ var xxx_messageInfo_Watermark proto.InternalMessageInfo
 func (m *Window) Reset()      { *m = Window{} }
 func (*Window) ProtoMessage() {}
 func (*Window) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{71}
+	return fileDescriptor_9d0d1b17d3865563, []int{92}
 }
 func (m *Window) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2067,20 +2655,26 @@ var xxx_messageInfo_Window proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*AbstractPodTemplate)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.AbstractPodTemplate")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.AbstractPodTemplate.NodeSelectorEntry")
+	proto.RegisterType((*AbstractSink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.AbstractSink")
 	proto.RegisterType((*AbstractVertex)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.AbstractVertex")
 	proto.RegisterType((*Authorization)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Authorization")
 	proto.RegisterType((*BasicAuth)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.BasicAuth")
 	proto.RegisterType((*Blackhole)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Blackhole")
 	proto.RegisterType((*BufferServiceConfig)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.BufferServiceConfig")
+	proto.RegisterType((*CircuitBreaker)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.CircuitBreaker")
 	proto.RegisterType((*CombinedEdge)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.CombinedEdge")
 	proto.RegisterType((*Container)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Container")
 	proto.RegisterType((*ContainerTemplate)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ContainerTemplate")
 	proto.RegisterType((*DaemonTemplate)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.DaemonTemplate")
 	proto.RegisterType((*Edge)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Edge")
+	proto.RegisterType((*FileEventTime)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.FileEventTime")
+	proto.RegisterType((*FileSink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.FileSink")
+	proto.RegisterType((*FileSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.FileSource")
 	proto.RegisterType((*FixedWindow)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.FixedWindow")
 	proto.RegisterType((*ForwardConditions)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ForwardConditions")
 	proto.RegisterType((*Function)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Function")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Function.KwargsEntry")
+	proto.RegisterType((*GRPCSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GRPCSource")
 	proto.RegisterType((*GSSAPI)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GSSAPI")
 	proto.RegisterType((*GeneratorSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GeneratorSource")
 	proto.RegisterType((*GetDaemonDeploymentReq)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetDaemonDeploymentReq")
@@ -2092,8 +2686,11 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetRedisServiceSpecReq.LabelsEntry")
 	proto.RegisterType((*GetRedisStatefulSetSpecReq)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetRedisStatefulSetSpecReq")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetRedisStatefulSetSpecReq.LabelsEntry")
+	proto.RegisterType((*GetSideInputDeploymentReq)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetSideInputDeploymentReq")
 	proto.RegisterType((*GetVertexPodSpecReq)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetVertexPodSpecReq")
 	proto.RegisterType((*GroupBy)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GroupBy")
+	proto.RegisterType((*HTTPSink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.HTTPSink")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.HTTPSink.HeadersEntry")
 	proto.RegisterType((*HTTPSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.HTTPSource")
 	proto.RegisterType((*InterStepBufferService)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.InterStepBufferService")
 	proto.RegisterType((*InterStepBufferServiceList)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.InterStepBufferServiceList")
@@ -2104,6 +2701,7 @@ func init() {
 	proto.RegisterType((*JobTemplate)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.JobTemplate")
 	proto.RegisterType((*KafkaSink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.KafkaSink")
 	proto.RegisterType((*KafkaSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.KafkaSource")
+	proto.RegisterType((*KeyDistribution)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.KeyDistribution")
 	proto.RegisterType((*Lifecycle)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Lifecycle")
 	proto.RegisterType((*Log)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Log")
 	proto.RegisterType((*Metadata)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Metadata")
@@ -2113,6 +2711,7 @@ func init() {
 	proto.RegisterType((*NatsAuth)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.NatsAuth")
 	proto.RegisterType((*NatsSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.NatsSource")
 	proto.RegisterType((*PBQStorage)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PBQStorage")
+	proto.RegisterType((*ParquetOptions)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ParquetOptions")
 	proto.RegisterType((*PersistenceStrategy)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PersistenceStrategy")
 	proto.RegisterType((*Pipeline)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Pipeline")
 	proto.RegisterType((*PipelineLimits)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PipelineLimits")
@@ -2123,10 +2722,21 @@ func init() {
 	proto.RegisterType((*RedisConfig)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.RedisConfig")
 	proto.RegisterType((*RedisSettings)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.RedisSettings")
 	proto.RegisterType((*RedisStreamsSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.RedisStreamsSource")
+	proto.RegisterType((*RemoteUDF)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.RemoteUDF")
+	proto.RegisterType((*S3Sink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.S3Sink")
 	proto.RegisterType((*SASL)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SASL")
 	proto.RegisterType((*SASLPlain)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SASLPlain")
+	proto.RegisterType((*SQLArg)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SQLArg")
+	proto.RegisterType((*SQLSink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SQLSink")
 	proto.RegisterType((*Scale)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Scale")
+	proto.RegisterType((*SchemaRegistry)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SchemaRegistry")
+	proto.RegisterType((*SideInput)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SideInput")
+	proto.RegisterType((*SideInputBuiltin)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SideInputBuiltin")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SideInputBuiltin.KwargsEntry")
+	proto.RegisterType((*SideInputTrigger)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SideInputTrigger")
 	proto.RegisterType((*Sink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Sink")
+	proto.RegisterType((*SinkBatching)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SinkBatching")
+	proto.RegisterType((*SinkRateLimit)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SinkRateLimit")
 	proto.RegisterType((*SlidingWindow)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SlidingWindow")
 	proto.RegisterType((*Source)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Source")
 	proto.RegisterType((*Status)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Status")
@@ -2144,6 +2754,7 @@ func init() {
 	proto.RegisterType((*VertexList)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.VertexList")
 	proto.RegisterType((*VertexSpec)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.VertexSpec")
 	proto.RegisterType((*VertexStatus)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.VertexStatus")
+	proto.RegisterType((*Wasm)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Wasm")
 	proto.RegisterType((*Watermark)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Watermark")
 	proto.RegisterType((*Window)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Window")
 }
@@ -2153,401 +2764,534 @@ func init() {
 }
 
 var fileDescriptor_9d0d1b17d3865563 = []byte{
-	// 6292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x5d, 0x8c, 0x5c, 0xc9,
-	0x55, 0xff, 0xf6, 0xe7, 0x74, 0x9f, 0x9e, 0x19, 0xdb, 0xe5, 0x5d, 0xef, 0x78, 0xe2, 0xf5, 0x38,
-	0x37, 0xff, 0xdd, 0xbf, 0xf3, 0xff, 0x27, 0x63, 0xd6, 0x6c, 0xc8, 0x06, 0x48, 0x76, 0xa7, 0x67,
-	0x3c, 0x5e, 0xaf, 0x67, 0xec, 0xc9, 0xe9, 0x19, 0x7b, 0x93, 0x85, 0x2c, 0x35, 0xb7, 0x6b, 0x7a,
-	0xee, 0xf6, 0xed, 0x7b, 0x3b, 0xf7, 0x56, 0x8f, 0x3d, 0x0b, 0x11, 0x09, 0x79, 0xd8, 0x44, 0x44,
-	0x04, 0x09, 0x21, 0x45, 0xa0, 0x20, 0x21, 0x21, 0xf1, 0x80, 0x22, 0x21, 0x41, 0x78, 0x00, 0x21,
-	0xe0, 0x05, 0x05, 0x1e, 0x20, 0x0f, 0x48, 0x04, 0x81, 0x46, 0x64, 0x78, 0xe2, 0x01, 0x14, 0x11,
-	0x09, 0x45, 0x16, 0x12, 0xa8, 0xbe, 0xee, 0x57, 0xdf, 0xb6, 0x3d, 0xdd, 0x33, 0x1b, 0x47, 0xbc,
-	0xf5, 0xad, 0x73, 0xea, 0x77, 0xea, 0xd6, 0xad, 0x3a, 0x75, 0x3e, 0xaa, 0xaa, 0xe1, 0x7a, 0xc7,
-	0xe1, 0xbb, 0x83, 0xed, 0x45, 0xdb, 0xef, 0x5d, 0xf1, 0x06, 0x3d, 0xda, 0x0f, 0xfc, 0xb7, 0xe5,
-	0x8f, 0x1d, 0xd7, 0xbf, 0x77, 0xa5, 0xdf, 0xed, 0x5c, 0xa1, 0x7d, 0x27, 0x8c, 0x4b, 0xf6, 0x5e,
-	0xa4, 0x6e, 0x7f, 0x97, 0xbe, 0x78, 0xa5, 0xc3, 0x3c, 0x16, 0x50, 0xce, 0xda, 0x8b, 0xfd, 0xc0,
-	0xe7, 0x3e, 0xf9, 0x68, 0x0c, 0xb4, 0x68, 0x80, 0x16, 0x4d, 0xb5, 0xc5, 0x7e, 0xb7, 0xb3, 0x28,
-	0x80, 0xe2, 0x12, 0x03, 0x34, 0xff, 0xe1, 0x44, 0x0b, 0x3a, 0x7e, 0xc7, 0xbf, 0x22, 0xf1, 0xb6,
-	0x07, 0x3b, 0xf2, 0x49, 0x3e, 0xc8, 0x5f, 0x4a, 0xce, 0xbc, 0xd5, 0x7d, 0x39, 0x5c, 0x74, 0x7c,
-	0xd1, 0xac, 0x2b, 0xb6, 0x1f, 0xb0, 0x2b, 0x7b, 0x43, 0x6d, 0x99, 0x7f, 0x29, 0xe6, 0xe9, 0x51,
-	0x7b, 0xd7, 0xf1, 0x58, 0xb0, 0x6f, 0xde, 0xe5, 0x4a, 0xc0, 0x42, 0x7f, 0x10, 0xd8, 0xec, 0x48,
-	0xb5, 0xc2, 0x2b, 0x3d, 0xc6, 0x69, 0x9e, 0xac, 0x2b, 0xa3, 0x6a, 0x05, 0x03, 0x8f, 0x3b, 0xbd,
-	0x61, 0x31, 0x3f, 0xf1, 0xa8, 0x0a, 0xa1, 0xbd, 0xcb, 0x7a, 0x34, 0x5b, 0xcf, 0xfa, 0xc7, 0x3a,
-	0x9c, 0x5d, 0xda, 0x0e, 0x79, 0x40, 0x6d, 0xbe, 0xe1, 0xb7, 0x37, 0x59, 0xaf, 0xef, 0x52, 0xce,
-	0x48, 0x17, 0x6a, 0xa2, 0x6d, 0x6d, 0xca, 0xe9, 0x5c, 0xe1, 0x52, 0xe1, 0x72, 0xe3, 0xea, 0xd2,
-	0xe2, 0x98, 0xdf, 0x62, 0x71, 0x5d, 0x03, 0x35, 0xa7, 0x0f, 0x0f, 0x16, 0x6a, 0xe6, 0x09, 0x23,
-	0x01, 0xe4, 0x6b, 0x05, 0x98, 0xf6, 0xfc, 0x36, 0x6b, 0x31, 0x97, 0xd9, 0xdc, 0x0f, 0xe6, 0x8a,
-	0x97, 0x4a, 0x97, 0x1b, 0x57, 0x3f, 0x33, 0xb6, 0xc4, 0x9c, 0x37, 0x5a, 0xbc, 0x95, 0x10, 0x70,
-	0xcd, 0xe3, 0xc1, 0x7e, 0xf3, 0xe9, 0x6f, 0x1d, 0x2c, 0x3c, 0x75, 0x78, 0xb0, 0x30, 0x9d, 0x24,
-	0x61, 0xaa, 0x25, 0x64, 0x0b, 0x1a, 0xdc, 0x77, 0x45, 0x97, 0x39, 0xbe, 0x17, 0xce, 0x95, 0x64,
-	0xc3, 0x2e, 0x2e, 0xaa, 0xde, 0x16, 0xe2, 0x17, 0xc5, 0x70, 0x59, 0xdc, 0x7b, 0x71, 0x71, 0x33,
-	0x62, 0x6b, 0x9e, 0xd5, 0xc0, 0x8d, 0xb8, 0x2c, 0xc4, 0x24, 0x0e, 0x61, 0x70, 0x2a, 0x64, 0xf6,
-	0x20, 0x70, 0xf8, 0xfe, 0xb2, 0xef, 0x71, 0x76, 0x9f, 0xcf, 0x95, 0x65, 0x2f, 0xbf, 0x90, 0x07,
-	0xbd, 0xe1, 0xb7, 0x5b, 0x69, 0xee, 0xe6, 0xd9, 0xc3, 0x83, 0x85, 0x53, 0x99, 0x42, 0xcc, 0x62,
-	0x12, 0x0f, 0x4e, 0x3b, 0x3d, 0xda, 0x61, 0x1b, 0x03, 0xd7, 0x6d, 0x31, 0x3b, 0x60, 0x3c, 0x9c,
-	0xab, 0xc8, 0x57, 0xb8, 0x9c, 0x27, 0x67, 0xcd, 0xb7, 0xa9, 0x7b, 0x7b, 0xfb, 0x6d, 0x66, 0x73,
-	0x64, 0x3b, 0x2c, 0x60, 0x9e, 0xcd, 0x9a, 0x73, 0xfa, 0x65, 0x4e, 0xdf, 0xc8, 0x20, 0xe1, 0x10,
-	0x36, 0xb9, 0x0e, 0x67, 0xfa, 0x81, 0xe3, 0xcb, 0x26, 0xb8, 0x34, 0x0c, 0x6f, 0xd1, 0x1e, 0x9b,
-	0xab, 0x5e, 0x2a, 0x5c, 0xae, 0x37, 0xcf, 0x6b, 0x98, 0x33, 0x1b, 0x59, 0x06, 0x1c, 0xae, 0x43,
-	0x2e, 0x43, 0xcd, 0x14, 0xce, 0x4d, 0x5d, 0x2a, 0x5c, 0xae, 0xa8, 0xb1, 0x63, 0xea, 0x62, 0x44,
-	0x25, 0xab, 0x50, 0xa3, 0x3b, 0x3b, 0x8e, 0x27, 0x38, 0x6b, 0xb2, 0x0b, 0x2f, 0xe4, 0xbd, 0xda,
-	0x92, 0xe6, 0x51, 0x38, 0xe6, 0x09, 0xa3, 0xba, 0xe4, 0x75, 0x20, 0x21, 0x0b, 0xf6, 0x1c, 0x9b,
-	0x2d, 0xd9, 0xb6, 0x3f, 0xf0, 0xb8, 0x6c, 0x7b, 0x5d, 0xb6, 0x7d, 0x5e, 0xb7, 0x9d, 0xb4, 0x86,
-	0x38, 0x30, 0xa7, 0x16, 0x79, 0x15, 0x4e, 0xeb, 0x69, 0x17, 0xf7, 0x02, 0x48, 0xa4, 0xa7, 0x45,
-	0x47, 0x62, 0x86, 0x86, 0x43, 0xdc, 0xa4, 0x0d, 0x17, 0xe8, 0x80, 0xfb, 0x3d, 0x01, 0x99, 0x16,
-	0xba, 0xe9, 0x77, 0x99, 0x37, 0xd7, 0xb8, 0x54, 0xb8, 0x5c, 0x6b, 0x5e, 0x3a, 0x3c, 0x58, 0xb8,
-	0xb0, 0xf4, 0x10, 0x3e, 0x7c, 0x28, 0x0a, 0xb9, 0x0d, 0xf5, 0xb6, 0x17, 0x6e, 0xf8, 0xae, 0x63,
-	0xef, 0xcf, 0x4d, 0xcb, 0x06, 0xbe, 0xa8, 0x5f, 0xb5, 0xbe, 0x72, 0xab, 0xa5, 0x08, 0x0f, 0x0e,
-	0x16, 0x2e, 0x0c, 0x6b, 0xc7, 0xc5, 0x88, 0x8e, 0x31, 0x06, 0x59, 0x97, 0x80, 0xcb, 0xbe, 0xb7,
-	0xe3, 0x74, 0xe6, 0x66, 0xe4, 0xd7, 0xb8, 0x34, 0x62, 0x40, 0xaf, 0xdc, 0x6a, 0x29, 0xbe, 0xe6,
-	0x8c, 0x16, 0xa7, 0x1e, 0x31, 0x46, 0x98, 0x7f, 0x05, 0xce, 0x0c, 0xcd, 0x5a, 0x72, 0x1a, 0x4a,
-	0x5d, 0xb6, 0x2f, 0x95, 0x52, 0x1d, 0xc5, 0x4f, 0xf2, 0x34, 0x54, 0xf6, 0xa8, 0x3b, 0x60, 0x73,
-	0x45, 0x59, 0xa6, 0x1e, 0x7e, 0xb2, 0xf8, 0x72, 0xc1, 0xfa, 0x15, 0x80, 0x59, 0xa3, 0x0b, 0xee,
-	0xb0, 0x80, 0xb3, 0xfb, 0xe4, 0x12, 0x94, 0x3d, 0xf1, 0x3d, 0x64, 0xfd, 0xe6, 0xb4, 0x7e, 0xdd,
-	0xb2, 0xfc, 0x0e, 0x92, 0x42, 0x6c, 0xa8, 0x2a, 0x5d, 0x2e, 0xf1, 0x1a, 0x57, 0x5f, 0x19, 0x5b,
-	0x0d, 0xb5, 0x24, 0x4c, 0x13, 0x0e, 0x0f, 0x16, 0xaa, 0xea, 0x37, 0x6a, 0x68, 0xf2, 0x26, 0x94,
-	0x43, 0xc7, 0xeb, 0xce, 0x95, 0xa4, 0x88, 0x8f, 0x8f, 0x2f, 0xc2, 0xf1, 0xba, 0xcd, 0x9a, 0x78,
-	0x03, 0xf1, 0x0b, 0x25, 0x28, 0xb9, 0x0b, 0xa5, 0x41, 0x7b, 0x47, 0x6b, 0x94, 0x9f, 0x1e, 0x1b,
-	0x7b, 0x6b, 0x65, 0xb5, 0x39, 0x75, 0x78, 0xb0, 0x50, 0xda, 0x5a, 0x59, 0x45, 0x81, 0x48, 0xbe,
-	0x5a, 0x80, 0x33, 0xb6, 0xef, 0x71, 0x2a, 0xd6, 0x17, 0xa3, 0x59, 0xe7, 0x2a, 0x52, 0xce, 0xeb,
-	0x63, 0xcb, 0x59, 0xce, 0x22, 0x36, 0x9f, 0x11, 0x8a, 0x62, 0xa8, 0x18, 0x87, 0x65, 0x93, 0xdf,
-	0x2c, 0xc0, 0x33, 0x62, 0x02, 0x0f, 0x31, 0x4b, 0xb5, 0x73, 0xbc, 0xad, 0x3a, 0x7f, 0x78, 0xb0,
-	0xf0, 0xcc, 0x8d, 0x3c, 0x61, 0x98, 0xdf, 0x06, 0xd1, 0xba, 0xb3, 0x74, 0x78, 0x2d, 0x92, 0x2a,
-	0xad, 0x71, 0x75, 0xed, 0x38, 0xd7, 0xb7, 0xe6, 0xfb, 0xf4, 0x50, 0xce, 0x5b, 0xce, 0x31, 0xaf,
-	0x15, 0xe4, 0x1a, 0x4c, 0xed, 0xf9, 0xee, 0xa0, 0xc7, 0xc2, 0xb9, 0x9a, 0x5c, 0x14, 0xe6, 0xf3,
-	0xe6, 0xea, 0x1d, 0xc9, 0xd2, 0x3c, 0xa5, 0xe1, 0xa7, 0xd4, 0x73, 0x88, 0xa6, 0x2e, 0x71, 0xa0,
-	0xea, 0x3a, 0x3d, 0x87, 0x87, 0x52, 0x5b, 0x36, 0xae, 0x5e, 0x1b, 0xfb, 0xb5, 0xd4, 0x14, 0x5d,
-	0x93, 0x60, 0x6a, 0xd6, 0xa8, 0xdf, 0xa8, 0x05, 0x10, 0x1b, 0x2a, 0xa1, 0x4d, 0x5d, 0xa5, 0x4d,
-	0x1b, 0x57, 0x3f, 0x31, 0xfe, 0xb4, 0x11, 0x28, 0xcd, 0x19, 0xfd, 0x4e, 0x15, 0xf9, 0x88, 0x0a,
-	0x9b, 0xfc, 0x2c, 0xcc, 0xa6, 0xbe, 0x66, 0x38, 0xd7, 0x90, 0xbd, 0xf3, 0x5c, 0x5e, 0xef, 0x44,
-	0x5c, 0xcd, 0x73, 0x1a, 0x6c, 0x36, 0x35, 0x42, 0x42, 0xcc, 0x80, 0x91, 0x9b, 0x50, 0x0b, 0x9d,
-	0x36, 0xb3, 0x69, 0x10, 0xce, 0x4d, 0x3f, 0x0e, 0xf0, 0x69, 0x0d, 0x5c, 0x6b, 0xe9, 0x6a, 0x18,
-	0x01, 0x90, 0x45, 0x80, 0x3e, 0x0d, 0xb8, 0xa3, 0xac, 0x93, 0x19, 0xb9, 0x52, 0xce, 0x1e, 0x1e,
-	0x2c, 0xc0, 0x46, 0x54, 0x8a, 0x09, 0x0e, 0xeb, 0x2e, 0xcc, 0x2c, 0x0d, 0xf8, 0xae, 0x1f, 0x38,
-	0xef, 0x48, 0x4b, 0x84, 0xac, 0x42, 0x85, 0xcb, 0x15, 0x45, 0x19, 0x79, 0xcf, 0xe7, 0x35, 0x45,
-	0xad, 0xee, 0x37, 0xd9, 0xbe, 0x51, 0xc4, 0xcd, 0xba, 0xe8, 0x34, 0xb5, 0xc2, 0xa8, 0xea, 0xd6,
-	0x6f, 0x17, 0xa0, 0xde, 0xa4, 0xa1, 0x63, 0x0b, 0x78, 0xb2, 0x0c, 0xe5, 0x41, 0xc8, 0x82, 0xa3,
-	0x81, 0x4a, 0x2d, 0xb6, 0x15, 0xb2, 0x00, 0x65, 0x65, 0x72, 0x1b, 0x6a, 0x7d, 0x1a, 0x86, 0xf7,
-	0xfc, 0xa0, 0xad, 0x35, 0xf1, 0x63, 0x02, 0x29, 0x53, 0x41, 0x57, 0xc5, 0x08, 0xc4, 0x6a, 0x40,
-	0xbd, 0xe9, 0x52, 0xbb, 0xbb, 0xeb, 0xbb, 0xcc, 0xfa, 0x7e, 0x01, 0xce, 0x36, 0x07, 0x3b, 0x3b,
-	0x2c, 0xd0, 0x2b, 0xa3, 0x5a, 0x73, 0x08, 0x83, 0x4a, 0xc0, 0xda, 0x4e, 0xa8, 0xdb, 0xbe, 0x32,
-	0xf6, 0x10, 0x43, 0x81, 0xa2, 0x97, 0x38, 0xd9, 0x5f, 0xb2, 0x00, 0x15, 0x3a, 0x19, 0x40, 0xfd,
-	0x6d, 0xc6, 0x43, 0x1e, 0x30, 0xda, 0xd3, 0x6f, 0xf7, 0xda, 0xd8, 0xa2, 0x5e, 0x67, 0xbc, 0x25,
-	0x91, 0x92, 0x2b, 0x6a, 0x54, 0x88, 0xb1, 0x24, 0xeb, 0x2f, 0x2a, 0x30, 0xbd, 0xec, 0xf7, 0xb6,
-	0x1d, 0x8f, 0xb5, 0xaf, 0xb5, 0x3b, 0x8c, 0xbc, 0x05, 0x65, 0xd6, 0xee, 0x30, 0xfd, 0xb6, 0xe3,
-	0xaf, 0x43, 0x02, 0x2c, 0x5e, 0x4d, 0xc5, 0x13, 0x4a, 0x60, 0xb2, 0x06, 0xb3, 0x3b, 0x81, 0xdf,
-	0x53, 0x53, 0x7b, 0x73, 0xbf, 0xaf, 0x57, 0xe9, 0xe6, 0xff, 0x31, 0xd3, 0x65, 0x35, 0x45, 0x7d,
-	0x70, 0xb0, 0x00, 0xf1, 0x13, 0x66, 0xea, 0x92, 0x37, 0x60, 0x2e, 0x2e, 0x89, 0xc6, 0xf8, 0xb2,
-	0x30, 0x69, 0xe4, 0x52, 0x5a, 0x69, 0x5e, 0x38, 0x3c, 0x58, 0x98, 0x5b, 0x1d, 0xc1, 0x83, 0x23,
-	0x6b, 0x93, 0x77, 0x0b, 0x70, 0x3a, 0x26, 0x2a, 0xbd, 0xa3, 0x57, 0xd0, 0x63, 0x52, 0x68, 0xd2,
-	0xf6, 0x5b, 0xcd, 0x88, 0xc0, 0x21, 0xa1, 0x64, 0x15, 0xa6, 0xb9, 0x9f, 0xe8, 0xaf, 0x8a, 0xec,
-	0x2f, 0xcb, 0x38, 0x2b, 0x9b, 0xfe, 0xc8, 0xde, 0x4a, 0xd5, 0x23, 0x08, 0xe7, 0xcc, 0x73, 0xa6,
-	0xa7, 0xaa, 0xb2, 0xa7, 0xe6, 0x0f, 0x0f, 0x16, 0xce, 0x6d, 0xe6, 0x72, 0xe0, 0x88, 0x9a, 0xe4,
-	0x0b, 0x05, 0x98, 0x35, 0x24, 0xdd, 0x47, 0x53, 0xc7, 0xd9, 0x47, 0x44, 0x8c, 0x88, 0xcd, 0x94,
-	0x00, 0xcc, 0x08, 0xb4, 0x7e, 0x50, 0x86, 0x7a, 0xa4, 0x1d, 0xc9, 0x07, 0xa0, 0x22, 0xdd, 0x10,
-	0x6d, 0xd0, 0x45, 0x2a, 0x5d, 0x7a, 0x2b, 0xa8, 0x68, 0xe4, 0x79, 0x98, 0xb2, 0xfd, 0x5e, 0x8f,
-	0x7a, 0x6d, 0xe9, 0x5a, 0xd6, 0x9b, 0x0d, 0xb1, 0x92, 0x2d, 0xab, 0x22, 0x34, 0x34, 0x72, 0x01,
-	0xca, 0x34, 0xe8, 0x28, 0x2f, 0xaf, 0xae, 0xf4, 0xd1, 0x52, 0xd0, 0x09, 0x51, 0x96, 0x92, 0x8f,
-	0x41, 0x89, 0x79, 0x7b, 0x73, 0xe5, 0xd1, 0x4b, 0xe5, 0x35, 0x6f, 0xef, 0x0e, 0x0d, 0x9a, 0x0d,
-	0xdd, 0x86, 0xd2, 0x35, 0x6f, 0x0f, 0x45, 0x1d, 0xb2, 0x06, 0x53, 0xcc, 0xdb, 0x13, 0xdf, 0x5e,
-	0xbb, 0x5f, 0xef, 0x1f, 0x51, 0x5d, 0xb0, 0x68, 0xab, 0x31, 0x5a, 0x70, 0x75, 0x31, 0x1a, 0x08,
-	0xf2, 0x29, 0x98, 0x56, 0x6b, 0xef, 0xba, 0xf8, 0x26, 0xe1, 0x5c, 0x55, 0x42, 0x2e, 0x8c, 0x5e,
-	0xbc, 0x25, 0x5f, 0xec, 0xee, 0x26, 0x0a, 0x43, 0x4c, 0x41, 0x91, 0x4f, 0x41, 0xdd, 0x44, 0x32,
-	0xcc, 0x97, 0xcd, 0xf5, 0x14, 0x51, 0x33, 0x21, 0xfb, 0xec, 0xc0, 0x09, 0x58, 0x8f, 0x79, 0x3c,
-	0x6c, 0x9e, 0x31, 0xbe, 0x83, 0xa1, 0x86, 0x18, 0xa3, 0x91, 0xed, 0x61, 0x97, 0x57, 0xf9, 0x6b,
-	0x1f, 0x18, 0xa1, 0xd5, 0xc7, 0xf0, 0x77, 0x3f, 0x03, 0xa7, 0x22, 0x9f, 0x54, 0xbb, 0x35, 0xca,
-	0x83, 0x7b, 0x49, 0x54, 0xbf, 0x91, 0x26, 0x3d, 0x38, 0x58, 0x78, 0x2e, 0xc7, 0xb1, 0x89, 0x19,
-	0x30, 0x0b, 0x66, 0xfd, 0x59, 0x09, 0x86, 0xcd, 0xd2, 0x74, 0xa7, 0x15, 0x8e, 0xbb, 0xd3, 0xb2,
-	0x2f, 0xa4, 0xd4, 0xe7, 0xcb, 0xba, 0xda, 0xe4, 0x2f, 0x95, 0xf7, 0x61, 0x4a, 0xc7, 0xfd, 0x61,
-	0x9e, 0x94, 0xb9, 0x63, 0x7d, 0xa9, 0x0c, 0xb3, 0x2b, 0x94, 0xf5, 0x7c, 0xef, 0x91, 0x46, 0x7a,
-	0xe1, 0x89, 0x30, 0xd2, 0x2f, 0x43, 0x2d, 0x60, 0x7d, 0xd7, 0xb1, 0x69, 0x28, 0x3f, 0xbd, 0x8e,
-	0x84, 0xa0, 0x2e, 0xc3, 0x88, 0x3a, 0xc2, 0x39, 0x2b, 0x3d, 0x91, 0xce, 0x59, 0xf9, 0x87, 0xef,
-	0x9c, 0x59, 0x5f, 0x28, 0x82, 0x34, 0x54, 0xc8, 0x25, 0x28, 0x8b, 0x45, 0x38, 0x1b, 0x12, 0x90,
-	0x03, 0x47, 0x52, 0xc8, 0x3c, 0x14, 0xb9, 0xaf, 0x67, 0x1e, 0x68, 0x7a, 0x71, 0xd3, 0xc7, 0x22,
-	0xf7, 0xc9, 0x3b, 0x00, 0xb6, 0xef, 0xb5, 0x1d, 0x13, 0x20, 0x9c, 0xec, 0xc5, 0x56, 0xfd, 0xe0,
-	0x1e, 0x0d, 0xda, 0xcb, 0x11, 0xa2, 0x32, 0xe7, 0xe3, 0x67, 0x4c, 0x48, 0x23, 0xaf, 0x40, 0xd5,
-	0xf7, 0x56, 0x07, 0xae, 0x2b, 0x3b, 0xb4, 0xde, 0xfc, 0xbf, 0xc2, 0x67, 0xba, 0x2d, 0x4b, 0x1e,
-	0x1c, 0x2c, 0x9c, 0x57, 0xf6, 0xad, 0x78, 0xba, 0x1b, 0x38, 0xdc, 0xf1, 0x3a, 0x2d, 0x1e, 0x50,
-	0xce, 0x3a, 0xfb, 0xa8, 0xab, 0x59, 0x14, 0x1a, 0xab, 0xce, 0x7d, 0xd6, 0xbe, 0xeb, 0x78, 0x6d,
-	0xff, 0x1e, 0x41, 0xa8, 0xba, 0xcc, 0xeb, 0xf0, 0x5d, 0x3d, 0xf8, 0x17, 0x13, 0x53, 0x2d, 0x0a,
-	0x2b, 0xc7, 0xcd, 0xef, 0x31, 0x4e, 0xc5, 0xe4, 0x5b, 0x19, 0xe8, 0xc0, 0xa7, 0xf2, 0xd9, 0x24,
-	0x02, 0x6a, 0x24, 0x6b, 0x1f, 0xce, 0x0c, 0xbd, 0x14, 0x69, 0x43, 0x99, 0xd3, 0x8e, 0xd1, 0x96,
-	0xab, 0x63, 0x77, 0xd7, 0x26, 0xed, 0x24, 0xba, 0x4a, 0xae, 0xd8, 0x9b, 0x54, 0xac, 0xd8, 0x02,
-	0xdd, 0xfa, 0xaf, 0x02, 0xd4, 0x56, 0x07, 0x9e, 0x2d, 0x3d, 0x9d, 0x47, 0x07, 0x7e, 0xcc, 0xf2,
-	0x5f, 0xcc, 0x5d, 0xfe, 0x07, 0x50, 0xed, 0xde, 0x8b, 0xcc, 0x83, 0xc6, 0xd5, 0xf5, 0xf1, 0xbf,
-	0xb1, 0x6e, 0xd2, 0xe2, 0x4d, 0x89, 0xa7, 0x82, 0xd1, 0xb3, 0xba, 0x41, 0xd5, 0x9b, 0x77, 0xa5,
-	0x50, 0x2d, 0x6c, 0xfe, 0x63, 0xd0, 0x48, 0xb0, 0x1d, 0x29, 0xfa, 0xf5, 0x47, 0x65, 0xa8, 0x5e,
-	0x6f, 0xb5, 0x96, 0x36, 0x6e, 0x90, 0x8f, 0x40, 0x43, 0xc7, 0x29, 0x6f, 0xc5, 0x7d, 0x10, 0x85,
-	0xa9, 0x5b, 0x31, 0x09, 0x93, 0x7c, 0xc2, 0xb8, 0x0a, 0x18, 0x75, 0x7b, 0x7a, 0xe8, 0x47, 0xc6,
-	0x15, 0x8a, 0x42, 0x54, 0x34, 0x42, 0x61, 0x56, 0xf8, 0x6b, 0xa2, 0x0b, 0x95, 0x2f, 0xa6, 0x27,
-	0xc1, 0x63, 0x7a, 0x6b, 0xd2, 0xe4, 0xdb, 0x4a, 0x01, 0x60, 0x06, 0x90, 0xbc, 0x0c, 0x35, 0x3a,
-	0xe0, 0xbb, 0xd2, 0x1c, 0x56, 0x23, 0xfd, 0x82, 0x0c, 0xe3, 0xea, 0xb2, 0x07, 0x07, 0x0b, 0xd3,
-	0x37, 0xb1, 0xf9, 0x11, 0xf3, 0x8c, 0x11, 0xb7, 0x68, 0x9c, 0xf1, 0xff, 0x74, 0xe3, 0x2a, 0x47,
-	0x6e, 0xdc, 0x46, 0x0a, 0x00, 0x33, 0x80, 0xe4, 0x4d, 0x98, 0xee, 0xb2, 0x7d, 0x4e, 0xb7, 0xb5,
-	0x80, 0xea, 0x51, 0x04, 0x9c, 0x16, 0x06, 0xd9, 0xcd, 0x44, 0x75, 0x4c, 0x81, 0x91, 0x10, 0x9e,
-	0xee, 0xb2, 0x60, 0x9b, 0x05, 0xbe, 0xf6, 0x25, 0xb5, 0x90, 0xa9, 0xa3, 0x08, 0x99, 0x3b, 0x3c,
-	0x58, 0x78, 0xfa, 0x66, 0x0e, 0x0c, 0xe6, 0x82, 0x5b, 0x3f, 0x28, 0xc0, 0xa9, 0xeb, 0x2a, 0x51,
-	0xe4, 0x07, 0x6a, 0x49, 0x25, 0xe7, 0xa1, 0x14, 0xf4, 0x07, 0x72, 0xe4, 0x94, 0x54, 0x54, 0x10,
-	0x37, 0xb6, 0x50, 0x94, 0x91, 0x37, 0xa0, 0xd6, 0xd6, 0x1a, 0x40, 0xbb, 0xb2, 0x47, 0xd5, 0x1b,
-	0x72, 0x49, 0x33, 0x4f, 0x18, 0xa1, 0x09, 0xbb, 0xbd, 0x17, 0x76, 0x5a, 0xce, 0x3b, 0x4c, 0x7b,
-	0x77, 0xd2, 0x6e, 0x5f, 0x57, 0x45, 0x68, 0x68, 0x62, 0x8d, 0xec, 0xb2, 0x7d, 0xe5, 0xdb, 0x94,
-	0xe3, 0x35, 0xf2, 0xa6, 0x2e, 0xc3, 0x88, 0x4a, 0x16, 0xcc, 0x64, 0x11, 0xa3, 0xa0, 0xac, 0xfc,
-	0xf2, 0x3b, 0xa2, 0x40, 0xcf, 0x1b, 0xeb, 0xab, 0x45, 0x38, 0x77, 0x9d, 0x71, 0x65, 0x22, 0xac,
-	0xb0, 0xbe, 0xeb, 0xef, 0x0b, 0x3b, 0x0d, 0xd9, 0x67, 0xc9, 0xab, 0x00, 0x4e, 0xb8, 0xdd, 0xda,
-	0xb3, 0xe5, 0x30, 0x54, 0x53, 0xe8, 0x92, 0x9e, 0x11, 0x70, 0xa3, 0xd5, 0xd4, 0x94, 0x07, 0xa9,
-	0x27, 0x4c, 0xd4, 0x89, 0x7d, 0x95, 0xe2, 0x43, 0x7c, 0x95, 0x16, 0x40, 0x3f, 0xb6, 0xf6, 0x4a,
-	0x92, 0xf3, 0xc7, 0x8d, 0x98, 0xa3, 0x18, 0x7a, 0x09, 0x98, 0x09, 0xec, 0x2f, 0xeb, 0x8f, 0x4b,
-	0x30, 0x7f, 0x9d, 0xf1, 0x28, 0x9c, 0xa0, 0x95, 0x45, 0xab, 0xcf, 0x6c, 0xd1, 0x2b, 0xef, 0x16,
-	0xa0, 0xea, 0xd2, 0x6d, 0xe6, 0x0a, 0x65, 0x2e, 0xd0, 0xdf, 0x1a, 0x5b, 0x2f, 0x8e, 0x96, 0xb2,
-	0xb8, 0x26, 0x25, 0x64, 0x34, 0xa5, 0x2a, 0x44, 0x2d, 0x5e, 0xe8, 0x38, 0xdb, 0x1d, 0x84, 0x9c,
-	0x05, 0x1b, 0x7e, 0xc0, 0xb5, 0xb1, 0x14, 0xe9, 0xb8, 0xe5, 0x98, 0x84, 0x49, 0x3e, 0x72, 0x15,
-	0xc0, 0x76, 0x1d, 0xe6, 0x71, 0x59, 0x4b, 0x0d, 0x33, 0x62, 0xfa, 0x7b, 0x39, 0xa2, 0x60, 0x82,
-	0x4b, 0x88, 0xea, 0xf9, 0x9e, 0xc3, 0x7d, 0x25, 0xaa, 0x9c, 0x16, 0xb5, 0x1e, 0x93, 0x30, 0xc9,
-	0x27, 0xab, 0x31, 0x1e, 0x38, 0x76, 0x28, 0xab, 0x55, 0x32, 0xd5, 0x62, 0x12, 0x26, 0xf9, 0xc4,
-	0x12, 0x90, 0x78, 0xff, 0x23, 0x2d, 0x01, 0x7f, 0x52, 0x83, 0x8b, 0xa9, 0x6e, 0xe5, 0x94, 0xb3,
-	0x9d, 0x81, 0xdb, 0x62, 0xdc, 0x7c, 0xc0, 0x31, 0x97, 0x86, 0x5f, 0x8e, 0xbf, 0xbb, 0xca, 0xd6,
-	0xda, 0xc7, 0xf3, 0xdd, 0x87, 0x1a, 0xf8, 0x58, 0xdf, 0xfe, 0x0a, 0xd4, 0x3d, 0xca, 0x43, 0x39,
-	0x91, 0xf4, 0x9c, 0x89, 0x1c, 0xab, 0x5b, 0x86, 0x80, 0x31, 0x0f, 0xd9, 0x80, 0xa7, 0x75, 0x17,
-	0x5f, 0xbb, 0xdf, 0xf7, 0x03, 0xce, 0x02, 0x55, 0x57, 0xaf, 0x2e, 0xba, 0xee, 0xd3, 0xeb, 0x39,
-	0x3c, 0x98, 0x5b, 0x93, 0xac, 0xc3, 0x59, 0x5b, 0x65, 0xb0, 0x98, 0xeb, 0xd3, 0xb6, 0x01, 0x54,
-	0xd1, 0x9b, 0xc8, 0xee, 0x5f, 0x1e, 0x66, 0xc1, 0xbc, 0x7a, 0xd9, 0xd1, 0x5c, 0x1d, 0x6b, 0x34,
-	0x4f, 0x8d, 0x33, 0x9a, 0x6b, 0xe3, 0x8d, 0xe6, 0xfa, 0xe3, 0x8d, 0x66, 0xd1, 0xf3, 0x62, 0x1c,
-	0xb1, 0x40, 0xac, 0xd6, 0x6a, 0xc1, 0x49, 0x24, 0x48, 0xa3, 0x9e, 0x6f, 0xe5, 0xf0, 0x60, 0x6e,
-	0x4d, 0xb2, 0x0d, 0xf3, 0xaa, 0xfc, 0x9a, 0x67, 0x07, 0xfb, 0x7d, 0xb1, 0x72, 0x24, 0x70, 0x1b,
-	0xa9, 0xf0, 0xd9, 0x7c, 0x6b, 0x24, 0x27, 0x3e, 0x04, 0x85, 0xfc, 0x14, 0xcc, 0xa8, 0xaf, 0xb4,
-	0x4e, 0xfb, 0x12, 0x56, 0xa5, 0x4b, 0x9f, 0xd1, 0xb0, 0x33, 0xcb, 0x49, 0x22, 0xa6, 0x79, 0xc9,
-	0x12, 0x9c, 0xea, 0xef, 0xd9, 0xe2, 0xe7, 0x8d, 0x9d, 0x5b, 0x8c, 0xb5, 0x59, 0x5b, 0x86, 0xea,
-	0xeb, 0xcd, 0x67, 0x8d, 0x17, 0xbf, 0x91, 0x26, 0x63, 0x96, 0x9f, 0xbc, 0x0c, 0xd3, 0x21, 0xa7,
-	0x01, 0xd7, 0x31, 0xab, 0xb9, 0x59, 0x95, 0x4e, 0x36, 0x21, 0x9d, 0x56, 0x82, 0x86, 0x29, 0xce,
-	0x49, 0xb4, 0xc7, 0x03, 0xb5, 0x18, 0xca, 0xc0, 0x75, 0x46, 0xed, 0x7f, 0x31, 0xab, 0xf6, 0xdf,
-	0x9c, 0x64, 0xfa, 0xe7, 0x48, 0x78, 0xac, 0x69, 0xff, 0x3a, 0x90, 0x40, 0x87, 0xd9, 0x95, 0x73,
-	0x97, 0xd0, 0xfc, 0x51, 0xd2, 0x1e, 0x87, 0x38, 0x30, 0xa7, 0x16, 0x69, 0xc1, 0x33, 0x21, 0xf3,
-	0xb8, 0xe3, 0x31, 0x37, 0x0d, 0xa7, 0x96, 0x84, 0xe7, 0x34, 0xdc, 0x33, 0xad, 0x3c, 0x26, 0xcc,
-	0xaf, 0x3b, 0x49, 0xe7, 0xff, 0x53, 0x5d, 0xae, 0xbb, 0xaa, 0x6b, 0x8e, 0x4d, 0x6d, 0xbf, 0x9b,
-	0x55, 0xdb, 0x6f, 0x4d, 0xfe, 0xdd, 0xc6, 0x53, 0xd9, 0x57, 0x01, 0xe4, 0x57, 0x48, 0xea, 0xec,
-	0x48, 0x53, 0x61, 0x44, 0xc1, 0x04, 0x97, 0x98, 0x85, 0xa6, 0x9f, 0x93, 0xea, 0x3a, 0x9a, 0x85,
-	0xad, 0x24, 0x11, 0xd3, 0xbc, 0x23, 0x55, 0x7e, 0x65, 0x6c, 0x95, 0xff, 0x3a, 0x90, 0x54, 0x68,
-	0x41, 0xe1, 0x55, 0xd3, 0x7b, 0x46, 0x6e, 0x0c, 0x71, 0x60, 0x4e, 0xad, 0x11, 0x43, 0x79, 0xea,
-	0x78, 0x87, 0x72, 0x6d, 0xfc, 0xa1, 0x4c, 0xde, 0x82, 0xf3, 0x52, 0x94, 0xee, 0x9f, 0x34, 0xb0,
-	0x52, 0xfe, 0xef, 0xd7, 0xc0, 0xe7, 0x71, 0x14, 0x23, 0x8e, 0xc6, 0x10, 0xdf, 0xc7, 0x0e, 0x58,
-	0x5b, 0x08, 0xa7, 0xee, 0xe8, 0x85, 0x61, 0x39, 0x87, 0x07, 0x73, 0x6b, 0x8a, 0x21, 0xc6, 0xc5,
-	0x30, 0xa4, 0xdb, 0x2e, 0x6b, 0xeb, 0x3d, 0x33, 0xd1, 0x10, 0xdb, 0x5c, 0x6b, 0x69, 0x0a, 0x26,
-	0xb8, 0xf2, 0x74, 0xf5, 0xf4, 0x11, 0x75, 0xf5, 0x75, 0x19, 0x87, 0xdb, 0x49, 0x2d, 0x09, 0x5a,
-	0xe1, 0x47, 0xbb, 0xa0, 0x96, 0xb3, 0x0c, 0x38, 0x5c, 0x47, 0x2e, 0x95, 0x76, 0xe0, 0xf4, 0x79,
-	0x98, 0xc6, 0x9a, 0xcd, 0x2c, 0x95, 0x39, 0x3c, 0x98, 0x5b, 0x53, 0x18, 0x29, 0xbb, 0x8c, 0xba,
-	0x7c, 0x37, 0x0d, 0x78, 0x2a, 0x6d, 0xa4, 0xbc, 0x36, 0xcc, 0x82, 0x79, 0xf5, 0x26, 0x51, 0x6f,
-	0x5f, 0x29, 0xc2, 0xd9, 0xeb, 0x4c, 0xef, 0xca, 0xd9, 0xf0, 0xdb, 0x46, 0xaf, 0xfd, 0x2f, 0xf5,
-	0xb2, 0xfe, 0xa3, 0x08, 0x53, 0xd7, 0x03, 0x7f, 0xd0, 0x6f, 0xee, 0x93, 0x0e, 0x54, 0xef, 0xc9,
-	0x78, 0x9c, 0x0e, 0x8f, 0x8d, 0xbf, 0x01, 0x49, 0x85, 0xf5, 0x62, 0x15, 0xac, 0x9e, 0x51, 0xc3,
-	0x8b, 0x9e, 0xea, 0xb2, 0x7d, 0xa6, 0xd2, 0xeb, 0xb5, 0xb8, 0xa7, 0x6e, 0x8a, 0x42, 0x54, 0x34,
-	0xd2, 0x83, 0x53, 0xd4, 0x75, 0xfd, 0x7b, 0xac, 0xbd, 0x46, 0x39, 0xf3, 0x58, 0x68, 0x82, 0x9c,
-	0x47, 0x75, 0xf2, 0x65, 0xa6, 0x60, 0x29, 0x0d, 0x85, 0x59, 0x6c, 0xf2, 0x36, 0x4c, 0x85, 0xdc,
-	0x0f, 0x8c, 0x72, 0x6f, 0x5c, 0x5d, 0x1e, 0xfb, 0xed, 0x37, 0x9a, 0x9f, 0x6c, 0x29, 0x28, 0x15,
-	0x37, 0xd0, 0x0f, 0x68, 0x04, 0x58, 0x5f, 0x2f, 0x00, 0xbc, 0xb6, 0xb9, 0xb9, 0xa1, 0x43, 0x1c,
-	0x6d, 0x28, 0xd3, 0x41, 0x14, 0xfb, 0x1c, 0x3f, 0x28, 0x99, 0xda, 0x61, 0xa1, 0xe3, 0x88, 0x03,
-	0xbe, 0x8b, 0x12, 0x9d, 0x7c, 0x10, 0xa6, 0xf4, 0x82, 0xac, 0xbb, 0x3d, 0x4a, 0x56, 0xe8, 0x45,
-	0x1b, 0x0d, 0xdd, 0xfa, 0x5e, 0x11, 0xce, 0xdd, 0xf0, 0x38, 0x0b, 0x5a, 0x9c, 0xf5, 0x53, 0x9b,
-	0x15, 0xc8, 0xcf, 0x0d, 0xed, 0xcf, 0xfd, 0xb1, 0xc7, 0xfb, 0x1c, 0x6a, 0x7b, 0xe7, 0x3a, 0xe3,
-	0x34, 0x56, 0x85, 0x71, 0x59, 0x62, 0x53, 0xee, 0x00, 0xca, 0x61, 0x9f, 0xd9, 0x3a, 0xa2, 0xd3,
-	0x1a, 0xbb, 0x37, 0xf2, 0x5f, 0x40, 0x4c, 0xf7, 0x38, 0x08, 0x2b, 0x27, 0xbf, 0x14, 0x47, 0x3e,
-	0x07, 0xd5, 0x90, 0x53, 0x3e, 0x30, 0xa3, 0x6c, 0xeb, 0xb8, 0x05, 0x4b, 0xf0, 0x78, 0x4a, 0xa8,
-	0x67, 0xd4, 0x42, 0xad, 0xef, 0x15, 0x60, 0x3e, 0xbf, 0xe2, 0x9a, 0x13, 0x72, 0xf2, 0x33, 0x43,
-	0xdd, 0xfe, 0x98, 0xb3, 0x40, 0xd4, 0x96, 0x9d, 0x1e, 0xed, 0xe6, 0x31, 0x25, 0x89, 0x2e, 0xe7,
-	0x50, 0x71, 0x38, 0xeb, 0x19, 0xd3, 0xec, 0xf6, 0x31, 0xbf, 0x7a, 0x42, 0x15, 0x0a, 0x29, 0xa8,
-	0x84, 0x59, 0x5f, 0x2a, 0x8e, 0x7a, 0x65, 0xf1, 0x59, 0x88, 0x9b, 0xde, 0x10, 0x73, 0x73, 0xb2,
-	0x0d, 0x31, 0xe9, 0x06, 0x0d, 0xef, 0x8b, 0xf9, 0x85, 0xe1, 0x7d, 0x31, 0xb7, 0x27, 0xdf, 0x17,
-	0x93, 0xe9, 0x86, 0x91, 0xdb, 0x63, 0xbe, 0x52, 0x82, 0x0b, 0x0f, 0x1b, 0x36, 0x42, 0x35, 0xeb,
-	0xd1, 0x39, 0xa9, 0x6a, 0x7e, 0xf8, 0x38, 0x24, 0x57, 0xa1, 0xd2, 0xdf, 0xa5, 0xa1, 0x59, 0xc4,
-	0xcc, 0x5a, 0x5f, 0xd9, 0x10, 0x85, 0x0f, 0x0e, 0x16, 0x1a, 0x6a, 0xf1, 0x93, 0x8f, 0xa8, 0x58,
-	0x85, 0x66, 0xe9, 0xb1, 0x30, 0x8c, 0xcd, 0xe9, 0x48, 0xb3, 0xac, 0xab, 0x62, 0x34, 0x74, 0xc2,
-	0xa1, 0xaa, 0x5c, 0x54, 0xad, 0x64, 0xc7, 0xcf, 0x72, 0xe6, 0xec, 0xa1, 0x8a, 0x5f, 0x4a, 0x47,
-	0x3b, 0xb4, 0x2c, 0xb2, 0x08, 0x65, 0x1e, 0xef, 0x68, 0x31, 0x56, 0x6d, 0x39, 0x67, 0x3d, 0x97,
-	0x7c, 0xd6, 0xdf, 0xd6, 0xe0, 0x5c, 0xfe, 0x37, 0x14, 0xef, 0xba, 0xc7, 0x82, 0xd0, 0xf1, 0x3d,
-	0x6d, 0x23, 0xc4, 0xfb, 0x13, 0x55, 0x31, 0x1a, 0xfa, 0x8f, 0x74, 0x06, 0xf5, 0x77, 0x0b, 0xc2,
-	0xea, 0x56, 0x71, 0xa1, 0xf7, 0x22, 0x8b, 0xfa, 0x9c, 0xb2, 0xde, 0x47, 0x08, 0xc4, 0xd1, 0x6d,
-	0x21, 0xbf, 0x53, 0x80, 0xb9, 0x5e, 0xc6, 0xac, 0x3f, 0xc1, 0x1d, 0xc2, 0x72, 0x9b, 0xd7, 0xfa,
-	0x08, 0x79, 0x38, 0xb2, 0x25, 0xe4, 0x17, 0xa1, 0xd1, 0x17, 0xe3, 0x22, 0xe4, 0xcc, 0xb3, 0xcd,
-	0x26, 0xe1, 0xf1, 0x47, 0xff, 0x46, 0x8c, 0x65, 0x72, 0xab, 0xcd, 0x53, 0xc2, 0x01, 0x4f, 0x10,
-	0x30, 0x29, 0xf1, 0x09, 0xdf, 0x12, 0x7c, 0x19, 0x6a, 0x21, 0xe3, 0xdc, 0xf1, 0x3a, 0xa1, 0x74,
-	0x16, 0xeb, 0x6a, 0xae, 0xb4, 0x74, 0x19, 0x46, 0x54, 0xf2, 0xff, 0xa1, 0x2e, 0xc3, 0x4c, 0x4b,
-	0x41, 0x27, 0x9c, 0xab, 0xcb, 0x8c, 0xa9, 0xd4, 0xab, 0x2d, 0x53, 0x88, 0x31, 0x9d, 0xbc, 0x04,
-	0xd3, 0xdb, 0x72, 0xfa, 0xea, 0xa3, 0x01, 0xca, 0xa5, 0x93, 0xb9, 0xaf, 0x66, 0xa2, 0x1c, 0x53,
-	0x5c, 0xc2, 0x7d, 0x63, 0x51, 0x2c, 0x2e, 0xeb, 0xbe, 0xc5, 0x51, 0x3a, 0x4c, 0x70, 0x91, 0xe7,
-	0xa0, 0xc4, 0xdd, 0x50, 0xba, 0x6c, 0xb5, 0xd8, 0xcc, 0xde, 0x5c, 0x6b, 0xa1, 0x28, 0xb7, 0xfe,
-	0xbb, 0x00, 0xa7, 0x32, 0xbb, 0x25, 0x45, 0x95, 0x41, 0xe0, 0x6a, 0x35, 0x12, 0x55, 0xd9, 0xc2,
-	0x35, 0x14, 0xe5, 0xe4, 0x2d, 0x6d, 0x15, 0x16, 0x27, 0x3c, 0x05, 0x75, 0x8b, 0xf2, 0x50, 0x98,
-	0x81, 0x43, 0x06, 0xa1, 0x0c, 0xed, 0xc5, 0xed, 0xd1, 0xba, 0x3b, 0x11, 0xda, 0x8b, 0x69, 0x98,
-	0xe2, 0xcc, 0xf8, 0xb7, 0xe5, 0xc7, 0xf1, 0x6f, 0xad, 0xbf, 0x2e, 0x41, 0xe3, 0x75, 0x7f, 0xfb,
-	0x47, 0x64, 0xf7, 0x4b, 0xbe, 0x46, 0x2e, 0xfe, 0x10, 0x35, 0xf2, 0x16, 0x3c, 0xcb, 0xb9, 0xdb,
-	0x62, 0xb6, 0xef, 0xb5, 0xc3, 0xa5, 0x1d, 0xce, 0x82, 0x55, 0xc7, 0x73, 0xc2, 0x5d, 0xd6, 0xd6,
-	0x81, 0xc2, 0xf7, 0x1d, 0x1e, 0x2c, 0x3c, 0xbb, 0xb9, 0xb9, 0x96, 0xc7, 0x82, 0xa3, 0xea, 0xca,
-	0x19, 0x42, 0xed, 0xae, 0xbf, 0xb3, 0x23, 0x77, 0x39, 0xea, 0x94, 0x92, 0x9a, 0x21, 0x89, 0x72,
-	0x4c, 0x71, 0x59, 0xdf, 0x2c, 0x42, 0xfd, 0x26, 0xdd, 0xe9, 0xd2, 0x96, 0xe3, 0x75, 0xc9, 0xf3,
-	0x30, 0xb5, 0x1d, 0xf8, 0x5d, 0x16, 0xa8, 0x98, 0xac, 0xde, 0xe5, 0xd8, 0x54, 0x45, 0x68, 0x68,
-	0xc2, 0xeb, 0xe3, 0x7e, 0xdf, 0xb1, 0xb3, 0xfe, 0xf1, 0xa6, 0x28, 0x44, 0x45, 0x23, 0x77, 0xd5,
-	0x3c, 0x2a, 0x4d, 0x78, 0x84, 0x64, 0x73, 0xad, 0xa5, 0x92, 0xc5, 0x66, 0x06, 0x92, 0x17, 0x52,
-	0x96, 0x47, 0x7d, 0xa4, 0xad, 0xf0, 0x26, 0x94, 0x43, 0x1a, 0xba, 0x7a, 0xe9, 0x98, 0xe0, 0x80,
-	0xcc, 0x52, 0x6b, 0x4d, 0x1f, 0x90, 0x59, 0x6a, 0xad, 0xa1, 0x04, 0xb5, 0x7e, 0x50, 0x84, 0x86,
-	0xea, 0x37, 0xe5, 0xf9, 0x1d, 0x67, 0xcf, 0xbd, 0x22, 0x33, 0x05, 0xe1, 0xa0, 0xc7, 0x02, 0xe9,
-	0xd0, 0xeb, 0xf9, 0x9c, 0x8c, 0xfc, 0xc4, 0xc4, 0x28, 0x5b, 0x10, 0x17, 0x99, 0xae, 0x2f, 0x9f,
-	0x60, 0xd7, 0x57, 0x1e, 0xab, 0xeb, 0xab, 0x27, 0xd1, 0xf5, 0xbf, 0x5f, 0x80, 0xfa, 0x9a, 0xb3,
-	0xc3, 0xec, 0x7d, 0xdb, 0x95, 0xfb, 0xb9, 0xdb, 0xcc, 0x65, 0x9c, 0x5d, 0x0f, 0xa8, 0xcd, 0x36,
-	0x58, 0xe0, 0xc8, 0xa3, 0x8e, 0x62, 0x7e, 0x48, 0x0d, 0xa4, 0xf7, 0x73, 0xaf, 0x8c, 0xe0, 0xc1,
-	0x91, 0xb5, 0xc9, 0x0d, 0x98, 0x6e, 0xb3, 0xd0, 0x09, 0x58, 0x7b, 0x23, 0x61, 0x47, 0x3f, 0x6f,
-	0xb4, 0xea, 0x4a, 0x82, 0xf6, 0xe0, 0x60, 0x61, 0x66, 0xc3, 0xe9, 0x33, 0xd7, 0xf1, 0x98, 0x32,
-	0xa8, 0x53, 0x55, 0xad, 0x0a, 0x94, 0xd6, 0xfc, 0x8e, 0xf5, 0xa5, 0x12, 0x44, 0x87, 0x57, 0xc9,
-	0x97, 0x0b, 0xd0, 0xa0, 0x9e, 0xe7, 0x73, 0x7d, 0x30, 0x54, 0x25, 0x41, 0x70, 0xe2, 0x33, 0xb2,
-	0x8b, 0x4b, 0x31, 0xa8, 0x8a, 0x9f, 0x47, 0x31, 0xfd, 0x04, 0x05, 0x93, 0xb2, 0xc9, 0x20, 0x13,
-	0xd2, 0x5f, 0x9f, 0xbc, 0x15, 0x8f, 0x11, 0xc0, 0x9f, 0xff, 0x04, 0x9c, 0xce, 0x36, 0xf6, 0x28,
-	0x11, 0xc0, 0x49, 0x82, 0x87, 0x5f, 0xac, 0x43, 0xe3, 0x16, 0xe5, 0xce, 0x1e, 0x93, 0xce, 0xe3,
-	0xc9, 0x78, 0x03, 0xbf, 0x55, 0x80, 0x73, 0xe9, 0xe0, 0xfa, 0x09, 0xba, 0x04, 0x72, 0x33, 0x3e,
-	0xe6, 0x4a, 0xc3, 0x11, 0xad, 0x90, 0xce, 0xc1, 0x50, 0xac, 0xfe, 0xa4, 0x9d, 0x83, 0xd6, 0x28,
-	0x81, 0x38, 0xba, 0x2d, 0x3f, 0x2a, 0xce, 0xc1, 0x93, 0x7d, 0x98, 0x30, 0xe3, 0xba, 0x4c, 0x3d,
-	0x31, 0xae, 0x4b, 0xed, 0x89, 0x30, 0x15, 0xfb, 0x09, 0xd7, 0xa5, 0x3e, 0x61, 0x04, 0x57, 0xe7,
-	0xa3, 0x15, 0xda, 0x28, 0x17, 0x48, 0x6e, 0x2f, 0x35, 0x56, 0x3d, 0xb1, 0xa1, 0xb2, 0x4d, 0x43,
-	0xc7, 0xd6, 0x86, 0x73, 0x73, 0xfc, 0x80, 0x8a, 0x39, 0x45, 0xa7, 0xa2, 0x63, 0xf2, 0x11, 0x15,
-	0x76, 0x7c, 0x5a, 0xaf, 0x38, 0xd1, 0x69, 0x3d, 0xb2, 0x0c, 0x65, 0x4f, 0x28, 0xdb, 0xd2, 0x91,
-	0xcf, 0xe7, 0xdd, 0xba, 0xc9, 0xf6, 0x51, 0x56, 0x16, 0xc6, 0x27, 0x88, 0xd7, 0xd7, 0x36, 0xd4,
-	0x23, 0xdc, 0xa8, 0x0f, 0xc2, 0x54, 0x38, 0x90, 0x71, 0x66, 0xbd, 0x14, 0xc7, 0x61, 0x6f, 0x55,
-	0x8c, 0x86, 0x2e, 0xcc, 0xac, 0xcf, 0x0e, 0xd8, 0xc0, 0x44, 0xb1, 0x22, 0x33, 0xeb, 0x93, 0xa2,
-	0x10, 0x15, 0xed, 0xe4, 0xac, 0x24, 0xe3, 0xef, 0x55, 0x4e, 0xc8, 0xdf, 0xb3, 0x3e, 0x5f, 0x04,
-	0x88, 0x53, 0x13, 0xe4, 0xeb, 0x05, 0x78, 0x26, 0x9a, 0x65, 0x5c, 0x9d, 0xcd, 0x59, 0x76, 0xa9,
-	0xd3, 0x9b, 0xd8, 0x05, 0xcb, 0x9b, 0xe1, 0x52, 0xed, 0x6c, 0xe4, 0x89, 0xc3, 0xfc, 0x56, 0x10,
-	0x84, 0x1a, 0xeb, 0xf5, 0xf9, 0xfe, 0x8a, 0x13, 0xe8, 0x61, 0x97, 0x7b, 0xb8, 0xe5, 0x9a, 0xe6,
-	0x51, 0x55, 0xf5, 0x39, 0x0c, 0x39, 0x73, 0x0c, 0x05, 0x23, 0x1c, 0xeb, 0x6b, 0x45, 0x38, 0x9b,
-	0xd3, 0x3a, 0xf2, 0x2a, 0x9c, 0xd6, 0xb9, 0x99, 0xf8, 0xe2, 0x84, 0x42, 0x7c, 0x71, 0x42, 0x2b,
-	0x43, 0xc3, 0x21, 0x6e, 0xf2, 0x16, 0x00, 0xb5, 0x6d, 0x16, 0x86, 0xeb, 0x7e, 0xdb, 0x18, 0x7d,
-	0xaf, 0x08, 0x77, 0x78, 0x29, 0x2a, 0x7d, 0x70, 0xb0, 0xf0, 0xe1, 0xbc, 0x9c, 0x5e, 0xe6, 0xed,
-	0xe3, 0x0a, 0x98, 0x80, 0x24, 0x9f, 0x01, 0x50, 0x27, 0xa6, 0xa2, 0x5d, 0xa9, 0x8f, 0xc8, 0x01,
-	0x2c, 0x9a, 0xd3, 0x3c, 0x8b, 0x9f, 0x1c, 0x50, 0x8f, 0x3b, 0x7c, 0x5f, 0x6d, 0xe9, 0xbf, 0x13,
-	0xa1, 0x60, 0x02, 0xd1, 0xfa, 0xcb, 0x22, 0xd4, 0x8c, 0x31, 0xfa, 0x1e, 0x64, 0x79, 0x3a, 0xa9,
-	0x2c, 0xcf, 0xf8, 0xa7, 0xf8, 0x4c, 0x93, 0x47, 0xe6, 0x75, 0xfc, 0x4c, 0x5e, 0xe7, 0xfa, 0xe4,
-	0xa2, 0x1e, 0x9e, 0xc9, 0xf9, 0x46, 0x11, 0x66, 0x0d, 0xab, 0x3e, 0x59, 0xf9, 0x51, 0x98, 0x09,
-	0x18, 0x6d, 0x37, 0x29, 0xb7, 0x77, 0xe5, 0xe7, 0x2b, 0xc8, 0x5d, 0xc0, 0x67, 0x0e, 0x0f, 0x16,
-	0x66, 0x30, 0x49, 0xc0, 0x34, 0x1f, 0xf9, 0x38, 0x9c, 0x52, 0x91, 0xa9, 0x75, 0x7a, 0x5f, 0x1d,
-	0x6f, 0x90, 0x1d, 0x56, 0x56, 0x39, 0xcd, 0x66, 0x9a, 0x84, 0x59, 0x5e, 0x31, 0xac, 0x55, 0xd1,
-	0x56, 0x48, 0x3b, 0xaa, 0x31, 0xb2, 0x17, 0x66, 0xd4, 0xb0, 0x6e, 0x66, 0x68, 0x38, 0xc4, 0x4d,
-	0x28, 0x34, 0x44, 0x8b, 0x36, 0x9d, 0x1e, 0xf3, 0x07, 0xe6, 0xae, 0x98, 0xa3, 0x26, 0x60, 0xe5,
-	0xea, 0x8e, 0x31, 0x0c, 0x26, 0x31, 0xad, 0xbf, 0x2b, 0xc0, 0x74, 0xdc, 0x5f, 0x27, 0x9e, 0xeb,
-	0xda, 0x49, 0xe7, 0xba, 0x96, 0x26, 0x1e, 0x0e, 0x23, 0xb2, 0x5b, 0xbf, 0x5e, 0x8d, 0x5f, 0x4b,
-	0xe6, 0xb3, 0xb6, 0x61, 0xde, 0xc9, 0x4d, 0xf1, 0x24, 0xb4, 0x4d, 0xb4, 0x5b, 0xf0, 0xc6, 0x48,
-	0x4e, 0x7c, 0x08, 0x0a, 0x19, 0x40, 0x6d, 0x8f, 0x05, 0xdc, 0xb1, 0x99, 0x79, 0xbf, 0xeb, 0x13,
-	0x5b, 0x47, 0x6a, 0xa7, 0x44, 0xdc, 0xa7, 0x77, 0xb4, 0x00, 0x8c, 0x44, 0x91, 0x6d, 0xa8, 0xb0,
-	0x76, 0x87, 0x99, 0x13, 0x2a, 0x13, 0x9e, 0xe6, 0x8e, 0xfa, 0x53, 0x3c, 0x85, 0xa8, 0xa0, 0x49,
-	0x08, 0x75, 0xd7, 0xb8, 0xef, 0x7a, 0x1c, 0x8e, 0x6f, 0xeb, 0x44, 0x81, 0x80, 0x78, 0xb7, 0x6e,
-	0x54, 0x84, 0xb1, 0x1c, 0xd2, 0x8d, 0xae, 0x98, 0xa8, 0x1c, 0x93, 0xf2, 0x78, 0xc8, 0x25, 0x13,
-	0x21, 0xd4, 0xef, 0x51, 0xce, 0x82, 0x1e, 0x0d, 0xba, 0xda, 0xf0, 0x1f, 0xff, 0x0d, 0xef, 0x1a,
-	0xa4, 0xf8, 0x0d, 0xa3, 0x22, 0x8c, 0xe5, 0x10, 0x1f, 0xea, 0x5c, 0x5b, 0xb2, 0xe6, 0xe0, 0xed,
-	0xf8, 0x42, 0x8d, 0x4d, 0x1c, 0xaa, 0x90, 0x7c, 0xf4, 0x88, 0xb1, 0x0c, 0xeb, 0x41, 0x29, 0x56,
-	0x8f, 0xef, 0x75, 0x72, 0xf3, 0xa5, 0x74, 0x72, 0xf3, 0x62, 0x36, 0xb9, 0x99, 0x89, 0xc6, 0x1c,
-	0x3d, 0xbd, 0x49, 0xa1, 0xe1, 0xd2, 0x90, 0x6f, 0xf5, 0xdb, 0x94, 0xeb, 0xc8, 0x78, 0xe3, 0xea,
-	0xff, 0x7b, 0x3c, 0xed, 0x25, 0xf4, 0x61, 0x1c, 0x74, 0x59, 0x8b, 0x61, 0x30, 0x89, 0x49, 0x5e,
-	0x84, 0xc6, 0x9e, 0x9c, 0x91, 0xea, 0xd8, 0x49, 0x45, 0xaa, 0x73, 0xa9, 0x61, 0xef, 0xc4, 0xc5,
-	0x98, 0xe4, 0x11, 0x55, 0x94, 0x25, 0x10, 0x9f, 0xc2, 0xd7, 0x55, 0x5a, 0x71, 0x31, 0x26, 0x79,
-	0x64, 0x96, 0xc5, 0xf1, 0xba, 0xaa, 0xc2, 0x94, 0xac, 0xa0, 0xb2, 0x2c, 0xa6, 0x10, 0x63, 0x3a,
-	0xb9, 0x0c, 0xb5, 0x41, 0x7b, 0x47, 0xf1, 0xd6, 0x24, 0xaf, 0xb4, 0xbf, 0xb6, 0x56, 0x56, 0xf5,
-	0x31, 0x18, 0x43, 0xb5, 0xfe, 0xbd, 0x00, 0x64, 0x38, 0x1d, 0x4f, 0x76, 0xa1, 0xea, 0xc9, 0xa8,
-	0xca, 0xc4, 0x97, 0x5f, 0x24, 0x82, 0x33, 0x6a, 0x8e, 0xe9, 0x02, 0x8d, 0x4f, 0x3c, 0xa8, 0xb1,
-	0xfb, 0x9c, 0x05, 0x1e, 0x75, 0xb5, 0xe9, 0x71, 0x3c, 0x17, 0x6d, 0x28, 0x83, 0x53, 0x23, 0x63,
-	0x24, 0xc3, 0xfa, 0x7e, 0x11, 0x1a, 0x09, 0xbe, 0x47, 0x39, 0x2b, 0x72, 0x73, 0xad, 0x0a, 0x66,
-	0x6c, 0x05, 0xae, 0x1e, 0xa6, 0x89, 0xcd, 0xb5, 0x9a, 0x84, 0x6b, 0x98, 0xe4, 0x23, 0x57, 0x01,
-	0x7a, 0x34, 0xe4, 0x2c, 0x90, 0x4b, 0x49, 0x66, 0x4b, 0xeb, 0x7a, 0x44, 0xc1, 0x04, 0x17, 0xb9,
-	0xa4, 0xaf, 0x4a, 0x29, 0xa7, 0x8f, 0x25, 0x8e, 0xb8, 0x07, 0xa5, 0x72, 0x0c, 0xf7, 0xa0, 0x90,
-	0x0e, 0x9c, 0x36, 0xad, 0x36, 0xd4, 0xa3, 0x1d, 0x5a, 0x53, 0xc6, 0x78, 0x06, 0x02, 0x87, 0x40,
-	0xad, 0x6f, 0x16, 0x60, 0x26, 0xe5, 0x4a, 0xab, 0x03, 0x85, 0x66, 0x33, 0x49, 0xea, 0x40, 0x61,
-	0x62, 0x0f, 0xc8, 0x0b, 0x50, 0x55, 0x1d, 0xa4, 0x3b, 0x3e, 0x52, 0x23, 0xaa, 0x0b, 0x51, 0x53,
-	0x85, 0x42, 0xd0, 0xc1, 0xba, 0xac, 0x42, 0xd0, 0xd1, 0x3c, 0x34, 0x74, 0xf2, 0x21, 0xa8, 0x99,
-	0xd6, 0xe9, 0x9e, 0x8e, 0x6f, 0xd5, 0xd1, 0xe5, 0x18, 0x71, 0x58, 0x5f, 0x2b, 0xe9, 0xe9, 0xa1,
-	0x72, 0x6f, 0xc6, 0xc3, 0xfd, 0x79, 0x61, 0x84, 0x45, 0x63, 0xe8, 0x58, 0x2f, 0x88, 0x89, 0xc6,
-	0x56, 0xa2, 0x10, 0x93, 0xd2, 0x44, 0xa7, 0x24, 0x76, 0xc5, 0xd4, 0x93, 0xba, 0x55, 0xee, 0x62,
-	0xd1, 0x54, 0x7d, 0x50, 0x61, 0x28, 0xfd, 0x90, 0x3c, 0xa8, 0x10, 0x13, 0xb3, 0xa9, 0x87, 0xeb,
-	0x70, 0x46, 0x98, 0x84, 0xab, 0x81, 0xdf, 0x6b, 0xb2, 0x8e, 0xe3, 0x79, 0x8e, 0xd7, 0xd1, 0x79,
-	0xc5, 0x28, 0x7f, 0x81, 0x59, 0x06, 0x1c, 0xae, 0x63, 0xbc, 0xf3, 0xca, 0x71, 0x7b, 0xe7, 0xd6,
-	0x97, 0x8b, 0x20, 0xb3, 0x09, 0xe4, 0xa3, 0x50, 0xef, 0x31, 0x7b, 0x97, 0x7a, 0x4e, 0x68, 0x4e,
-	0x6e, 0x0b, 0xdf, 0xb6, 0xbe, 0x6e, 0x0a, 0x1f, 0x88, 0x6f, 0xbb, 0xd4, 0x5a, 0x93, 0xfb, 0x49,
-	0x62, 0x5e, 0x62, 0x43, 0xb5, 0x13, 0x86, 0xb4, 0xef, 0x4c, 0x7c, 0xbd, 0x9b, 0x3a, 0x5b, 0xab,
-	0xf4, 0x9b, 0xfa, 0x8d, 0x1a, 0x9a, 0xd8, 0x50, 0xe9, 0xbb, 0xd4, 0xf1, 0xb4, 0xb3, 0xd3, 0x9c,
-	0x28, 0x87, 0xb2, 0x21, 0x90, 0x54, 0x14, 0x47, 0xfe, 0x44, 0x85, 0x6d, 0xfd, 0x67, 0x01, 0xea,
-	0x11, 0x9d, 0x6c, 0x01, 0x08, 0x75, 0xa1, 0xcf, 0x87, 0x1e, 0xe9, 0xe6, 0x25, 0xe9, 0x8f, 0x6e,
-	0x45, 0x95, 0x31, 0x01, 0x94, 0x73, 0x80, 0xb6, 0x78, 0xdc, 0x07, 0x68, 0xaf, 0x40, 0x7d, 0x97,
-	0x7a, 0xed, 0x70, 0x97, 0x76, 0x95, 0xd6, 0xac, 0xc5, 0xc6, 0xd2, 0x6b, 0x86, 0x80, 0x31, 0x8f,
-	0xf5, 0x07, 0x65, 0x50, 0x57, 0x76, 0x89, 0x79, 0xdd, 0x76, 0x42, 0x95, 0xff, 0x2e, 0xc8, 0x9a,
-	0xd1, 0xbc, 0x5e, 0xd1, 0xe5, 0x18, 0x71, 0x90, 0xf3, 0x50, 0xea, 0x39, 0x9e, 0x0e, 0xfb, 0xcb,
-	0x71, 0xb5, 0xee, 0x78, 0x28, 0xca, 0x24, 0x89, 0xde, 0xd7, 0x29, 0x5c, 0x45, 0xa2, 0xf7, 0x51,
-	0x94, 0x09, 0xe7, 0xcf, 0xf5, 0xfd, 0xee, 0x36, 0xb5, 0xbb, 0x26, 0x35, 0x55, 0x96, 0xab, 0xab,
-	0x74, 0xfe, 0xd6, 0xd2, 0x24, 0xcc, 0xf2, 0x8a, 0xea, 0xb6, 0xef, 0xbb, 0x6d, 0xff, 0x9e, 0x67,
-	0xaa, 0x57, 0xe2, 0xea, 0xcb, 0x69, 0x12, 0x66, 0x79, 0xc9, 0x16, 0x3c, 0xfb, 0x0e, 0x0b, 0x7c,
-	0xad, 0xd1, 0x5a, 0x2e, 0x63, 0x7d, 0x03, 0xa3, 0x0c, 0x08, 0x99, 0x6f, 0xfe, 0x74, 0x3e, 0x0b,
-	0x8e, 0xaa, 0x2b, 0xd3, 0xd8, 0x34, 0xe8, 0x30, 0xbe, 0x11, 0xf8, 0x36, 0x0b, 0x43, 0xc7, 0xeb,
-	0x18, 0xd8, 0xa9, 0x18, 0x76, 0x33, 0x9f, 0x05, 0x47, 0xd5, 0x25, 0x6f, 0xc0, 0x9c, 0x22, 0x29,
-	0xc3, 0x62, 0x69, 0x8f, 0x3a, 0x2e, 0xdd, 0x76, 0x5c, 0x87, 0xef, 0xcb, 0x4d, 0x1f, 0x33, 0x2a,
-	0x36, 0xbf, 0x39, 0x82, 0x07, 0x47, 0xd6, 0x96, 0x77, 0x6a, 0xea, 0xcc, 0xcc, 0x06, 0x0b, 0xe4,
-	0xd7, 0x97, 0x61, 0x5e, 0xed, 0x43, 0x63, 0x86, 0x86, 0x43, 0xdc, 0xd6, 0x6f, 0x94, 0x40, 0x5e,
-	0x92, 0x28, 0x94, 0x93, 0xeb, 0x1b, 0xfd, 0x3d, 0xbe, 0x72, 0x5a, 0xf3, 0x3b, 0x6a, 0xa4, 0xac,
-	0xf9, 0x1d, 0x14, 0x88, 0x62, 0xd6, 0x77, 0xe9, 0x4e, 0x97, 0xea, 0x29, 0x32, 0xfe, 0xac, 0x8f,
-	0x72, 0xfa, 0x6a, 0xd6, 0xcb, 0x47, 0x54, 0xd8, 0xc2, 0x53, 0xd8, 0x36, 0xb7, 0x98, 0x4d, 0xac,
-	0x5e, 0xa2, 0xfb, 0xd0, 0x94, 0x59, 0x19, 0x3d, 0x62, 0x2c, 0x43, 0x28, 0xcc, 0x41, 0x5b, 0x5e,
-	0x56, 0x59, 0x9e, 0x50, 0x61, 0x6e, 0xad, 0xc8, 0x77, 0x92, 0x0a, 0x53, 0xfd, 0x46, 0x0d, 0x6d,
-	0xfd, 0x61, 0x01, 0x66, 0x5a, 0xae, 0xd3, 0x76, 0xbc, 0xce, 0xc9, 0xdd, 0x45, 0x41, 0x6e, 0x43,
-	0x25, 0x74, 0x9d, 0x36, 0x1b, 0xf3, 0x98, 0xba, 0xfc, 0x18, 0xa2, 0x95, 0x0c, 0x15, 0x8e, 0xf5,
-	0x8d, 0x0a, 0xe8, 0x9b, 0x3d, 0xc9, 0x00, 0xea, 0x1d, 0x73, 0x66, 0x5e, 0x37, 0xf9, 0xb5, 0x09,
-	0xce, 0x56, 0xa5, 0x4e, 0xdf, 0xab, 0xaf, 0x13, 0x15, 0x62, 0x2c, 0x89, 0xb0, 0xf4, 0x98, 0x5b,
-	0x99, 0x70, 0xcc, 0x29, 0x71, 0xc3, 0xa3, 0x8e, 0x42, 0x79, 0x97, 0xf3, 0xbe, 0x1e, 0x70, 0xe3,
-	0xef, 0xc9, 0x8f, 0xb7, 0xdb, 0xab, 0xb8, 0xb8, 0x78, 0x46, 0x09, 0x2d, 0x44, 0x78, 0x34, 0xba,
-	0x74, 0x6d, 0x79, 0xa2, 0xc0, 0x7b, 0x52, 0x84, 0x78, 0x46, 0x09, 0x4d, 0xbe, 0x50, 0x80, 0xe9,
-	0x20, 0x61, 0xd8, 0x69, 0x03, 0x65, 0xc2, 0x3d, 0xcd, 0x29, 0x2b, 0x51, 0xed, 0xd9, 0x49, 0x96,
-	0x63, 0x4a, 0xa4, 0xb0, 0x22, 0x79, 0x40, 0xbd, 0x70, 0xc7, 0x0f, 0x7a, 0x2c, 0xd0, 0x86, 0xf7,
-	0xea, 0x04, 0x73, 0x6a, 0x33, 0x46, 0x53, 0xa1, 0xcc, 0x54, 0x11, 0x26, 0xa5, 0x59, 0x3d, 0xd0,
-	0xbe, 0x38, 0xb1, 0x53, 0xd7, 0xd6, 0xa8, 0xed, 0x0b, 0x57, 0x1e, 0x6f, 0x3e, 0x44, 0x37, 0xae,
-	0x24, 0x4e, 0x16, 0xe7, 0xde, 0x4f, 0x63, 0xfd, 0x43, 0x11, 0x84, 0xe9, 0xa6, 0x0e, 0xca, 0xc9,
-	0x3b, 0xa1, 0x58, 0xab, 0xeb, 0xf4, 0xef, 0xb0, 0xc0, 0xd9, 0xd9, 0xd7, 0x0b, 0x76, 0xe2, 0xa0,
-	0x5c, 0x96, 0x03, 0x73, 0x6a, 0x91, 0x37, 0x61, 0xda, 0xa6, 0xcb, 0x2c, 0xe0, 0xe3, 0x98, 0x23,
-	0xf2, 0xe3, 0x2c, 0x2f, 0xc5, 0xd5, 0x31, 0x05, 0x26, 0x8c, 0x28, 0x3b, 0x86, 0x2e, 0x1d, 0xd9,
-	0x88, 0x4a, 0x00, 0x27, 0x80, 0x08, 0x42, 0xbd, 0x2b, 0x58, 0x25, 0x6a, 0xf9, 0x28, 0xa8, 0x72,
-	0xe2, 0xdf, 0x34, 0x75, 0x31, 0x86, 0xb1, 0x3c, 0x98, 0x49, 0xdd, 0x7e, 0x43, 0x3e, 0x06, 0x35,
-	0xbf, 0x9f, 0xd0, 0x3f, 0x75, 0x99, 0xb0, 0xaf, 0xdd, 0xd6, 0x65, 0x0f, 0x0e, 0x16, 0x66, 0xd6,
-	0xfc, 0x8e, 0x63, 0x9b, 0x02, 0x8c, 0xd8, 0x89, 0x05, 0x55, 0xb9, 0xb9, 0xc2, 0xdc, 0x7d, 0x23,
-	0x75, 0xa7, 0xbc, 0x17, 0x23, 0x44, 0x4d, 0xb1, 0xfe, 0xb5, 0x00, 0x71, 0x24, 0x89, 0x84, 0x50,
-	0x6d, 0xcb, 0x3b, 0x32, 0xb4, 0xaa, 0x1b, 0x3f, 0x22, 0x97, 0xbe, 0x8d, 0x4b, 0x19, 0x8c, 0xe9,
-	0x32, 0xd4, 0xa2, 0x48, 0x07, 0x4a, 0x6f, 0xfb, 0xdb, 0x13, 0x6b, 0xba, 0xc4, 0xf6, 0x47, 0x15,
-	0x7e, 0x49, 0x14, 0xa0, 0x90, 0x60, 0xfd, 0x52, 0x11, 0x1a, 0x89, 0x39, 0x34, 0xf1, 0xdd, 0x41,
-	0xf7, 0x33, 0x77, 0x07, 0x6d, 0x8c, 0xef, 0x11, 0xc5, 0xad, 0x3a, 0xe9, 0xeb, 0x83, 0xfe, 0xaa,
-	0x08, 0xa5, 0xad, 0x95, 0x55, 0x61, 0x70, 0x44, 0xdb, 0x20, 0x27, 0xce, 0x6e, 0xc7, 0xd7, 0xda,
-	0xca, 0x91, 0x1d, 0x3d, 0x62, 0x2c, 0x83, 0xec, 0xc2, 0xd4, 0xf6, 0xc0, 0x71, 0xb9, 0xe3, 0x4d,
-	0xbc, 0xe9, 0xd6, 0x5c, 0xb5, 0xa4, 0x37, 0xf4, 0x29, 0x54, 0x34, 0xf0, 0xa4, 0x03, 0x53, 0x1d,
-	0x75, 0xe8, 0x4e, 0xcf, 0xf5, 0x57, 0xc7, 0x5f, 0xb1, 0x15, 0x8e, 0x12, 0xa4, 0x1f, 0xd0, 0xa0,
-	0x5b, 0x9f, 0x03, 0x6d, 0xf0, 0x90, 0xf0, 0x64, 0x7a, 0x33, 0x72, 0x98, 0xf2, 0x7a, 0xd4, 0xfa,
-	0xb7, 0x02, 0xa4, 0x57, 0x85, 0xf7, 0xfe, 0xa3, 0x76, 0xb3, 0x1f, 0x75, 0xe5, 0x38, 0xe6, 0x40,
-	0xfe, 0x77, 0xb5, 0xfe, 0xbc, 0x08, 0x55, 0x7d, 0xdf, 0xfb, 0xc9, 0xa7, 0x50, 0x59, 0x2a, 0x85,
-	0xba, 0x3c, 0xe1, 0x45, 0xa8, 0x23, 0x13, 0xa8, 0xbd, 0x4c, 0x02, 0x75, 0xd2, 0x1b, 0x57, 0x1f,
-	0x91, 0x3e, 0xfd, 0x9b, 0x02, 0xcc, 0x2a, 0xc6, 0x1b, 0x5e, 0xc8, 0xa9, 0x67, 0x4b, 0x47, 0x40,
-	0x85, 0xb3, 0x27, 0xce, 0x0f, 0xe8, 0x5c, 0x96, 0x5a, 0x66, 0xe4, 0x6f, 0xd4, 0xd0, 0xc2, 0xa3,
-	0xdf, 0xf5, 0x43, 0x2e, 0xd5, 0x6d, 0x31, 0x1d, 0xa9, 0x7b, 0x4d, 0x97, 0x63, 0xc4, 0x91, 0x0d,
-	0x01, 0x56, 0x46, 0x87, 0x00, 0xad, 0xdf, 0x2b, 0xc2, 0x74, 0xea, 0x9e, 0xdd, 0xb1, 0xb3, 0xc1,
-	0x99, 0x64, 0x6c, 0xf1, 0xf8, 0x93, 0xb1, 0x79, 0x09, 0xe7, 0xd2, 0x84, 0x09, 0xe7, 0xf2, 0x51,
-	0x12, 0xce, 0xd6, 0xb7, 0x0b, 0x00, 0xa6, 0xb7, 0x4e, 0x3c, 0x17, 0xdc, 0x4e, 0xe7, 0x82, 0x27,
-	0x1e, 0x57, 0xf9, 0x99, 0xe0, 0x3f, 0xad, 0x98, 0x57, 0x92, 0x79, 0xe0, 0x77, 0x0b, 0x30, 0x4b,
-	0x53, 0xb9, 0xd5, 0x89, 0x4d, 0x99, 0x4c, 0xaa, 0x36, 0xba, 0x11, 0x3e, 0x5d, 0x8e, 0x19, 0xb1,
-	0xe4, 0x65, 0x98, 0xee, 0xeb, 0x84, 0xd7, 0xad, 0x78, 0xd8, 0x47, 0x07, 0x40, 0x36, 0x12, 0x34,
-	0x4c, 0x71, 0x3e, 0x22, 0x97, 0x5d, 0x3a, 0x96, 0x5c, 0x76, 0x72, 0xc3, 0x6c, 0xf9, 0xa1, 0x1b,
-	0x66, 0xf7, 0xa0, 0xbe, 0x13, 0xf8, 0x3d, 0x99, 0x2e, 0xd6, 0x77, 0xb5, 0x5e, 0x9b, 0x60, 0x4d,
-	0x89, 0x6f, 0x29, 0x8f, 0x57, 0xb7, 0x55, 0x83, 0x8f, 0xb1, 0x28, 0xd2, 0x87, 0x29, 0xee, 0x2b,
-	0xa9, 0xd5, 0xe3, 0x94, 0x1a, 0xe9, 0x92, 0x4d, 0x85, 0x8e, 0x46, 0x4c, 0x3a, 0x45, 0x3c, 0xf5,
-	0xde, 0xa4, 0x88, 0xad, 0xbf, 0x8f, 0x14, 0x58, 0x2b, 0x73, 0x46, 0xb4, 0x30, 0xe2, 0x8c, 0xa8,
-	0xbe, 0x5c, 0x21, 0x99, 0x44, 0x7d, 0x01, 0xaa, 0x01, 0xa3, 0xa1, 0xef, 0xe9, 0x6b, 0x4a, 0x22,
-	0xf5, 0x8f, 0xb2, 0x14, 0x35, 0x35, 0x99, 0x6c, 0x2d, 0x3e, 0x22, 0xd9, 0xfa, 0xa1, 0xc4, 0x00,
-	0x51, 0xbb, 0x5a, 0xa2, 0xb9, 0x9e, 0x33, 0x48, 0x64, 0x26, 0x46, 0xff, 0xcd, 0x53, 0x25, 0x9b,
-	0x89, 0xd1, 0x7f, 0xc1, 0x14, 0x71, 0x90, 0x36, 0x4c, 0xbb, 0x34, 0xe4, 0x32, 0x80, 0xd7, 0x5e,
-	0xe2, 0x63, 0x64, 0x72, 0xa3, 0x69, 0xb4, 0x96, 0xc0, 0xc1, 0x14, 0xaa, 0xf5, 0x6b, 0x05, 0x88,
-	0xbb, 0xfc, 0x88, 0x31, 0xe5, 0x37, 0xa0, 0xd6, 0xa3, 0xf7, 0x57, 0x98, 0x4b, 0xf7, 0x27, 0xb9,
-	0xfc, 0x70, 0x5d, 0x63, 0x60, 0x84, 0x66, 0x1d, 0x14, 0x40, 0x5f, 0xd8, 0x40, 0x18, 0x54, 0x76,
-	0x9c, 0xfb, 0xba, 0x3d, 0x93, 0x98, 0x4e, 0x89, 0xcb, 0x5e, 0x55, 0x90, 0x47, 0x16, 0xa0, 0x42,
-	0x27, 0x3d, 0x98, 0x0a, 0x55, 0x0c, 0x4e, 0xbf, 0xca, 0xf8, 0x61, 0x89, 0x54, 0x2c, 0x4f, 0x5f,
-	0xbf, 0xa0, 0x8a, 0xd0, 0xc8, 0x68, 0x2e, 0x7e, 0xeb, 0xbb, 0x17, 0x9f, 0xfa, 0xf6, 0x77, 0x2f,
-	0x3e, 0xf5, 0x9d, 0xef, 0x5e, 0x7c, 0xea, 0xf3, 0x87, 0x17, 0x0b, 0xdf, 0x3a, 0xbc, 0x58, 0xf8,
-	0xf6, 0xe1, 0xc5, 0xc2, 0x77, 0x0e, 0x2f, 0x16, 0xfe, 0xf9, 0xf0, 0x62, 0xe1, 0x57, 0xff, 0xe5,
-	0xe2, 0x53, 0x9f, 0xae, 0x19, 0xcc, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x97, 0xe6, 0x55, 0xbf,
-	0x56, 0x6e, 0x00, 0x00,
+	// 8417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x6c, 0x24, 0x59,
+	0x92, 0xd0, 0xd4, 0xa7, 0xab, 0xa2, 0x6c, 0x77, 0xf7, 0xeb, 0x99, 0x59, 0x77, 0x6f, 0x4f, 0xbb,
+	0x2f, 0x97, 0x19, 0xfa, 0x60, 0xcf, 0x7d, 0xd3, 0x33, 0xc7, 0xce, 0x1e, 0xb7, 0x33, 0xe3, 0xb2,
+	0xdb, 0x9e, 0x9e, 0xb6, 0xbb, 0x6b, 0xa2, 0xec, 0xe9, 0xb9, 0x6d, 0x98, 0x21, 0x9d, 0xf5, 0xaa,
+	0x9c, 0xe3, 0xac, 0xcc, 0x9a, 0xcc, 0x2c, 0xb7, 0x6b, 0x8e, 0xd5, 0xdd, 0xde, 0x22, 0xcd, 0x1e,
+	0xec, 0x71, 0x08, 0xfe, 0x9c, 0x0e, 0x1d, 0x12, 0x12, 0x12, 0xfc, 0x41, 0x42, 0x82, 0x43, 0x88,
+	0x13, 0x5f, 0x12, 0x42, 0xcb, 0xfe, 0x38, 0x56, 0x02, 0x74, 0x87, 0x40, 0x16, 0x6b, 0x24, 0x24,
+	0x24, 0x74, 0x9c, 0x58, 0x81, 0x90, 0x85, 0x00, 0xbd, 0xcf, 0xfc, 0xa8, 0xac, 0x6e, 0xbb, 0xd2,
+	0xee, 0x9d, 0x15, 0xf7, 0x2f, 0x33, 0x22, 0x5e, 0xc4, 0xcb, 0x97, 0xef, 0x23, 0x22, 0x5e, 0xbc,
+	0x78, 0xb0, 0xde, 0xb3, 0xc3, 0xdd, 0xe1, 0xce, 0x92, 0xe5, 0xf5, 0x6f, 0xb9, 0xc3, 0xbe, 0x39,
+	0xf0, 0xbd, 0x8f, 0xf9, 0x43, 0xd7, 0xf1, 0x1e, 0xdf, 0x1a, 0xec, 0xf5, 0x6e, 0x99, 0x03, 0x3b,
+	0x88, 0x20, 0xfb, 0xaf, 0x9a, 0xce, 0x60, 0xd7, 0x7c, 0xf5, 0x56, 0x8f, 0xba, 0xd4, 0x37, 0x43,
+	0xda, 0x59, 0x1a, 0xf8, 0x5e, 0xe8, 0x91, 0xaf, 0x44, 0x8c, 0x96, 0x14, 0xa3, 0x25, 0x55, 0x6c,
+	0x69, 0xb0, 0xd7, 0x5b, 0x62, 0x8c, 0x22, 0x88, 0x62, 0x74, 0xf5, 0xa7, 0x62, 0x35, 0xe8, 0x79,
+	0x3d, 0xef, 0x16, 0xe7, 0xb7, 0x33, 0xec, 0xf2, 0x37, 0xfe, 0xc2, 0x9f, 0x84, 0x9c, 0xab, 0xc6,
+	0xde, 0x1b, 0xc1, 0x92, 0xed, 0xb1, 0x6a, 0xdd, 0xb2, 0x3c, 0x9f, 0xde, 0xda, 0x1f, 0xab, 0xcb,
+	0xd5, 0xd7, 0x23, 0x9a, 0xbe, 0x69, 0xed, 0xda, 0x2e, 0xf5, 0x47, 0xea, 0x5b, 0x6e, 0xf9, 0x34,
+	0xf0, 0x86, 0xbe, 0x45, 0x4f, 0x55, 0x2a, 0xb8, 0xd5, 0xa7, 0xa1, 0x99, 0x25, 0xeb, 0xd6, 0xa4,
+	0x52, 0xfe, 0xd0, 0x0d, 0xed, 0xfe, 0xb8, 0x98, 0x3f, 0xf1, 0xb4, 0x02, 0x81, 0xb5, 0x4b, 0xfb,
+	0x66, 0xba, 0x9c, 0xf1, 0xef, 0xeb, 0x70, 0x79, 0x79, 0x27, 0x08, 0x7d, 0xd3, 0x0a, 0x5b, 0x5e,
+	0x67, 0x8b, 0xf6, 0x07, 0x8e, 0x19, 0x52, 0xb2, 0x07, 0x35, 0x56, 0xb7, 0x8e, 0x19, 0x9a, 0x0b,
+	0x85, 0x1b, 0x85, 0x9b, 0x8d, 0xdb, 0xcb, 0x4b, 0x53, 0xfe, 0x8b, 0xa5, 0x4d, 0xc9, 0xa8, 0x39,
+	0x7b, 0x74, 0xb8, 0x58, 0x53, 0x6f, 0xa8, 0x05, 0x90, 0x5f, 0x2f, 0xc0, 0xac, 0xeb, 0x75, 0x68,
+	0x9b, 0x3a, 0xd4, 0x0a, 0x3d, 0x7f, 0xa1, 0x78, 0xa3, 0x74, 0xb3, 0x71, 0xfb, 0xc3, 0xa9, 0x25,
+	0x66, 0x7c, 0xd1, 0xd2, 0xfd, 0x98, 0x80, 0x3b, 0x6e, 0xe8, 0x8f, 0x9a, 0xcf, 0x7f, 0xf7, 0x70,
+	0xf1, 0xb9, 0xa3, 0xc3, 0xc5, 0xd9, 0x38, 0x0a, 0x13, 0x35, 0x21, 0xdb, 0xd0, 0x08, 0x3d, 0x87,
+	0x35, 0x99, 0xed, 0xb9, 0xc1, 0x42, 0x89, 0x57, 0xec, 0xfa, 0x92, 0x68, 0x6d, 0x26, 0x7e, 0x89,
+	0x75, 0x97, 0xa5, 0xfd, 0x57, 0x97, 0xb6, 0x34, 0x59, 0xf3, 0xb2, 0x64, 0xdc, 0x88, 0x60, 0x01,
+	0xc6, 0xf9, 0x10, 0x0a, 0x17, 0x02, 0x6a, 0x0d, 0x7d, 0x3b, 0x1c, 0xad, 0x78, 0x6e, 0x48, 0x0f,
+	0xc2, 0x85, 0x32, 0x6f, 0xe5, 0x57, 0xb2, 0x58, 0xb7, 0xbc, 0x4e, 0x3b, 0x49, 0xdd, 0xbc, 0x7c,
+	0x74, 0xb8, 0x78, 0x21, 0x05, 0xc4, 0x34, 0x4f, 0xe2, 0xc2, 0x45, 0xbb, 0x6f, 0xf6, 0x68, 0x6b,
+	0xe8, 0x38, 0x6d, 0x6a, 0xf9, 0x34, 0x0c, 0x16, 0x2a, 0xfc, 0x13, 0x6e, 0x66, 0xc9, 0xd9, 0xf0,
+	0x2c, 0xd3, 0x79, 0xb0, 0xf3, 0x31, 0xb5, 0x42, 0xa4, 0x5d, 0xea, 0x53, 0xd7, 0xa2, 0xcd, 0x05,
+	0xf9, 0x31, 0x17, 0xef, 0xa6, 0x38, 0xe1, 0x18, 0x6f, 0xb2, 0x0e, 0x97, 0x06, 0xbe, 0xed, 0xf1,
+	0x2a, 0x38, 0x66, 0x10, 0xdc, 0x37, 0xfb, 0x74, 0xa1, 0x7a, 0xa3, 0x70, 0xb3, 0xde, 0xbc, 0x22,
+	0xd9, 0x5c, 0x6a, 0xa5, 0x09, 0x70, 0xbc, 0x0c, 0xb9, 0x09, 0x35, 0x05, 0x5c, 0x98, 0xb9, 0x51,
+	0xb8, 0x59, 0x11, 0x7d, 0x47, 0x95, 0x45, 0x8d, 0x25, 0x6b, 0x50, 0x33, 0xbb, 0x5d, 0xdb, 0x65,
+	0x94, 0x35, 0xde, 0x84, 0xd7, 0xb2, 0x3e, 0x6d, 0x59, 0xd2, 0x08, 0x3e, 0xea, 0x0d, 0x75, 0x59,
+	0xf2, 0x2e, 0x90, 0x80, 0xfa, 0xfb, 0xb6, 0x45, 0x97, 0x2d, 0xcb, 0x1b, 0xba, 0x21, 0xaf, 0x7b,
+	0x9d, 0xd7, 0xfd, 0xaa, 0xac, 0x3b, 0x69, 0x8f, 0x51, 0x60, 0x46, 0x29, 0xf2, 0x36, 0x5c, 0x94,
+	0xc3, 0x2e, 0x6a, 0x05, 0xe0, 0x9c, 0x9e, 0x67, 0x0d, 0x89, 0x29, 0x1c, 0x8e, 0x51, 0x93, 0x0e,
+	0x5c, 0x33, 0x87, 0xa1, 0xd7, 0x67, 0x2c, 0x93, 0x42, 0xb7, 0xbc, 0x3d, 0xea, 0x2e, 0x34, 0x6e,
+	0x14, 0x6e, 0xd6, 0x9a, 0x37, 0x8e, 0x0e, 0x17, 0xaf, 0x2d, 0x3f, 0x81, 0x0e, 0x9f, 0xc8, 0x85,
+	0x3c, 0x80, 0x7a, 0xc7, 0x0d, 0x5a, 0x9e, 0x63, 0x5b, 0xa3, 0x85, 0x59, 0x5e, 0xc1, 0x57, 0xe5,
+	0xa7, 0xd6, 0x57, 0xef, 0xb7, 0x05, 0xe2, 0xf8, 0x70, 0xf1, 0xda, 0xf8, 0xec, 0xb8, 0xa4, 0xf1,
+	0x18, 0xf1, 0x20, 0x9b, 0x9c, 0xe1, 0x8a, 0xe7, 0x76, 0xed, 0xde, 0xc2, 0x1c, 0xff, 0x1b, 0x37,
+	0x26, 0x74, 0xe8, 0xd5, 0xfb, 0x6d, 0x41, 0xd7, 0x9c, 0x93, 0xe2, 0xc4, 0x2b, 0x46, 0x1c, 0xae,
+	0xbe, 0x05, 0x97, 0xc6, 0x46, 0x2d, 0xb9, 0x08, 0xa5, 0x3d, 0x3a, 0xe2, 0x93, 0x52, 0x1d, 0xd9,
+	0x23, 0x79, 0x1e, 0x2a, 0xfb, 0xa6, 0x33, 0xa4, 0x0b, 0x45, 0x0e, 0x13, 0x2f, 0x3f, 0x5b, 0x7c,
+	0xa3, 0x60, 0xfc, 0x46, 0x15, 0x66, 0xd5, 0x5c, 0xd0, 0xb6, 0xdd, 0x3d, 0xf2, 0x10, 0x4a, 0x8e,
+	0xd7, 0x93, 0x33, 0xda, 0xcf, 0x4d, 0x3d, 0xbf, 0x6c, 0x78, 0xbd, 0xe6, 0xcc, 0xd1, 0xe1, 0x62,
+	0x69, 0xc3, 0xeb, 0x21, 0xe3, 0x48, 0x2c, 0xa8, 0xec, 0x99, 0xdd, 0x3d, 0x93, 0xd7, 0xa1, 0x71,
+	0xbb, 0x39, 0x35, 0xeb, 0x7b, 0x8c, 0x0b, 0xab, 0x6b, 0xb3, 0x7e, 0x74, 0xb8, 0x58, 0xe1, 0xaf,
+	0x28, 0x78, 0x13, 0x0f, 0xea, 0x3b, 0x8e, 0x69, 0xed, 0xed, 0x7a, 0x0e, 0x5d, 0x28, 0xe5, 0x14,
+	0xd4, 0x54, 0x9c, 0xc4, 0x0f, 0xd0, 0xaf, 0x18, 0xc9, 0x20, 0x16, 0x54, 0x87, 0x9d, 0xc0, 0x76,
+	0xf7, 0xe4, 0xec, 0xf4, 0xd6, 0xd4, 0xd2, 0xb6, 0x57, 0xf9, 0x37, 0xc1, 0xd1, 0xe1, 0x62, 0x55,
+	0x3c, 0xa3, 0x64, 0x4d, 0x3e, 0x82, 0xf2, 0x6e, 0x18, 0x0e, 0x16, 0x2a, 0x39, 0x97, 0x99, 0x77,
+	0xb6, 0xb6, 0x5a, 0x5c, 0x48, 0xed, 0xe8, 0x70, 0xb1, 0xcc, 0xde, 0x90, 0x33, 0x66, 0x02, 0xba,
+	0xb6, 0x23, 0x26, 0xa2, 0x3c, 0x02, 0xd6, 0x6c, 0x87, 0x46, 0x02, 0xd8, 0x1b, 0x72, 0xc6, 0xe4,
+	0x21, 0x14, 0x83, 0xd7, 0xf8, 0x3c, 0x95, 0xa7, 0x89, 0xda, 0xaf, 0x71, 0xe6, 0xd5, 0xa3, 0xc3,
+	0xc5, 0x62, 0xfb, 0x35, 0x2c, 0x06, 0xaf, 0x91, 0x47, 0x50, 0x0a, 0x3e, 0x71, 0xe4, 0xbc, 0xf6,
+	0xf6, 0xf4, 0x9c, 0xdf, 0xdb, 0xe0, 0xac, 0x79, 0x97, 0x6d, 0xbf, 0xb7, 0x81, 0x8c, 0xab, 0xf1,
+	0x8f, 0x01, 0xe6, 0xd5, 0xe0, 0x78, 0x9f, 0xfa, 0x21, 0x3d, 0x20, 0x37, 0xa0, 0xec, 0xb2, 0xc9,
+	0x8a, 0x0f, 0xae, 0xe6, 0xac, 0x9c, 0x0b, 0xca, 0x7c, 0x92, 0xe2, 0x18, 0xd6, 0x23, 0x84, 0xa2,
+	0x23, 0x3b, 0x7a, 0x8e, 0xcf, 0xe5, 0x6c, 0x44, 0x8f, 0x10, 0xcf, 0x28, 0x59, 0x93, 0x47, 0x50,
+	0xe6, 0x9d, 0x4e, 0x74, 0xf1, 0xaf, 0x4d, 0x2f, 0x42, 0xff, 0x2c, 0xde, 0xe1, 0x38, 0x53, 0x36,
+	0x05, 0x0c, 0x3b, 0x5d, 0xd9, 0xa1, 0x7f, 0x2e, 0x47, 0x87, 0x5e, 0x13, 0xed, 0xb9, 0xbd, 0xba,
+	0x86, 0x8c, 0x23, 0xf9, 0xb5, 0x02, 0x5c, 0xb2, 0x3c, 0x37, 0x34, 0x99, 0xf2, 0xa5, 0xd4, 0x0e,
+	0xd9, 0xab, 0xdf, 0x9d, 0x5a, 0xce, 0x4a, 0x9a, 0x63, 0xf3, 0x05, 0xb6, 0x8a, 0x8e, 0x81, 0x71,
+	0x5c, 0x36, 0xf9, 0xab, 0x05, 0x78, 0x81, 0xad, 0x6e, 0x63, 0xc4, 0x72, 0x28, 0x9c, 0x65, 0xad,
+	0xae, 0x1c, 0x1d, 0x2e, 0xbe, 0x70, 0x37, 0x4b, 0x18, 0x66, 0xd7, 0x81, 0xd5, 0xee, 0xb2, 0x39,
+	0xae, 0xa8, 0xc9, 0x71, 0xb4, 0x71, 0x96, 0xca, 0x5f, 0xf3, 0x8b, 0xb2, 0x2b, 0x67, 0xe9, 0xba,
+	0x98, 0x55, 0x0b, 0x72, 0x07, 0x66, 0xf6, 0x3d, 0x67, 0xd8, 0xa7, 0xc1, 0x42, 0x8d, 0x6b, 0x4c,
+	0x57, 0xb3, 0x16, 0xb2, 0xf7, 0x39, 0x49, 0xf3, 0x82, 0x64, 0x3f, 0x23, 0xde, 0x03, 0x54, 0x65,
+	0x89, 0x0d, 0x55, 0xc7, 0xee, 0xdb, 0x61, 0xc0, 0x55, 0x89, 0xc6, 0xed, 0x3b, 0x53, 0x7f, 0x96,
+	0x18, 0xa2, 0x1b, 0x9c, 0x99, 0x18, 0x35, 0xe2, 0x19, 0xa5, 0x00, 0xb6, 0x04, 0x05, 0x96, 0xe9,
+	0x08, 0x55, 0xa3, 0x71, 0xfb, 0xcd, 0xe9, 0x87, 0x0d, 0xe3, 0xd2, 0x9c, 0x93, 0xdf, 0x54, 0xe1,
+	0xaf, 0x28, 0x78, 0x93, 0x3f, 0x0d, 0xf3, 0x89, 0xbf, 0x19, 0x2c, 0x34, 0x78, 0xeb, 0xbc, 0x94,
+	0xd5, 0x3a, 0x9a, 0xaa, 0xf9, 0xa2, 0x64, 0x36, 0x9f, 0xe8, 0x21, 0x01, 0xa6, 0x98, 0x91, 0x7b,
+	0x50, 0x0b, 0xec, 0x0e, 0xb5, 0x4c, 0x3f, 0x58, 0x98, 0x3d, 0x09, 0xe3, 0x8b, 0x92, 0x71, 0xad,
+	0x2d, 0x8b, 0xa1, 0x66, 0x40, 0x96, 0x00, 0x06, 0xa6, 0x1f, 0xda, 0x42, 0x75, 0x9f, 0xe3, 0x6a,
+	0xe4, 0xfc, 0xd1, 0xe1, 0x22, 0xb4, 0x34, 0x14, 0x63, 0x14, 0x8c, 0x9e, 0x95, 0xbd, 0xeb, 0x0e,
+	0x86, 0x61, 0xb0, 0x30, 0x7f, 0xa3, 0x74, 0xb3, 0x2e, 0xe8, 0xdb, 0x1a, 0x8a, 0x31, 0x0a, 0xe3,
+	0x21, 0xcc, 0x2d, 0x0f, 0xc3, 0x5d, 0xcf, 0xb7, 0x3f, 0xe5, 0x6a, 0x3d, 0x59, 0x83, 0x4a, 0xc8,
+	0xd5, 0x33, 0xa1, 0x5f, 0xbc, 0x9c, 0x55, 0x75, 0xa1, 0x2a, 0xdf, 0xa3, 0x23, 0xa5, 0xd5, 0x88,
+	0x75, 0x5e, 0xa8, 0x6b, 0xa2, 0xb8, 0xf1, 0xd7, 0x0b, 0x50, 0x6f, 0x9a, 0x81, 0x6d, 0x31, 0xf6,
+	0x64, 0x05, 0xca, 0xc3, 0x80, 0xfa, 0xa7, 0x63, 0xca, 0x67, 0xbd, 0xed, 0x80, 0xfa, 0xc8, 0x0b,
+	0x93, 0x07, 0x50, 0x1b, 0x98, 0x41, 0xf0, 0xd8, 0xf3, 0x3b, 0x72, 0xe6, 0x3e, 0x21, 0x23, 0xa1,
+	0x77, 0xcb, 0xa2, 0xa8, 0x99, 0x18, 0x0d, 0x88, 0x54, 0x06, 0xe3, 0x87, 0x05, 0xb8, 0xdc, 0x1c,
+	0x76, 0xbb, 0xd4, 0x97, 0x6a, 0xa6, 0x50, 0xe0, 0x08, 0x85, 0x8a, 0x4f, 0x3b, 0x76, 0x20, 0xeb,
+	0xbe, 0x3a, 0x75, 0x97, 0x44, 0xc6, 0x45, 0xea, 0x8b, 0xbc, 0xbd, 0x38, 0x00, 0x05, 0x77, 0x32,
+	0x84, 0xfa, 0xc7, 0x34, 0x0c, 0x42, 0x9f, 0x9a, 0x7d, 0xf9, 0x75, 0xef, 0x4c, 0x2d, 0xea, 0x5d,
+	0x1a, 0xb6, 0x39, 0xa7, 0xb8, 0x7a, 0xaa, 0x81, 0x18, 0x49, 0x32, 0xfe, 0x41, 0x01, 0xe6, 0x57,
+	0x6c, 0xdf, 0x1a, 0xda, 0x61, 0xd3, 0xa7, 0xe6, 0x1e, 0xf5, 0x99, 0xe6, 0xdf, 0x35, 0x6d, 0x67,
+	0xe8, 0xd3, 0xad, 0x5d, 0x9f, 0x06, 0xbb, 0x9e, 0xd3, 0xe1, 0xdf, 0x3e, 0x27, 0x34, 0xff, 0xb5,
+	0x14, 0x0e, 0xc7, 0xa8, 0x49, 0x07, 0x66, 0xbd, 0x01, 0x75, 0x57, 0x87, 0xc2, 0x54, 0x94, 0x9f,
+	0xb3, 0x14, 0xfb, 0x59, 0xda, 0xbe, 0x8f, 0xbe, 0x82, 0x59, 0xd2, 0xec, 0xf7, 0xa9, 0x52, 0xcd,
+	0x8b, 0xcc, 0xac, 0x7d, 0x10, 0xe3, 0x83, 0x09, 0xae, 0xc6, 0x3f, 0xab, 0xc0, 0xec, 0x8a, 0xd7,
+	0xdf, 0xb1, 0x5d, 0xda, 0xb9, 0xd3, 0xe9, 0x51, 0xa6, 0x23, 0xd1, 0x4e, 0x8f, 0xca, 0x1f, 0x35,
+	0xfd, 0x92, 0xcb, 0x98, 0x45, 0x8a, 0x03, 0x7b, 0x43, 0xce, 0x98, 0x6c, 0xc0, 0x7c, 0xd7, 0xf7,
+	0xfa, 0x62, 0x16, 0xdb, 0x1a, 0x0d, 0xa4, 0xb6, 0xde, 0xfc, 0x23, 0x6a, 0x66, 0x58, 0x4b, 0x60,
+	0x8f, 0x0f, 0x17, 0x21, 0x7a, 0xc3, 0x54, 0x59, 0xf2, 0x01, 0x2c, 0x44, 0x10, 0x3d, 0x9c, 0x57,
+	0x98, 0x69, 0xc3, 0xb5, 0x86, 0x4a, 0xf3, 0xda, 0xd1, 0xe1, 0xe2, 0xc2, 0xda, 0x04, 0x1a, 0x9c,
+	0x58, 0x9a, 0x7c, 0x56, 0x80, 0x8b, 0x11, 0x52, 0x4c, 0xb1, 0x52, 0x59, 0x38, 0xa3, 0xb9, 0x5b,
+	0xf4, 0x84, 0x94, 0x08, 0x1c, 0x13, 0x4a, 0xd6, 0x60, 0x36, 0xf4, 0x62, 0xed, 0x55, 0xe1, 0xed,
+	0x65, 0x28, 0xa7, 0xc5, 0x96, 0x37, 0xb1, 0xb5, 0x12, 0xe5, 0x08, 0xc2, 0x8b, 0xea, 0x3d, 0xd5,
+	0x52, 0x55, 0xde, 0x52, 0x57, 0x8f, 0x0e, 0x17, 0x5f, 0xdc, 0xca, 0xa4, 0xc0, 0x09, 0x25, 0xc9,
+	0x37, 0x0b, 0x30, 0xaf, 0x50, 0xb2, 0x8d, 0x66, 0xce, 0xb2, 0x8d, 0x08, 0xeb, 0x11, 0x5b, 0x09,
+	0x01, 0x98, 0x12, 0x68, 0xfc, 0xaf, 0x32, 0xd4, 0xf5, 0x42, 0x40, 0xbe, 0x04, 0x15, 0xee, 0x8e,
+	0x90, 0xba, 0xab, 0x5e, 0xbd, 0xb8, 0xd7, 0x02, 0x05, 0x8e, 0xbc, 0x0c, 0x33, 0x96, 0xd7, 0xef,
+	0x9b, 0x6e, 0x87, 0xbb, 0x98, 0xea, 0xcd, 0x06, 0x5b, 0xb4, 0x57, 0x04, 0x08, 0x15, 0x8e, 0x5c,
+	0x83, 0xb2, 0xe9, 0xf7, 0x84, 0xb7, 0xa7, 0x2e, 0xa6, 0xd2, 0x65, 0xbf, 0x17, 0x20, 0x87, 0x92,
+	0xaf, 0x42, 0x89, 0xba, 0xfb, 0x0b, 0xe5, 0xc9, 0x5a, 0xc1, 0x1d, 0x77, 0xff, 0x7d, 0xd3, 0x6f,
+	0x36, 0x64, 0x1d, 0x4a, 0x77, 0xdc, 0x7d, 0x64, 0x65, 0xc8, 0x06, 0xcc, 0x50, 0x77, 0x9f, 0xfd,
+	0x7b, 0xe9, 0x86, 0xf9, 0x89, 0x09, 0xc5, 0x19, 0x89, 0x54, 0x90, 0xb5, 0x6e, 0x21, 0xc1, 0xa8,
+	0x58, 0x90, 0x9f, 0x87, 0x59, 0xa1, 0x66, 0x6c, 0xb2, 0x7f, 0x12, 0x2c, 0x54, 0x39, 0xcb, 0xc5,
+	0xc9, 0x7a, 0x0a, 0xa7, 0x8b, 0xdc, 0x5e, 0x31, 0x60, 0x80, 0x09, 0x56, 0xe4, 0xe7, 0xa1, 0xae,
+	0x3c, 0x9a, 0xea, 0xcf, 0x66, 0x7a, 0x8c, 0x50, 0x12, 0x21, 0xfd, 0x64, 0x68, 0xfb, 0xb4, 0x4f,
+	0xdd, 0x30, 0x68, 0x5e, 0x52, 0x3e, 0x04, 0x85, 0x0d, 0x30, 0xe2, 0x46, 0x76, 0xc6, 0x5d, 0x5f,
+	0xc2, 0xbe, 0xf9, 0xd2, 0x84, 0x05, 0x69, 0x0a, 0xbf, 0xd7, 0x87, 0x70, 0x41, 0xfb, 0xa6, 0xa4,
+	0x7b, 0x43, 0x78, 0x72, 0x5e, 0x67, 0xc5, 0xef, 0x26, 0x51, 0xc7, 0x87, 0x8b, 0x2f, 0x65, 0x38,
+	0x38, 0x22, 0x02, 0x4c, 0x33, 0x33, 0xfe, 0x49, 0x09, 0xc6, 0x35, 0xf0, 0x64, 0xa3, 0x15, 0xce,
+	0xba, 0xd1, 0xd2, 0x1f, 0x24, 0xa6, 0xcf, 0x37, 0x64, 0xb1, 0xfc, 0x1f, 0x95, 0xf5, 0x63, 0x4a,
+	0x67, 0xfd, 0x63, 0x3e, 0x2f, 0x63, 0xc7, 0xf8, 0x76, 0x19, 0xe6, 0x57, 0x4d, 0xda, 0xf7, 0xdc,
+	0xa7, 0xda, 0x23, 0x85, 0xcf, 0x85, 0x3d, 0x72, 0x13, 0x6a, 0x3e, 0x1d, 0x38, 0xb6, 0x65, 0x06,
+	0xfc, 0xd7, 0x4b, 0x8f, 0x28, 0x4a, 0x18, 0x6a, 0xec, 0x04, 0x3b, 0xb4, 0xf4, 0xb9, 0xb4, 0x43,
+	0xcb, 0x3f, 0x7a, 0x3b, 0xd4, 0xf8, 0x66, 0x11, 0xb8, 0xa2, 0x42, 0x6e, 0x40, 0x99, 0x2d, 0xc2,
+	0x69, 0xef, 0x07, 0xef, 0x38, 0x1c, 0x43, 0xae, 0x42, 0x31, 0xf4, 0xe4, 0xc8, 0x03, 0x89, 0x2f,
+	0x6e, 0x79, 0x58, 0x0c, 0x3d, 0xf2, 0x29, 0x80, 0xe5, 0xb9, 0x1d, 0x5b, 0x6d, 0x14, 0xe4, 0xfb,
+	0xb0, 0x35, 0xcf, 0x7f, 0x6c, 0xfa, 0x9d, 0x15, 0xcd, 0x51, 0x58, 0x22, 0xd1, 0x3b, 0xc6, 0xa4,
+	0x91, 0xb7, 0xa0, 0xea, 0xb9, 0x6b, 0x43, 0xc7, 0xe1, 0x0d, 0x5a, 0x6f, 0xfe, 0x51, 0x66, 0x1e,
+	0x3e, 0xe0, 0x90, 0xe3, 0xc3, 0xc5, 0x2b, 0x42, 0x35, 0x67, 0x6f, 0x0f, 0x7d, 0x3b, 0xb4, 0xdd,
+	0x5e, 0x3b, 0xf4, 0xcd, 0x90, 0xf6, 0x46, 0x28, 0x8b, 0x19, 0x7b, 0x30, 0xb7, 0x66, 0x3b, 0xf4,
+	0xce, 0x3e, 0x75, 0xc3, 0x2d, 0xbb, 0x4f, 0xc9, 0x6d, 0x00, 0x7a, 0x30, 0xf0, 0x69, 0x10, 0x30,
+	0x25, 0x54, 0xb4, 0x08, 0x91, 0x5f, 0x0c, 0x77, 0x34, 0x06, 0x63, 0x54, 0xe4, 0x15, 0xa8, 0x76,
+	0x3d, 0xbf, 0x6f, 0x86, 0xb2, 0x85, 0xe6, 0x25, 0x7d, 0x75, 0x8d, 0x43, 0x51, 0x62, 0x8d, 0x7f,
+	0x5b, 0x81, 0x9a, 0xf2, 0xa5, 0x31, 0x41, 0x62, 0xe5, 0xb9, 0x1f, 0x39, 0x9e, 0xb4, 0xa0, 0xf7,
+	0x35, 0x06, 0x63, 0x54, 0xec, 0x47, 0x0d, 0xcc, 0x70, 0x57, 0x8a, 0xd1, 0x3f, 0xaa, 0x65, 0x86,
+	0xbb, 0xc8, 0x31, 0xe4, 0x1d, 0x68, 0x58, 0x5e, 0x5f, 0xd7, 0xbf, 0xc4, 0x09, 0x5f, 0x51, 0xdb,
+	0x32, 0x2b, 0x11, 0xea, 0xf8, 0x70, 0xf1, 0x02, 0xab, 0x4b, 0x0c, 0x84, 0xf1, 0xa2, 0x24, 0x80,
+	0x4b, 0xda, 0x44, 0xd4, 0x4a, 0x79, 0x79, 0x2a, 0xa5, 0x9c, 0x0f, 0x98, 0x56, 0x9a, 0x19, 0x8e,
+	0xf3, 0x27, 0xcb, 0x70, 0x41, 0x03, 0x45, 0xe3, 0x49, 0xed, 0xef, 0x0b, 0x6a, 0xba, 0x6f, 0x25,
+	0xd1, 0x98, 0xa6, 0x27, 0x26, 0x34, 0xfa, 0xe6, 0x81, 0x68, 0xe6, 0x4f, 0x95, 0xc3, 0xe7, 0x89,
+	0x35, 0x5e, 0x52, 0xcb, 0xcd, 0xd2, 0x7b, 0x43, 0xd3, 0x0d, 0xed, 0x70, 0xd4, 0xbc, 0xc0, 0x5a,
+	0x6b, 0x33, 0x62, 0x83, 0x71, 0x9e, 0xcc, 0x54, 0xf1, 0x3d, 0xc7, 0xb9, 0xeb, 0x86, 0xd4, 0xdf,
+	0x37, 0x1d, 0xa9, 0x27, 0x4c, 0x65, 0xaa, 0x60, 0x8c, 0x0f, 0x26, 0xb8, 0x92, 0x37, 0x74, 0xaf,
+	0xaa, 0xf1, 0x26, 0xb8, 0x91, 0xec, 0x55, 0xc7, 0xcc, 0x74, 0x90, 0x9d, 0x29, 0xd9, 0xcf, 0x88,
+	0x0b, 0x33, 0x03, 0xd3, 0xff, 0x64, 0x48, 0x43, 0xe9, 0x7c, 0x59, 0x9f, 0x7a, 0x38, 0xb6, 0x04,
+	0x9f, 0x07, 0x03, 0x31, 0x16, 0xb9, 0xda, 0x28, 0x61, 0xa8, 0x84, 0x18, 0xbf, 0x5b, 0x02, 0xe0,
+	0x55, 0x11, 0x5e, 0xcc, 0xf3, 0xe9, 0xd9, 0xaf, 0xeb, 0xe6, 0x10, 0x9d, 0xfa, 0xda, 0x58, 0x73,
+	0xf0, 0x3a, 0xa4, 0x9a, 0xc2, 0x60, 0xa5, 0x1c, 0xc7, 0x7b, 0xcc, 0xbb, 0x6e, 0x4d, 0xf8, 0x8f,
+	0xd6, 0x38, 0x04, 0x25, 0x86, 0xfd, 0xce, 0x41, 0xfc, 0x77, 0x56, 0xa6, 0xff, 0x9d, 0xad, 0xc4,
+	0xef, 0x8c, 0x73, 0x25, 0x6f, 0xc2, 0xbc, 0xb5, 0x4b, 0xad, 0xbd, 0x81, 0x67, 0xbb, 0x21, 0xfb,
+	0x2e, 0xb9, 0x3f, 0xa8, 0x3d, 0x44, 0x2b, 0x09, 0x2c, 0xa6, 0xa8, 0x49, 0x00, 0x75, 0xaa, 0x66,
+	0x29, 0xd9, 0xe3, 0xd6, 0x72, 0x79, 0xf4, 0xf5, 0x9c, 0x27, 0x2c, 0x7d, 0xfd, 0x8a, 0x91, 0x1c,
+	0xc3, 0x84, 0xc6, 0x9a, 0x7d, 0x40, 0x3b, 0x0f, 0x6d, 0xb7, 0xe3, 0x3d, 0x26, 0x08, 0x55, 0x87,
+	0xba, 0xbd, 0x70, 0x57, 0xea, 0x06, 0xa7, 0x6d, 0x23, 0xe1, 0xbd, 0xe3, 0x1c, 0x50, 0x72, 0x32,
+	0x46, 0x70, 0x69, 0x6c, 0xce, 0x27, 0x1d, 0x28, 0x87, 0x66, 0x4f, 0x29, 0x93, 0xd3, 0x7f, 0xe7,
+	0x96, 0xd9, 0x8b, 0xad, 0x24, 0xdc, 0xa0, 0xd9, 0x32, 0x99, 0x41, 0xc3, 0xb8, 0x1b, 0xff, 0xbb,
+	0x00, 0xb5, 0xb5, 0xa1, 0x6b, 0xf1, 0xa9, 0xe7, 0xe9, 0x5b, 0x00, 0xca, 0x3a, 0x2a, 0x66, 0x5a,
+	0x47, 0x43, 0xa8, 0xee, 0x3d, 0xd6, 0xd6, 0x53, 0xe3, 0xf6, 0xe6, 0xf4, 0x3f, 0x47, 0x56, 0x69,
+	0xe9, 0x1e, 0xe7, 0x27, 0xf6, 0xec, 0xf5, 0x9a, 0x72, 0xef, 0x21, 0x17, 0x2a, 0x85, 0x5d, 0xfd,
+	0x2a, 0x34, 0x62, 0x64, 0xa7, 0xdb, 0x24, 0x2c, 0x02, 0xac, 0x63, 0x6b, 0x45, 0x0e, 0xdb, 0x0e,
+	0x94, 0xcd, 0xa1, 0xfe, 0xb5, 0xd3, 0xb7, 0x79, 0xc2, 0x35, 0x28, 0x9b, 0x69, 0xc8, 0x86, 0x31,
+	0xe3, 0x4e, 0x1e, 0x42, 0x29, 0x74, 0x02, 0xe9, 0xdd, 0x99, 0x7e, 0x17, 0x62, 0x6b, 0xa3, 0x2d,
+	0x76, 0x21, 0xb6, 0x36, 0xda, 0xc8, 0x38, 0x92, 0x9f, 0x84, 0x19, 0xb9, 0x23, 0xcd, 0x27, 0x88,
+	0x5a, 0xa4, 0x03, 0x4b, 0xd7, 0x1c, 0x2a, 0x3c, 0x9b, 0x14, 0x1e, 0xf3, 0x0e, 0xcd, 0x27, 0x85,
+	0x39, 0xd1, 0x2d, 0x45, 0x17, 0x47, 0x89, 0x31, 0xfe, 0x7e, 0x19, 0xaa, 0xeb, 0xed, 0xf6, 0x72,
+	0xeb, 0x2e, 0xf9, 0x19, 0x68, 0xc8, 0x92, 0xb1, 0x09, 0x4d, 0x87, 0x3a, 0xb4, 0x23, 0x14, 0xc6,
+	0xe9, 0x98, 0x61, 0xee, 0x53, 0xd3, 0xe9, 0xcb, 0x39, 0x4d, 0x1b, 0xe6, 0xc8, 0x80, 0x28, 0x70,
+	0xc4, 0x84, 0xf9, 0x61, 0x40, 0x7d, 0xd6, 0xbf, 0x84, 0x0b, 0x52, 0x2a, 0x50, 0x27, 0x74, 0x52,
+	0x72, 0x77, 0xc1, 0x76, 0x82, 0x01, 0xa6, 0x18, 0x92, 0x37, 0xa0, 0xc6, 0x5a, 0x9e, 0xbb, 0x52,
+	0x84, 0x96, 0x74, 0x8d, 0x87, 0x02, 0x48, 0xd8, 0xf1, 0xe1, 0xe2, 0xec, 0x3d, 0x6c, 0xfe, 0x8c,
+	0x7a, 0x47, 0x4d, 0xcd, 0x2a, 0xa7, 0xdc, 0x9e, 0xb2, 0x72, 0x95, 0x53, 0x57, 0xae, 0x95, 0x60,
+	0x80, 0x29, 0x86, 0xe4, 0x11, 0xcc, 0xee, 0xd1, 0x51, 0x68, 0xee, 0x48, 0x01, 0xd5, 0xd3, 0x08,
+	0xe0, 0x53, 0xee, 0xbd, 0x58, 0x71, 0x4c, 0x30, 0x23, 0x01, 0x3c, 0xbf, 0x47, 0xfd, 0x1d, 0xea,
+	0x7b, 0xd2, 0x85, 0x2a, 0x85, 0xcc, 0x9c, 0x46, 0xc8, 0xc2, 0xd1, 0xe1, 0xe2, 0xf3, 0xf7, 0x32,
+	0xd8, 0x60, 0x26, 0x73, 0xe3, 0xb3, 0x0a, 0x5c, 0x58, 0x17, 0xc1, 0x46, 0x9e, 0x2f, 0x87, 0xd6,
+	0x15, 0x28, 0xf9, 0x83, 0x21, 0xef, 0x39, 0x25, 0xd1, 0x6d, 0xb1, 0xb5, 0x8d, 0x0c, 0x46, 0x3e,
+	0x80, 0x5a, 0x27, 0x9f, 0xcb, 0x93, 0x9b, 0x43, 0x5a, 0xa9, 0xd2, 0xdc, 0xc8, 0xcb, 0x30, 0xd3,
+	0x0f, 0x7a, 0x5c, 0x09, 0x12, 0x9e, 0x41, 0xbe, 0x78, 0x6f, 0x0a, 0x10, 0x2a, 0x1c, 0xb3, 0xaf,
+	0xf6, 0xe8, 0x48, 0xf8, 0xc5, 0xca, 0x91, 0x7d, 0x75, 0x4f, 0xc2, 0x50, 0x63, 0xc9, 0xa2, 0x9a,
+	0x49, 0x58, 0x2f, 0x28, 0x0b, 0x77, 0xf4, 0xfb, 0x0c, 0x20, 0x27, 0x15, 0xc6, 0x2a, 0x8c, 0x6f,
+	0xb4, 0xd5, 0x05, 0x2b, 0x6d, 0x87, 0x68, 0x2c, 0xf9, 0xac, 0x00, 0x17, 0xf6, 0xe8, 0x68, 0xd5,
+	0x0e, 0x42, 0xdf, 0xde, 0x19, 0xf2, 0xaf, 0x9f, 0xc9, 0xe9, 0xbf, 0xbe, 0x97, 0xe4, 0x27, 0x0c,
+	0xf3, 0x14, 0x10, 0xd3, 0x52, 0xd9, 0x92, 0xf6, 0xb1, 0x1d, 0x86, 0xd4, 0x97, 0xce, 0x98, 0xa9,
+	0x96, 0xb4, 0x77, 0x39, 0x07, 0x94, 0x9c, 0xc8, 0xab, 0xd0, 0x60, 0x5f, 0xd9, 0xa2, 0xbe, 0x45,
+	0x5d, 0xa1, 0x83, 0xcd, 0x09, 0x95, 0x72, 0x23, 0x02, 0x63, 0x9c, 0x86, 0xaf, 0xac, 0xcc, 0x8a,
+	0x1b, 0xc9, 0x4d, 0xac, 0xe9, 0x56, 0x56, 0xce, 0x01, 0x25, 0x27, 0xe3, 0xd7, 0x8a, 0xf0, 0xe2,
+	0x3a, 0x0d, 0x85, 0xb5, 0xbf, 0x4a, 0x07, 0x8e, 0x37, 0xea, 0x33, 0xc1, 0xf4, 0x13, 0xf2, 0x36,
+	0x80, 0x1d, 0xec, 0xb4, 0xf7, 0x2d, 0x3e, 0x2b, 0x14, 0x12, 0xfa, 0x25, 0xdc, 0x6d, 0x37, 0x25,
+	0xe6, 0x38, 0xf1, 0x86, 0xb1, 0x32, 0x91, 0xdb, 0xb1, 0xf8, 0x04, 0xb7, 0x63, 0x1b, 0x60, 0x10,
+	0x39, 0x6e, 0x84, 0xde, 0xf6, 0x9a, 0x12, 0x73, 0x1a, 0x9f, 0x4d, 0x8c, 0x4d, 0x0e, 0x57, 0x8a,
+	0xf1, 0x0f, 0x4b, 0x70, 0x75, 0x9d, 0x86, 0x7a, 0x53, 0x43, 0xce, 0xdd, 0xed, 0x01, 0xb5, 0x58,
+	0xab, 0x7c, 0x56, 0x60, 0x7f, 0x61, 0x87, 0x3a, 0x4c, 0xf1, 0x60, 0xdc, 0x3f, 0x9a, 0xba, 0x33,
+	0x4e, 0x96, 0xb2, 0xb4, 0xc1, 0x25, 0xa4, 0x56, 0x75, 0x01, 0x44, 0x29, 0x9e, 0x2d, 0x39, 0x96,
+	0x33, 0x0c, 0x42, 0xea, 0xb7, 0x3c, 0x3f, 0x94, 0x7e, 0x0f, 0xbd, 0xe4, 0xac, 0x44, 0x28, 0x8c,
+	0xd3, 0x31, 0xcd, 0xdb, 0x72, 0x6c, 0xea, 0x86, 0xbc, 0x94, 0x18, 0xf5, 0x5a, 0xf3, 0x5e, 0xd1,
+	0x18, 0x8c, 0x51, 0x31, 0x51, 0x7d, 0xcf, 0xb5, 0x43, 0x4f, 0x88, 0x2a, 0x27, 0x45, 0x6d, 0x46,
+	0x28, 0x8c, 0xd3, 0xf1, 0x62, 0x34, 0xf4, 0x6d, 0x2b, 0xe0, 0xc5, 0x2a, 0xa9, 0x62, 0x11, 0x0a,
+	0xe3, 0x74, 0x4c, 0x5d, 0x89, 0x7d, 0xff, 0xa9, 0xd4, 0x95, 0xdf, 0xae, 0xc1, 0xf5, 0x44, 0xb3,
+	0x86, 0x66, 0x48, 0xbb, 0x43, 0xa7, 0x4d, 0x43, 0xf5, 0x03, 0xa7, 0x5c, 0xa9, 0xff, 0x42, 0xf4,
+	0xdf, 0x45, 0x00, 0xa6, 0x75, 0x36, 0xff, 0x7d, 0xac, 0x82, 0x27, 0xfa, 0xf7, 0xb7, 0xa0, 0xee,
+	0x9a, 0x61, 0xc0, 0x07, 0x92, 0x1c, 0x33, 0xda, 0x47, 0x7a, 0x5f, 0x21, 0x30, 0xa2, 0x21, 0x2d,
+	0x78, 0x5e, 0x36, 0xf1, 0x9d, 0x83, 0x81, 0xe7, 0x87, 0xd4, 0x17, 0x65, 0xcb, 0x09, 0x3b, 0xe9,
+	0xf9, 0xcd, 0x0c, 0x1a, 0xcc, 0x2c, 0x49, 0x36, 0xe1, 0xb2, 0x25, 0x82, 0xd2, 0xa8, 0xe3, 0x99,
+	0x1d, 0xc5, 0x50, 0x98, 0xe2, 0xda, 0x85, 0xb7, 0x32, 0x4e, 0x82, 0x59, 0xe5, 0xd2, 0xbd, 0xb9,
+	0x3a, 0x55, 0x6f, 0x9e, 0x99, 0xa6, 0x37, 0xd7, 0xa6, 0xeb, 0xcd, 0xf5, 0x93, 0xf5, 0x66, 0xd6,
+	0xf2, 0xac, 0x1f, 0x51, 0x9f, 0x29, 0x4f, 0x62, 0xfd, 0x8f, 0xc5, 0x3c, 0xea, 0x96, 0x6f, 0x67,
+	0xd0, 0x60, 0x66, 0x49, 0xb2, 0x03, 0x57, 0x05, 0xfc, 0x8e, 0x6b, 0xf9, 0x23, 0x6e, 0x75, 0xc7,
+	0xf8, 0x36, 0x12, 0x3b, 0x61, 0x57, 0xdb, 0x13, 0x29, 0xf1, 0x09, 0x5c, 0xc8, 0x9f, 0x84, 0x39,
+	0xf1, 0x97, 0x36, 0xcd, 0x01, 0x67, 0x2b, 0x22, 0x20, 0x5f, 0x90, 0x6c, 0xe7, 0x56, 0xe2, 0x48,
+	0x4c, 0xd2, 0x72, 0x0f, 0xcd, 0xbe, 0xc5, 0x1e, 0xef, 0x76, 0xef, 0x53, 0xda, 0xa1, 0x1d, 0x1e,
+	0x60, 0x10, 0xf7, 0xd0, 0x24, 0xd1, 0x98, 0xa6, 0x27, 0x6f, 0xc0, 0x6c, 0x10, 0x9a, 0x7e, 0x28,
+	0xb7, 0x9f, 0x16, 0xe6, 0x45, 0x84, 0xa8, 0xda, 0x9d, 0x69, 0xc7, 0x70, 0x98, 0xa0, 0xcc, 0x33,
+	0x7b, 0x1c, 0x8b, 0xc5, 0x90, 0x6f, 0x9f, 0xa7, 0xa6, 0xfd, 0x6f, 0xa5, 0xa7, 0xfd, 0x47, 0x79,
+	0x86, 0x7f, 0x86, 0x84, 0x13, 0x0d, 0xfb, 0x77, 0x81, 0xf8, 0x72, 0xb3, 0x5f, 0xf8, 0x69, 0x63,
+	0x33, 0xbf, 0x8e, 0xc3, 0xc5, 0x31, 0x0a, 0xcc, 0x28, 0x45, 0xda, 0xf0, 0x42, 0x40, 0xdd, 0xd0,
+	0x76, 0xa9, 0x93, 0x64, 0x27, 0x96, 0x84, 0x97, 0x24, 0xbb, 0x17, 0xda, 0x59, 0x44, 0x98, 0x5d,
+	0x36, 0x4f, 0xe3, 0xff, 0x87, 0x3a, 0x5f, 0x77, 0x45, 0xd3, 0x9c, 0xd9, 0xb4, 0xfd, 0x59, 0x7a,
+	0xda, 0xfe, 0x28, 0xff, 0x7f, 0x9b, 0x6e, 0xca, 0xbe, 0x0d, 0xc0, 0xff, 0x42, 0x7c, 0xce, 0xd6,
+	0x33, 0x15, 0x6a, 0x0c, 0xc6, 0xa8, 0xd8, 0x28, 0x54, 0xed, 0x1c, 0x9f, 0xae, 0xf5, 0x28, 0x6c,
+	0xc7, 0x91, 0x98, 0xa4, 0x9d, 0x38, 0xe5, 0x57, 0xa6, 0x9e, 0xf2, 0xdf, 0x05, 0x92, 0xd8, 0x25,
+	0x10, 0xfc, 0xaa, 0xc9, 0x30, 0xf0, 0xbb, 0x63, 0x14, 0x98, 0x51, 0x6a, 0x42, 0x57, 0x9e, 0x39,
+	0xdb, 0xae, 0x5c, 0x9b, 0xbe, 0x2b, 0x93, 0x8f, 0xe0, 0x0a, 0x17, 0x25, 0xdb, 0x27, 0xc9, 0x58,
+	0x4c, 0xfe, 0x3f, 0x21, 0x19, 0x5f, 0xc1, 0x49, 0x84, 0x38, 0x99, 0x07, 0xfb, 0x3f, 0x96, 0x4f,
+	0x3b, 0x4c, 0xb8, 0xe9, 0x4c, 0x5e, 0x18, 0x56, 0x32, 0x68, 0x30, 0xb3, 0x24, 0xeb, 0x62, 0x21,
+	0xeb, 0x86, 0xe6, 0x8e, 0x43, 0x3b, 0x32, 0x0c, 0x5e, 0x77, 0xb1, 0xad, 0x8d, 0xb6, 0xc4, 0x60,
+	0x8c, 0x2a, 0x6b, 0xae, 0x9e, 0x3d, 0xe5, 0x5c, 0xbd, 0xce, 0xb7, 0xd4, 0xba, 0x89, 0x25, 0x41,
+	0x4e, 0xf8, 0xfa, 0x60, 0xc3, 0x4a, 0x9a, 0x00, 0xc7, 0xcb, 0xf0, 0xa5, 0xd2, 0xf2, 0xed, 0x41,
+	0x18, 0x24, 0x79, 0xcd, 0xa7, 0x96, 0xca, 0x0c, 0x1a, 0xcc, 0x2c, 0xc9, 0x94, 0x94, 0x5d, 0x6a,
+	0x3a, 0xe1, 0x6e, 0x92, 0xe1, 0x85, 0xa4, 0x92, 0xf2, 0xce, 0x38, 0x09, 0x66, 0x95, 0xcb, 0x33,
+	0xbd, 0xfd, 0xe5, 0x22, 0x5c, 0x59, 0xa7, 0xa1, 0x8e, 0x96, 0xfb, 0x43, 0x5b, 0xcb, 0xdd, 0x37,
+	0xbe, 0x53, 0x84, 0xcb, 0xeb, 0x54, 0x06, 0x58, 0xb7, 0xbc, 0x8e, 0x9a, 0xec, 0xff, 0x3f, 0x6d,
+	0x8e, 0xff, 0x5e, 0x84, 0x99, 0x75, 0xdf, 0x1b, 0x0e, 0x9a, 0x23, 0xd2, 0xd3, 0xfe, 0xc7, 0x42,
+	0xce, 0x58, 0x72, 0xe1, 0xb4, 0x8c, 0xd6, 0xa5, 0xa4, 0x13, 0x93, 0xb5, 0xd4, 0x1e, 0x1d, 0x51,
+	0x11, 0xf9, 0x58, 0x8b, 0x5a, 0xea, 0x1e, 0x03, 0xa2, 0xc0, 0x91, 0x3e, 0x5c, 0x30, 0x1d, 0xc7,
+	0x7b, 0x4c, 0x3b, 0x1b, 0x66, 0x48, 0x5d, 0x1a, 0xa8, 0x4d, 0xdc, 0xd3, 0xfa, 0x20, 0xb8, 0xc3,
+	0x65, 0x39, 0xc9, 0x0a, 0xd3, 0xbc, 0xc9, 0xc7, 0x30, 0x13, 0x84, 0x9e, 0xaf, 0x56, 0xbc, 0xc6,
+	0xed, 0x95, 0xe9, 0x37, 0xa7, 0x9a, 0xef, 0xb5, 0x05, 0x2b, 0xe1, 0xdb, 0x92, 0x2f, 0xa8, 0x04,
+	0x18, 0xbf, 0x5c, 0x85, 0x9a, 0x3a, 0x1d, 0x41, 0x5e, 0x82, 0xd2, 0xd0, 0x77, 0x64, 0x8f, 0xd3,
+	0x3f, 0x68, 0x1b, 0x37, 0x90, 0xc1, 0xc9, 0x2b, 0x50, 0xed, 0xd3, 0x70, 0xd7, 0xeb, 0xa4, 0x37,
+	0x71, 0x37, 0x39, 0x14, 0x25, 0x96, 0x8c, 0x60, 0x66, 0x97, 0x32, 0xdb, 0x46, 0x39, 0xfa, 0xef,
+	0xe7, 0x3e, 0xb8, 0xb1, 0xf4, 0x8e, 0x60, 0x28, 0x94, 0x0c, 0xed, 0xb7, 0x96, 0x50, 0x54, 0xf2,
+	0xb4, 0x87, 0xbe, 0x7c, 0xae, 0x1e, 0x7a, 0x0f, 0xea, 0x3b, 0x2a, 0x06, 0x57, 0x3a, 0x7c, 0x73,
+	0x1c, 0xb6, 0x51, 0x9c, 0xe4, 0x61, 0x1b, 0xf5, 0x8a, 0x91, 0x0c, 0xb5, 0x25, 0x50, 0x3d, 0xf3,
+	0x2d, 0x81, 0x2f, 0x41, 0x65, 0xc7, 0x0c, 0xad, 0x5d, 0xae, 0x7a, 0xc4, 0xba, 0x7f, 0x93, 0x01,
+	0x51, 0xe0, 0xc8, 0x36, 0xcc, 0x84, 0x76, 0x9f, 0x7a, 0xc3, 0x70, 0x4a, 0x0f, 0x20, 0xef, 0x7a,
+	0x5b, 0x82, 0x05, 0x2a, 0x5e, 0x64, 0x03, 0x9e, 0xf7, 0x69, 0xe8, 0x8f, 0xd8, 0x4a, 0xcc, 0xb4,
+	0xca, 0x61, 0xb0, 0xe2, 0x75, 0x68, 0xb0, 0x50, 0xbf, 0x51, 0xba, 0x59, 0x11, 0x4e, 0x65, 0xcc,
+	0xc0, 0x63, 0x66, 0xa9, 0xab, 0x3f, 0x0b, 0xb3, 0xf1, 0x3e, 0x72, 0xaa, 0xd5, 0xe9, 0x37, 0x0b,
+	0x00, 0xbc, 0xa7, 0x3d, 0xcb, 0x6d, 0x9e, 0xd8, 0x6e, 0x4c, 0xf1, 0xc9, 0xbb, 0x31, 0xc6, 0x1f,
+	0x14, 0xe1, 0x45, 0xbe, 0x4b, 0xda, 0x0e, 0xe9, 0x20, 0x11, 0x4c, 0x4d, 0xfe, 0xcc, 0xd8, 0x61,
+	0xdc, 0x9f, 0x3e, 0xd9, 0xcf, 0x11, 0x67, 0x39, 0x37, 0x69, 0x68, 0x46, 0x4a, 0x52, 0x04, 0x8b,
+	0x9d, 0xc0, 0x1d, 0x42, 0x39, 0x18, 0x50, 0x4b, 0xba, 0xde, 0xdb, 0x53, 0xb7, 0x46, 0xf6, 0x07,
+	0xb0, 0x35, 0x2f, 0xda, 0x4a, 0xe4, 0x2b, 0x20, 0x17, 0x47, 0xbe, 0x01, 0xd5, 0x80, 0xff, 0x5e,
+	0x39, 0xd5, 0x6e, 0x9f, 0xb5, 0x60, 0xce, 0x3c, 0x9a, 0xc3, 0xc4, 0x3b, 0x4a, 0xa1, 0xc6, 0x1f,
+	0x14, 0xe0, 0x6a, 0x76, 0xc1, 0x0d, 0x3b, 0x08, 0xc9, 0x9f, 0x1a, 0x6b, 0xf6, 0x13, 0x8e, 0x09,
+	0x56, 0x9a, 0x37, 0xba, 0x3e, 0x9d, 0xa0, 0x20, 0xb1, 0x26, 0x0f, 0xa1, 0x62, 0x87, 0xb4, 0xaf,
+	0x8c, 0xb6, 0x07, 0x67, 0xfc, 0xe9, 0x31, 0x7d, 0x80, 0x49, 0x41, 0x21, 0xcc, 0xf8, 0x76, 0x71,
+	0xd2, 0x27, 0xb3, 0xdf, 0x42, 0x9c, 0x64, 0xc0, 0xfe, 0xbd, 0x7c, 0x01, 0xfb, 0xc9, 0x0a, 0x8d,
+	0xc7, 0xed, 0xff, 0xd9, 0xf1, 0xb8, 0xfd, 0x07, 0xf9, 0xe3, 0xf6, 0x53, 0xcd, 0x30, 0x31, 0x7c,
+	0xff, 0x3b, 0x25, 0xb8, 0xf6, 0xa4, 0x6e, 0xc3, 0xf4, 0x13, 0xd9, 0x3b, 0xf3, 0xea, 0x27, 0x4f,
+	0xee, 0x87, 0xe4, 0x36, 0x54, 0x06, 0xbb, 0x66, 0xa0, 0x34, 0x39, 0x65, 0x05, 0x54, 0x5a, 0x0c,
+	0x78, 0x7c, 0xb8, 0xd8, 0x10, 0x1a, 0x20, 0x7f, 0x45, 0x41, 0xca, 0x66, 0x96, 0x3e, 0x0d, 0x82,
+	0xc8, 0xd0, 0xd6, 0x33, 0xcb, 0xa6, 0x00, 0xa3, 0xc2, 0x93, 0x10, 0xaa, 0xc2, 0x79, 0x25, 0x57,
+	0xcc, 0xe9, 0x43, 0x19, 0x33, 0xce, 0x78, 0x44, 0x1f, 0x25, 0xfd, 0xa0, 0x52, 0x16, 0x59, 0x82,
+	0x72, 0x18, 0x85, 0xad, 0x2b, 0x7b, 0xb7, 0x9c, 0xa1, 0xd4, 0x72, 0x3a, 0xe3, 0x5f, 0xd5, 0xe0,
+	0xc5, 0xec, 0x7f, 0xc8, 0xbe, 0x75, 0x9f, 0xfa, 0xb1, 0x48, 0xb4, 0xe8, 0xbc, 0x95, 0x00, 0xa3,
+	0xc2, 0xff, 0x58, 0x87, 0x49, 0xfe, 0xcd, 0x02, 0xb3, 0xc7, 0x85, 0xc7, 0xf8, 0x59, 0x84, 0x4a,
+	0xbe, 0x24, 0xec, 0xfa, 0x09, 0x02, 0x71, 0x72, 0x5d, 0xc8, 0xdf, 0x28, 0xc0, 0x42, 0x3f, 0x65,
+	0xf0, 0x9f, 0xe3, 0x89, 0x47, 0x7e, 0x96, 0x63, 0x73, 0x82, 0x3c, 0x9c, 0x58, 0x13, 0xf2, 0x8b,
+	0xd0, 0x18, 0xb0, 0x7e, 0x11, 0x84, 0xd4, 0xb5, 0x54, 0x0c, 0xdc, 0xf4, 0xbd, 0xbf, 0x15, 0xf1,
+	0x52, 0x01, 0x94, 0x62, 0x3b, 0x33, 0x86, 0xc0, 0xb8, 0xc4, 0xcf, 0xf9, 0x11, 0xc7, 0x9b, 0x50,
+	0x0b, 0x68, 0x18, 0xda, 0x6e, 0x2f, 0x90, 0xb1, 0x75, 0x7c, 0xac, 0xb4, 0x25, 0x0c, 0x35, 0x96,
+	0xfc, 0x71, 0xa8, 0x73, 0x07, 0xf4, 0xb2, 0xdf, 0x13, 0xaa, 0x5b, 0x5d, 0xcc, 0xab, 0x6d, 0x05,
+	0xc4, 0x08, 0x4f, 0x5e, 0x87, 0xd9, 0x1d, 0x3e, 0x7c, 0x65, 0x1e, 0x00, 0xe1, 0xec, 0xe1, 0x41,
+	0x0a, 0xcd, 0x18, 0x1c, 0x13, 0x54, 0x3c, 0xe0, 0x54, 0x7b, 0xe9, 0xd3, 0x8e, 0x9d, 0xc8, 0x7f,
+	0x8f, 0x31, 0x2a, 0x66, 0xca, 0x30, 0x8d, 0x79, 0x96, 0x13, 0x6b, 0x53, 0x46, 0xe9, 0xbd, 0xc6,
+	0xff, 0x2d, 0xc0, 0x85, 0xd4, 0x69, 0xae, 0xa7, 0x59, 0x3f, 0x1f, 0x49, 0xad, 0xb0, 0x98, 0xf3,
+	0xa8, 0xf8, 0x7d, 0x33, 0x0c, 0xb8, 0xba, 0x9f, 0x56, 0x08, 0xb9, 0xd3, 0x3f, 0xaa, 0x8f, 0x9c,
+	0xbb, 0x63, 0x4e, 0xff, 0x08, 0x87, 0x09, 0xca, 0x94, 0xe7, 0xab, 0x7c, 0x12, 0xcf, 0x97, 0xf1,
+	0xbd, 0x12, 0x34, 0xde, 0xf5, 0x76, 0x7e, 0x4c, 0x42, 0xdc, 0xb3, 0x67, 0xe4, 0xe2, 0x8f, 0x70,
+	0x46, 0xde, 0x86, 0x2f, 0x84, 0xa1, 0xd3, 0xa6, 0x96, 0xe7, 0x76, 0x82, 0xe5, 0x6e, 0x48, 0xfd,
+	0x35, 0xdb, 0xb5, 0x83, 0x5d, 0xda, 0x91, 0x5b, 0x08, 0x5f, 0x3c, 0x3a, 0x5c, 0xfc, 0xc2, 0xd6,
+	0xd6, 0x46, 0x16, 0x09, 0x4e, 0x2a, 0xcb, 0x47, 0x88, 0x69, 0xed, 0x79, 0xdd, 0x2e, 0x3f, 0xca,
+	0x24, 0x37, 0x9b, 0xc5, 0x08, 0x89, 0xc1, 0x31, 0x41, 0x65, 0xfc, 0x76, 0x09, 0xea, 0x3a, 0x3b,
+	0x04, 0x79, 0x19, 0x66, 0x76, 0x7c, 0x6f, 0x8f, 0xd9, 0xdf, 0x85, 0xe8, 0x28, 0x53, 0x53, 0x80,
+	0x50, 0xe1, 0x98, 0xed, 0x17, 0x7a, 0x03, 0xdb, 0x4a, 0x3b, 0x89, 0xb6, 0x18, 0x10, 0x05, 0x4e,
+	0x59, 0x9e, 0xa5, 0x33, 0xb7, 0x3c, 0x5f, 0x49, 0x68, 0x1e, 0xf5, 0x89, 0xba, 0xc2, 0x23, 0x28,
+	0x07, 0x66, 0xa0, 0x42, 0x4e, 0x73, 0x1c, 0xf8, 0x5f, 0x6e, 0x6f, 0xc8, 0x03, 0xff, 0xcb, 0xed,
+	0x0d, 0xe4, 0x4c, 0xc9, 0xb7, 0x0a, 0x30, 0x2f, 0xb2, 0x1f, 0x21, 0xed, 0xd9, 0x41, 0xe8, 0x8f,
+	0xe4, 0x4a, 0xb0, 0x9e, 0xe3, 0x84, 0x74, 0x9c, 0x9d, 0x88, 0xf0, 0x4a, 0xc2, 0x30, 0x25, 0xd2,
+	0xf8, 0x3f, 0x25, 0x68, 0x88, 0xbf, 0x27, 0xec, 0xcf, 0xb3, 0xfc, 0x7f, 0x6f, 0xf1, 0x9d, 0xcc,
+	0x60, 0xd8, 0xa7, 0x3e, 0xf7, 0xad, 0xc9, 0x59, 0x25, 0xee, 0x99, 0x8e, 0x90, 0x7a, 0x37, 0x33,
+	0x02, 0xa9, 0x0e, 0x50, 0x3e, 0xc7, 0x0e, 0x50, 0x39, 0x51, 0x07, 0xa8, 0x3e, 0xa3, 0x0e, 0x30,
+	0xf3, 0xec, 0x3b, 0xc0, 0x9f, 0x2b, 0x40, 0x3a, 0x0c, 0x8b, 0x7c, 0x45, 0xea, 0xc8, 0x62, 0x39,
+	0xfa, 0x52, 0x4a, 0x47, 0xbe, 0x9c, 0x22, 0x8f, 0x94, 0x65, 0xb6, 0x8c, 0x7c, 0x6a, 0x0f, 0xba,
+	0x77, 0x0e, 0x06, 0x9e, 0x4b, 0x5d, 0x75, 0xe0, 0x42, 0x2f, 0x23, 0x5f, 0x8f, 0xe1, 0x30, 0x41,
+	0x69, 0xfc, 0x9d, 0x02, 0xd4, 0x37, 0xec, 0x2e, 0xb5, 0x46, 0x96, 0xc3, 0xcf, 0xd1, 0x76, 0xa8,
+	0x43, 0x43, 0xba, 0xee, 0x9b, 0x16, 0x6d, 0x51, 0xdf, 0xe6, 0xa9, 0xa6, 0xd8, 0x94, 0xc5, 0x2b,
+	0x25, 0xcf, 0xd1, 0xae, 0x4e, 0xa0, 0xc1, 0x89, 0xa5, 0xc9, 0x5d, 0x98, 0xed, 0xd0, 0xc0, 0xf6,
+	0x69, 0xa7, 0x15, 0x33, 0x6d, 0x5e, 0x56, 0x35, 0x5c, 0x8d, 0xe1, 0x8e, 0x0f, 0x17, 0xe7, 0x5a,
+	0xf6, 0x80, 0x3a, 0xb6, 0x4b, 0x85, 0x8d, 0x93, 0x28, 0x6a, 0xfc, 0xe7, 0x02, 0x94, 0x36, 0xbc,
+	0x1e, 0x79, 0x4d, 0x87, 0xbe, 0x17, 0x12, 0x9b, 0x1b, 0x51, 0xe8, 0x7b, 0x7d, 0xc3, 0xeb, 0xa5,
+	0x22, 0xdf, 0x97, 0xa0, 0xda, 0xb5, 0xa9, 0xd3, 0x51, 0xf1, 0xca, 0x2f, 0xf2, 0x02, 0x1c, 0x72,
+	0xcc, 0x0c, 0x73, 0xaf, 0xc7, 0x5f, 0x50, 0x52, 0xf1, 0x05, 0xda, 0xec, 0x0f, 0x1c, 0xdb, 0xed,
+	0xa1, 0x32, 0x08, 0xe2, 0x0b, 0x74, 0x0c, 0x87, 0x09, 0x4a, 0xf2, 0x36, 0x5c, 0xec, 0x9b, 0x07,
+	0x2d, 0x73, 0xc4, 0xb4, 0x66, 0x11, 0xdd, 0x2d, 0x03, 0x6b, 0xf9, 0x89, 0xdf, 0xcd, 0x14, 0x0e,
+	0xc7, 0xa8, 0x8d, 0x6f, 0x97, 0x40, 0xa7, 0x47, 0x23, 0xbf, 0x52, 0x80, 0x86, 0xe9, 0xba, 0x5e,
+	0x28, 0x53, 0x8f, 0x89, 0x3d, 0x79, 0xcc, 0x9d, 0x85, 0x6d, 0x69, 0x39, 0x62, 0x2a, 0x3c, 0xad,
+	0x7a, 0x8b, 0x39, 0x86, 0xc1, 0xb8, 0x6c, 0x32, 0x4c, 0xed, 0x30, 0x6f, 0xe6, 0xaf, 0xc5, 0x09,
+	0xf6, 0x93, 0xaf, 0xbe, 0x09, 0x17, 0xd3, 0x95, 0x3d, 0x8d, 0xcb, 0x2f, 0xcf, 0x5e, 0xd6, 0xb7,
+	0xea, 0xd0, 0xb8, 0x6f, 0x86, 0xf6, 0x3e, 0xe5, 0x1e, 0x8b, 0xf3, 0x31, 0x41, 0xff, 0x5a, 0x01,
+	0x5e, 0x4c, 0xee, 0xf5, 0x9e, 0xa3, 0x1d, 0xca, 0x8f, 0x79, 0x63, 0xa6, 0x34, 0x9c, 0x50, 0x0b,
+	0x6e, 0x91, 0x8e, 0x6d, 0x1d, 0x9f, 0xb7, 0x45, 0xda, 0x9e, 0x24, 0x10, 0x27, 0xd7, 0xe5, 0xc7,
+	0xc5, 0x22, 0xfd, 0x7c, 0x67, 0xe4, 0x49, 0xd9, 0xcb, 0x33, 0x9f, 0x1b, 0x7b, 0xb9, 0xf6, 0xb9,
+	0xb0, 0x4f, 0x06, 0x31, 0x7b, 0xb9, 0x9e, 0x73, 0xdb, 0x40, 0x86, 0x47, 0x09, 0x6e, 0x93, 0xec,
+	0x6e, 0x7e, 0x32, 0x47, 0x99, 0x92, 0xc4, 0x82, 0x0a, 0xdf, 0x2c, 0x92, 0xd6, 0xda, 0x59, 0x6c,
+	0x46, 0xd5, 0xc5, 0x36, 0x50, 0xc0, 0x54, 0x49, 0xce, 0x3b, 0x4a, 0x61, 0x53, 0xcc, 0x95, 0xc2,
+	0x86, 0xac, 0x40, 0xd9, 0x65, 0x93, 0x6d, 0xe9, 0xd4, 0x49, 0x6b, 0xee, 0xdf, 0xa3, 0x23, 0xe4,
+	0x85, 0x8d, 0xdf, 0x2a, 0x02, 0xb0, 0xcf, 0x97, 0x2a, 0xf3, 0x53, 0x6c, 0xf7, 0x9f, 0x84, 0x99,
+	0x60, 0xc8, 0x37, 0x37, 0xa4, 0xb2, 0x11, 0xed, 0xb5, 0x08, 0x30, 0x2a, 0x3c, 0xd3, 0xaa, 0x3f,
+	0x19, 0xd2, 0xa1, 0x5a, 0xdd, 0xb5, 0x56, 0xfd, 0x1e, 0x03, 0xa2, 0xc0, 0x9d, 0x9f, 0x52, 0xac,
+	0x9c, 0x0c, 0x95, 0x73, 0x72, 0x32, 0x18, 0xbf, 0x54, 0x04, 0x88, 0x36, 0x85, 0xc9, 0x6f, 0x16,
+	0xe0, 0x05, 0x3d, 0xca, 0x42, 0x71, 0xf2, 0x70, 0xc5, 0x31, 0xed, 0x7e, 0x6e, 0xbb, 0x3f, 0x6b,
+	0x84, 0xf3, 0x69, 0xa7, 0x95, 0x25, 0x0e, 0xb3, 0x6b, 0x41, 0x10, 0x6a, 0xb4, 0x3f, 0x08, 0x47,
+	0xab, 0xb6, 0x2f, 0xbb, 0x5d, 0x66, 0xda, 0x84, 0x3b, 0x92, 0x46, 0x14, 0x95, 0x27, 0xfc, 0xf9,
+	0xc8, 0x51, 0x18, 0xd4, 0x7c, 0x8c, 0xff, 0x56, 0x80, 0xf9, 0xe4, 0xa1, 0x4d, 0x66, 0x8b, 0x08,
+	0x95, 0x5c, 0xf6, 0xa0, 0xc8, 0x1b, 0x2f, 0x14, 0x75, 0x89, 0x25, 0x0f, 0xd8, 0x14, 0xdd, 0xa5,
+	0xbe, 0x00, 0x73, 0x85, 0x4f, 0x9c, 0xa1, 0x2d, 0x72, 0x65, 0x4e, 0x4e, 0xab, 0x19, 0x04, 0x98,
+	0x5d, 0x4e, 0x9c, 0x93, 0x7d, 0xcc, 0x2d, 0x2d, 0x7d, 0x0c, 0xe5, 0xf4, 0x67, 0x71, 0xe5, 0x39,
+	0xd9, 0x88, 0x0f, 0x26, 0xb8, 0x1a, 0xbf, 0x5e, 0x84, 0xcb, 0x19, 0xff, 0x83, 0xa9, 0xa5, 0x32,
+	0x0e, 0x20, 0x4a, 0x46, 0x5a, 0x88, 0x92, 0x91, 0xb6, 0x53, 0x38, 0x1c, 0xa3, 0x26, 0x1f, 0x01,
+	0x98, 0x96, 0x45, 0x83, 0x60, 0xd3, 0xeb, 0x28, 0x45, 0xfe, 0xad, 0xa3, 0xc3, 0x45, 0x58, 0xd6,
+	0xd0, 0xe3, 0xc3, 0xc5, 0x9f, 0xca, 0x8a, 0x1f, 0x49, 0xfd, 0xef, 0xa8, 0x00, 0xc6, 0x58, 0x92,
+	0x0f, 0xd5, 0x49, 0xd9, 0x1c, 0xcd, 0x33, 0x1f, 0x9d, 0xaa, 0xe5, 0x8d, 0x13, 0xe3, 0x68, 0xfc,
+	0x8b, 0x22, 0xd4, 0x94, 0x81, 0xf1, 0x0c, 0x36, 0x53, 0x7b, 0x89, 0xcd, 0xd4, 0xe9, 0x33, 0xe2,
+	0xa8, 0x2a, 0x4f, 0xdc, 0x3e, 0xf5, 0x52, 0xdb, 0xa7, 0xeb, 0xf9, 0x45, 0x3d, 0x79, 0xc3, 0xf4,
+	0x6f, 0x17, 0x61, 0x5e, 0x91, 0xca, 0x2c, 0x45, 0x5f, 0x81, 0x39, 0x9f, 0x9a, 0x1d, 0x1e, 0x4b,
+	0xc0, 0x7f, 0x5f, 0x81, 0x9f, 0x8a, 0xba, 0x74, 0x74, 0xb8, 0x38, 0x87, 0x71, 0x04, 0x26, 0xe9,
+	0xc8, 0xd7, 0xe0, 0x82, 0x70, 0x00, 0x6f, 0x9a, 0x07, 0xd2, 0x5a, 0x2a, 0xf2, 0xa2, 0x3c, 0x7e,
+	0xa6, 0x99, 0x44, 0x61, 0x9a, 0x96, 0x75, 0x6b, 0x01, 0xda, 0x0e, 0xcc, 0x9e, 0xa8, 0x0c, 0x6f,
+	0x05, 0x69, 0x6d, 0x35, 0x53, 0x38, 0x1c, 0xa3, 0x26, 0x26, 0x34, 0x58, 0x8d, 0x64, 0xc8, 0xc2,
+	0x94, 0x67, 0xfa, 0xb9, 0x3e, 0x83, 0x11, 0x1b, 0x8c, 0xf3, 0x34, 0xfe, 0x4d, 0x01, 0x66, 0xa3,
+	0xf6, 0x3a, 0xf7, 0x2d, 0xe5, 0x6e, 0x72, 0x4b, 0x79, 0x39, 0x77, 0x77, 0x98, 0xb0, 0x89, 0xfc,
+	0xab, 0x33, 0xd1, 0x67, 0xf1, 0x6d, 0xe3, 0x1d, 0xb8, 0x6a, 0x67, 0xee, 0xa4, 0xc6, 0x66, 0x1b,
+	0x1d, 0xae, 0x7f, 0x77, 0x22, 0x25, 0x3e, 0x81, 0x0b, 0x19, 0x42, 0x6d, 0x9f, 0xfa, 0xa1, 0x6d,
+	0x51, 0xf5, 0x7d, 0xeb, 0xb9, 0xf5, 0x41, 0x11, 0x95, 0x17, 0xb5, 0xe9, 0xfb, 0x52, 0x00, 0x6a,
+	0x51, 0x64, 0x07, 0x2a, 0xb4, 0xd3, 0xa3, 0x2a, 0xca, 0x29, 0x67, 0x66, 0x34, 0xdd, 0x9e, 0xec,
+	0x2d, 0x40, 0xc1, 0x9a, 0x04, 0x50, 0x77, 0x94, 0x4b, 0x46, 0xf6, 0xc3, 0xe9, 0xb5, 0x3b, 0xed,
+	0xdc, 0x89, 0x8e, 0xcb, 0x68, 0x10, 0x46, 0x72, 0xc8, 0x9e, 0xce, 0x4c, 0x59, 0x39, 0xa3, 0xc9,
+	0xe3, 0x09, 0xb9, 0x29, 0x03, 0xa8, 0x3f, 0x36, 0x43, 0xea, 0xf7, 0x4d, 0x7f, 0x4f, 0x9a, 0x3a,
+	0xd3, 0x7f, 0xe1, 0x43, 0xc5, 0x29, 0xfa, 0x42, 0x0d, 0xc2, 0x48, 0x0e, 0xf1, 0xa0, 0xae, 0x4e,
+	0x5a, 0xaa, 0x24, 0x56, 0xd3, 0x0b, 0x55, 0x56, 0x40, 0x20, 0x76, 0xbe, 0xf4, 0x2b, 0x46, 0x32,
+	0xc8, 0x7e, 0x22, 0x81, 0xa4, 0x48, 0x1b, 0xda, 0xcc, 0x91, 0xbd, 0x56, 0xb2, 0x8a, 0x96, 0x9b,
+	0x09, 0x89, 0x28, 0x8f, 0x4b, 0xd1, 0xb4, 0xfc, 0xac, 0x63, 0x17, 0x5e, 0x4f, 0xc6, 0x2e, 0x5c,
+	0x4f, 0xc7, 0x2e, 0xa4, 0x3c, 0x7b, 0xa7, 0x8f, 0x5e, 0x30, 0xa1, 0xe1, 0x98, 0x41, 0xb8, 0x3d,
+	0xe8, 0x98, 0xa1, 0xdc, 0xf8, 0x6a, 0xdc, 0xfe, 0x63, 0x27, 0x9b, 0x35, 0x79, 0x5a, 0x07, 0xed,
+	0xde, 0xda, 0x88, 0xd8, 0x60, 0x9c, 0x27, 0x79, 0x15, 0x1a, 0xfb, 0x7c, 0x26, 0x10, 0xc7, 0x7f,
+	0x2b, 0xd1, 0x41, 0xd5, 0xf7, 0x23, 0x30, 0xc6, 0x69, 0x58, 0x11, 0xa1, 0x81, 0x44, 0x99, 0xf4,
+	0x64, 0x91, 0x76, 0x04, 0xc6, 0x38, 0x0d, 0xdf, 0x44, 0xb5, 0xdd, 0x3d, 0x51, 0x60, 0x86, 0x17,
+	0x10, 0x9b, 0xa8, 0x0a, 0x88, 0x11, 0x9e, 0xdc, 0x84, 0xda, 0xb0, 0xd3, 0x15, 0xb4, 0x35, 0x4e,
+	0xcb, 0x35, 0xdd, 0xed, 0xd5, 0x35, 0x79, 0x1c, 0x59, 0x61, 0x8d, 0xdf, 0x2f, 0x00, 0x19, 0x8f,
+	0xb6, 0x21, 0xbb, 0x50, 0x75, 0xb9, 0xff, 0x2a, 0x77, 0xee, 0xcd, 0x98, 0x1b, 0x4c, 0x8c, 0x6d,
+	0x09, 0x90, 0xfc, 0x89, 0x0b, 0x35, 0x7a, 0x10, 0x52, 0xdf, 0x35, 0x1d, 0xa9, 0xf2, 0x9c, 0x4d,
+	0x9e, 0x4f, 0xa1, 0xda, 0x4b, 0xce, 0xa8, 0x65, 0x18, 0x3f, 0x2c, 0x42, 0x23, 0x46, 0xf7, 0x34,
+	0xb3, 0x90, 0x9f, 0xaa, 0x11, 0x6e, 0xa3, 0x6d, 0xdf, 0x91, 0xdd, 0x34, 0x76, 0xaa, 0x46, 0xa2,
+	0x70, 0x03, 0xe3, 0x74, 0xe4, 0x36, 0x40, 0xdf, 0x0c, 0x42, 0xea, 0xf3, 0x25, 0x2c, 0x75, 0x96,
+	0x65, 0x53, 0x63, 0x30, 0x46, 0x45, 0x6e, 0xc8, 0x4c, 0xad, 0xe5, 0x64, 0xee, 0x8c, 0x09, 0x69,
+	0x58, 0x2b, 0x67, 0x90, 0x86, 0x95, 0xf4, 0xe0, 0xa2, 0xaa, 0xb5, 0xc2, 0x9e, 0x2e, 0x79, 0x80,
+	0x30, 0x02, 0x52, 0x2c, 0x70, 0x8c, 0xa9, 0xf1, 0x5b, 0x05, 0x98, 0x4b, 0x38, 0x2d, 0x44, 0x62,
+	0x07, 0x15, 0x2b, 0x96, 0x48, 0xec, 0x10, 0x0b, 0xf1, 0x7a, 0x05, 0xaa, 0xa2, 0x81, 0xc6, 0xc2,
+	0x89, 0x39, 0x14, 0x25, 0x96, 0x4d, 0x08, 0xd2, 0x2d, 0x9a, 0x9e, 0x10, 0xa4, 0xdf, 0x14, 0x15,
+	0x9e, 0x7c, 0x19, 0x6a, 0xaa, 0x76, 0xb2, 0xa5, 0xa3, 0x24, 0xc0, 0x12, 0x8e, 0x9a, 0xc2, 0xf8,
+	0x5b, 0x65, 0x39, 0x3c, 0xc4, 0xd6, 0xba, 0xf2, 0x25, 0xfc, 0x02, 0x53, 0xfe, 0x74, 0x1f, 0x3a,
+	0xd3, 0xfc, 0xb4, 0xba, 0x6f, 0xc5, 0x80, 0x18, 0x97, 0xc6, 0x2d, 0xd1, 0x28, 0xe8, 0x2d, 0x6e,
+	0x89, 0x8a, 0x20, 0x35, 0x89, 0x95, 0x27, 0x14, 0xc7, 0xf6, 0xf5, 0xe2, 0x27, 0x14, 0x23, 0x64,
+	0x7a, 0x4f, 0x6f, 0x1d, 0x2e, 0x31, 0x55, 0x74, 0xcd, 0xf7, 0xfa, 0x4d, 0xda, 0xb3, 0x5d, 0xd7,
+	0x76, 0x7b, 0x32, 0x6c, 0x40, 0x6f, 0x0c, 0x62, 0x9a, 0x00, 0xc7, 0xcb, 0x28, 0x3f, 0x48, 0xe5,
+	0xcc, 0xfd, 0x20, 0x2f, 0xc3, 0x8c, 0xf8, 0x50, 0x91, 0xba, 0xb2, 0xae, 0xa2, 0xd7, 0x39, 0x08,
+	0x15, 0x8e, 0xf4, 0x60, 0xce, 0x72, 0x4c, 0xbb, 0x7f, 0xb7, 0xe3, 0xd0, 0x58, 0xd6, 0x9f, 0xd3,
+	0x6a, 0xea, 0xdc, 0x22, 0x59, 0x89, 0x33, 0xc2, 0x24, 0x5f, 0xe3, 0xbf, 0x16, 0xa1, 0x8e, 0xb4,
+	0xef, 0x85, 0x74, 0x7b, 0x75, 0x8d, 0xf5, 0x48, 0xb3, 0xd3, 0xf1, 0x69, 0x10, 0xa4, 0x3d, 0xfe,
+	0xcb, 0x02, 0x8c, 0x0a, 0x7f, 0x7e, 0xc9, 0x5c, 0x62, 0x41, 0xd9, 0xa5, 0x33, 0x0c, 0xca, 0xfe,
+	0x56, 0x01, 0xe6, 0xad, 0x44, 0xe2, 0x62, 0xb9, 0xac, 0x4e, 0xaf, 0x03, 0x26, 0xf3, 0x20, 0x8b,
+	0x0d, 0xd1, 0x24, 0x0c, 0x53, 0x22, 0x8d, 0x3f, 0x5f, 0x81, 0xaa, 0xb8, 0xf3, 0x80, 0x0d, 0x69,
+	0xea, 0x76, 0x78, 0x92, 0x27, 0xd9, 0xd8, 0x7a, 0x48, 0xdf, 0x91, 0x70, 0xd4, 0x14, 0x6c, 0xf8,
+	0xf8, 0xb4, 0xa7, 0x32, 0x85, 0xc4, 0x86, 0x0f, 0x72, 0x28, 0x4a, 0x2c, 0xa3, 0xdb, 0x19, 0x5a,
+	0x7b, 0x54, 0xa5, 0xca, 0xd2, 0x74, 0x4d, 0x0e, 0x45, 0x89, 0x65, 0x0b, 0xc8, 0x1e, 0x1d, 0xc9,
+	0xb9, 0x44, 0x2f, 0x20, 0xf7, 0xe8, 0x48, 0x6c, 0x12, 0x21, 0xd4, 0x85, 0xaf, 0xe2, 0x1e, 0x1d,
+	0x9d, 0x6e, 0xd2, 0xe6, 0xcb, 0xfb, 0xb2, 0x2a, 0x8b, 0x11, 0x1b, 0xc6, 0x33, 0x50, 0xe4, 0xa7,
+	0x9b, 0xaf, 0x85, 0xca, 0xa0, 0xc0, 0x18, 0xb1, 0x21, 0x6f, 0xc2, 0x7c, 0xd7, 0xf3, 0x2d, 0xda,
+	0x32, 0xc3, 0xdd, 0x76, 0x38, 0x72, 0xa8, 0x8c, 0xf7, 0xd7, 0x99, 0xb5, 0xd6, 0x12, 0x58, 0x4c,
+	0x51, 0xa7, 0x73, 0xe6, 0xd5, 0xa6, 0xcf, 0x99, 0xf7, 0x01, 0x5b, 0xe5, 0xfc, 0x90, 0xbb, 0x03,
+	0xea, 0x53, 0x79, 0x73, 0xe4, 0x72, 0x27, 0x78, 0xa0, 0xe6, 0xa6, 0x46, 0x1a, 0x9c, 0xf5, 0x48,
+	0x33, 0x7e, 0xa5, 0x08, 0x3c, 0x64, 0x80, 0x7c, 0x05, 0xea, 0x7d, 0x6a, 0xed, 0x9a, 0xae, 0x1d,
+	0xa8, 0x4c, 0x90, 0x57, 0x58, 0x93, 0x6f, 0x2a, 0xe0, 0x31, 0x5b, 0x67, 0x96, 0xdb, 0x1b, 0x7c,
+	0x37, 0x3e, 0xa2, 0x25, 0x16, 0x54, 0x7b, 0x41, 0x60, 0x0e, 0xec, 0xdc, 0x37, 0x63, 0x88, 0x7c,
+	0x4b, 0x42, 0xd7, 0x12, 0xcf, 0x28, 0x59, 0x13, 0x0b, 0x2a, 0x03, 0xc7, 0xb4, 0xdd, 0xdc, 0xb7,
+	0xbf, 0xb0, 0x2f, 0x68, 0x31, 0x4e, 0xc2, 0x77, 0xcf, 0x1f, 0x51, 0xf0, 0x36, 0xfe, 0x67, 0x01,
+	0xea, 0x1a, 0x4f, 0xb6, 0x01, 0x98, 0xea, 0x22, 0x73, 0x06, 0x9d, 0x2a, 0x09, 0x3d, 0xf7, 0xc9,
+	0x6d, 0xeb, 0xc2, 0x18, 0x63, 0x94, 0x91, 0x54, 0xa9, 0x78, 0xd6, 0x49, 0x95, 0x6e, 0x41, 0x7d,
+	0xd7, 0x74, 0x3b, 0xc1, 0xae, 0xb9, 0xa7, 0x92, 0x61, 0x69, 0x83, 0xf1, 0x1d, 0x85, 0xc0, 0x88,
+	0xc6, 0xe8, 0x43, 0xb5, 0xfd, 0xde, 0xc6, 0xb2, 0xdf, 0x63, 0xba, 0x0d, 0x8f, 0x07, 0x48, 0xeb,
+	0x36, 0x22, 0x56, 0x40, 0xe0, 0xc8, 0x9b, 0x31, 0x67, 0x4e, 0x31, 0xe1, 0xe3, 0xd0, 0xbb, 0xf8,
+	0xc7, 0x87, 0x8b, 0xf3, 0x82, 0xe5, 0xf8, 0xb5, 0x67, 0xc6, 0xf7, 0x8a, 0x30, 0x23, 0xaf, 0x66,
+	0x21, 0xaf, 0x41, 0xb5, 0xe3, 0xdb, 0xfb, 0x32, 0xcd, 0x7f, 0x2c, 0xb6, 0x61, 0x95, 0x43, 0x8f,
+	0xd9, 0xa0, 0x7f, 0x6f, 0x43, 0xbc, 0xa0, 0x24, 0x25, 0x6f, 0x43, 0xa9, 0x13, 0x9c, 0x72, 0xab,
+	0x86, 0x77, 0xfb, 0xd5, 0xf6, 0x7d, 0x64, 0x45, 0x59, 0x13, 0x31, 0x3b, 0x8e, 0xe7, 0x20, 0x4e,
+	0x27, 0xd9, 0x68, 0x2b, 0x04, 0x46, 0x34, 0xc4, 0x94, 0xc9, 0xdf, 0xc4, 0xd9, 0xbf, 0xb7, 0xf2,
+	0x5c, 0x49, 0xb3, 0xec, 0xf7, 0x22, 0x1d, 0x39, 0x96, 0x41, 0xee, 0x75, 0x98, 0xed, 0x9b, 0x07,
+	0x0f, 0x06, 0xd4, 0x5d, 0xf1, 0x5c, 0x37, 0x90, 0x39, 0x55, 0xb8, 0xfb, 0x7b, 0x33, 0x06, 0xc7,
+	0x04, 0x95, 0xf1, 0x77, 0xcb, 0x20, 0x6e, 0xaa, 0x60, 0x8b, 0x49, 0xc7, 0x0e, 0x44, 0x98, 0x64,
+	0x81, 0xff, 0x75, 0xbd, 0x98, 0xac, 0x4a, 0x38, 0x6a, 0x0a, 0x72, 0x05, 0x4a, 0x7d, 0xdb, 0x95,
+	0x1b, 0xf5, 0xbc, 0x71, 0x36, 0x6d, 0x17, 0x19, 0x8c, 0xa3, 0xcc, 0x03, 0x19, 0xe9, 0x27, 0x50,
+	0xe6, 0x01, 0x32, 0x18, 0xf9, 0x1a, 0x5c, 0x70, 0x3c, 0x6f, 0x6f, 0xc7, 0xb4, 0xf6, 0x54, 0xb8,
+	0x8c, 0x08, 0xf5, 0xe0, 0xce, 0xcb, 0x8d, 0x24, 0x0a, 0xd3, 0xb4, 0xac, 0xb8, 0xe5, 0x79, 0x4e,
+	0xc7, 0x7b, 0xec, 0xaa, 0xe2, 0x95, 0xa8, 0xf8, 0x4a, 0x12, 0x85, 0x69, 0x5a, 0xb2, 0x0d, 0x5f,
+	0xf8, 0x94, 0xfa, 0x9e, 0xd4, 0x8c, 0xdb, 0x0e, 0xa5, 0x03, 0xc5, 0x46, 0x18, 0xa2, 0x3c, 0x2c,
+	0xf1, 0xeb, 0xd9, 0x24, 0x38, 0xa9, 0x2c, 0x8f, 0x76, 0x34, 0xfd, 0x1e, 0x0d, 0x5b, 0xbe, 0xc7,
+	0x16, 0x2a, 0xdb, 0xed, 0x29, 0xb6, 0x33, 0x11, 0xdb, 0xad, 0x6c, 0x12, 0x9c, 0x54, 0x96, 0x7c,
+	0x00, 0x0b, 0x02, 0x25, 0x0c, 0xd4, 0xe5, 0x7d, 0xd3, 0x76, 0xcc, 0x1d, 0xdb, 0xb1, 0x43, 0x91,
+	0xe5, 0x69, 0x4e, 0xec, 0xa6, 0x6f, 0x4d, 0xa0, 0xc1, 0x89, 0xa5, 0xf9, 0x3d, 0x6b, 0x32, 0x96,
+	0xa2, 0x45, 0x7d, 0xfe, 0xf7, 0x65, 0x96, 0x29, 0x71, 0xcf, 0x5a, 0x0a, 0x87, 0x63, 0xd4, 0xc6,
+	0xef, 0x94, 0x20, 0x15, 0xb7, 0xf5, 0x34, 0x73, 0xf2, 0xdc, 0x74, 0xbd, 0xc4, 0x79, 0xc3, 0xd2,
+	0x33, 0x38, 0x6f, 0x18, 0xdb, 0x2f, 0x2d, 0x3f, 0x65, 0xbf, 0xf4, 0x3e, 0xd4, 0x3d, 0x57, 0x5e,
+	0x5e, 0x21, 0x23, 0xf9, 0x7e, 0x5a, 0x4d, 0x13, 0x0f, 0x14, 0xe2, 0xf8, 0x70, 0xf1, 0x8b, 0xc9,
+	0xb6, 0x94, 0x08, 0x75, 0x4f, 0x9c, 0x66, 0xc1, 0x14, 0x04, 0xcb, 0xb4, 0x76, 0xe9, 0xd6, 0xd6,
+	0xc6, 0x49, 0x32, 0xd3, 0x4e, 0xca, 0xf6, 0xb6, 0x22, 0x79, 0xa0, 0xe6, 0x66, 0xfc, 0xcb, 0x12,
+	0xd4, 0xb5, 0x97, 0xec, 0x04, 0xc9, 0x2c, 0x3d, 0xa8, 0xeb, 0xb0, 0xdf, 0xdc, 0x77, 0xb7, 0x45,
+	0xd7, 0xd2, 0xf0, 0x56, 0xd7, 0xaf, 0x18, 0xc9, 0x88, 0xdf, 0x2b, 0x54, 0xca, 0x71, 0xaf, 0xd0,
+	0x00, 0x66, 0x42, 0xdf, 0xee, 0xf5, 0xb4, 0xea, 0x7e, 0x37, 0xbf, 0x9f, 0x71, 0x4b, 0x30, 0x94,
+	0x46, 0x83, 0x78, 0x41, 0x25, 0x86, 0x49, 0xdc, 0x19, 0xda, 0x4e, 0x68, 0xbb, 0x52, 0x09, 0x3e,
+	0x03, 0x89, 0x4d, 0xc1, 0x50, 0xc6, 0xbe, 0x8a, 0x17, 0x54, 0x62, 0x8c, 0xdf, 0x2f, 0xc0, 0xc5,
+	0x34, 0xe9, 0x09, 0x7e, 0xe9, 0x37, 0x74, 0x06, 0x52, 0xb1, 0x4d, 0xb0, 0x7d, 0x66, 0xf5, 0x3c,
+	0xef, 0x4c, 0xa4, 0xff, 0x3c, 0xfe, 0xc1, 0xf2, 0x07, 0x70, 0x77, 0x87, 0xb5, 0x4b, 0x3b, 0x43,
+	0x87, 0xa6, 0x6d, 0xa3, 0xb6, 0x84, 0xa3, 0xa6, 0x60, 0x23, 0xcb, 0x56, 0x09, 0x7c, 0x73, 0xe4,
+	0x51, 0xd4, 0xc9, 0x7b, 0x35, 0x37, 0x9e, 0xd5, 0xd0, 0xee, 0xd3, 0x4f, 0x3d, 0x57, 0xb9, 0xc3,
+	0x44, 0x56, 0x43, 0x09, 0x43, 0x8d, 0x35, 0xfe, 0x62, 0x19, 0xf8, 0x85, 0x6b, 0xe4, 0x17, 0x61,
+	0xd6, 0x8c, 0xdd, 0xbe, 0x28, 0x95, 0xc7, 0x3b, 0xb9, 0xb7, 0x6d, 0xf8, 0xbd, 0x6e, 0x3a, 0x24,
+	0x33, 0x0e, 0xc5, 0x84, 0x40, 0xe2, 0x41, 0xad, 0x6b, 0x3a, 0x0e, 0x5b, 0x7a, 0x73, 0xef, 0xc6,
+	0x26, 0x84, 0xf3, 0x4f, 0x5f, 0x93, 0xac, 0x51, 0x0b, 0x21, 0x4b, 0x00, 0x7d, 0xf3, 0x00, 0x69,
+	0xe8, 0xdb, 0x34, 0x90, 0xfb, 0x91, 0xf3, 0xc2, 0x63, 0xa8, 0xa0, 0x18, 0xa3, 0x60, 0x15, 0xe4,
+	0xc7, 0xaf, 0x95, 0x6f, 0x26, 0x4f, 0x05, 0x79, 0xc5, 0x24, 0x33, 0x51, 0x41, 0xf5, 0x86, 0x5a,
+	0x08, 0x09, 0xa0, 0xee, 0x9b, 0xa1, 0xdc, 0x2f, 0xad, 0xe4, 0x0c, 0x62, 0xe2, 0x2d, 0xae, 0xb8,
+	0x89, 0x39, 0x4f, 0xbf, 0x62, 0x24, 0xc7, 0xf8, 0xd5, 0x22, 0xcc, 0xc6, 0x6b, 0x27, 0x55, 0xbc,
+	0xf4, 0x9e, 0xb1, 0x52, 0xf1, 0xa2, 0x2d, 0xe3, 0x04, 0x15, 0xe9, 0xc1, 0x9c, 0x7a, 0x6f, 0x8e,
+	0x42, 0x1a, 0x9c, 0xa4, 0x83, 0x67, 0xd8, 0x96, 0xdc, 0x11, 0xb4, 0x19, 0x67, 0x84, 0x49, 0xbe,
+	0xe4, 0x43, 0xfe, 0x17, 0x79, 0xaa, 0x06, 0x6b, 0x34, 0xa5, 0xe7, 0x45, 0xfd, 0x75, 0xc9, 0x05,
+	0x63, 0x1c, 0x8d, 0x7f, 0x5d, 0x84, 0xb9, 0x44, 0xdb, 0x91, 0x15, 0xb8, 0x24, 0xf7, 0x3b, 0xb8,
+	0x6e, 0xc2, 0x35, 0x27, 0x79, 0x71, 0x14, 0x3f, 0xad, 0xb2, 0x99, 0x46, 0xe2, 0x38, 0x3d, 0x6f,
+	0x55, 0x01, 0x6c, 0x0e, 0xfd, 0x20, 0x94, 0xf1, 0x2a, 0xa2, 0x55, 0x63, 0x70, 0x4c, 0x50, 0x91,
+	0x8f, 0x61, 0x7e, 0x87, 0x7d, 0x75, 0x24, 0x77, 0xba, 0x00, 0x0c, 0x6e, 0x91, 0x35, 0x13, 0x9c,
+	0x30, 0xc5, 0x99, 0x3c, 0x82, 0x3a, 0x83, 0x88, 0xea, 0x95, 0xa7, 0x12, 0x23, 0xf4, 0x19, 0xc5,
+	0x04, 0x23, 0x7e, 0xc6, 0xdf, 0x2b, 0xc0, 0x5c, 0xdb, 0xb1, 0x3b, 0xb6, 0xdb, 0x3b, 0xbf, 0x3c,
+	0xdd, 0xe4, 0x01, 0x54, 0x02, 0xc7, 0xee, 0xd0, 0x29, 0x67, 0x57, 0x6e, 0x6d, 0xb3, 0x5a, 0x52,
+	0x14, 0x7c, 0x8c, 0x1f, 0x56, 0x41, 0xde, 0x7f, 0x49, 0x86, 0x50, 0xef, 0xa9, 0x94, 0xb9, 0xb2,
+	0xca, 0xef, 0xe4, 0xc8, 0xe5, 0x95, 0x48, 0xbe, 0x2b, 0x1a, 0x4e, 0x03, 0x31, 0x92, 0x44, 0x68,
+	0xf2, 0xee, 0xda, 0xd5, 0x9c, 0x77, 0xd7, 0x0a, 0x71, 0xe3, 0xb7, 0xd7, 0x9a, 0xf2, 0x9e, 0xd7,
+	0x52, 0xce, 0x74, 0x27, 0x51, 0x12, 0x87, 0xb1, 0x9b, 0x5e, 0x4d, 0xa6, 0x1c, 0xe8, 0xfb, 0xba,
+	0x56, 0x72, 0x45, 0xd6, 0xc5, 0x45, 0xb0, 0x77, 0xe4, 0xac, 0xc9, 0x37, 0x0b, 0x30, 0xeb, 0xc7,
+	0xf6, 0x13, 0xe4, 0x24, 0x9a, 0xf3, 0xa4, 0x7c, 0x62, 0x73, 0x42, 0x86, 0x7a, 0xc5, 0xe0, 0x98,
+	0x10, 0x49, 0x7e, 0x01, 0x1a, 0xa1, 0x6f, 0xba, 0x41, 0xd7, 0xf3, 0xfb, 0xd4, 0x97, 0x1a, 0xf4,
+	0x5a, 0x8e, 0xab, 0x4c, 0xb7, 0x22, 0x6e, 0x62, 0x7a, 0x4c, 0x80, 0x30, 0x2e, 0x8d, 0xb5, 0x31,
+	0xbf, 0x4d, 0x77, 0x26, 0x67, 0x1b, 0x47, 0x37, 0x25, 0x8c, 0xdd, 0xa7, 0x6b, 0x42, 0xb9, 0xe7,
+	0x0f, 0x2c, 0x19, 0xf6, 0x3b, 0xbd, 0x88, 0x28, 0xab, 0xbb, 0x10, 0xc1, 0xde, 0x91, 0xb3, 0xe6,
+	0xae, 0x1e, 0xb1, 0x81, 0x6d, 0x25, 0xee, 0x6d, 0x11, 0xa7, 0x2c, 0x6e, 0x9d, 0x6c, 0x54, 0xeb,
+	0x9c, 0xfa, 0xb1, 0x7c, 0x9c, 0x99, 0x17, 0xb4, 0x18, 0xff, 0xae, 0x08, 0xcc, 0xd2, 0x13, 0xe9,
+	0xe5, 0xf8, 0xa5, 0x48, 0xb4, 0xbd, 0x67, 0x0f, 0xde, 0xa7, 0xbe, 0xdd, 0x1d, 0x49, 0x2f, 0x45,
+	0x2c, 0xbd, 0x5c, 0x9a, 0x02, 0x33, 0x4a, 0x91, 0x47, 0x30, 0x6b, 0x99, 0x2b, 0xd4, 0x0f, 0xa7,
+	0xf1, 0x9f, 0xf1, 0x2e, 0xb6, 0xb2, 0x1c, 0x15, 0xc7, 0x04, 0x33, 0xb2, 0x0d, 0x60, 0x45, 0xac,
+	0x4b, 0xa7, 0xf6, 0xfa, 0xc5, 0x18, 0xc7, 0x18, 0x11, 0x84, 0xfa, 0x1e, 0x23, 0xe5, 0x5c, 0xcb,
+	0xa7, 0xf6, 0x7b, 0xdf, 0x53, 0x65, 0x31, 0x62, 0x63, 0xb8, 0x30, 0x97, 0xb8, 0xdf, 0x80, 0x7c,
+	0x15, 0x6a, 0xde, 0x20, 0x36, 0x8b, 0xd6, 0xf9, 0xb9, 0x82, 0xda, 0x03, 0x09, 0x3b, 0x3e, 0x5c,
+	0x9c, 0xdb, 0xf0, 0x7a, 0xb6, 0xa5, 0x00, 0xa8, 0xc9, 0x89, 0x01, 0x55, 0xae, 0x8e, 0xab, 0xd3,
+	0x42, 0x7c, 0x05, 0xe0, 0xc9, 0xbd, 0x03, 0x94, 0x18, 0xe3, 0xbf, 0x14, 0x20, 0x0a, 0xff, 0x20,
+	0x01, 0x54, 0x3b, 0x3c, 0xb3, 0xb4, 0x9c, 0xb0, 0xa7, 0xdf, 0x42, 0x49, 0x5e, 0x47, 0x25, 0xd6,
+	0xd3, 0x24, 0x0c, 0xa5, 0x28, 0xd2, 0x83, 0xd2, 0xc7, 0xde, 0x4e, 0xee, 0xf9, 0x3a, 0x76, 0x34,
+	0x58, 0xc4, 0x2e, 0xc4, 0x00, 0xc8, 0x24, 0x18, 0xbf, 0x5c, 0x84, 0x46, 0x6c, 0x26, 0xc8, 0x7d,
+	0x3b, 0xc4, 0x41, 0xea, 0x76, 0x88, 0xd6, 0xf4, 0x0e, 0x94, 0xa8, 0x56, 0xe7, 0x6d, 0x96, 0x7d,
+	0xb7, 0x0c, 0xa5, 0xed, 0xd5, 0xb5, 0xa4, 0xaf, 0xa0, 0xf0, 0x0c, 0x7c, 0x05, 0xbb, 0x91, 0xc9,
+	0x9d, 0xf7, 0x40, 0xba, 0xba, 0x4c, 0x23, 0xdb, 0xd4, 0x26, 0x3d, 0x98, 0xe9, 0x89, 0xac, 0x6c,
+	0x72, 0xac, 0x4f, 0x7f, 0xd9, 0xb8, 0xcc, 0xee, 0x26, 0x04, 0xc9, 0x17, 0x54, 0xdc, 0xc9, 0x1b,
+	0x50, 0xf3, 0xfc, 0x0e, 0xf5, 0x95, 0xc1, 0x13, 0x65, 0x3b, 0xa9, 0x3d, 0x90, 0xf0, 0xe3, 0xd8,
+	0x33, 0x6a, 0x6a, 0xf2, 0x08, 0xca, 0x8f, 0xcd, 0xa0, 0x9f, 0xfb, 0x8c, 0xf0, 0x43, 0x33, 0xe8,
+	0x8b, 0x7e, 0xc9, 0x9e, 0x90, 0x33, 0x25, 0x5d, 0xa8, 0xfa, 0x7c, 0xe7, 0x37, 0x77, 0x70, 0x9a,
+	0xde, 0x40, 0x16, 0x73, 0x87, 0x78, 0x45, 0xc9, 0xdd, 0xf8, 0x06, 0xc8, 0xdb, 0xef, 0x99, 0x21,
+	0x76, 0x1e, 0x9d, 0x49, 0x7b, 0xef, 0xb3, 0x3a, 0x94, 0xf1, 0xbd, 0x22, 0x24, 0x97, 0xf6, 0x67,
+	0xdf, 0xa7, 0xf7, 0xd2, 0x7d, 0x7a, 0xf5, 0x2c, 0xa6, 0x80, 0x09, 0xdd, 0x5a, 0xf5, 0x99, 0xd2,
+	0x39, 0xf4, 0x19, 0xe3, 0x9f, 0x16, 0xa1, 0x2a, 0xef, 0xcd, 0x3f, 0xff, 0x98, 0x72, 0x9a, 0x88,
+	0x29, 0x5f, 0xc9, 0x79, 0xcb, 0xea, 0xc4, 0x88, 0xf2, 0x7e, 0x2a, 0xa2, 0x3c, 0xef, 0x75, 0xae,
+	0x4f, 0x89, 0x27, 0xff, 0x9d, 0x02, 0xcc, 0x0b, 0xc2, 0xbb, 0x6e, 0x10, 0x9a, 0xae, 0x45, 0x89,
+	0x05, 0x55, 0x11, 0x67, 0x97, 0x3b, 0x70, 0x51, 0x06, 0xf7, 0x8a, 0x25, 0x9c, 0x3f, 0xa3, 0x64,
+	0x4d, 0xbe, 0x0c, 0xb5, 0x5d, 0x2f, 0x08, 0xf9, 0x52, 0x56, 0x4c, 0xfa, 0xd4, 0xde, 0x91, 0x70,
+	0xd4, 0x14, 0xe9, 0xd8, 0xa4, 0xca, 0xe4, 0xd8, 0x24, 0xe3, 0x37, 0xca, 0x30, 0x9b, 0xb8, 0xc4,
+	0x77, 0xea, 0xf0, 0xf8, 0x54, 0x74, 0x7a, 0xf1, 0xec, 0xa3, 0xd3, 0xb3, 0x22, 0xf0, 0x4b, 0x39,
+	0x23, 0xf0, 0xcb, 0xa7, 0x8a, 0xc0, 0xff, 0x10, 0x60, 0xd8, 0xe9, 0xaa, 0x4f, 0xac, 0x4c, 0xef,
+	0x67, 0xd9, 0x5e, 0x5d, 0x53, 0x5f, 0x18, 0xe3, 0x48, 0xbe, 0x53, 0x80, 0x4b, 0xc3, 0x4e, 0x37,
+	0x19, 0x87, 0x92, 0x3b, 0xf9, 0x43, 0x2a, 0xd4, 0x85, 0xfb, 0x67, 0xb6, 0x57, 0xd7, 0x52, 0xd1,
+	0x2e, 0xe3, 0x82, 0x8d, 0xef, 0x17, 0x00, 0x54, 0xe7, 0x38, 0xf7, 0xb3, 0x00, 0x9d, 0xe4, 0x59,
+	0x80, 0xdc, 0xc3, 0x28, 0xfb, 0x24, 0xc0, 0x3f, 0xaa, 0xa8, 0x4f, 0xe2, 0xe7, 0x00, 0x3e, 0x2b,
+	0xc0, 0xbc, 0x99, 0x88, 0xad, 0xcf, 0xad, 0x15, 0xa7, 0x42, 0xf5, 0x75, 0x30, 0x4b, 0x12, 0x8e,
+	0x29, 0xb1, 0xe4, 0x0d, 0x98, 0x1d, 0xc8, 0xc0, 0xe3, 0xfb, 0xd1, 0x28, 0xd7, 0x3e, 0xe3, 0x56,
+	0x0c, 0x87, 0x09, 0xca, 0xa7, 0x9c, 0x65, 0x28, 0x9d, 0xc9, 0x59, 0x86, 0xf8, 0x11, 0xf1, 0xf2,
+	0x13, 0x8f, 0x88, 0xef, 0x43, 0xbd, 0xeb, 0x7b, 0x7d, 0x7e, 0x5c, 0x40, 0xde, 0x7b, 0x7b, 0x27,
+	0xc7, 0xfa, 0x1c, 0xdd, 0xf8, 0x1e, 0x69, 0x0a, 0x6b, 0x8a, 0x3f, 0x46, 0xa2, 0xf8, 0xfe, 0x92,
+	0x27, 0xa4, 0x56, 0xcf, 0x52, 0xaa, 0x9e, 0x3a, 0xb7, 0x04, 0x77, 0x54, 0x62, 0x92, 0x47, 0x04,
+	0x66, 0x9e, 0xcd, 0x11, 0x01, 0xe3, 0x77, 0x8b, 0x6a, 0xbe, 0x6e, 0xa7, 0x52, 0xf1, 0x15, 0x26,
+	0xa4, 0xe2, 0x93, 0x89, 0x9c, 0xe3, 0xc1, 0xec, 0x3c, 0x1e, 0xcd, 0x0c, 0x3c, 0x57, 0xe6, 0x89,
+	0x8f, 0xc5, 0xa3, 0x31, 0x28, 0x4a, 0x6c, 0x3c, 0xe8, 0xbd, 0xf8, 0x94, 0xa0, 0xf7, 0x2f, 0xc7,
+	0x3a, 0x88, 0xd8, 0x45, 0xd0, 0x63, 0x3d, 0xa3, 0x93, 0xf0, 0x88, 0x58, 0x61, 0x27, 0xcb, 0xdd,
+	0xd9, 0x58, 0x44, 0xac, 0x80, 0xa3, 0xa6, 0x20, 0x1d, 0x98, 0x75, 0xcc, 0x20, 0xe4, 0x1b, 0xe0,
+	0x9d, 0xe5, 0x70, 0x8a, 0x88, 0x7a, 0x3d, 0x8c, 0x36, 0x62, 0x7c, 0x30, 0xc1, 0xd5, 0xf8, 0x1f,
+	0x05, 0xe0, 0xca, 0x12, 0xd9, 0xe6, 0x1a, 0xa6, 0xc8, 0x30, 0xfe, 0xa4, 0x5b, 0xb1, 0x75, 0x1a,
+	0xf2, 0x31, 0xab, 0x5f, 0x63, 0x30, 0xe2, 0x94, 0xba, 0x5d, 0xb3, 0x78, 0xaa, 0xdb, 0x35, 0x4b,
+	0x13, 0x6f, 0xd7, 0x7c, 0x1b, 0x2e, 0xf6, 0x69, 0xdf, 0xf3, 0x47, 0x7c, 0x81, 0x6a, 0x99, 0xac,
+	0xff, 0xc7, 0x73, 0x78, 0xa4, 0x70, 0x38, 0x46, 0x6d, 0xfc, 0x95, 0x02, 0x44, 0x5d, 0xed, 0x94,
+	0xb1, 0x28, 0x1f, 0x40, 0xad, 0x6f, 0x1e, 0xac, 0x52, 0xc7, 0x1c, 0xe5, 0xd9, 0xbc, 0xdb, 0x94,
+	0x3c, 0x50, 0x73, 0x33, 0x0e, 0x0b, 0x20, 0x93, 0x62, 0x13, 0x0a, 0x95, 0xae, 0x7d, 0x20, 0xeb,
+	0x93, 0x47, 0xfd, 0x8e, 0xdd, 0x88, 0x29, 0xbc, 0xbd, 0x1c, 0x80, 0x82, 0x3b, 0xe9, 0xc3, 0x4c,
+	0x20, 0x9c, 0xf1, 0xf2, 0x53, 0x72, 0x6c, 0x33, 0xc5, 0x9d, 0xfa, 0x32, 0x48, 0x58, 0x80, 0x50,
+	0xc9, 0x68, 0x2e, 0x7d, 0xf7, 0x07, 0xd7, 0x9f, 0xfb, 0xfe, 0x0f, 0xae, 0x3f, 0xf7, 0x7b, 0x3f,
+	0xb8, 0xfe, 0xdc, 0x2f, 0x1d, 0x5d, 0x2f, 0x7c, 0xf7, 0xe8, 0x7a, 0xe1, 0xfb, 0x47, 0xd7, 0x0b,
+	0xbf, 0x77, 0x74, 0xbd, 0xf0, 0x1f, 0x8f, 0xae, 0x17, 0xfe, 0xd2, 0x7f, 0xba, 0xfe, 0xdc, 0xd7,
+	0x6b, 0x8a, 0xe7, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xd9, 0x9c, 0x82, 0x8d, 0xa2, 0x94, 0x00,
+	0x00,
 }
 
 func (m *AbstractPodTemplate) Marshal() (dAtA []byte, err error) {
@@ -2710,7 +3454,7 @@ func (m *AbstractPodTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AbstractVertex) Marshal() (dAtA []byte, err error) {
+func (m *AbstractSink) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2720,37 +3464,165 @@ func (m *AbstractVertex) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AbstractVertex) MarshalTo(dAtA []byte) (int, error) {
+func (m *AbstractSink) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AbstractVertex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AbstractSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Partitions != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.Partitions))
+	if m.SQL != nil {
+		{
+			size, err := m.SQL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x68
+		dAtA[i] = 0x42
 	}
-	if len(m.Sidecars) > 0 {
-		for iNdEx := len(m.Sidecars) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sidecars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if m.S3 != nil {
+		{
+			size, err := m.S3.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x62
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.InitContainers) > 0 {
-		for iNdEx := len(m.InitContainers) - 1; iNdEx >= 0; iNdEx-- {
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UDSink != nil {
+		{
+			size, err := m.UDSink.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Blackhole != nil {
+		{
+			size, err := m.Blackhole.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Kafka != nil {
+		{
+			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Log != nil {
+		{
+			size, err := m.Log.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AbstractVertex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AbstractVertex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AbstractVertex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SideInputs) > 0 {
+		for iNdEx := len(m.SideInputs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SideInputs[iNdEx])
+			copy(dAtA[i:], m.SideInputs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.SideInputs[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.Partitions != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Partitions))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Sidecars) > 0 {
+		for iNdEx := len(m.Sidecars) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sidecars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.InitContainers) > 0 {
+		for iNdEx := len(m.InitContainers) - 1; iNdEx >= 0; iNdEx-- {
 			{
 				size, err := m.InitContainers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
@@ -3029,6 +3901,46 @@ func (m *BufferServiceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OpenDuration != nil {
+		{
+			size, err := m.OpenDuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FailureThreshold != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.FailureThreshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CombinedEdge) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3415,7 +4327,7 @@ func (m *Edge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FixedWindow) Marshal() (dAtA []byte, err error) {
+func (m *FileEventTime) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3425,32 +4337,30 @@ func (m *FixedWindow) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FixedWindow) MarshalTo(dAtA []byte) (int, error) {
+func (m *FileEventTime) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FixedWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FileEventTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Length != nil {
-		{
-			size, err := m.Length.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ForwardConditions) Marshal() (dAtA []byte, err error) {
+func (m *FileSink) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3460,19 +4370,19 @@ func (m *ForwardConditions) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ForwardConditions) MarshalTo(dAtA []byte) (int, error) {
+func (m *FileSink) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ForwardConditions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FileSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Tags != nil {
+	if m.Parquet != nil {
 		{
-			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Parquet.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3480,12 +4390,73 @@ func (m *ForwardConditions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x4a
+	}
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0x42
+	if m.RollInterval != nil {
+		{
+			size, err := m.RollInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MaxFileSize != nil {
+		{
+			size, err := m.MaxFileSize.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	i -= len(m.PartitionFormat)
+	copy(dAtA[i:], m.PartitionFormat)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PartitionFormat)))
+	i--
+	dAtA[i] = 0x2a
+	if m.PartitionDuration != nil {
+		{
+			size, err := m.PartitionDuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
+	i -= len(m.Compression)
+	copy(dAtA[i:], m.Compression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Compression)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.VolumeName)
+	copy(dAtA[i:], m.VolumeName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.VolumeName)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Function) Marshal() (dAtA []byte, err error) {
+func (m *FileSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3495,47 +4466,194 @@ func (m *Function) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Function) MarshalTo(dAtA []byte) (int, error) {
+func (m *FileSource) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Function) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FileSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.KWArgs) > 0 {
-		keysForKWArgs := make([]string, 0, len(m.KWArgs))
-		for k := range m.KWArgs {
-			keysForKWArgs = append(keysForKWArgs, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForKWArgs)
-		for iNdEx := len(keysForKWArgs) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.KWArgs[string(keysForKWArgs[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForKWArgs[iNdEx])
-			copy(dAtA[i:], keysForKWArgs[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForKWArgs[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
+	if m.EventTime != nil {
+		{
+			size, err := m.EventTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Args[iNdEx])
-			copy(dAtA[i:], m.Args[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Args[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	i -= len(m.CheckpointPath)
+	copy(dAtA[i:], m.CheckpointPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CheckpointPath)))
+	i--
+	dAtA[i] = 0x32
+	if m.PollInterval != nil {
+		{
+			size, err := m.PollInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Follow != nil {
+		i--
+		if *m.Follow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.VolumeName)
+	copy(dAtA[i:], m.VolumeName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.VolumeName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FixedWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FixedWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FixedWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Length != nil {
+		{
+			size, err := m.Length.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardConditions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardConditions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardConditions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tags != nil {
+		{
+			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Function) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Function) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Function) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KWArgs) > 0 {
+		keysForKWArgs := make([]string, 0, len(m.KWArgs))
+		for k := range m.KWArgs {
+			keysForKWArgs = append(keysForKWArgs, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForKWArgs)
+		for iNdEx := len(keysForKWArgs) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.KWArgs[string(keysForKWArgs[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForKWArgs[iNdEx])
+			copy(dAtA[i:], keysForKWArgs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForKWArgs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
 	i -= len(m.Name)
@@ -3546,6 +4664,66 @@ func (m *Function) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GRPCSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GRPCSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GRPCSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Window))
+		i--
+		dAtA[i] = 0x20
+	}
+	i--
+	if m.Service {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Auth != nil {
+		{
+			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GSSAPI) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3654,6 +4832,54 @@ func (m *GeneratorSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LateBy != nil {
+		{
+			size, err := m.LateBy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.LatePercent != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.LatePercent))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Jitter != nil {
+		{
+			size, err := m.Jitter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.KeyDistribution != nil {
+		{
+			size, err := m.KeyDistribution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Template != nil {
+		i -= len(*m.Template)
+		copy(dAtA[i:], *m.Template)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Template)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Value != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.Value))
 		i--
@@ -4071,7 +5297,7 @@ func (m *GetRedisStatefulSetSpecReq) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *GetVertexPodSpecReq) Marshal() (dAtA []byte, err error) {
+func (m *GetSideInputDeploymentReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4081,12 +5307,12 @@ func (m *GetVertexPodSpecReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetVertexPodSpecReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetSideInputDeploymentReq) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetVertexPodSpecReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetSideInputDeploymentReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4123,7 +5349,7 @@ func (m *GetVertexPodSpecReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GroupBy) Marshal() (dAtA []byte, err error) {
+func (m *GetVertexPodSpecReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4133,22 +5359,74 @@ func (m *GroupBy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GroupBy) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetVertexPodSpecReq) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GroupBy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetVertexPodSpecReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Storage != nil {
-		{
-			size, err := m.Storage.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
+	if len(m.Env) > 0 {
+		for iNdEx := len(m.Env) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Env[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.PullPolicy)
+	copy(dAtA[i:], m.PullPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PullPolicy)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Image)
+	copy(dAtA[i:], m.Image)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Image)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.ISBSvcType)
+	copy(dAtA[i:], m.ISBSvcType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ISBSvcType)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupBy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupBy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupBy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Storage != nil {
+		{
+			size, err := m.Storage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
 			i -= size
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
@@ -4188,6 +5466,126 @@ func (m *GroupBy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPSink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPSink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RetryableStatusCodes) > 0 {
+		for iNdEx := len(m.RetryableStatusCodes) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintGenerated(dAtA, i, uint64(m.RetryableStatusCodes[iNdEx]))
+			i--
+			dAtA[i] = 0x48
+		}
+	}
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	i--
+	if m.Batch {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BasicAuth != nil {
+		{
+			size, err := m.BasicAuth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Auth != nil {
+		{
+			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Headers) > 0 {
+		keysForHeaders := make([]string, 0, len(m.Headers))
+		for k := range m.Headers {
+			keysForHeaders = append(keysForHeaders, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+		for iNdEx := len(keysForHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Headers[string(keysForHeaders[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHeaders[iNdEx])
+			copy(dAtA[i:], keysForHeaders[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHeaders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HTTPSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4694,6 +6092,18 @@ func (m *KafkaSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SchemaRegistry != nil {
+		{
+			size, err := m.SchemaRegistry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.SASL != nil {
 		{
 			size, err := m.SASL.MarshalToSizedBuffer(dAtA[:i])
@@ -4760,6 +6170,18 @@ func (m *KafkaSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SchemaRegistry != nil {
+		{
+			size, err := m.SchemaRegistry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.SASL != nil {
 		{
 			size, err := m.SASL.MarshalToSizedBuffer(dAtA[:i])
@@ -4811,6 +6233,39 @@ func (m *KafkaSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KeyDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ZipfExponent)
+	copy(dAtA[i:], m.ZipfExponent)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ZipfExponent)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Lifecycle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4864,6 +6319,30 @@ func (m *Log) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPayloadLength != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxPayloadLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	i -= len(m.SamplingRate)
+	copy(dAtA[i:], m.SamplingRate)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SamplingRate)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -5221,6 +6700,51 @@ func (m *PBQStorage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParquetOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParquetOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParquetOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RowGroupSize != nil {
+		{
+			size, err := m.RowGroupSize.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InferSchemaSampleSize != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.InferSchemaSampleSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	i -= len(m.Schema)
+	copy(dAtA[i:], m.Schema)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schema)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PersistenceStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5440,16 +6964,30 @@ func (m *PipelineSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Templates != nil {
-		{
-			size, err := m.Templates.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
+	if len(m.SideInputs) > 0 {
+		for iNdEx := len(m.SideInputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SideInputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Templates != nil {
+		{
+			size, err := m.Templates.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
 		dAtA[i] = 0x3a
 	}
 	{
@@ -5770,6 +7308,27 @@ func (m *RedisStreamsSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClaimIdleTime != nil {
+		{
+			size, err := m.ClaimIdleTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Streams[iNdEx])
+			copy(dAtA[i:], m.Streams[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Streams[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.TLS != nil {
 		{
 			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
@@ -5813,6 +7372,174 @@ func (m *RedisStreamsSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RemoteUDF) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteUDF) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteUDF) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CircuitBreaker != nil {
+		{
+			size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Address)
+	copy(dAtA[i:], m.Address)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Address)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *S3Sink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *S3Sink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *S3Sink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.PartSize != nil {
+		{
+			size, err := m.PartSize.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	i -= len(m.Compression)
+	copy(dAtA[i:], m.Compression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Compression)))
+	i--
+	dAtA[i] = 0x42
+	i--
+	if m.ForcePathStyle {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
+	if m.SecretKey != nil {
+		{
+			size, err := m.SecretKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AccessKey != nil {
+		{
+			size, err := m.AccessKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Bucket)
+	copy(dAtA[i:], m.Bucket)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Bucket)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Region)
+	copy(dAtA[i:], m.Region)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Endpoint)
+	copy(dAtA[i:], m.Endpoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SASL) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5922,6 +7649,103 @@ func (m *SASLPlain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SQLArg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SQLArg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SQLArg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Metadata)
+	copy(dAtA[i:], m.Metadata)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Metadata)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Field)
+	copy(dAtA[i:], m.Field)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Field)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SQLSink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SQLSink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SQLSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxOpenConns != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxOpenConns))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.Statement)
+	copy(dAtA[i:], m.Statement)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Statement)))
+	i--
+	dAtA[i] = 0x1a
+	if m.DSN != nil {
+		{
+			size, err := m.DSN.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Driver)
+	copy(dAtA[i:], m.Driver)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Driver)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Scale) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5993,7 +7817,7 @@ func (m *Scale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Sink) Marshal() (dAtA []byte, err error) {
+func (m *SchemaRegistry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6003,19 +7827,19 @@ func (m *Sink) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Sink) MarshalTo(dAtA []byte) (int, error) {
+func (m *SchemaRegistry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Sink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SchemaRegistry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UDSink != nil {
+	if m.CacheTTL != nil {
 		{
-			size, err := m.UDSink.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CacheTTL.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6023,23 +7847,21 @@ func (m *Sink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x32
 	}
-	if m.Blackhole != nil {
-		{
-			size, err := m.Blackhole.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Kafka != nil {
+	i -= len(m.OnFailure)
+	copy(dAtA[i:], m.OnFailure)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnFailure)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Subject)
+	copy(dAtA[i:], m.Subject)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Subject)))
+	i--
+	dAtA[i] = 0x22
+	if m.BasicAuth != nil {
 		{
-			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.BasicAuth.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6047,11 +7869,11 @@ func (m *Sink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if m.Log != nil {
+	if m.TLS != nil {
 		{
-			size, err := m.Log.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6059,12 +7881,17 @@ func (m *Sink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *SlidingWindow) Marshal() (dAtA []byte, err error) {
+func (m *SideInput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6074,19 +7901,19 @@ func (m *SlidingWindow) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SlidingWindow) MarshalTo(dAtA []byte) (int, error) {
+func (m *SideInput) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SlidingWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SideInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Slide != nil {
+	if m.Builtin != nil {
 		{
-			size, err := m.Slide.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Builtin.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6094,11 +7921,11 @@ func (m *SlidingWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x2a
 	}
-	if m.Length != nil {
+	if m.Trigger != nil {
 		{
-			size, err := m.Length.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6106,12 +7933,43 @@ func (m *SlidingWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
+	}
+	if len(m.Volumes) > 0 {
+		for iNdEx := len(m.Volumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Container != nil {
+		{
+			size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Source) Marshal() (dAtA []byte, err error) {
+func (m *SideInputBuiltin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6121,19 +7979,78 @@ func (m *Source) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Source) MarshalTo(dAtA []byte) (int, error) {
+func (m *SideInputBuiltin) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Source) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SideInputBuiltin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UDTransformer != nil {
+	if len(m.KWArgs) > 0 {
+		keysForKWArgs := make([]string, 0, len(m.KWArgs))
+		for k := range m.KWArgs {
+			keysForKWArgs = append(keysForKWArgs, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForKWArgs)
+		for iNdEx := len(keysForKWArgs) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.KWArgs[string(keysForKWArgs[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForKWArgs[iNdEx])
+			copy(dAtA[i:], keysForKWArgs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForKWArgs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SideInputTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SideInputTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SideInputTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timezone != nil {
+		i -= len(*m.Timezone)
+		copy(dAtA[i:], *m.Timezone)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Timezone)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Interval != nil {
 		{
-			size, err := m.UDTransformer.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6141,11 +8058,39 @@ func (m *Source) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x12
 	}
-	if m.RedisStreams != nil {
+	i -= len(m.Schedule)
+	copy(dAtA[i:], m.Schedule)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Sink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RateLimit != nil {
 		{
-			size, err := m.RedisStreams.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6155,9 +8100,9 @@ func (m *Source) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.Nats != nil {
+	if m.Batching != nil {
 		{
-			size, err := m.Nats.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Batching.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6167,21 +8112,14 @@ func (m *Source) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.HTTP != nil {
-		{
-			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
+	if m.MaxRetries != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxRetries))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if m.Kafka != nil {
+	if m.Fallback != nil {
 		{
-			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Fallback.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6191,22 +8129,20 @@ func (m *Source) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Generator != nil {
-		{
-			size, err := m.Generator.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	{
+		size, err := m.AbstractSink.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Status) Marshal() (dAtA []byte, err error) {
+func (m *SinkBatching) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6216,34 +8152,49 @@ func (m *Status) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Status) MarshalTo(dAtA []byte) (int, error) {
+func (m *SinkBatching) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SinkBatching) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Conditions) > 0 {
-		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if m.MaxLatency != nil {
+		{
+			size, err := m.MaxLatency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxBatchBytes != nil {
+		{
+			size, err := m.MaxBatchBytes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxBatchSize != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TLS) Marshal() (dAtA []byte, err error) {
+func (m *SinkRateLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6253,19 +8204,19 @@ func (m *TLS) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TLS) MarshalTo(dAtA []byte) (int, error) {
+func (m *SinkRateLimit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TLS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SinkRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.KeySecret != nil {
+	if m.ByteBurst != nil {
 		{
-			size, err := m.KeySecret.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ByteBurst.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6275,9 +8226,9 @@ func (m *TLS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.CertSecret != nil {
+	if m.BytesPerSecond != nil {
 		{
-			size, err := m.CertSecret.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.BytesPerSecond.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6287,30 +8238,20 @@ func (m *TLS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.CACertSecret != nil {
-		{
-			size, err := m.CACertSecret.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
+	if m.MessageBurst != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MessageBurst))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	i--
-	if m.InsecureSkipVerify {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	if m.MessagesPerSecond != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MessagesPerSecond))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0x8
 	return len(dAtA) - i, nil
 }
 
-func (m *TagConditions) Marshal() (dAtA []byte, err error) {
+func (m *SlidingWindow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6320,36 +8261,44 @@ func (m *TagConditions) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TagConditions) MarshalTo(dAtA []byte) (int, error) {
+func (m *SlidingWindow) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TagConditions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SlidingWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Values) > 0 {
-		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Values[iNdEx])
-			copy(dAtA[i:], m.Values[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Values[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	if m.Slide != nil {
+		{
+			size, err := m.Slide.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Operator != nil {
-		i -= len(*m.Operator)
-		copy(dAtA[i:], *m.Operator)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Operator)))
+	if m.Length != nil {
+		{
+			size, err := m.Length.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Templates) Marshal() (dAtA []byte, err error) {
+func (m *Source) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6359,19 +8308,91 @@ func (m *Templates) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Templates) MarshalTo(dAtA []byte) (int, error) {
+func (m *Source) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Templates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Source) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.JobTemplate != nil {
+	if m.GRPC != nil {
 		{
-			size, err := m.JobTemplate.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.GRPC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.UDTransformer != nil {
+		{
+			size, err := m.UDTransformer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RedisStreams != nil {
+		{
+			size, err := m.RedisStreams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Nats != nil {
+		{
+			size, err := m.Nats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Kafka != nil {
+		{
+			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6381,9 +8402,9 @@ func (m *Templates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.DaemonTemplate != nil {
+	if m.Generator != nil {
 		{
-			size, err := m.DaemonTemplate.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Generator.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6396,7 +8417,7 @@ func (m *Templates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Transformer) Marshal() (dAtA []byte, err error) {
+func (m *Status) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6406,58 +8427,34 @@ func (m *Transformer) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Transformer) MarshalTo(dAtA []byte) (int, error) {
+func (m *Status) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Transformer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.KWArgs) > 0 {
-		keysForKWArgs := make([]string, 0, len(m.KWArgs))
-		for k := range m.KWArgs {
-			keysForKWArgs = append(keysForKWArgs, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForKWArgs)
-		for iNdEx := len(keysForKWArgs) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.KWArgs[string(keysForKWArgs[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForKWArgs[iNdEx])
-			copy(dAtA[i:], keysForKWArgs[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForKWArgs[iNdEx])))
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Args[iNdEx])
-			copy(dAtA[i:], m.Args[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Args[iNdEx])))
-			i--
-			dAtA[i] = 0x12
 		}
 	}
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *UDF) Marshal() (dAtA []byte, err error) {
+func (m *TLS) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6467,19 +8464,19 @@ func (m *UDF) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UDF) MarshalTo(dAtA []byte) (int, error) {
+func (m *TLS) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UDF) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TLS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GroupBy != nil {
+	if m.KeySecret != nil {
 		{
-			size, err := m.GroupBy.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.KeySecret.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6487,11 +8484,11 @@ func (m *UDF) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
-	if m.Builtin != nil {
+	if m.CertSecret != nil {
 		{
-			size, err := m.Builtin.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CertSecret.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6499,11 +8496,11 @@ func (m *UDF) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if m.Container != nil {
+	if m.CACertSecret != nil {
 		{
-			size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CACertSecret.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6511,12 +8508,20 @@ func (m *UDF) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	i--
+	if m.InsecureSkipVerify {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
+	i--
+	dAtA[i] = 0x8
 	return len(dAtA) - i, nil
 }
 
-func (m *UDSink) Marshal() (dAtA []byte, err error) {
+func (m *TagConditions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6526,30 +8531,36 @@ func (m *UDSink) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UDSink) MarshalTo(dAtA []byte) (int, error) {
+func (m *TagConditions) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UDSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TagConditions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
+	if m.Operator != nil {
+		i -= len(*m.Operator)
+		copy(dAtA[i:], *m.Operator)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UDTransformer) Marshal() (dAtA []byte, err error) {
+func (m *Templates) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6559,19 +8570,19 @@ func (m *UDTransformer) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UDTransformer) MarshalTo(dAtA []byte) (int, error) {
+func (m *Templates) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UDTransformer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Templates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Builtin != nil {
+	if m.JobTemplate != nil {
 		{
-			size, err := m.Builtin.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobTemplate.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6581,9 +8592,9 @@ func (m *UDTransformer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Container != nil {
+	if m.DaemonTemplate != nil {
 		{
-			size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DaemonTemplate.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6596,7 +8607,7 @@ func (m *UDTransformer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Vertex) Marshal() (dAtA []byte, err error) {
+func (m *Transformer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6606,50 +8617,58 @@ func (m *Vertex) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Vertex) MarshalTo(dAtA []byte) (int, error) {
+func (m *Transformer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Vertex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Transformer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.KWArgs) > 0 {
+		keysForKWArgs := make([]string, 0, len(m.KWArgs))
+		for k := range m.KWArgs {
+			keysForKWArgs = append(keysForKWArgs, string(k))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+		github_com_gogo_protobuf_sortkeys.Strings(keysForKWArgs)
+		for iNdEx := len(keysForKWArgs) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.KWArgs[string(keysForKWArgs[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForKWArgs[iNdEx])
+			copy(dAtA[i:], keysForKWArgs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForKWArgs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *VertexInstance) Marshal() (dAtA []byte, err error) {
+func (m *UDF) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6659,27 +8678,72 @@ func (m *VertexInstance) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VertexInstance) MarshalTo(dAtA []byte) (int, error) {
+func (m *UDF) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VertexInstance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UDF) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Replica))
-	i--
-	dAtA[i] = 0x18
-	i -= len(m.Hostname)
-	copy(dAtA[i:], m.Hostname)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Hostname)))
+	if m.Remote != nil {
+		{
+			size, err := m.Remote.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Wasm != nil {
+		{
+			size, err := m.Wasm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.Ordering)
+	copy(dAtA[i:], m.Ordering)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Ordering)))
 	i--
-	dAtA[i] = 0x12
-	if m.Vertex != nil {
+	dAtA[i] = 0x22
+	if m.GroupBy != nil {
 		{
-			size, err := m.Vertex.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.GroupBy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Builtin != nil {
+		{
+			size, err := m.Builtin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Container != nil {
+		{
+			size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6692,7 +8756,7 @@ func (m *VertexInstance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *VertexLimits) Marshal() (dAtA []byte, err error) {
+func (m *UDSink) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6702,47 +8766,30 @@ func (m *VertexLimits) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VertexLimits) MarshalTo(dAtA []byte) (int, error) {
+func (m *UDSink) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VertexLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UDSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BufferUsageLimit != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.BufferUsageLimit))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.BufferMaxLength != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.BufferMaxLength))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ReadTimeout != nil {
-		{
-			size, err := m.ReadTimeout.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	{
+		size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ReadBatchSize != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.ReadBatchSize))
-		i--
-		dAtA[i] = 0x8
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *VertexList) Marshal() (dAtA []byte, err error) {
+func (m *UDTransformer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6752,44 +8799,56 @@ func (m *VertexList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VertexList) MarshalTo(dAtA []byte) (int, error) {
+func (m *UDTransformer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VertexList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UDTransformer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if m.Wasm != nil {
+		{
+			size, err := m.Wasm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Builtin != nil {
+		{
+			size, err := m.Builtin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Container != nil {
+		{
+			size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *VertexSpec) Marshal() (dAtA []byte, err error) {
+func (m *Vertex) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6799,18 +8858,18 @@ func (m *VertexSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VertexSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *Vertex) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VertexSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Vertex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Watermark.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -6818,52 +8877,19 @@ func (m *VertexSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x3a
-	if len(m.ToEdges) > 0 {
-		for iNdEx := len(m.ToEdges) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ToEdges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.FromEdges) > 0 {
-		for iNdEx := len(m.FromEdges) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FromEdges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.Replicas != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.Replicas))
-		i--
-		dAtA[i] = 0x20
-	}
-	i -= len(m.InterStepBufferServiceName)
-	copy(dAtA[i:], m.InterStepBufferServiceName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.InterStepBufferServiceName)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.PipelineName)
-	copy(dAtA[i:], m.PipelineName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PipelineName)))
 	i--
 	dAtA[i] = 0x12
 	{
-		size, err := m.AbstractVertex.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -6875,7 +8901,7 @@ func (m *VertexSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *VertexStatus) Marshal() (dAtA []byte, err error) {
+func (m *VertexInstance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6885,53 +8911,40 @@ func (m *VertexStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VertexStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *VertexInstance) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VertexStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VertexInstance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Reason)
-	copy(dAtA[i:], m.Reason)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
-	i--
-	dAtA[i] = 0x32
-	i -= len(m.Selector)
-	copy(dAtA[i:], m.Selector)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Selector)))
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.LastScaledAt.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Replicas))
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Replica))
 	i--
 	dAtA[i] = 0x18
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i -= len(m.Hostname)
+	copy(dAtA[i:], m.Hostname)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Hostname)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Phase)
-	copy(dAtA[i:], m.Phase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
-	i--
-	dAtA[i] = 0xa
+	if m.Vertex != nil {
+		{
+			size, err := m.Vertex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Watermark) Marshal() (dAtA []byte, err error) {
+func (m *VertexLimits) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6941,19 +8954,53 @@ func (m *Watermark) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Watermark) MarshalTo(dAtA []byte) (int, error) {
+func (m *VertexLimits) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Watermark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VertexLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxDelay != nil {
+	if m.UDFCircuitBreaker != nil {
 		{
-			size, err := m.MaxDelay.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.UDFCircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.UDFTimeout != nil {
+		{
+			size, err := m.UDFTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BufferUsageLimit != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.BufferUsageLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BufferMaxLength != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.BufferMaxLength))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReadTimeout != nil {
+		{
+			size, err := m.ReadTimeout.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6963,18 +9010,15 @@ func (m *Watermark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	i--
-	if m.Disabled {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	if m.ReadBatchSize != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ReadBatchSize))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0x8
 	return len(dAtA) - i, nil
 }
 
-func (m *Window) Marshal() (dAtA []byte, err error) {
+func (m *VertexList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6984,350 +9028,457 @@ func (m *Window) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Window) MarshalTo(dAtA []byte) (int, error) {
+func (m *VertexList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Window) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VertexList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Sliding != nil {
-		{
-			size, err := m.Sliding.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.Fixed != nil {
-		{
-			size, err := m.Fixed.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *VertexSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AbstractPodTemplate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *VertexSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VertexSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.NodeSelector) > 0 {
-		for k, v := range m.NodeSelector {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	{
+		size, err := m.Watermark.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if len(m.Tolerations) > 0 {
-		for _, e := range m.Tolerations {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.ToEdges) > 0 {
+		for iNdEx := len(m.ToEdges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ToEdges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	if m.SecurityContext != nil {
-		l = m.SecurityContext.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.ImagePullSecrets) > 0 {
-		for _, e := range m.ImagePullSecrets {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.FromEdges) > 0 {
+		for iNdEx := len(m.FromEdges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FromEdges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	l = len(m.PriorityClassName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Priority != nil {
-		n += 1 + sovGenerated(uint64(*m.Priority))
+	if m.Replicas != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Replicas))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Affinity != nil {
-		l = m.Affinity.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.InterStepBufferServiceName)
+	copy(dAtA[i:], m.InterStepBufferServiceName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.InterStepBufferServiceName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.PipelineName)
+	copy(dAtA[i:], m.PipelineName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PipelineName)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.AbstractVertex.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	l = len(m.ServiceAccountName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.RuntimeClassName != nil {
-		l = len(*m.RuntimeClassName)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.AutomountServiceAccountToken != nil {
-		n += 2
-	}
-	l = len(m.DNSPolicy)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.DNSConfig != nil {
-		l = m.DNSConfig.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *AbstractVertex) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VertexStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *VertexStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VertexStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Source != nil {
-		l = m.Source.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Sink != nil {
-		l = m.Sink.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.UDF != nil {
-		l = m.UDF.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.ContainerTemplate != nil {
-		l = m.ContainerTemplate.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.InitContainerTemplate != nil {
-		l = m.InitContainerTemplate.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = m.AbstractPodTemplate.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Volumes) > 0 {
-		for _, e := range m.Volumes {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if m.Limits != nil {
-		l = m.Limits.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = m.Scale.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.InitContainers) > 0 {
-		for _, e := range m.InitContainers {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.Sidecars) > 0 {
-		for _, e := range m.Sidecars {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Selector)
+	copy(dAtA[i:], m.Selector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Selector)))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.LastScaledAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.Partitions != nil {
-		n += 1 + sovGenerated(uint64(*m.Partitions))
-	}
-	return n
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Replicas))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Authorization) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Token != nil {
-		l = m.Token.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *Wasm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *BasicAuth) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Wasm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Wasm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.User != nil {
-		l = m.User.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.MemoryLimitPages != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MemoryLimitPages))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Password != nil {
-		l = m.Password.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.VolumeName)
+	copy(dAtA[i:], m.VolumeName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.VolumeName)))
+	i--
+	dAtA[i] = 0x12
+	if m.ConfigMap != nil {
+		{
+			size, err := m.ConfigMap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Blackhole) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Watermark) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *BufferServiceConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Watermark) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Watermark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Redis != nil {
-		l = m.Redis.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.MaxDelay != nil {
+		{
+			size, err := m.MaxDelay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.JetStream != nil {
-		l = m.JetStream.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i--
+	if m.Disabled {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	return n
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
-func (m *CombinedEdge) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Window) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Window) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Window) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Edge.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.FromVertexType)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.FromVertexPartitionCount != nil {
-		n += 1 + sovGenerated(uint64(*m.FromVertexPartitionCount))
+	if m.Sliding != nil {
+		{
+			size, err := m.Sliding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.FromVertexLimits != nil {
-		l = m.FromVertexLimits.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.ToVertexType)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.ToVertexPartitionCount != nil {
-		n += 1 + sovGenerated(uint64(*m.ToVertexPartitionCount))
-	}
-	if m.ToVertexLimits != nil {
-		l = m.ToVertexLimits.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.Fixed != nil {
+		{
+			size, err := m.Fixed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Container) Size() (n int) {
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AbstractPodTemplate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Image)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Command) > 0 {
-		for _, s := range m.Command {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
-	if len(m.Env) > 0 {
-		for _, e := range m.Env {
+	if len(m.Tolerations) > 0 {
+		for _, e := range m.Tolerations {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.EnvFrom) > 0 {
-		for _, e := range m.EnvFrom {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.SecurityContext != nil {
+		l = m.SecurityContext.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if len(m.VolumeMounts) > 0 {
-		for _, e := range m.VolumeMounts {
+	if len(m.ImagePullSecrets) > 0 {
+		for _, e := range m.ImagePullSecrets {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	l = m.Resources.Size()
+	l = len(m.PriorityClassName)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.SecurityContext != nil {
-		l = m.SecurityContext.Size()
+	if m.Priority != nil {
+		n += 1 + sovGenerated(uint64(*m.Priority))
+	}
+	if m.Affinity != nil {
+		l = m.Affinity.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.ImagePullPolicy != nil {
-		l = len(*m.ImagePullPolicy)
+	l = len(m.ServiceAccountName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.RuntimeClassName != nil {
+		l = len(*m.RuntimeClassName)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.AutomountServiceAccountToken != nil {
+		n += 2
+	}
+	l = len(m.DNSPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.DNSConfig != nil {
+		l = m.DNSConfig.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *ContainerTemplate) Size() (n int) {
+func (m *AbstractSink) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Resources.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ImagePullPolicy)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.SecurityContext != nil {
-		l = m.SecurityContext.Size()
+	if m.Log != nil {
+		l = m.Log.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if len(m.Env) > 0 {
-		for _, e := range m.Env {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.Kafka != nil {
+		l = m.Kafka.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if len(m.EnvFrom) > 0 {
-		for _, e := range m.EnvFrom {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.Blackhole != nil {
+		l = m.Blackhole.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.UDSink != nil {
+		l = m.UDSink.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.S3 != nil {
+		l = m.S3.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SQL != nil {
+		l = m.SQL.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *DaemonTemplate) Size() (n int) {
+func (m *AbstractVertex) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.AbstractPodTemplate.Size()
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Replicas != nil {
-		n += 1 + sovGenerated(uint64(*m.Replicas))
+	if m.Source != nil {
+		l = m.Source.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Sink != nil {
+		l = m.Sink.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.UDF != nil {
+		l = m.UDF.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
 	if m.ContainerTemplate != nil {
 		l = m.ContainerTemplate.Size()
@@ -7337,583 +9488,580 @@ func (m *DaemonTemplate) Size() (n int) {
 		l = m.InitContainerTemplate.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = m.AbstractPodTemplate.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Volumes) > 0 {
+		for _, e := range m.Volumes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Limits != nil {
+		l = m.Limits.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = m.Scale.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.InitContainers) > 0 {
+		for _, e := range m.InitContainers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Sidecars) > 0 {
+		for _, e := range m.Sidecars {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Partitions != nil {
+		n += 1 + sovGenerated(uint64(*m.Partitions))
+	}
+	if len(m.SideInputs) > 0 {
+		for _, s := range m.SideInputs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *Edge) Size() (n int) {
+func (m *Authorization) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.From)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.To)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Conditions != nil {
-		l = m.Conditions.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.OnFull != nil {
-		l = len(*m.OnFull)
+	if m.Token != nil {
+		l = m.Token.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *FixedWindow) Size() (n int) {
+func (m *BasicAuth) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Length != nil {
-		l = m.Length.Size()
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Password != nil {
+		l = m.Password.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *ForwardConditions) Size() (n int) {
+func (m *Blackhole) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Tags != nil {
-		l = m.Tags.Size()
+	return n
+}
+
+func (m *BufferServiceConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Redis != nil {
+		l = m.Redis.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.JetStream != nil {
+		l = m.JetStream.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *Function) Size() (n int) {
+func (m *CircuitBreaker) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.FailureThreshold != nil {
+		n += 1 + sovGenerated(uint64(*m.FailureThreshold))
 	}
-	if len(m.KWArgs) > 0 {
-		for k, v := range m.KWArgs {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
+	if m.OpenDuration != nil {
+		l = m.OpenDuration.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *GSSAPI) Size() (n int) {
+func (m *CombinedEdge) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ServiceName)
+	l = m.Edge.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Realm)
+	l = len(m.FromVertexType)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.UsernameSecret != nil {
-		l = m.UsernameSecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.FromVertexPartitionCount != nil {
+		n += 1 + sovGenerated(uint64(*m.FromVertexPartitionCount))
 	}
-	if m.AuthType != nil {
-		l = len(*m.AuthType)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.PasswordSecret != nil {
-		l = m.PasswordSecret.Size()
+	if m.FromVertexLimits != nil {
+		l = m.FromVertexLimits.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.KeytabSecret != nil {
-		l = m.KeytabSecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ToVertexType)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ToVertexPartitionCount != nil {
+		n += 1 + sovGenerated(uint64(*m.ToVertexPartitionCount))
 	}
-	if m.KerberosConfigSecret != nil {
-		l = m.KerberosConfigSecret.Size()
+	if m.ToVertexLimits != nil {
+		l = m.ToVertexLimits.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *GeneratorSource) Size() (n int) {
+func (m *Container) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.RPU != nil {
-		n += 1 + sovGenerated(uint64(*m.RPU))
+	l = len(m.Image)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	if m.Duration != nil {
-		l = m.Duration.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	if m.MsgSize != nil {
-		n += 1 + sovGenerated(uint64(*m.MsgSize))
+	if len(m.Env) > 0 {
+		for _, e := range m.Env {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	if m.KeyCount != nil {
-		n += 1 + sovGenerated(uint64(*m.KeyCount))
+	if len(m.EnvFrom) > 0 {
+		for _, e := range m.EnvFrom {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	if m.Value != nil {
-		n += 1 + sovGenerated(uint64(*m.Value))
+	if len(m.VolumeMounts) > 0 {
+		for _, e := range m.VolumeMounts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Resources.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SecurityContext != nil {
+		l = m.SecurityContext.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ImagePullPolicy != nil {
+		l = len(*m.ImagePullPolicy)
+		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *GetDaemonDeploymentReq) Size() (n int) {
+func (m *ContainerTemplate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ISBSvcType)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Image)
+	l = m.Resources.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.PullPolicy)
+	l = len(m.ImagePullPolicy)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.SecurityContext != nil {
+		l = m.SecurityContext.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	if len(m.Env) > 0 {
 		for _, e := range m.Env {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *GetJetStreamServiceSpecReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if len(m.EnvFrom) > 0 {
+		for _, e := range m.EnvFrom {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	n += 1 + sovGenerated(uint64(m.ClusterPort))
-	n += 1 + sovGenerated(uint64(m.ClientPort))
-	n += 1 + sovGenerated(uint64(m.MonitorPort))
-	n += 1 + sovGenerated(uint64(m.MetricsPort))
 	return n
 }
 
-func (m *GetJetStreamStatefulSetSpecReq) Size() (n int) {
+func (m *DaemonTemplate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ServiceName)
+	l = m.AbstractPodTemplate.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
+	if m.Replicas != nil {
+		n += 1 + sovGenerated(uint64(*m.Replicas))
+	}
+	if m.ContainerTemplate != nil {
+		l = m.ContainerTemplate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.InitContainerTemplate != nil {
+		l = m.InitContainerTemplate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.NatsImage)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.MetricsExporterImage)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ConfigReloaderImage)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.ClusterPort))
-	n += 1 + sovGenerated(uint64(m.ClientPort))
-	n += 1 + sovGenerated(uint64(m.MonitorPort))
-	n += 1 + sovGenerated(uint64(m.MetricsPort))
-	l = len(m.ServerAuthSecretName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ServerEncryptionSecretName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ConfigMapName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.PvcNameIfNeeded)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.StartCommand)
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *GetRedisServiceSpecReq) Size() (n int) {
+func (m *Edge) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
+	l = len(m.From)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.To)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Conditions != nil {
+		l = m.Conditions.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.OnFull != nil {
+		l = len(*m.OnFull)
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	n += 1 + sovGenerated(uint64(m.RedisContainerPort))
-	n += 1 + sovGenerated(uint64(m.SentinelContainerPort))
 	return n
 }
 
-func (m *GetRedisStatefulSetSpecReq) Size() (n int) {
+func (m *FileEventTime) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ServiceName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	l = len(m.RedisImage)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SentinelImage)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.MetricsExporterImage)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.InitContainerImage)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.RedisContainerPort))
-	n += 1 + sovGenerated(uint64(m.SentinelContainerPort))
-	n += 1 + sovGenerated(uint64(m.RedisMetricsContainerPort))
-	l = len(m.CredentialSecretName)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	l = len(m.PvcNameIfNeeded)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ConfConfigMapName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ScriptsConfigMapName)
+	l = len(m.Expression)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.HealthConfigMapName)
+	l = len(m.Format)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *GetVertexPodSpecReq) Size() (n int) {
+func (m *FileSink) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ISBSvcType)
+	l = len(m.VolumeName)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Image)
+	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.PullPolicy)
+	l = len(m.Compression)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Env) > 0 {
-		for _, e := range m.Env {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.PartitionDuration != nil {
+		l = m.PartitionDuration.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.PartitionFormat)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.MaxFileSize != nil {
+		l = m.MaxFileSize.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RollInterval != nil {
+		l = m.RollInterval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Parquet != nil {
+		l = m.Parquet.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *GroupBy) Size() (n int) {
+func (m *FileSource) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Window.Size()
+	l = len(m.VolumeName)
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	if m.AllowedLateness != nil {
-		l = m.AllowedLateness.Size()
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Follow != nil {
+		n += 2
+	}
+	if m.PollInterval != nil {
+		l = m.PollInterval.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Storage != nil {
-		l = m.Storage.Size()
+	l = len(m.CheckpointPath)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.EventTime != nil {
+		l = m.EventTime.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *HTTPSource) Size() (n int) {
+func (m *FixedWindow) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Auth != nil {
-		l = m.Auth.Size()
+	if m.Length != nil {
+		l = m.Length.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	n += 2
 	return n
 }
 
-func (m *InterStepBufferService) Size() (n int) {
+func (m *ForwardConditions) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
+	if m.Tags != nil {
+		l = m.Tags.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *InterStepBufferServiceList) Size() (n int) {
+func (m *Function) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.KWArgs) > 0 {
+		for k, v := range m.KWArgs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
 	return n
 }
 
-func (m *InterStepBufferServiceSpec) Size() (n int) {
+func (m *GRPCSource) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Redis != nil {
-		l = m.Redis.Size()
+	if m.Auth != nil {
+		l = m.Auth.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.JetStream != nil {
-		l = m.JetStream.Size()
+	if m.TLS != nil {
+		l = m.TLS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	return n
-}
-
-func (m *InterStepBufferServiceStatus) Size() (n int) {
-	if m == nil {
-		return 0
+	n += 2
+	if m.Window != nil {
+		n += 1 + sovGenerated(uint64(*m.Window))
 	}
-	var l int
-	_ = l
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Config.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Type)
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *JetStreamBufferService) Size() (n int) {
+func (m *GSSAPI) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Version)
+	l = len(m.ServiceName)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Replicas != nil {
-		n += 1 + sovGenerated(uint64(*m.Replicas))
-	}
-	if m.ContainerTemplate != nil {
-		l = m.ContainerTemplate.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.ReloaderContainerTemplate != nil {
-		l = m.ReloaderContainerTemplate.Size()
+	l = len(m.Realm)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.UsernameSecret != nil {
+		l = m.UsernameSecret.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.MetricsContainerTemplate != nil {
-		l = m.MetricsContainerTemplate.Size()
+	if m.AuthType != nil {
+		l = len(*m.AuthType)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Persistence != nil {
-		l = m.Persistence.Size()
+	if m.PasswordSecret != nil {
+		l = m.PasswordSecret.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = m.AbstractPodTemplate.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Settings != nil {
-		l = len(*m.Settings)
+	if m.KeytabSecret != nil {
+		l = m.KeytabSecret.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if len(m.StartArgs) > 0 {
-		for _, s := range m.StartArgs {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if m.BufferConfig != nil {
-		l = len(*m.BufferConfig)
+	if m.KerberosConfigSecret != nil {
+		l = m.KerberosConfigSecret.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	n += 2
-	n += 2
 	return n
 }
 
-func (m *JetStreamConfig) Size() (n int) {
+func (m *GeneratorSource) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.URL)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Auth != nil {
-		l = m.Auth.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.StreamConfig)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	return n
-}
-
-func (m *JobTemplate) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.RPU != nil {
+		n += 1 + sovGenerated(uint64(*m.RPU))
 	}
-	var l int
-	_ = l
-	l = m.AbstractPodTemplate.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.ContainerTemplate != nil {
-		l = m.ContainerTemplate.Size()
+	if m.Duration != nil {
+		l = m.Duration.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.TTLSecondsAfterFinished != nil {
-		n += 1 + sovGenerated(uint64(*m.TTLSecondsAfterFinished))
+	if m.MsgSize != nil {
+		n += 1 + sovGenerated(uint64(*m.MsgSize))
 	}
-	if m.BackoffLimit != nil {
-		n += 1 + sovGenerated(uint64(*m.BackoffLimit))
+	if m.KeyCount != nil {
+		n += 1 + sovGenerated(uint64(*m.KeyCount))
 	}
-	return n
-}
-
-func (m *KafkaSink) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Value != nil {
+		n += 1 + sovGenerated(uint64(*m.Value))
 	}
-	var l int
-	_ = l
-	if len(m.Brokers) > 0 {
-		for _, s := range m.Brokers {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.Template != nil {
+		l = len(*m.Template)
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.Topic)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.TLS != nil {
-		l = m.TLS.Size()
+	if m.KeyDistribution != nil {
+		l = m.KeyDistribution.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.Config)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.SASL != nil {
-		l = m.SASL.Size()
+	if m.Jitter != nil {
+		l = m.Jitter.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.LatePercent != nil {
+		n += 1 + sovGenerated(uint64(*m.LatePercent))
+	}
+	if m.LateBy != nil {
+		l = m.LateBy.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *KafkaSource) Size() (n int) {
+func (m *GetDaemonDeploymentReq) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Brokers) > 0 {
-		for _, s := range m.Brokers {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	l = len(m.Topic)
+	l = len(m.ISBSvcType)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ConsumerGroupName)
+	l = len(m.Image)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.TLS != nil {
-		l = m.TLS.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.Config)
+	l = len(m.PullPolicy)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.SASL != nil {
-		l = m.SASL.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Env) > 0 {
+		for _, e := range m.Env {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *Lifecycle) Size() (n int) {
+func (m *GetJetStreamServiceSpecReq) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.DeleteGracePeriodSeconds != nil {
-		n += 1 + sovGenerated(uint64(*m.DeleteGracePeriodSeconds))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	l = len(m.DesiredPhase)
-	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ClusterPort))
+	n += 1 + sovGenerated(uint64(m.ClientPort))
+	n += 1 + sovGenerated(uint64(m.MonitorPort))
+	n += 1 + sovGenerated(uint64(m.MetricsPort))
 	return n
 }
 
-func (m *Log) Size() (n int) {
+func (m *GetJetStreamStatefulSetSpecReq) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.ServiceName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.NatsImage)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MetricsExporterImage)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ConfigReloaderImage)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ClusterPort))
+	n += 1 + sovGenerated(uint64(m.ClientPort))
+	n += 1 + sovGenerated(uint64(m.MonitorPort))
+	n += 1 + sovGenerated(uint64(m.MetricsPort))
+	l = len(m.ServerAuthSecretName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ServerEncryptionSecretName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ConfigMapName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PvcNameIfNeeded)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.StartCommand)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *Metadata) Size() (n int) {
+func (m *GetRedisServiceSpecReq) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
 	if len(m.Labels) > 0 {
 		for k, v := range m.Labels {
 			_ = k
@@ -7922,169 +10070,187 @@ func (m *Metadata) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	n += 1 + sovGenerated(uint64(m.RedisContainerPort))
+	n += 1 + sovGenerated(uint64(m.SentinelContainerPort))
 	return n
 }
 
-func (m *NativeRedis) Size() (n int) {
+func (m *GetRedisStatefulSetSpecReq) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Version)
+	l = len(m.ServiceName)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Replicas != nil {
-		n += 1 + sovGenerated(uint64(*m.Replicas))
-	}
-	if m.RedisContainerTemplate != nil {
-		l = m.RedisContainerTemplate.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.SentinelContainerTemplate != nil {
-		l = m.SentinelContainerTemplate.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	if m.MetricsContainerTemplate != nil {
-		l = m.MetricsContainerTemplate.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.InitContainerTemplate != nil {
-		l = m.InitContainerTemplate.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Persistence != nil {
-		l = m.Persistence.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = m.AbstractPodTemplate.Size()
+	l = len(m.RedisImage)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SentinelImage)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MetricsExporterImage)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.InitContainerImage)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.RedisContainerPort))
+	n += 1 + sovGenerated(uint64(m.SentinelContainerPort))
+	n += 1 + sovGenerated(uint64(m.RedisMetricsContainerPort))
+	l = len(m.CredentialSecretName)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.PvcNameIfNeeded)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ConfConfigMapName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ScriptsConfigMapName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HealthConfigMapName)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Settings != nil {
-		l = m.Settings.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
 	return n
 }
 
-func (m *NatsAuth) Size() (n int) {
+func (m *GetSideInputDeploymentReq) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Basic != nil {
-		l = m.Basic.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Token != nil {
-		l = m.Token.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.NKey != nil {
-		l = m.NKey.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ISBSvcType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Image)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PullPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Env) > 0 {
+		for _, e := range m.Env {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *NatsSource) Size() (n int) {
+func (m *GetVertexPodSpecReq) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.URL)
+	l = len(m.ISBSvcType)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Subject)
+	l = len(m.Image)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Queue)
+	l = len(m.PullPolicy)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.TLS != nil {
-		l = m.TLS.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Auth != nil {
-		l = m.Auth.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Env) > 0 {
+		for _, e := range m.Env {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *PBQStorage) Size() (n int) {
+func (m *GroupBy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.PersistentVolumeClaim != nil {
-		l = m.PersistentVolumeClaim.Size()
+	l = m.Window.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.AllowedLateness != nil {
+		l = m.AllowedLateness.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.EmptyDir != nil {
-		l = m.EmptyDir.Size()
+	if m.Storage != nil {
+		l = m.Storage.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *PersistenceStrategy) Size() (n int) {
+func (m *HTTPSink) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.StorageClassName != nil {
-		l = len(*m.StorageClassName)
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.Auth != nil {
+		l = m.Auth.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.AccessMode != nil {
-		l = len(*m.AccessMode)
+	if m.BasicAuth != nil {
+		l = m.BasicAuth.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.VolumeSize != nil {
-		l = m.VolumeSize.Size()
+	if m.TLS != nil {
+		l = m.TLS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 2
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.RetryableStatusCodes) > 0 {
+		for _, e := range m.RetryableStatusCodes {
+			n += 1 + sovGenerated(uint64(e))
+		}
+	}
 	return n
 }
 
-func (m *Pipeline) Size() (n int) {
+func (m *HTTPSource) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
+	if m.Auth != nil {
+		l = m.Auth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
 	return n
 }
 
-func (m *PipelineLimits) Size() (n int) {
+func (m *InterStepBufferService) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ReadBatchSize != nil {
-		n += 1 + sovGenerated(uint64(*m.ReadBatchSize))
-	}
-	if m.BufferMaxLength != nil {
-		n += 1 + sovGenerated(uint64(*m.BufferMaxLength))
-	}
-	if m.BufferUsageLimit != nil {
-		n += 1 + sovGenerated(uint64(*m.BufferUsageLimit))
-	}
-	if m.ReadTimeout != nil {
-		l = m.ReadTimeout.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *PipelineList) Size() (n int) {
+func (m *InterStepBufferServiceList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -8101,42 +10267,24 @@ func (m *PipelineList) Size() (n int) {
 	return n
 }
 
-func (m *PipelineSpec) Size() (n int) {
+func (m *InterStepBufferServiceSpec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.InterStepBufferServiceName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Vertices) > 0 {
-		for _, e := range m.Vertices {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.Edges) > 0 {
-		for _, e := range m.Edges {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	l = m.Lifecycle.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Limits != nil {
-		l = m.Limits.Size()
+	if m.Redis != nil {
+		l = m.Redis.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = m.Watermark.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Templates != nil {
-		l = m.Templates.Size()
+	if m.JetStream != nil {
+		l = m.JetStream.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *PipelineStatus) Size() (n int) {
+func (m *InterStepBufferServiceStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -8148,1715 +10296,7860 @@ func (m *PipelineStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Message)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.LastUpdated.Size()
+	l = m.Config.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Type)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.VertexCount != nil {
-		n += 1 + sovGenerated(uint64(*m.VertexCount))
-	}
-	if m.SourceCount != nil {
-		n += 1 + sovGenerated(uint64(*m.SourceCount))
-	}
-	if m.SinkCount != nil {
-		n += 1 + sovGenerated(uint64(*m.SinkCount))
-	}
-	if m.UDFCount != nil {
-		n += 1 + sovGenerated(uint64(*m.UDFCount))
-	}
 	return n
 }
 
-func (m *RedisBufferService) Size() (n int) {
+func (m *JetStreamBufferService) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Native != nil {
-		l = m.Native.Size()
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Replicas != nil {
+		n += 1 + sovGenerated(uint64(*m.Replicas))
+	}
+	if m.ContainerTemplate != nil {
+		l = m.ContainerTemplate.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.External != nil {
-		l = m.External.Size()
+	if m.ReloaderContainerTemplate != nil {
+		l = m.ReloaderContainerTemplate.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	return n
-}
-
-func (m *RedisConfig) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MetricsContainerTemplate != nil {
+		l = m.MetricsContainerTemplate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	var l int
-	_ = l
-	l = len(m.URL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SentinelURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.MasterName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.User)
+	if m.Persistence != nil {
+		l = m.Persistence.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = m.AbstractPodTemplate.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Password != nil {
-		l = m.Password.Size()
+	if m.Settings != nil {
+		l = len(*m.Settings)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.SentinelPassword != nil {
-		l = m.SentinelPassword.Size()
+	if len(m.StartArgs) > 0 {
+		for _, s := range m.StartArgs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.BufferConfig != nil {
+		l = len(*m.BufferConfig)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 2
+	n += 2
 	return n
 }
 
-func (m *RedisSettings) Size() (n int) {
+func (m *JetStreamConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Redis)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Master)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Replica)
+	l = len(m.URL)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Sentinel)
+	if m.Auth != nil {
+		l = m.Auth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.StreamConfig)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
-func (m *RedisStreamsSource) Size() (n int) {
+func (m *JobTemplate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.RedisConfig.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Stream)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ConsumerGroup)
+	l = m.AbstractPodTemplate.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	if m.TLS != nil {
-		l = m.TLS.Size()
+	if m.ContainerTemplate != nil {
+		l = m.ContainerTemplate.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.TTLSecondsAfterFinished != nil {
+		n += 1 + sovGenerated(uint64(*m.TTLSecondsAfterFinished))
+	}
+	if m.BackoffLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.BackoffLimit))
+	}
 	return n
 }
 
-func (m *SASL) Size() (n int) {
+func (m *KafkaSink) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Mechanism != nil {
-		l = len(*m.Mechanism)
+	if len(m.Brokers) > 0 {
+		for _, s := range m.Brokers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Topic)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TLS != nil {
+		l = m.TLS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.GSSAPI != nil {
-		l = m.GSSAPI.Size()
+	l = len(m.Config)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SASL != nil {
+		l = m.SASL.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Plain != nil {
-		l = m.Plain.Size()
+	if m.SchemaRegistry != nil {
+		l = m.SchemaRegistry.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *SASLPlain) Size() (n int) {
+func (m *KafkaSource) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.UserSecret != nil {
-		l = m.UserSecret.Size()
+	if len(m.Brokers) > 0 {
+		for _, s := range m.Brokers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Topic)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ConsumerGroupName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TLS != nil {
+		l = m.TLS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.PasswordSecret != nil {
-		l = m.PasswordSecret.Size()
+	l = len(m.Config)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SASL != nil {
+		l = m.SASL.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SchemaRegistry != nil {
+		l = m.SchemaRegistry.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	n += 2
 	return n
 }
 
-func (m *Scale) Size() (n int) {
+func (m *KeyDistribution) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	n += 2
-	if m.Min != nil {
-		n += 1 + sovGenerated(uint64(*m.Min))
-	}
-	if m.Max != nil {
-		n += 1 + sovGenerated(uint64(*m.Max))
-	}
-	if m.LookbackSeconds != nil {
-		n += 1 + sovGenerated(uint64(*m.LookbackSeconds))
-	}
-	if m.CooldownSeconds != nil {
-		n += 1 + sovGenerated(uint64(*m.CooldownSeconds))
-	}
-	if m.ZeroReplicaSleepSeconds != nil {
-		n += 1 + sovGenerated(uint64(*m.ZeroReplicaSleepSeconds))
-	}
-	if m.TargetProcessingSeconds != nil {
-		n += 1 + sovGenerated(uint64(*m.TargetProcessingSeconds))
-	}
-	if m.ReplicasPerScale != nil {
-		n += 1 + sovGenerated(uint64(*m.ReplicasPerScale))
-	}
-	if m.TargetBufferAvailability != nil {
-		n += 1 + sovGenerated(uint64(*m.TargetBufferAvailability))
-	}
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ZipfExponent)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *Sink) Size() (n int) {
+func (m *Lifecycle) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Log != nil {
-		l = m.Log.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.DeleteGracePeriodSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.DeleteGracePeriodSeconds))
 	}
-	if m.Kafka != nil {
-		l = m.Kafka.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DesiredPhase)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *Log) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.Blackhole != nil {
-		l = m.Blackhole.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	var l int
+	_ = l
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	if m.UDSink != nil {
-		l = m.UDSink.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SamplingRate)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.MaxPayloadLength != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxPayloadLength))
 	}
 	return n
 }
 
-func (m *SlidingWindow) Size() (n int) {
+func (m *Metadata) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Length != nil {
-		l = m.Length.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	if m.Slide != nil {
-		l = m.Slide.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
 	return n
 }
 
-func (m *Source) Size() (n int) {
+func (m *NativeRedis) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Generator != nil {
-		l = m.Generator.Size()
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Replicas != nil {
+		n += 1 + sovGenerated(uint64(*m.Replicas))
+	}
+	if m.RedisContainerTemplate != nil {
+		l = m.RedisContainerTemplate.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Kafka != nil {
-		l = m.Kafka.Size()
+	if m.SentinelContainerTemplate != nil {
+		l = m.SentinelContainerTemplate.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.HTTP != nil {
-		l = m.HTTP.Size()
+	if m.MetricsContainerTemplate != nil {
+		l = m.MetricsContainerTemplate.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Nats != nil {
-		l = m.Nats.Size()
+	if m.InitContainerTemplate != nil {
+		l = m.InitContainerTemplate.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.RedisStreams != nil {
-		l = m.RedisStreams.Size()
+	if m.Persistence != nil {
+		l = m.Persistence.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.UDTransformer != nil {
-		l = m.UDTransformer.Size()
+	l = m.AbstractPodTemplate.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Settings != nil {
+		l = m.Settings.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *Status) Size() (n int) {
+func (m *NatsAuth) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.Basic != nil {
+		l = m.Basic.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.NKey != nil {
+		l = m.NKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *TLS) Size() (n int) {
+func (m *NatsSource) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	n += 2
-	if m.CACertSecret != nil {
-		l = m.CACertSecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.CertSecret != nil {
-		l = m.CertSecret.Size()
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Queue)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TLS != nil {
+		l = m.TLS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.KeySecret != nil {
-		l = m.KeySecret.Size()
+	if m.Auth != nil {
+		l = m.Auth.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *TagConditions) Size() (n int) {
+func (m *PBQStorage) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Operator != nil {
-		l = len(*m.Operator)
+	if m.PersistentVolumeClaim != nil {
+		l = m.PersistentVolumeClaim.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if len(m.Values) > 0 {
-		for _, s := range m.Values {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.EmptyDir != nil {
+		l = m.EmptyDir.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *Templates) Size() (n int) {
+func (m *ParquetOptions) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.DaemonTemplate != nil {
-		l = m.DaemonTemplate.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Schema)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.InferSchemaSampleSize != nil {
+		n += 1 + sovGenerated(uint64(*m.InferSchemaSampleSize))
 	}
-	if m.JobTemplate != nil {
-		l = m.JobTemplate.Size()
+	if m.RowGroupSize != nil {
+		l = m.RowGroupSize.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *Transformer) Size() (n int) {
+func (m *PersistenceStrategy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.StorageClassName != nil {
+		l = len(*m.StorageClassName)
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if len(m.KWArgs) > 0 {
-		for k, v := range m.KWArgs {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
+	if m.AccessMode != nil {
+		l = len(*m.AccessMode)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.VolumeSize != nil {
+		l = m.VolumeSize.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *UDF) Size() (n int) {
+func (m *Pipeline) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Container != nil {
-		l = m.Container.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *PipelineLimits) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.Builtin != nil {
-		l = m.Builtin.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	var l int
+	_ = l
+	if m.ReadBatchSize != nil {
+		n += 1 + sovGenerated(uint64(*m.ReadBatchSize))
 	}
-	if m.GroupBy != nil {
-		l = m.GroupBy.Size()
+	if m.BufferMaxLength != nil {
+		n += 1 + sovGenerated(uint64(*m.BufferMaxLength))
+	}
+	if m.BufferUsageLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.BufferUsageLimit))
+	}
+	if m.ReadTimeout != nil {
+		l = m.ReadTimeout.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *UDSink) Size() (n int) {
+func (m *PipelineList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Container.Size()
+	l = m.ListMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *UDTransformer) Size() (n int) {
+func (m *PipelineSpec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Container != nil {
-		l = m.Container.Size()
+	l = len(m.InterStepBufferServiceName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Vertices) > 0 {
+		for _, e := range m.Vertices {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Edges) > 0 {
+		for _, e := range m.Edges {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Lifecycle.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Limits != nil {
+		l = m.Limits.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Builtin != nil {
-		l = m.Builtin.Size()
+	l = m.Watermark.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Templates != nil {
+		l = m.Templates.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.SideInputs) > 0 {
+		for _, e := range m.SideInputs {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *Vertex) Size() (n int) {
+func (m *PipelineStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
+	l = m.Status.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
+	l = len(m.Phase)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastUpdated.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.VertexCount != nil {
+		n += 1 + sovGenerated(uint64(*m.VertexCount))
+	}
+	if m.SourceCount != nil {
+		n += 1 + sovGenerated(uint64(*m.SourceCount))
+	}
+	if m.SinkCount != nil {
+		n += 1 + sovGenerated(uint64(*m.SinkCount))
+	}
+	if m.UDFCount != nil {
+		n += 1 + sovGenerated(uint64(*m.UDFCount))
+	}
 	return n
 }
 
-func (m *VertexInstance) Size() (n int) {
+func (m *RedisBufferService) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Vertex != nil {
-		l = m.Vertex.Size()
+	if m.Native != nil {
+		l = m.Native.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.External != nil {
+		l = m.External.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.Hostname)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.Replica))
 	return n
 }
 
-func (m *VertexLimits) Size() (n int) {
+func (m *RedisConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ReadBatchSize != nil {
-		n += 1 + sovGenerated(uint64(*m.ReadBatchSize))
-	}
-	if m.ReadTimeout != nil {
-		l = m.ReadTimeout.Size()
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SentinelURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MasterName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.User)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Password != nil {
+		l = m.Password.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.BufferMaxLength != nil {
-		n += 1 + sovGenerated(uint64(*m.BufferMaxLength))
-	}
-	if m.BufferUsageLimit != nil {
-		n += 1 + sovGenerated(uint64(*m.BufferUsageLimit))
+	if m.SentinelPassword != nil {
+		l = m.SentinelPassword.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *VertexList) Size() (n int) {
+func (m *RedisSettings) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.Redis)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Master)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Replica)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Sentinel)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *VertexSpec) Size() (n int) {
+func (m *RedisStreamsSource) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.AbstractVertex.Size()
+	l = m.RedisConfig.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.PipelineName)
+	l = len(m.Stream)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.InterStepBufferServiceName)
+	l = len(m.ConsumerGroup)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Replicas != nil {
-		n += 1 + sovGenerated(uint64(*m.Replicas))
+	n += 2
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if len(m.FromEdges) > 0 {
-		for _, e := range m.FromEdges {
-			l = e.Size()
+	if len(m.Streams) > 0 {
+		for _, s := range m.Streams {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.ToEdges) > 0 {
-		for _, e := range m.ToEdges {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.ClaimIdleTime != nil {
+		l = m.ClaimIdleTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = m.Watermark.Size()
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *VertexStatus) Size() (n int) {
+func (m *RemoteUDF) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.Replicas))
-	l = m.LastScaledAt.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Selector)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Reason)
+	l = len(m.Address)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.CircuitBreaker != nil {
+		l = m.CircuitBreaker.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *Watermark) Size() (n int) {
+func (m *S3Sink) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Endpoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Bucket)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AccessKey != nil {
+		l = m.AccessKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SecretKey != nil {
+		l = m.SecretKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	n += 2
-	if m.MaxDelay != nil {
-		l = m.MaxDelay.Size()
+	l = len(m.Compression)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.PartSize != nil {
+		l = m.PartSize.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.TLS != nil {
+		l = m.TLS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *Window) Size() (n int) {
+func (m *SASL) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Fixed != nil {
-		l = m.Fixed.Size()
+	if m.Mechanism != nil {
+		l = len(*m.Mechanism)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Sliding != nil {
-		l = m.Sliding.Size()
+	if m.GSSAPI != nil {
+		l = m.GSSAPI.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Plain != nil {
+		l = m.Plain.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func sovGenerated(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenerated(x uint64) (n int) {
-	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *AbstractPodTemplate) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForTolerations := "[]Toleration{"
-	for _, f := range this.Tolerations {
-		repeatedStringForTolerations += fmt.Sprintf("%v", f) + ","
+func (m *SASLPlain) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForTolerations += "}"
-	repeatedStringForImagePullSecrets := "[]LocalObjectReference{"
-	for _, f := range this.ImagePullSecrets {
-		repeatedStringForImagePullSecrets += fmt.Sprintf("%v", f) + ","
+	var l int
+	_ = l
+	if m.UserSecret != nil {
+		l = m.UserSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForImagePullSecrets += "}"
-	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
-	for k := range this.NodeSelector {
-		keysForNodeSelector = append(keysForNodeSelector, k)
+	if m.PasswordSecret != nil {
+		l = m.PasswordSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
-	mapStringForNodeSelector := "map[string]string{"
-	for _, k := range keysForNodeSelector {
-		mapStringForNodeSelector += fmt.Sprintf("%v: %v,", k, this.NodeSelector[k])
+	n += 2
+	return n
+}
+
+func (m *SQLArg) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	mapStringForNodeSelector += "}"
-	s := strings.Join([]string{`&AbstractPodTemplate{`,
-		`Metadata:` + strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1) + `,`,
-		`NodeSelector:` + mapStringForNodeSelector + `,`,
-		`Tolerations:` + repeatedStringForTolerations + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v1.PodSecurityContext", 1) + `,`,
-		`ImagePullSecrets:` + repeatedStringForImagePullSecrets + `,`,
-		`PriorityClassName:` + fmt.Sprintf("%v", this.PriorityClassName) + `,`,
-		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
-		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
-		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
-		`RuntimeClassName:` + valueToStringGenerated(this.RuntimeClassName) + `,`,
-		`AutomountServiceAccountToken:` + valueToStringGenerated(this.AutomountServiceAccountToken) + `,`,
-		`DNSPolicy:` + fmt.Sprintf("%v", this.DNSPolicy) + `,`,
-		`DNSConfig:` + strings.Replace(fmt.Sprintf("%v", this.DNSConfig), "PodDNSConfig", "v1.PodDNSConfig", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Field)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Metadata)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *AbstractVertex) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SQLSink) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForVolumes := "[]Volume{"
-	for _, f := range this.Volumes {
-		repeatedStringForVolumes += fmt.Sprintf("%v", f) + ","
+	var l int
+	_ = l
+	l = len(m.Driver)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.DSN != nil {
+		l = m.DSN.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForVolumes += "}"
-	repeatedStringForInitContainers := "[]Container{"
-	for _, f := range this.InitContainers {
-		repeatedStringForInitContainers += fmt.Sprintf("%v", f) + ","
+	l = len(m.Statement)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Args) > 0 {
+		for _, e := range m.Args {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForInitContainers += "}"
-	repeatedStringForSidecars := "[]Container{"
-	for _, f := range this.Sidecars {
-		repeatedStringForSidecars += fmt.Sprintf("%v", f) + ","
+	if m.MaxOpenConns != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxOpenConns))
 	}
-	repeatedStringForSidecars += "}"
-	s := strings.Join([]string{`&AbstractVertex{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Source:` + strings.Replace(this.Source.String(), "Source", "Source", 1) + `,`,
-		`Sink:` + strings.Replace(this.Sink.String(), "Sink", "Sink", 1) + `,`,
-		`UDF:` + strings.Replace(this.UDF.String(), "UDF", "UDF", 1) + `,`,
-		`ContainerTemplate:` + strings.Replace(this.ContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
-		`InitContainerTemplate:` + strings.Replace(this.InitContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
-		`AbstractPodTemplate:` + strings.Replace(strings.Replace(this.AbstractPodTemplate.String(), "AbstractPodTemplate", "AbstractPodTemplate", 1), `&`, ``, 1) + `,`,
-		`Volumes:` + repeatedStringForVolumes + `,`,
-		`Limits:` + strings.Replace(this.Limits.String(), "VertexLimits", "VertexLimits", 1) + `,`,
-		`Scale:` + strings.Replace(strings.Replace(this.Scale.String(), "Scale", "Scale", 1), `&`, ``, 1) + `,`,
-		`InitContainers:` + repeatedStringForInitContainers + `,`,
-		`Sidecars:` + repeatedStringForSidecars + `,`,
-		`Partitions:` + valueToStringGenerated(this.Partitions) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Authorization) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Scale) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Authorization{`,
-		`Token:` + strings.Replace(fmt.Sprintf("%v", this.Token), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *BasicAuth) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	n += 2
+	if m.Min != nil {
+		n += 1 + sovGenerated(uint64(*m.Min))
 	}
-	s := strings.Join([]string{`&BasicAuth{`,
-		`User:` + strings.Replace(fmt.Sprintf("%v", this.User), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`Password:` + strings.Replace(fmt.Sprintf("%v", this.Password), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Blackhole) String() string {
-	if this == nil {
-		return "nil"
+	if m.Max != nil {
+		n += 1 + sovGenerated(uint64(*m.Max))
 	}
-	s := strings.Join([]string{`&Blackhole{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *BufferServiceConfig) String() string {
-	if this == nil {
-		return "nil"
+	if m.LookbackSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.LookbackSeconds))
 	}
-	s := strings.Join([]string{`&BufferServiceConfig{`,
-		`Redis:` + strings.Replace(this.Redis.String(), "RedisConfig", "RedisConfig", 1) + `,`,
-		`JetStream:` + strings.Replace(this.JetStream.String(), "JetStreamConfig", "JetStreamConfig", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CombinedEdge) String() string {
-	if this == nil {
-		return "nil"
+	if m.CooldownSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.CooldownSeconds))
 	}
-	s := strings.Join([]string{`&CombinedEdge{`,
-		`Edge:` + strings.Replace(strings.Replace(this.Edge.String(), "Edge", "Edge", 1), `&`, ``, 1) + `,`,
-		`FromVertexType:` + fmt.Sprintf("%v", this.FromVertexType) + `,`,
-		`FromVertexPartitionCount:` + valueToStringGenerated(this.FromVertexPartitionCount) + `,`,
-		`FromVertexLimits:` + strings.Replace(this.FromVertexLimits.String(), "VertexLimits", "VertexLimits", 1) + `,`,
-		`ToVertexType:` + fmt.Sprintf("%v", this.ToVertexType) + `,`,
-		`ToVertexPartitionCount:` + valueToStringGenerated(this.ToVertexPartitionCount) + `,`,
-		`ToVertexLimits:` + strings.Replace(this.ToVertexLimits.String(), "VertexLimits", "VertexLimits", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Container) String() string {
-	if this == nil {
-		return "nil"
+	if m.ZeroReplicaSleepSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.ZeroReplicaSleepSeconds))
 	}
-	repeatedStringForEnv := "[]EnvVar{"
-	for _, f := range this.Env {
-		repeatedStringForEnv += fmt.Sprintf("%v", f) + ","
+	if m.TargetProcessingSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.TargetProcessingSeconds))
 	}
-	repeatedStringForEnv += "}"
-	repeatedStringForEnvFrom := "[]EnvFromSource{"
-	for _, f := range this.EnvFrom {
-		repeatedStringForEnvFrom += fmt.Sprintf("%v", f) + ","
+	if m.ReplicasPerScale != nil {
+		n += 1 + sovGenerated(uint64(*m.ReplicasPerScale))
 	}
-	repeatedStringForEnvFrom += "}"
-	repeatedStringForVolumeMounts := "[]VolumeMount{"
-	for _, f := range this.VolumeMounts {
-		repeatedStringForVolumeMounts += fmt.Sprintf("%v", f) + ","
+	if m.TargetBufferAvailability != nil {
+		n += 1 + sovGenerated(uint64(*m.TargetBufferAvailability))
 	}
-	repeatedStringForVolumeMounts += "}"
-	s := strings.Join([]string{`&Container{`,
-		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
-		`Command:` + fmt.Sprintf("%v", this.Command) + `,`,
-		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
-		`Env:` + repeatedStringForEnv + `,`,
-		`EnvFrom:` + repeatedStringForEnvFrom + `,`,
-		`VolumeMounts:` + repeatedStringForVolumeMounts + `,`,
-		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceRequirements", "v1.ResourceRequirements", 1), `&`, ``, 1) + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "SecurityContext", "v1.SecurityContext", 1) + `,`,
-		`ImagePullPolicy:` + valueToStringGenerated(this.ImagePullPolicy) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ContainerTemplate) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SchemaRegistry) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForEnv := "[]EnvVar{"
-	for _, f := range this.Env {
-		repeatedStringForEnv += fmt.Sprintf("%v", f) + ","
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForEnv += "}"
-	repeatedStringForEnvFrom := "[]EnvFromSource{"
-	for _, f := range this.EnvFrom {
-		repeatedStringForEnvFrom += fmt.Sprintf("%v", f) + ","
+	if m.BasicAuth != nil {
+		l = m.BasicAuth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForEnvFrom += "}"
-	s := strings.Join([]string{`&ContainerTemplate{`,
-		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceRequirements", "v1.ResourceRequirements", 1), `&`, ``, 1) + `,`,
-		`ImagePullPolicy:` + fmt.Sprintf("%v", this.ImagePullPolicy) + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "SecurityContext", "v1.SecurityContext", 1) + `,`,
-		`Env:` + repeatedStringForEnv + `,`,
-		`EnvFrom:` + repeatedStringForEnvFrom + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DaemonTemplate) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.OnFailure)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CacheTTL != nil {
+		l = m.CacheTTL.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&DaemonTemplate{`,
-		`AbstractPodTemplate:` + strings.Replace(strings.Replace(this.AbstractPodTemplate.String(), "AbstractPodTemplate", "AbstractPodTemplate", 1), `&`, ``, 1) + `,`,
-		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
-		`ContainerTemplate:` + strings.Replace(this.ContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
-		`InitContainerTemplate:` + strings.Replace(this.InitContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Edge) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SideInput) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Edge{`,
-		`From:` + fmt.Sprintf("%v", this.From) + `,`,
-		`To:` + fmt.Sprintf("%v", this.To) + `,`,
-		`Conditions:` + strings.Replace(this.Conditions.String(), "ForwardConditions", "ForwardConditions", 1) + `,`,
-		`OnFull:` + valueToStringGenerated(this.OnFull) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *FixedWindow) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Container != nil {
+		l = m.Container.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&FixedWindow{`,
-		`Length:` + strings.Replace(fmt.Sprintf("%v", this.Length), "Duration", "v11.Duration", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.Volumes) > 0 {
+		for _, e := range m.Volumes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Trigger != nil {
+		l = m.Trigger.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Builtin != nil {
+		l = m.Builtin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
 }
-func (this *ForwardConditions) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SideInputBuiltin) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ForwardConditions{`,
-		`Tags:` + strings.Replace(this.Tags.String(), "TagConditions", "TagConditions", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.KWArgs) > 0 {
+		for k, v := range m.KWArgs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
 }
-func (this *Function) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SideInputTrigger) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	keysForKWArgs := make([]string, 0, len(this.KWArgs))
-	for k := range this.KWArgs {
-		keysForKWArgs = append(keysForKWArgs, k)
+	var l int
+	_ = l
+	l = len(m.Schedule)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Interval != nil {
+		l = m.Interval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForKWArgs)
-	mapStringForKWArgs := "map[string]string{"
-	for _, k := range keysForKWArgs {
-		mapStringForKWArgs += fmt.Sprintf("%v: %v,", k, this.KWArgs[k])
+	if m.Timezone != nil {
+		l = len(*m.Timezone)
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForKWArgs += "}"
-	s := strings.Join([]string{`&Function{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
-		`KWArgs:` + mapStringForKWArgs + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *GSSAPI) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Sink) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GSSAPI{`,
-		`ServiceName:` + fmt.Sprintf("%v", this.ServiceName) + `,`,
-		`Realm:` + fmt.Sprintf("%v", this.Realm) + `,`,
-		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`AuthType:` + valueToStringGenerated(this.AuthType) + `,`,
-		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`KeytabSecret:` + strings.Replace(fmt.Sprintf("%v", this.KeytabSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`KerberosConfigSecret:` + strings.Replace(fmt.Sprintf("%v", this.KerberosConfigSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GeneratorSource) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = m.AbstractSink.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Fallback != nil {
+		l = m.Fallback.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&GeneratorSource{`,
-		`RPU:` + valueToStringGenerated(this.RPU) + `,`,
-		`Duration:` + strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "v11.Duration", 1) + `,`,
-		`MsgSize:` + valueToStringGenerated(this.MsgSize) + `,`,
-		`KeyCount:` + valueToStringGenerated(this.KeyCount) + `,`,
-		`Value:` + valueToStringGenerated(this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetDaemonDeploymentReq) String() string {
-	if this == nil {
-		return "nil"
+	if m.MaxRetries != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxRetries))
 	}
-	repeatedStringForEnv := "[]EnvVar{"
-	for _, f := range this.Env {
-		repeatedStringForEnv += fmt.Sprintf("%v", f) + ","
+	if m.Batching != nil {
+		l = m.Batching.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForEnv += "}"
-	s := strings.Join([]string{`&GetDaemonDeploymentReq{`,
-		`ISBSvcType:` + fmt.Sprintf("%v", this.ISBSvcType) + `,`,
-		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
-		`PullPolicy:` + fmt.Sprintf("%v", this.PullPolicy) + `,`,
-		`Env:` + repeatedStringForEnv + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
 }
-func (this *GetJetStreamServiceSpecReq) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SinkBatching) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	var l int
+	_ = l
+	if m.MaxBatchSize != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxBatchSize))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	if m.MaxBatchBytes != nil {
+		l = m.MaxBatchBytes.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForLabels += "}"
-	s := strings.Join([]string{`&GetJetStreamServiceSpecReq{`,
-		`Labels:` + mapStringForLabels + `,`,
-		`ClusterPort:` + fmt.Sprintf("%v", this.ClusterPort) + `,`,
-		`ClientPort:` + fmt.Sprintf("%v", this.ClientPort) + `,`,
-		`MonitorPort:` + fmt.Sprintf("%v", this.MonitorPort) + `,`,
-		`MetricsPort:` + fmt.Sprintf("%v", this.MetricsPort) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.MaxLatency != nil {
+		l = m.MaxLatency.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
 }
-func (this *GetJetStreamStatefulSetSpecReq) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SinkRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	var l int
+	_ = l
+	if m.MessagesPerSecond != nil {
+		n += 1 + sovGenerated(uint64(*m.MessagesPerSecond))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	if m.MessageBurst != nil {
+		n += 1 + sovGenerated(uint64(*m.MessageBurst))
 	}
-	mapStringForLabels += "}"
-	s := strings.Join([]string{`&GetJetStreamStatefulSetSpecReq{`,
-		`ServiceName:` + fmt.Sprintf("%v", this.ServiceName) + `,`,
-		`Labels:` + mapStringForLabels + `,`,
-		`NatsImage:` + fmt.Sprintf("%v", this.NatsImage) + `,`,
-		`MetricsExporterImage:` + fmt.Sprintf("%v", this.MetricsExporterImage) + `,`,
-		`ConfigReloaderImage:` + fmt.Sprintf("%v", this.ConfigReloaderImage) + `,`,
-		`ClusterPort:` + fmt.Sprintf("%v", this.ClusterPort) + `,`,
-		`ClientPort:` + fmt.Sprintf("%v", this.ClientPort) + `,`,
-		`MonitorPort:` + fmt.Sprintf("%v", this.MonitorPort) + `,`,
-		`MetricsPort:` + fmt.Sprintf("%v", this.MetricsPort) + `,`,
-		`ServerAuthSecretName:` + fmt.Sprintf("%v", this.ServerAuthSecretName) + `,`,
-		`ServerEncryptionSecretName:` + fmt.Sprintf("%v", this.ServerEncryptionSecretName) + `,`,
-		`ConfigMapName:` + fmt.Sprintf("%v", this.ConfigMapName) + `,`,
-		`PvcNameIfNeeded:` + fmt.Sprintf("%v", this.PvcNameIfNeeded) + `,`,
-		`StartCommand:` + fmt.Sprintf("%v", this.StartCommand) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.BytesPerSecond != nil {
+		l = m.BytesPerSecond.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ByteBurst != nil {
+		l = m.ByteBurst.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
 }
-func (this *GetRedisServiceSpecReq) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SlidingWindow) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	var l int
+	_ = l
+	if m.Length != nil {
+		l = m.Length.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	if m.Slide != nil {
+		l = m.Slide.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForLabels += "}"
-	s := strings.Join([]string{`&GetRedisServiceSpecReq{`,
-		`Labels:` + mapStringForLabels + `,`,
-		`RedisContainerPort:` + fmt.Sprintf("%v", this.RedisContainerPort) + `,`,
-		`SentinelContainerPort:` + fmt.Sprintf("%v", this.SentinelContainerPort) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *GetRedisStatefulSetSpecReq) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Source) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	var l int
+	_ = l
+	if m.Generator != nil {
+		l = m.Generator.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	if m.Kafka != nil {
+		l = m.Kafka.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForLabels += "}"
-	s := strings.Join([]string{`&GetRedisStatefulSetSpecReq{`,
-		`ServiceName:` + fmt.Sprintf("%v", this.ServiceName) + `,`,
-		`Labels:` + mapStringForLabels + `,`,
-		`RedisImage:` + fmt.Sprintf("%v", this.RedisImage) + `,`,
-		`SentinelImage:` + fmt.Sprintf("%v", this.SentinelImage) + `,`,
-		`MetricsExporterImage:` + fmt.Sprintf("%v", this.MetricsExporterImage) + `,`,
-		`InitContainerImage:` + fmt.Sprintf("%v", this.InitContainerImage) + `,`,
-		`RedisContainerPort:` + fmt.Sprintf("%v", this.RedisContainerPort) + `,`,
-		`SentinelContainerPort:` + fmt.Sprintf("%v", this.SentinelContainerPort) + `,`,
-		`RedisMetricsContainerPort:` + fmt.Sprintf("%v", this.RedisMetricsContainerPort) + `,`,
-		`CredentialSecretName:` + fmt.Sprintf("%v", this.CredentialSecretName) + `,`,
-		`TLSEnabled:` + fmt.Sprintf("%v", this.TLSEnabled) + `,`,
-		`PvcNameIfNeeded:` + fmt.Sprintf("%v", this.PvcNameIfNeeded) + `,`,
-		`ConfConfigMapName:` + fmt.Sprintf("%v", this.ConfConfigMapName) + `,`,
-		`ScriptsConfigMapName:` + fmt.Sprintf("%v", this.ScriptsConfigMapName) + `,`,
-		`HealthConfigMapName:` + fmt.Sprintf("%v", this.HealthConfigMapName) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetVertexPodSpecReq) String() string {
-	if this == nil {
-		return "nil"
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForEnv := "[]EnvVar{"
-	for _, f := range this.Env {
-		repeatedStringForEnv += fmt.Sprintf("%v", f) + ","
+	if m.Nats != nil {
+		l = m.Nats.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForEnv += "}"
-	s := strings.Join([]string{`&GetVertexPodSpecReq{`,
-		`ISBSvcType:` + fmt.Sprintf("%v", this.ISBSvcType) + `,`,
-		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
-		`PullPolicy:` + fmt.Sprintf("%v", this.PullPolicy) + `,`,
-		`Env:` + repeatedStringForEnv + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GroupBy) String() string {
-	if this == nil {
-		return "nil"
+	if m.RedisStreams != nil {
+		l = m.RedisStreams.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&GroupBy{`,
-		`Window:` + strings.Replace(strings.Replace(this.Window.String(), "Window", "Window", 1), `&`, ``, 1) + `,`,
-		`Keyed:` + fmt.Sprintf("%v", this.Keyed) + `,`,
-		`AllowedLateness:` + strings.Replace(fmt.Sprintf("%v", this.AllowedLateness), "Duration", "v11.Duration", 1) + `,`,
-		`Storage:` + strings.Replace(this.Storage.String(), "PBQStorage", "PBQStorage", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *HTTPSource) String() string {
-	if this == nil {
-		return "nil"
+	if m.UDTransformer != nil {
+		l = m.UDTransformer.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&HTTPSource{`,
-		`Auth:` + strings.Replace(this.Auth.String(), "Authorization", "Authorization", 1) + `,`,
-		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *InterStepBufferService) String() string {
-	if this == nil {
-		return "nil"
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&InterStepBufferService{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "InterStepBufferServiceSpec", "InterStepBufferServiceSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "InterStepBufferServiceStatus", "InterStepBufferServiceStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.GRPC != nil {
+		l = m.GRPC.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
 }
-func (this *InterStepBufferServiceList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Status) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems := "[]InterStepBufferService{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "InterStepBufferService", "InterStepBufferService", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&InterStepBufferServiceList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *InterStepBufferServiceSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLS) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&InterStepBufferServiceSpec{`,
-		`Redis:` + strings.Replace(this.Redis.String(), "RedisBufferService", "RedisBufferService", 1) + `,`,
-		`JetStream:` + strings.Replace(this.JetStream.String(), "JetStreamBufferService", "JetStreamBufferService", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *InterStepBufferServiceStatus) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	n += 2
+	if m.CACertSecret != nil {
+		l = m.CACertSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&InterStepBufferServiceStatus{`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "Status", "Status", 1), `&`, ``, 1) + `,`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`Config:` + strings.Replace(strings.Replace(this.Config.String(), "BufferServiceConfig", "BufferServiceConfig", 1), `&`, ``, 1) + `,`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JetStreamBufferService) String() string {
-	if this == nil {
-		return "nil"
+	if m.CertSecret != nil {
+		l = m.CertSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&JetStreamBufferService{`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
-		`ContainerTemplate:` + strings.Replace(this.ContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
-		`ReloaderContainerTemplate:` + strings.Replace(this.ReloaderContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
-		`MetricsContainerTemplate:` + strings.Replace(this.MetricsContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
-		`Persistence:` + strings.Replace(this.Persistence.String(), "PersistenceStrategy", "PersistenceStrategy", 1) + `,`,
-		`AbstractPodTemplate:` + strings.Replace(strings.Replace(this.AbstractPodTemplate.String(), "AbstractPodTemplate", "AbstractPodTemplate", 1), `&`, ``, 1) + `,`,
-		`Settings:` + valueToStringGenerated(this.Settings) + `,`,
-		`StartArgs:` + fmt.Sprintf("%v", this.StartArgs) + `,`,
-		`BufferConfig:` + valueToStringGenerated(this.BufferConfig) + `,`,
-		`Encryption:` + fmt.Sprintf("%v", this.Encryption) + `,`,
-		`TLS:` + fmt.Sprintf("%v", this.TLS) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JetStreamConfig) String() string {
-	if this == nil {
-		return "nil"
+	if m.KeySecret != nil {
+		l = m.KeySecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&JetStreamConfig{`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`Auth:` + strings.Replace(this.Auth.String(), "NatsAuth", "NatsAuth", 1) + `,`,
-		`StreamConfig:` + fmt.Sprintf("%v", this.StreamConfig) + `,`,
-		`TLSEnabled:` + fmt.Sprintf("%v", this.TLSEnabled) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *JobTemplate) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *TagConditions) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&JobTemplate{`,
-		`AbstractPodTemplate:` + strings.Replace(strings.Replace(this.AbstractPodTemplate.String(), "AbstractPodTemplate", "AbstractPodTemplate", 1), `&`, ``, 1) + `,`,
-		`ContainerTemplate:` + strings.Replace(this.ContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
-		`TTLSecondsAfterFinished:` + valueToStringGenerated(this.TTLSecondsAfterFinished) + `,`,
-		`BackoffLimit:` + valueToStringGenerated(this.BackoffLimit) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *KafkaSink) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Operator != nil {
+		l = len(*m.Operator)
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&KafkaSink{`,
-		`Brokers:` + fmt.Sprintf("%v", this.Brokers) + `,`,
-		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
-		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
-		`Config:` + fmt.Sprintf("%v", this.Config) + `,`,
-		`SASL:` + strings.Replace(this.SASL.String(), "SASL", "SASL", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *KafkaSource) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&KafkaSource{`,
-		`Brokers:` + fmt.Sprintf("%v", this.Brokers) + `,`,
-		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
-		`ConsumerGroupName:` + fmt.Sprintf("%v", this.ConsumerGroupName) + `,`,
-		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
-		`Config:` + fmt.Sprintf("%v", this.Config) + `,`,
-		`SASL:` + strings.Replace(this.SASL.String(), "SASL", "SASL", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Lifecycle) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Templates) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Lifecycle{`,
-		`DeleteGracePeriodSeconds:` + valueToStringGenerated(this.DeleteGracePeriodSeconds) + `,`,
-		`DesiredPhase:` + fmt.Sprintf("%v", this.DesiredPhase) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if m.DaemonTemplate != nil {
+		l = m.DaemonTemplate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.JobTemplate != nil {
+		l = m.JobTemplate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
 }
-func (this *Log) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Transformer) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Log{`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.KWArgs) > 0 {
+		for k, v := range m.KWArgs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
 }
-func (this *Metadata) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UDF) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	var l int
+	_ = l
+	if m.Container != nil {
+		l = m.Container.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	if m.Builtin != nil {
+		l = m.Builtin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForAnnotations += "}"
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	if m.GroupBy != nil {
+		l = m.GroupBy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	l = len(m.Ordering)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Wasm != nil {
+		l = m.Wasm.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForLabels += "}"
-	s := strings.Join([]string{`&Metadata{`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`Labels:` + mapStringForLabels + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *NativeRedis) String() string {
-	if this == nil {
-		return "nil"
+	if m.Remote != nil {
+		l = m.Remote.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&NativeRedis{`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
-		`RedisContainerTemplate:` + strings.Replace(this.RedisContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
-		`SentinelContainerTemplate:` + strings.Replace(this.SentinelContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
-		`MetricsContainerTemplate:` + strings.Replace(this.MetricsContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
-		`InitContainerTemplate:` + strings.Replace(this.InitContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
-		`Persistence:` + strings.Replace(this.Persistence.String(), "PersistenceStrategy", "PersistenceStrategy", 1) + `,`,
-		`AbstractPodTemplate:` + strings.Replace(strings.Replace(this.AbstractPodTemplate.String(), "AbstractPodTemplate", "AbstractPodTemplate", 1), `&`, ``, 1) + `,`,
-		`Settings:` + strings.Replace(this.Settings.String(), "RedisSettings", "RedisSettings", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *NatsAuth) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UDSink) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&NatsAuth{`,
-		`Basic:` + strings.Replace(this.Basic.String(), "BasicAuth", "BasicAuth", 1) + `,`,
-		`Token:` + strings.Replace(fmt.Sprintf("%v", this.Token), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`NKey:` + strings.Replace(fmt.Sprintf("%v", this.NKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.Container.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *NatsSource) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UDTransformer) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&NatsSource{`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
-		`Auth:` + strings.Replace(this.Auth.String(), "NatsAuth", "NatsAuth", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PBQStorage) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Container != nil {
+		l = m.Container.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&PBQStorage{`,
-		`PersistentVolumeClaim:` + strings.Replace(this.PersistentVolumeClaim.String(), "PersistenceStrategy", "PersistenceStrategy", 1) + `,`,
-		`EmptyDir:` + strings.Replace(fmt.Sprintf("%v", this.EmptyDir), "EmptyDirVolumeSource", "v1.EmptyDirVolumeSource", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PersistenceStrategy) String() string {
-	if this == nil {
-		return "nil"
+	if m.Builtin != nil {
+		l = m.Builtin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&PersistenceStrategy{`,
-		`StorageClassName:` + valueToStringGenerated(this.StorageClassName) + `,`,
-		`AccessMode:` + valueToStringGenerated(this.AccessMode) + `,`,
-		`VolumeSize:` + strings.Replace(fmt.Sprintf("%v", this.VolumeSize), "Quantity", "resource.Quantity", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Pipeline) String() string {
-	if this == nil {
-		return "nil"
+	if m.Wasm != nil {
+		l = m.Wasm.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&Pipeline{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PipelineSpec", "PipelineSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "PipelineStatus", "PipelineStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PipelineLimits) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Vertex) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&PipelineLimits{`,
-		`ReadBatchSize:` + valueToStringGenerated(this.ReadBatchSize) + `,`,
-		`BufferMaxLength:` + valueToStringGenerated(this.BufferMaxLength) + `,`,
-		`BufferUsageLimit:` + valueToStringGenerated(this.BufferUsageLimit) + `,`,
-		`ReadTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ReadTimeout), "Duration", "v11.Duration", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *PipelineList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *VertexInstance) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems := "[]Pipeline{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Pipeline", "Pipeline", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if m.Vertex != nil {
+		l = m.Vertex.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&PipelineList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.Hostname)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Replica))
+	return n
 }
-func (this *PipelineSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *VertexLimits) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForVertices := "[]AbstractVertex{"
-	for _, f := range this.Vertices {
-		repeatedStringForVertices += strings.Replace(strings.Replace(f.String(), "AbstractVertex", "AbstractVertex", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if m.ReadBatchSize != nil {
+		n += 1 + sovGenerated(uint64(*m.ReadBatchSize))
 	}
-	repeatedStringForVertices += "}"
-	repeatedStringForEdges := "[]Edge{"
-	for _, f := range this.Edges {
-		repeatedStringForEdges += strings.Replace(strings.Replace(f.String(), "Edge", "Edge", 1), `&`, ``, 1) + ","
+	if m.ReadTimeout != nil {
+		l = m.ReadTimeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForEdges += "}"
-	s := strings.Join([]string{`&PipelineSpec{`,
-		`InterStepBufferServiceName:` + fmt.Sprintf("%v", this.InterStepBufferServiceName) + `,`,
-		`Vertices:` + repeatedStringForVertices + `,`,
-		`Edges:` + repeatedStringForEdges + `,`,
-		`Lifecycle:` + strings.Replace(strings.Replace(this.Lifecycle.String(), "Lifecycle", "Lifecycle", 1), `&`, ``, 1) + `,`,
-		`Limits:` + strings.Replace(this.Limits.String(), "PipelineLimits", "PipelineLimits", 1) + `,`,
-		`Watermark:` + strings.Replace(strings.Replace(this.Watermark.String(), "Watermark", "Watermark", 1), `&`, ``, 1) + `,`,
-		`Templates:` + strings.Replace(this.Templates.String(), "Templates", "Templates", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.BufferMaxLength != nil {
+		n += 1 + sovGenerated(uint64(*m.BufferMaxLength))
+	}
+	if m.BufferUsageLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.BufferUsageLimit))
+	}
+	if m.UDFTimeout != nil {
+		l = m.UDFTimeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.UDFCircuitBreaker != nil {
+		l = m.UDFCircuitBreaker.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
 }
-func (this *PipelineStatus) String() string {
+
+func (m *VertexList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VertexSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AbstractVertex.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PipelineName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.InterStepBufferServiceName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Replicas != nil {
+		n += 1 + sovGenerated(uint64(*m.Replicas))
+	}
+	if len(m.FromEdges) > 0 {
+		for _, e := range m.FromEdges {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ToEdges) > 0 {
+		for _, e := range m.ToEdges {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Watermark.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *VertexStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Replicas))
+	l = m.LastScaledAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Selector)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *Wasm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConfigMap != nil {
+		l = m.ConfigMap.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.VolumeName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.MemoryLimitPages != nil {
+		n += 1 + sovGenerated(uint64(*m.MemoryLimitPages))
+	}
+	return n
+}
+
+func (m *Watermark) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	if m.MaxDelay != nil {
+		l = m.MaxDelay.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *Window) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fixed != nil {
+		l = m.Fixed.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Sliding != nil {
+		l = m.Sliding.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func sovGenerated(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenerated(x uint64) (n int) {
+	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AbstractPodTemplate) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&PipelineStatus{`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "Status", "Status", 1), `&`, ``, 1) + `,`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`LastUpdated:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdated), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
-		`VertexCount:` + valueToStringGenerated(this.VertexCount) + `,`,
-		`SourceCount:` + valueToStringGenerated(this.SourceCount) + `,`,
-		`SinkCount:` + valueToStringGenerated(this.SinkCount) + `,`,
-		`UDFCount:` + valueToStringGenerated(this.UDFCount) + `,`,
+	repeatedStringForTolerations := "[]Toleration{"
+	for _, f := range this.Tolerations {
+		repeatedStringForTolerations += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForTolerations += "}"
+	repeatedStringForImagePullSecrets := "[]LocalObjectReference{"
+	for _, f := range this.ImagePullSecrets {
+		repeatedStringForImagePullSecrets += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForImagePullSecrets += "}"
+	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
+	for k := range this.NodeSelector {
+		keysForNodeSelector = append(keysForNodeSelector, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
+	mapStringForNodeSelector := "map[string]string{"
+	for _, k := range keysForNodeSelector {
+		mapStringForNodeSelector += fmt.Sprintf("%v: %v,", k, this.NodeSelector[k])
+	}
+	mapStringForNodeSelector += "}"
+	s := strings.Join([]string{`&AbstractPodTemplate{`,
+		`Metadata:` + strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1) + `,`,
+		`NodeSelector:` + mapStringForNodeSelector + `,`,
+		`Tolerations:` + repeatedStringForTolerations + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v1.PodSecurityContext", 1) + `,`,
+		`ImagePullSecrets:` + repeatedStringForImagePullSecrets + `,`,
+		`PriorityClassName:` + fmt.Sprintf("%v", this.PriorityClassName) + `,`,
+		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
+		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
+		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
+		`RuntimeClassName:` + valueToStringGenerated(this.RuntimeClassName) + `,`,
+		`AutomountServiceAccountToken:` + valueToStringGenerated(this.AutomountServiceAccountToken) + `,`,
+		`DNSPolicy:` + fmt.Sprintf("%v", this.DNSPolicy) + `,`,
+		`DNSConfig:` + strings.Replace(fmt.Sprintf("%v", this.DNSConfig), "PodDNSConfig", "v1.PodDNSConfig", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *RedisBufferService) String() string {
+func (this *AbstractSink) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RedisBufferService{`,
-		`Native:` + strings.Replace(this.Native.String(), "NativeRedis", "NativeRedis", 1) + `,`,
-		`External:` + strings.Replace(this.External.String(), "RedisConfig", "RedisConfig", 1) + `,`,
+	s := strings.Join([]string{`&AbstractSink{`,
+		`Log:` + strings.Replace(this.Log.String(), "Log", "Log", 1) + `,`,
+		`Kafka:` + strings.Replace(this.Kafka.String(), "KafkaSink", "KafkaSink", 1) + `,`,
+		`Blackhole:` + strings.Replace(this.Blackhole.String(), "Blackhole", "Blackhole", 1) + `,`,
+		`UDSink:` + strings.Replace(this.UDSink.String(), "UDSink", "UDSink", 1) + `,`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPSink", "HTTPSink", 1) + `,`,
+		`File:` + strings.Replace(this.File.String(), "FileSink", "FileSink", 1) + `,`,
+		`S3:` + strings.Replace(this.S3.String(), "S3Sink", "S3Sink", 1) + `,`,
+		`SQL:` + strings.Replace(this.SQL.String(), "SQLSink", "SQLSink", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *RedisConfig) String() string {
+func (this *AbstractVertex) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RedisConfig{`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`SentinelURL:` + fmt.Sprintf("%v", this.SentinelURL) + `,`,
-		`MasterName:` + fmt.Sprintf("%v", this.MasterName) + `,`,
-		`User:` + fmt.Sprintf("%v", this.User) + `,`,
-		`Password:` + strings.Replace(fmt.Sprintf("%v", this.Password), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`SentinelPassword:` + strings.Replace(fmt.Sprintf("%v", this.SentinelPassword), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+	repeatedStringForVolumes := "[]Volume{"
+	for _, f := range this.Volumes {
+		repeatedStringForVolumes += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForVolumes += "}"
+	repeatedStringForInitContainers := "[]Container{"
+	for _, f := range this.InitContainers {
+		repeatedStringForInitContainers += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForInitContainers += "}"
+	repeatedStringForSidecars := "[]Container{"
+	for _, f := range this.Sidecars {
+		repeatedStringForSidecars += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForSidecars += "}"
+	s := strings.Join([]string{`&AbstractVertex{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Source:` + strings.Replace(this.Source.String(), "Source", "Source", 1) + `,`,
+		`Sink:` + strings.Replace(this.Sink.String(), "Sink", "Sink", 1) + `,`,
+		`UDF:` + strings.Replace(this.UDF.String(), "UDF", "UDF", 1) + `,`,
+		`ContainerTemplate:` + strings.Replace(this.ContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
+		`InitContainerTemplate:` + strings.Replace(this.InitContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
+		`AbstractPodTemplate:` + strings.Replace(strings.Replace(this.AbstractPodTemplate.String(), "AbstractPodTemplate", "AbstractPodTemplate", 1), `&`, ``, 1) + `,`,
+		`Volumes:` + repeatedStringForVolumes + `,`,
+		`Limits:` + strings.Replace(this.Limits.String(), "VertexLimits", "VertexLimits", 1) + `,`,
+		`Scale:` + strings.Replace(strings.Replace(this.Scale.String(), "Scale", "Scale", 1), `&`, ``, 1) + `,`,
+		`InitContainers:` + repeatedStringForInitContainers + `,`,
+		`Sidecars:` + repeatedStringForSidecars + `,`,
+		`Partitions:` + valueToStringGenerated(this.Partitions) + `,`,
+		`SideInputs:` + fmt.Sprintf("%v", this.SideInputs) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *RedisSettings) String() string {
+func (this *Authorization) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RedisSettings{`,
-		`Redis:` + fmt.Sprintf("%v", this.Redis) + `,`,
-		`Master:` + fmt.Sprintf("%v", this.Master) + `,`,
-		`Replica:` + fmt.Sprintf("%v", this.Replica) + `,`,
-		`Sentinel:` + fmt.Sprintf("%v", this.Sentinel) + `,`,
+	s := strings.Join([]string{`&Authorization{`,
+		`Token:` + strings.Replace(fmt.Sprintf("%v", this.Token), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *RedisStreamsSource) String() string {
+func (this *BasicAuth) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RedisStreamsSource{`,
-		`RedisConfig:` + strings.Replace(strings.Replace(this.RedisConfig.String(), "RedisConfig", "RedisConfig", 1), `&`, ``, 1) + `,`,
-		`Stream:` + fmt.Sprintf("%v", this.Stream) + `,`,
-		`ConsumerGroup:` + fmt.Sprintf("%v", this.ConsumerGroup) + `,`,
-		`ReadFromBeginning:` + fmt.Sprintf("%v", this.ReadFromBeginning) + `,`,
-		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
+	s := strings.Join([]string{`&BasicAuth{`,
+		`User:` + strings.Replace(fmt.Sprintf("%v", this.User), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Password:` + strings.Replace(fmt.Sprintf("%v", this.Password), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *SASL) String() string {
+func (this *Blackhole) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&SASL{`,
-		`Mechanism:` + valueToStringGenerated(this.Mechanism) + `,`,
-		`GSSAPI:` + strings.Replace(this.GSSAPI.String(), "GSSAPI", "GSSAPI", 1) + `,`,
-		`Plain:` + strings.Replace(this.Plain.String(), "SASLPlain", "SASLPlain", 1) + `,`,
+	s := strings.Join([]string{`&Blackhole{`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *SASLPlain) String() string {
+func (this *BufferServiceConfig) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&SASLPlain{`,
-		`UserSecret:` + strings.Replace(fmt.Sprintf("%v", this.UserSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`Handshake:` + fmt.Sprintf("%v", this.Handshake) + `,`,
+	s := strings.Join([]string{`&BufferServiceConfig{`,
+		`Redis:` + strings.Replace(this.Redis.String(), "RedisConfig", "RedisConfig", 1) + `,`,
+		`JetStream:` + strings.Replace(this.JetStream.String(), "JetStreamConfig", "JetStreamConfig", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *Scale) String() string {
+func (this *CircuitBreaker) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Scale{`,
-		`Disabled:` + fmt.Sprintf("%v", this.Disabled) + `,`,
-		`Min:` + valueToStringGenerated(this.Min) + `,`,
-		`Max:` + valueToStringGenerated(this.Max) + `,`,
-		`LookbackSeconds:` + valueToStringGenerated(this.LookbackSeconds) + `,`,
-		`CooldownSeconds:` + valueToStringGenerated(this.CooldownSeconds) + `,`,
-		`ZeroReplicaSleepSeconds:` + valueToStringGenerated(this.ZeroReplicaSleepSeconds) + `,`,
-		`TargetProcessingSeconds:` + valueToStringGenerated(this.TargetProcessingSeconds) + `,`,
-		`ReplicasPerScale:` + valueToStringGenerated(this.ReplicasPerScale) + `,`,
-		`TargetBufferAvailability:` + valueToStringGenerated(this.TargetBufferAvailability) + `,`,
+	s := strings.Join([]string{`&CircuitBreaker{`,
+		`FailureThreshold:` + valueToStringGenerated(this.FailureThreshold) + `,`,
+		`OpenDuration:` + strings.Replace(fmt.Sprintf("%v", this.OpenDuration), "Duration", "v11.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *Sink) String() string {
+func (this *CombinedEdge) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Sink{`,
-		`Log:` + strings.Replace(this.Log.String(), "Log", "Log", 1) + `,`,
-		`Kafka:` + strings.Replace(this.Kafka.String(), "KafkaSink", "KafkaSink", 1) + `,`,
-		`Blackhole:` + strings.Replace(this.Blackhole.String(), "Blackhole", "Blackhole", 1) + `,`,
-		`UDSink:` + strings.Replace(this.UDSink.String(), "UDSink", "UDSink", 1) + `,`,
+	s := strings.Join([]string{`&CombinedEdge{`,
+		`Edge:` + strings.Replace(strings.Replace(this.Edge.String(), "Edge", "Edge", 1), `&`, ``, 1) + `,`,
+		`FromVertexType:` + fmt.Sprintf("%v", this.FromVertexType) + `,`,
+		`FromVertexPartitionCount:` + valueToStringGenerated(this.FromVertexPartitionCount) + `,`,
+		`FromVertexLimits:` + strings.Replace(this.FromVertexLimits.String(), "VertexLimits", "VertexLimits", 1) + `,`,
+		`ToVertexType:` + fmt.Sprintf("%v", this.ToVertexType) + `,`,
+		`ToVertexPartitionCount:` + valueToStringGenerated(this.ToVertexPartitionCount) + `,`,
+		`ToVertexLimits:` + strings.Replace(this.ToVertexLimits.String(), "VertexLimits", "VertexLimits", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *SlidingWindow) String() string {
+func (this *Container) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&SlidingWindow{`,
-		`Length:` + strings.Replace(fmt.Sprintf("%v", this.Length), "Duration", "v11.Duration", 1) + `,`,
-		`Slide:` + strings.Replace(fmt.Sprintf("%v", this.Slide), "Duration", "v11.Duration", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Source) String() string {
-	if this == nil {
-		return "nil"
+	repeatedStringForEnv := "[]EnvVar{"
+	for _, f := range this.Env {
+		repeatedStringForEnv += fmt.Sprintf("%v", f) + ","
 	}
-	s := strings.Join([]string{`&Source{`,
-		`Generator:` + strings.Replace(this.Generator.String(), "GeneratorSource", "GeneratorSource", 1) + `,`,
-		`Kafka:` + strings.Replace(this.Kafka.String(), "KafkaSource", "KafkaSource", 1) + `,`,
-		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPSource", "HTTPSource", 1) + `,`,
-		`Nats:` + strings.Replace(this.Nats.String(), "NatsSource", "NatsSource", 1) + `,`,
-		`RedisStreams:` + strings.Replace(this.RedisStreams.String(), "RedisStreamsSource", "RedisStreamsSource", 1) + `,`,
-		`UDTransformer:` + strings.Replace(this.UDTransformer.String(), "UDTransformer", "UDTransformer", 1) + `,`,
+	repeatedStringForEnv += "}"
+	repeatedStringForEnvFrom := "[]EnvFromSource{"
+	for _, f := range this.EnvFrom {
+		repeatedStringForEnvFrom += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForEnvFrom += "}"
+	repeatedStringForVolumeMounts := "[]VolumeMount{"
+	for _, f := range this.VolumeMounts {
+		repeatedStringForVolumeMounts += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForVolumeMounts += "}"
+	s := strings.Join([]string{`&Container{`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`Command:` + fmt.Sprintf("%v", this.Command) + `,`,
+		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
+		`Env:` + repeatedStringForEnv + `,`,
+		`EnvFrom:` + repeatedStringForEnvFrom + `,`,
+		`VolumeMounts:` + repeatedStringForVolumeMounts + `,`,
+		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceRequirements", "v1.ResourceRequirements", 1), `&`, ``, 1) + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "SecurityContext", "v1.SecurityContext", 1) + `,`,
+		`ImagePullPolicy:` + valueToStringGenerated(this.ImagePullPolicy) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *Status) String() string {
+func (this *ContainerTemplate) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForConditions := "[]Condition{"
-	for _, f := range this.Conditions {
-		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	repeatedStringForEnv := "[]EnvVar{"
+	for _, f := range this.Env {
+		repeatedStringForEnv += fmt.Sprintf("%v", f) + ","
 	}
-	repeatedStringForConditions += "}"
-	s := strings.Join([]string{`&Status{`,
-		`Conditions:` + repeatedStringForConditions + `,`,
+	repeatedStringForEnv += "}"
+	repeatedStringForEnvFrom := "[]EnvFromSource{"
+	for _, f := range this.EnvFrom {
+		repeatedStringForEnvFrom += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForEnvFrom += "}"
+	s := strings.Join([]string{`&ContainerTemplate{`,
+		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceRequirements", "v1.ResourceRequirements", 1), `&`, ``, 1) + `,`,
+		`ImagePullPolicy:` + fmt.Sprintf("%v", this.ImagePullPolicy) + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "SecurityContext", "v1.SecurityContext", 1) + `,`,
+		`Env:` + repeatedStringForEnv + `,`,
+		`EnvFrom:` + repeatedStringForEnvFrom + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *TLS) String() string {
+func (this *DaemonTemplate) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&TLS{`,
-		`InsecureSkipVerify:` + fmt.Sprintf("%v", this.InsecureSkipVerify) + `,`,
-		`CACertSecret:` + strings.Replace(fmt.Sprintf("%v", this.CACertSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`CertSecret:` + strings.Replace(fmt.Sprintf("%v", this.CertSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`KeySecret:` + strings.Replace(fmt.Sprintf("%v", this.KeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+	s := strings.Join([]string{`&DaemonTemplate{`,
+		`AbstractPodTemplate:` + strings.Replace(strings.Replace(this.AbstractPodTemplate.String(), "AbstractPodTemplate", "AbstractPodTemplate", 1), `&`, ``, 1) + `,`,
+		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
+		`ContainerTemplate:` + strings.Replace(this.ContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
+		`InitContainerTemplate:` + strings.Replace(this.InitContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *TagConditions) String() string {
+func (this *Edge) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&TagConditions{`,
-		`Operator:` + valueToStringGenerated(this.Operator) + `,`,
-		`Values:` + fmt.Sprintf("%v", this.Values) + `,`,
+	s := strings.Join([]string{`&Edge{`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`To:` + fmt.Sprintf("%v", this.To) + `,`,
+		`Conditions:` + strings.Replace(this.Conditions.String(), "ForwardConditions", "ForwardConditions", 1) + `,`,
+		`OnFull:` + valueToStringGenerated(this.OnFull) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *Templates) String() string {
+func (this *FileEventTime) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Templates{`,
-		`DaemonTemplate:` + strings.Replace(this.DaemonTemplate.String(), "DaemonTemplate", "DaemonTemplate", 1) + `,`,
-		`JobTemplate:` + strings.Replace(this.JobTemplate.String(), "JobTemplate", "JobTemplate", 1) + `,`,
+	s := strings.Join([]string{`&FileEventTime{`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *Transformer) String() string {
+func (this *FileSink) String() string {
 	if this == nil {
 		return "nil"
 	}
-	keysForKWArgs := make([]string, 0, len(this.KWArgs))
-	for k := range this.KWArgs {
-		keysForKWArgs = append(keysForKWArgs, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForKWArgs)
-	mapStringForKWArgs := "map[string]string{"
-	for _, k := range keysForKWArgs {
-		mapStringForKWArgs += fmt.Sprintf("%v: %v,", k, this.KWArgs[k])
-	}
-	mapStringForKWArgs += "}"
-	s := strings.Join([]string{`&Transformer{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
-		`KWArgs:` + mapStringForKWArgs + `,`,
+	s := strings.Join([]string{`&FileSink{`,
+		`VolumeName:` + fmt.Sprintf("%v", this.VolumeName) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Compression:` + fmt.Sprintf("%v", this.Compression) + `,`,
+		`PartitionDuration:` + strings.Replace(fmt.Sprintf("%v", this.PartitionDuration), "Duration", "v11.Duration", 1) + `,`,
+		`PartitionFormat:` + fmt.Sprintf("%v", this.PartitionFormat) + `,`,
+		`MaxFileSize:` + strings.Replace(fmt.Sprintf("%v", this.MaxFileSize), "Quantity", "resource.Quantity", 1) + `,`,
+		`RollInterval:` + strings.Replace(fmt.Sprintf("%v", this.RollInterval), "Duration", "v11.Duration", 1) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`Parquet:` + strings.Replace(this.Parquet.String(), "ParquetOptions", "ParquetOptions", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *UDF) String() string {
+func (this *FileSource) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&UDF{`,
-		`Container:` + strings.Replace(this.Container.String(), "Container", "Container", 1) + `,`,
-		`Builtin:` + strings.Replace(this.Builtin.String(), "Function", "Function", 1) + `,`,
-		`GroupBy:` + strings.Replace(this.GroupBy.String(), "GroupBy", "GroupBy", 1) + `,`,
+	s := strings.Join([]string{`&FileSource{`,
+		`VolumeName:` + fmt.Sprintf("%v", this.VolumeName) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`Follow:` + valueToStringGenerated(this.Follow) + `,`,
+		`PollInterval:` + strings.Replace(fmt.Sprintf("%v", this.PollInterval), "Duration", "v11.Duration", 1) + `,`,
+		`CheckpointPath:` + fmt.Sprintf("%v", this.CheckpointPath) + `,`,
+		`EventTime:` + strings.Replace(this.EventTime.String(), "FileEventTime", "FileEventTime", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *UDSink) String() string {
+func (this *FixedWindow) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&UDSink{`,
-		`Container:` + strings.Replace(strings.Replace(this.Container.String(), "Container", "Container", 1), `&`, ``, 1) + `,`,
+	s := strings.Join([]string{`&FixedWindow{`,
+		`Length:` + strings.Replace(fmt.Sprintf("%v", this.Length), "Duration", "v11.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *UDTransformer) String() string {
+func (this *ForwardConditions) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&UDTransformer{`,
-		`Container:` + strings.Replace(this.Container.String(), "Container", "Container", 1) + `,`,
-		`Builtin:` + strings.Replace(this.Builtin.String(), "Transformer", "Transformer", 1) + `,`,
+	s := strings.Join([]string{`&ForwardConditions{`,
+		`Tags:` + strings.Replace(this.Tags.String(), "TagConditions", "TagConditions", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *Vertex) String() string {
+func (this *Function) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Vertex{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "VertexSpec", "VertexSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "VertexStatus", "VertexStatus", 1), `&`, ``, 1) + `,`,
+	keysForKWArgs := make([]string, 0, len(this.KWArgs))
+	for k := range this.KWArgs {
+		keysForKWArgs = append(keysForKWArgs, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForKWArgs)
+	mapStringForKWArgs := "map[string]string{"
+	for _, k := range keysForKWArgs {
+		mapStringForKWArgs += fmt.Sprintf("%v: %v,", k, this.KWArgs[k])
+	}
+	mapStringForKWArgs += "}"
+	s := strings.Join([]string{`&Function{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
+		`KWArgs:` + mapStringForKWArgs + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *VertexInstance) String() string {
+func (this *GRPCSource) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&VertexInstance{`,
-		`Vertex:` + strings.Replace(this.Vertex.String(), "Vertex", "Vertex", 1) + `,`,
-		`Hostname:` + fmt.Sprintf("%v", this.Hostname) + `,`,
-		`Replica:` + fmt.Sprintf("%v", this.Replica) + `,`,
+	s := strings.Join([]string{`&GRPCSource{`,
+		`Auth:` + strings.Replace(this.Auth.String(), "Authorization", "Authorization", 1) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
+		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`Window:` + valueToStringGenerated(this.Window) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *VertexLimits) String() string {
+func (this *GSSAPI) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&VertexLimits{`,
-		`ReadBatchSize:` + valueToStringGenerated(this.ReadBatchSize) + `,`,
-		`ReadTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ReadTimeout), "Duration", "v11.Duration", 1) + `,`,
-		`BufferMaxLength:` + valueToStringGenerated(this.BufferMaxLength) + `,`,
-		`BufferUsageLimit:` + valueToStringGenerated(this.BufferUsageLimit) + `,`,
+	s := strings.Join([]string{`&GSSAPI{`,
+		`ServiceName:` + fmt.Sprintf("%v", this.ServiceName) + `,`,
+		`Realm:` + fmt.Sprintf("%v", this.Realm) + `,`,
+		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`AuthType:` + valueToStringGenerated(this.AuthType) + `,`,
+		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`KeytabSecret:` + strings.Replace(fmt.Sprintf("%v", this.KeytabSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`KerberosConfigSecret:` + strings.Replace(fmt.Sprintf("%v", this.KerberosConfigSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *VertexList) String() string {
+func (this *GeneratorSource) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForItems := "[]Vertex{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Vertex", "Vertex", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&VertexList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
+	s := strings.Join([]string{`&GeneratorSource{`,
+		`RPU:` + valueToStringGenerated(this.RPU) + `,`,
+		`Duration:` + strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "v11.Duration", 1) + `,`,
+		`MsgSize:` + valueToStringGenerated(this.MsgSize) + `,`,
+		`KeyCount:` + valueToStringGenerated(this.KeyCount) + `,`,
+		`Value:` + valueToStringGenerated(this.Value) + `,`,
+		`Template:` + valueToStringGenerated(this.Template) + `,`,
+		`KeyDistribution:` + strings.Replace(this.KeyDistribution.String(), "KeyDistribution", "KeyDistribution", 1) + `,`,
+		`Jitter:` + strings.Replace(fmt.Sprintf("%v", this.Jitter), "Duration", "v11.Duration", 1) + `,`,
+		`LatePercent:` + valueToStringGenerated(this.LatePercent) + `,`,
+		`LateBy:` + strings.Replace(fmt.Sprintf("%v", this.LateBy), "Duration", "v11.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *VertexSpec) String() string {
+func (this *GetDaemonDeploymentReq) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForFromEdges := "[]CombinedEdge{"
-	for _, f := range this.FromEdges {
-		repeatedStringForFromEdges += strings.Replace(strings.Replace(f.String(), "CombinedEdge", "CombinedEdge", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForFromEdges += "}"
-	repeatedStringForToEdges := "[]CombinedEdge{"
-	for _, f := range this.ToEdges {
-		repeatedStringForToEdges += strings.Replace(strings.Replace(f.String(), "CombinedEdge", "CombinedEdge", 1), `&`, ``, 1) + ","
+	repeatedStringForEnv := "[]EnvVar{"
+	for _, f := range this.Env {
+		repeatedStringForEnv += fmt.Sprintf("%v", f) + ","
 	}
-	repeatedStringForToEdges += "}"
-	s := strings.Join([]string{`&VertexSpec{`,
-		`AbstractVertex:` + strings.Replace(strings.Replace(this.AbstractVertex.String(), "AbstractVertex", "AbstractVertex", 1), `&`, ``, 1) + `,`,
-		`PipelineName:` + fmt.Sprintf("%v", this.PipelineName) + `,`,
-		`InterStepBufferServiceName:` + fmt.Sprintf("%v", this.InterStepBufferServiceName) + `,`,
-		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
-		`FromEdges:` + repeatedStringForFromEdges + `,`,
-		`ToEdges:` + repeatedStringForToEdges + `,`,
-		`Watermark:` + strings.Replace(strings.Replace(this.Watermark.String(), "Watermark", "Watermark", 1), `&`, ``, 1) + `,`,
+	repeatedStringForEnv += "}"
+	s := strings.Join([]string{`&GetDaemonDeploymentReq{`,
+		`ISBSvcType:` + fmt.Sprintf("%v", this.ISBSvcType) + `,`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`PullPolicy:` + fmt.Sprintf("%v", this.PullPolicy) + `,`,
+		`Env:` + repeatedStringForEnv + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *VertexStatus) String() string {
+func (this *GetJetStreamServiceSpecReq) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&VertexStatus{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`Replicas:` + fmt.Sprintf("%v", this.Replicas) + `,`,
-		`LastScaledAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastScaledAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
-		`Selector:` + fmt.Sprintf("%v", this.Selector) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&GetJetStreamServiceSpecReq{`,
+		`Labels:` + mapStringForLabels + `,`,
+		`ClusterPort:` + fmt.Sprintf("%v", this.ClusterPort) + `,`,
+		`ClientPort:` + fmt.Sprintf("%v", this.ClientPort) + `,`,
+		`MonitorPort:` + fmt.Sprintf("%v", this.MonitorPort) + `,`,
+		`MetricsPort:` + fmt.Sprintf("%v", this.MetricsPort) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *Watermark) String() string {
+func (this *GetJetStreamStatefulSetSpecReq) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Watermark{`,
-		`Disabled:` + fmt.Sprintf("%v", this.Disabled) + `,`,
-		`MaxDelay:` + strings.Replace(fmt.Sprintf("%v", this.MaxDelay), "Duration", "v11.Duration", 1) + `,`,
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&GetJetStreamStatefulSetSpecReq{`,
+		`ServiceName:` + fmt.Sprintf("%v", this.ServiceName) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`NatsImage:` + fmt.Sprintf("%v", this.NatsImage) + `,`,
+		`MetricsExporterImage:` + fmt.Sprintf("%v", this.MetricsExporterImage) + `,`,
+		`ConfigReloaderImage:` + fmt.Sprintf("%v", this.ConfigReloaderImage) + `,`,
+		`ClusterPort:` + fmt.Sprintf("%v", this.ClusterPort) + `,`,
+		`ClientPort:` + fmt.Sprintf("%v", this.ClientPort) + `,`,
+		`MonitorPort:` + fmt.Sprintf("%v", this.MonitorPort) + `,`,
+		`MetricsPort:` + fmt.Sprintf("%v", this.MetricsPort) + `,`,
+		`ServerAuthSecretName:` + fmt.Sprintf("%v", this.ServerAuthSecretName) + `,`,
+		`ServerEncryptionSecretName:` + fmt.Sprintf("%v", this.ServerEncryptionSecretName) + `,`,
+		`ConfigMapName:` + fmt.Sprintf("%v", this.ConfigMapName) + `,`,
+		`PvcNameIfNeeded:` + fmt.Sprintf("%v", this.PvcNameIfNeeded) + `,`,
+		`StartCommand:` + fmt.Sprintf("%v", this.StartCommand) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *Window) String() string {
+func (this *GetRedisServiceSpecReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&GetRedisServiceSpecReq{`,
+		`Labels:` + mapStringForLabels + `,`,
+		`RedisContainerPort:` + fmt.Sprintf("%v", this.RedisContainerPort) + `,`,
+		`SentinelContainerPort:` + fmt.Sprintf("%v", this.SentinelContainerPort) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetRedisStatefulSetSpecReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&GetRedisStatefulSetSpecReq{`,
+		`ServiceName:` + fmt.Sprintf("%v", this.ServiceName) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`RedisImage:` + fmt.Sprintf("%v", this.RedisImage) + `,`,
+		`SentinelImage:` + fmt.Sprintf("%v", this.SentinelImage) + `,`,
+		`MetricsExporterImage:` + fmt.Sprintf("%v", this.MetricsExporterImage) + `,`,
+		`InitContainerImage:` + fmt.Sprintf("%v", this.InitContainerImage) + `,`,
+		`RedisContainerPort:` + fmt.Sprintf("%v", this.RedisContainerPort) + `,`,
+		`SentinelContainerPort:` + fmt.Sprintf("%v", this.SentinelContainerPort) + `,`,
+		`RedisMetricsContainerPort:` + fmt.Sprintf("%v", this.RedisMetricsContainerPort) + `,`,
+		`CredentialSecretName:` + fmt.Sprintf("%v", this.CredentialSecretName) + `,`,
+		`TLSEnabled:` + fmt.Sprintf("%v", this.TLSEnabled) + `,`,
+		`PvcNameIfNeeded:` + fmt.Sprintf("%v", this.PvcNameIfNeeded) + `,`,
+		`ConfConfigMapName:` + fmt.Sprintf("%v", this.ConfConfigMapName) + `,`,
+		`ScriptsConfigMapName:` + fmt.Sprintf("%v", this.ScriptsConfigMapName) + `,`,
+		`HealthConfigMapName:` + fmt.Sprintf("%v", this.HealthConfigMapName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetSideInputDeploymentReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEnv := "[]EnvVar{"
+	for _, f := range this.Env {
+		repeatedStringForEnv += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForEnv += "}"
+	s := strings.Join([]string{`&GetSideInputDeploymentReq{`,
+		`ISBSvcType:` + fmt.Sprintf("%v", this.ISBSvcType) + `,`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`PullPolicy:` + fmt.Sprintf("%v", this.PullPolicy) + `,`,
+		`Env:` + repeatedStringForEnv + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetVertexPodSpecReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEnv := "[]EnvVar{"
+	for _, f := range this.Env {
+		repeatedStringForEnv += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForEnv += "}"
+	s := strings.Join([]string{`&GetVertexPodSpecReq{`,
+		`ISBSvcType:` + fmt.Sprintf("%v", this.ISBSvcType) + `,`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`PullPolicy:` + fmt.Sprintf("%v", this.PullPolicy) + `,`,
+		`Env:` + repeatedStringForEnv + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GroupBy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GroupBy{`,
+		`Window:` + strings.Replace(strings.Replace(this.Window.String(), "Window", "Window", 1), `&`, ``, 1) + `,`,
+		`Keyed:` + fmt.Sprintf("%v", this.Keyed) + `,`,
+		`AllowedLateness:` + strings.Replace(fmt.Sprintf("%v", this.AllowedLateness), "Duration", "v11.Duration", 1) + `,`,
+		`Storage:` + strings.Replace(this.Storage.String(), "PBQStorage", "PBQStorage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPSink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{`&HTTPSink{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`Auth:` + strings.Replace(this.Auth.String(), "Authorization", "Authorization", 1) + `,`,
+		`BasicAuth:` + strings.Replace(this.BasicAuth.String(), "BasicAuth", "BasicAuth", 1) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
+		`Batch:` + fmt.Sprintf("%v", this.Batch) + `,`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "v11.Duration", 1) + `,`,
+		`RetryableStatusCodes:` + fmt.Sprintf("%v", this.RetryableStatusCodes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPSource{`,
+		`Auth:` + strings.Replace(this.Auth.String(), "Authorization", "Authorization", 1) + `,`,
+		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InterStepBufferService) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InterStepBufferService{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "InterStepBufferServiceSpec", "InterStepBufferServiceSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "InterStepBufferServiceStatus", "InterStepBufferServiceStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InterStepBufferServiceList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]InterStepBufferService{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "InterStepBufferService", "InterStepBufferService", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&InterStepBufferServiceList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InterStepBufferServiceSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InterStepBufferServiceSpec{`,
+		`Redis:` + strings.Replace(this.Redis.String(), "RedisBufferService", "RedisBufferService", 1) + `,`,
+		`JetStream:` + strings.Replace(this.JetStream.String(), "JetStreamBufferService", "JetStreamBufferService", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InterStepBufferServiceStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InterStepBufferServiceStatus{`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "Status", "Status", 1), `&`, ``, 1) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Config:` + strings.Replace(strings.Replace(this.Config.String(), "BufferServiceConfig", "BufferServiceConfig", 1), `&`, ``, 1) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JetStreamBufferService) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JetStreamBufferService{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
+		`ContainerTemplate:` + strings.Replace(this.ContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
+		`ReloaderContainerTemplate:` + strings.Replace(this.ReloaderContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
+		`MetricsContainerTemplate:` + strings.Replace(this.MetricsContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
+		`Persistence:` + strings.Replace(this.Persistence.String(), "PersistenceStrategy", "PersistenceStrategy", 1) + `,`,
+		`AbstractPodTemplate:` + strings.Replace(strings.Replace(this.AbstractPodTemplate.String(), "AbstractPodTemplate", "AbstractPodTemplate", 1), `&`, ``, 1) + `,`,
+		`Settings:` + valueToStringGenerated(this.Settings) + `,`,
+		`StartArgs:` + fmt.Sprintf("%v", this.StartArgs) + `,`,
+		`BufferConfig:` + valueToStringGenerated(this.BufferConfig) + `,`,
+		`Encryption:` + fmt.Sprintf("%v", this.Encryption) + `,`,
+		`TLS:` + fmt.Sprintf("%v", this.TLS) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JetStreamConfig) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Window{`,
-		`Fixed:` + strings.Replace(this.Fixed.String(), "FixedWindow", "FixedWindow", 1) + `,`,
-		`Sliding:` + strings.Replace(this.Sliding.String(), "SlidingWindow", "SlidingWindow", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	s := strings.Join([]string{`&JetStreamConfig{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Auth:` + strings.Replace(this.Auth.String(), "NatsAuth", "NatsAuth", 1) + `,`,
+		`StreamConfig:` + fmt.Sprintf("%v", this.StreamConfig) + `,`,
+		`TLSEnabled:` + fmt.Sprintf("%v", this.TLSEnabled) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobTemplate{`,
+		`AbstractPodTemplate:` + strings.Replace(strings.Replace(this.AbstractPodTemplate.String(), "AbstractPodTemplate", "AbstractPodTemplate", 1), `&`, ``, 1) + `,`,
+		`ContainerTemplate:` + strings.Replace(this.ContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
+		`TTLSecondsAfterFinished:` + valueToStringGenerated(this.TTLSecondsAfterFinished) + `,`,
+		`BackoffLimit:` + valueToStringGenerated(this.BackoffLimit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KafkaSink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KafkaSink{`,
+		`Brokers:` + fmt.Sprintf("%v", this.Brokers) + `,`,
+		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
+		`Config:` + fmt.Sprintf("%v", this.Config) + `,`,
+		`SASL:` + strings.Replace(this.SASL.String(), "SASL", "SASL", 1) + `,`,
+		`SchemaRegistry:` + strings.Replace(this.SchemaRegistry.String(), "SchemaRegistry", "SchemaRegistry", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KafkaSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KafkaSource{`,
+		`Brokers:` + fmt.Sprintf("%v", this.Brokers) + `,`,
+		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
+		`ConsumerGroupName:` + fmt.Sprintf("%v", this.ConsumerGroupName) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
+		`Config:` + fmt.Sprintf("%v", this.Config) + `,`,
+		`SASL:` + strings.Replace(this.SASL.String(), "SASL", "SASL", 1) + `,`,
+		`SchemaRegistry:` + strings.Replace(this.SchemaRegistry.String(), "SchemaRegistry", "SchemaRegistry", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KeyDistribution) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KeyDistribution{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ZipfExponent:` + fmt.Sprintf("%v", this.ZipfExponent) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Lifecycle) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Lifecycle{`,
+		`DeleteGracePeriodSeconds:` + valueToStringGenerated(this.DeleteGracePeriodSeconds) + `,`,
+		`DesiredPhase:` + fmt.Sprintf("%v", this.DesiredPhase) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Log) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Log{`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`Fields:` + fmt.Sprintf("%v", this.Fields) + `,`,
+		`SamplingRate:` + fmt.Sprintf("%v", this.SamplingRate) + `,`,
+		`MaxPayloadLength:` + valueToStringGenerated(this.MaxPayloadLength) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Metadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&Metadata{`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NativeRedis) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NativeRedis{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
+		`RedisContainerTemplate:` + strings.Replace(this.RedisContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
+		`SentinelContainerTemplate:` + strings.Replace(this.SentinelContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
+		`MetricsContainerTemplate:` + strings.Replace(this.MetricsContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
+		`InitContainerTemplate:` + strings.Replace(this.InitContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
+		`Persistence:` + strings.Replace(this.Persistence.String(), "PersistenceStrategy", "PersistenceStrategy", 1) + `,`,
+		`AbstractPodTemplate:` + strings.Replace(strings.Replace(this.AbstractPodTemplate.String(), "AbstractPodTemplate", "AbstractPodTemplate", 1), `&`, ``, 1) + `,`,
+		`Settings:` + strings.Replace(this.Settings.String(), "RedisSettings", "RedisSettings", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NatsAuth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NatsAuth{`,
+		`Basic:` + strings.Replace(this.Basic.String(), "BasicAuth", "BasicAuth", 1) + `,`,
+		`Token:` + strings.Replace(fmt.Sprintf("%v", this.Token), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`NKey:` + strings.Replace(fmt.Sprintf("%v", this.NKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NatsSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NatsSource{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
+		`Auth:` + strings.Replace(this.Auth.String(), "NatsAuth", "NatsAuth", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PBQStorage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PBQStorage{`,
+		`PersistentVolumeClaim:` + strings.Replace(this.PersistentVolumeClaim.String(), "PersistenceStrategy", "PersistenceStrategy", 1) + `,`,
+		`EmptyDir:` + strings.Replace(fmt.Sprintf("%v", this.EmptyDir), "EmptyDirVolumeSource", "v1.EmptyDirVolumeSource", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ParquetOptions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ParquetOptions{`,
+		`Schema:` + fmt.Sprintf("%v", this.Schema) + `,`,
+		`InferSchemaSampleSize:` + valueToStringGenerated(this.InferSchemaSampleSize) + `,`,
+		`RowGroupSize:` + strings.Replace(fmt.Sprintf("%v", this.RowGroupSize), "Quantity", "resource.Quantity", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PersistenceStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PersistenceStrategy{`,
+		`StorageClassName:` + valueToStringGenerated(this.StorageClassName) + `,`,
+		`AccessMode:` + valueToStringGenerated(this.AccessMode) + `,`,
+		`VolumeSize:` + strings.Replace(fmt.Sprintf("%v", this.VolumeSize), "Quantity", "resource.Quantity", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Pipeline) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Pipeline{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PipelineSpec", "PipelineSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "PipelineStatus", "PipelineStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PipelineLimits) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PipelineLimits{`,
+		`ReadBatchSize:` + valueToStringGenerated(this.ReadBatchSize) + `,`,
+		`BufferMaxLength:` + valueToStringGenerated(this.BufferMaxLength) + `,`,
+		`BufferUsageLimit:` + valueToStringGenerated(this.BufferUsageLimit) + `,`,
+		`ReadTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ReadTimeout), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PipelineList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Pipeline{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Pipeline", "Pipeline", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&PipelineList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PipelineSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVertices := "[]AbstractVertex{"
+	for _, f := range this.Vertices {
+		repeatedStringForVertices += strings.Replace(strings.Replace(f.String(), "AbstractVertex", "AbstractVertex", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForVertices += "}"
+	repeatedStringForEdges := "[]Edge{"
+	for _, f := range this.Edges {
+		repeatedStringForEdges += strings.Replace(strings.Replace(f.String(), "Edge", "Edge", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForEdges += "}"
+	repeatedStringForSideInputs := "[]SideInput{"
+	for _, f := range this.SideInputs {
+		repeatedStringForSideInputs += strings.Replace(strings.Replace(f.String(), "SideInput", "SideInput", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSideInputs += "}"
+	s := strings.Join([]string{`&PipelineSpec{`,
+		`InterStepBufferServiceName:` + fmt.Sprintf("%v", this.InterStepBufferServiceName) + `,`,
+		`Vertices:` + repeatedStringForVertices + `,`,
+		`Edges:` + repeatedStringForEdges + `,`,
+		`Lifecycle:` + strings.Replace(strings.Replace(this.Lifecycle.String(), "Lifecycle", "Lifecycle", 1), `&`, ``, 1) + `,`,
+		`Limits:` + strings.Replace(this.Limits.String(), "PipelineLimits", "PipelineLimits", 1) + `,`,
+		`Watermark:` + strings.Replace(strings.Replace(this.Watermark.String(), "Watermark", "Watermark", 1), `&`, ``, 1) + `,`,
+		`Templates:` + strings.Replace(this.Templates.String(), "Templates", "Templates", 1) + `,`,
+		`SideInputs:` + repeatedStringForSideInputs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PipelineStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PipelineStatus{`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "Status", "Status", 1), `&`, ``, 1) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`LastUpdated:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdated), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`VertexCount:` + valueToStringGenerated(this.VertexCount) + `,`,
+		`SourceCount:` + valueToStringGenerated(this.SourceCount) + `,`,
+		`SinkCount:` + valueToStringGenerated(this.SinkCount) + `,`,
+		`UDFCount:` + valueToStringGenerated(this.UDFCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RedisBufferService) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RedisBufferService{`,
+		`Native:` + strings.Replace(this.Native.String(), "NativeRedis", "NativeRedis", 1) + `,`,
+		`External:` + strings.Replace(this.External.String(), "RedisConfig", "RedisConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RedisConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RedisConfig{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`SentinelURL:` + fmt.Sprintf("%v", this.SentinelURL) + `,`,
+		`MasterName:` + fmt.Sprintf("%v", this.MasterName) + `,`,
+		`User:` + fmt.Sprintf("%v", this.User) + `,`,
+		`Password:` + strings.Replace(fmt.Sprintf("%v", this.Password), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SentinelPassword:` + strings.Replace(fmt.Sprintf("%v", this.SentinelPassword), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RedisSettings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RedisSettings{`,
+		`Redis:` + fmt.Sprintf("%v", this.Redis) + `,`,
+		`Master:` + fmt.Sprintf("%v", this.Master) + `,`,
+		`Replica:` + fmt.Sprintf("%v", this.Replica) + `,`,
+		`Sentinel:` + fmt.Sprintf("%v", this.Sentinel) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RedisStreamsSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RedisStreamsSource{`,
+		`RedisConfig:` + strings.Replace(strings.Replace(this.RedisConfig.String(), "RedisConfig", "RedisConfig", 1), `&`, ``, 1) + `,`,
+		`Stream:` + fmt.Sprintf("%v", this.Stream) + `,`,
+		`ConsumerGroup:` + fmt.Sprintf("%v", this.ConsumerGroup) + `,`,
+		`ReadFromBeginning:` + fmt.Sprintf("%v", this.ReadFromBeginning) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
+		`Streams:` + fmt.Sprintf("%v", this.Streams) + `,`,
+		`ClaimIdleTime:` + strings.Replace(fmt.Sprintf("%v", this.ClaimIdleTime), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoteUDF) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoteUDF{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "v11.Duration", 1) + `,`,
+		`CircuitBreaker:` + strings.Replace(this.CircuitBreaker.String(), "CircuitBreaker", "CircuitBreaker", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *S3Sink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&S3Sink{`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`AccessKey:` + strings.Replace(fmt.Sprintf("%v", this.AccessKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SecretKey:` + strings.Replace(fmt.Sprintf("%v", this.SecretKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ForcePathStyle:` + fmt.Sprintf("%v", this.ForcePathStyle) + `,`,
+		`Compression:` + fmt.Sprintf("%v", this.Compression) + `,`,
+		`PartSize:` + strings.Replace(fmt.Sprintf("%v", this.PartSize), "Quantity", "resource.Quantity", 1) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SASL) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SASL{`,
+		`Mechanism:` + valueToStringGenerated(this.Mechanism) + `,`,
+		`GSSAPI:` + strings.Replace(this.GSSAPI.String(), "GSSAPI", "GSSAPI", 1) + `,`,
+		`Plain:` + strings.Replace(this.Plain.String(), "SASLPlain", "SASLPlain", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SASLPlain) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SASLPlain{`,
+		`UserSecret:` + strings.Replace(fmt.Sprintf("%v", this.UserSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Handshake:` + fmt.Sprintf("%v", this.Handshake) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SQLArg) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SQLArg{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Metadata:` + fmt.Sprintf("%v", this.Metadata) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SQLSink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForArgs := "[]SQLArg{"
+	for _, f := range this.Args {
+		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "SQLArg", "SQLArg", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArgs += "}"
+	s := strings.Join([]string{`&SQLSink{`,
+		`Driver:` + fmt.Sprintf("%v", this.Driver) + `,`,
+		`DSN:` + strings.Replace(fmt.Sprintf("%v", this.DSN), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Statement:` + fmt.Sprintf("%v", this.Statement) + `,`,
+		`Args:` + repeatedStringForArgs + `,`,
+		`MaxOpenConns:` + valueToStringGenerated(this.MaxOpenConns) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Scale) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Scale{`,
+		`Disabled:` + fmt.Sprintf("%v", this.Disabled) + `,`,
+		`Min:` + valueToStringGenerated(this.Min) + `,`,
+		`Max:` + valueToStringGenerated(this.Max) + `,`,
+		`LookbackSeconds:` + valueToStringGenerated(this.LookbackSeconds) + `,`,
+		`CooldownSeconds:` + valueToStringGenerated(this.CooldownSeconds) + `,`,
+		`ZeroReplicaSleepSeconds:` + valueToStringGenerated(this.ZeroReplicaSleepSeconds) + `,`,
+		`TargetProcessingSeconds:` + valueToStringGenerated(this.TargetProcessingSeconds) + `,`,
+		`ReplicasPerScale:` + valueToStringGenerated(this.ReplicasPerScale) + `,`,
+		`TargetBufferAvailability:` + valueToStringGenerated(this.TargetBufferAvailability) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SchemaRegistry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SchemaRegistry{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
+		`BasicAuth:` + strings.Replace(this.BasicAuth.String(), "BasicAuth", "BasicAuth", 1) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`OnFailure:` + fmt.Sprintf("%v", this.OnFailure) + `,`,
+		`CacheTTL:` + strings.Replace(fmt.Sprintf("%v", this.CacheTTL), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SideInput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVolumes := "[]Volume{"
+	for _, f := range this.Volumes {
+		repeatedStringForVolumes += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForVolumes += "}"
+	s := strings.Join([]string{`&SideInput{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Container:` + strings.Replace(this.Container.String(), "Container", "Container", 1) + `,`,
+		`Volumes:` + repeatedStringForVolumes + `,`,
+		`Trigger:` + strings.Replace(this.Trigger.String(), "SideInputTrigger", "SideInputTrigger", 1) + `,`,
+		`Builtin:` + strings.Replace(this.Builtin.String(), "SideInputBuiltin", "SideInputBuiltin", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SideInputBuiltin) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForKWArgs := make([]string, 0, len(this.KWArgs))
+	for k := range this.KWArgs {
+		keysForKWArgs = append(keysForKWArgs, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForKWArgs)
+	mapStringForKWArgs := "map[string]string{"
+	for _, k := range keysForKWArgs {
+		mapStringForKWArgs += fmt.Sprintf("%v: %v,", k, this.KWArgs[k])
+	}
+	mapStringForKWArgs += "}"
+	s := strings.Join([]string{`&SideInputBuiltin{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`KWArgs:` + mapStringForKWArgs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SideInputTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SideInputTrigger{`,
+		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
+		`Interval:` + strings.Replace(fmt.Sprintf("%v", this.Interval), "Duration", "v11.Duration", 1) + `,`,
+		`Timezone:` + valueToStringGenerated(this.Timezone) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Sink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Sink{`,
+		`AbstractSink:` + strings.Replace(strings.Replace(this.AbstractSink.String(), "AbstractSink", "AbstractSink", 1), `&`, ``, 1) + `,`,
+		`Fallback:` + strings.Replace(this.Fallback.String(), "AbstractSink", "AbstractSink", 1) + `,`,
+		`MaxRetries:` + valueToStringGenerated(this.MaxRetries) + `,`,
+		`Batching:` + strings.Replace(this.Batching.String(), "SinkBatching", "SinkBatching", 1) + `,`,
+		`RateLimit:` + strings.Replace(this.RateLimit.String(), "SinkRateLimit", "SinkRateLimit", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SinkBatching) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SinkBatching{`,
+		`MaxBatchSize:` + valueToStringGenerated(this.MaxBatchSize) + `,`,
+		`MaxBatchBytes:` + strings.Replace(fmt.Sprintf("%v", this.MaxBatchBytes), "Quantity", "resource.Quantity", 1) + `,`,
+		`MaxLatency:` + strings.Replace(fmt.Sprintf("%v", this.MaxLatency), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SinkRateLimit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SinkRateLimit{`,
+		`MessagesPerSecond:` + valueToStringGenerated(this.MessagesPerSecond) + `,`,
+		`MessageBurst:` + valueToStringGenerated(this.MessageBurst) + `,`,
+		`BytesPerSecond:` + strings.Replace(fmt.Sprintf("%v", this.BytesPerSecond), "Quantity", "resource.Quantity", 1) + `,`,
+		`ByteBurst:` + strings.Replace(fmt.Sprintf("%v", this.ByteBurst), "Quantity", "resource.Quantity", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SlidingWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SlidingWindow{`,
+		`Length:` + strings.Replace(fmt.Sprintf("%v", this.Length), "Duration", "v11.Duration", 1) + `,`,
+		`Slide:` + strings.Replace(fmt.Sprintf("%v", this.Slide), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Source) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Source{`,
+		`Generator:` + strings.Replace(this.Generator.String(), "GeneratorSource", "GeneratorSource", 1) + `,`,
+		`Kafka:` + strings.Replace(this.Kafka.String(), "KafkaSource", "KafkaSource", 1) + `,`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPSource", "HTTPSource", 1) + `,`,
+		`Nats:` + strings.Replace(this.Nats.String(), "NatsSource", "NatsSource", 1) + `,`,
+		`RedisStreams:` + strings.Replace(this.RedisStreams.String(), "RedisStreamsSource", "RedisStreamsSource", 1) + `,`,
+		`UDTransformer:` + strings.Replace(this.UDTransformer.String(), "UDTransformer", "UDTransformer", 1) + `,`,
+		`File:` + strings.Replace(this.File.String(), "FileSource", "FileSource", 1) + `,`,
+		`GRPC:` + strings.Replace(this.GRPC.String(), "GRPCSource", "GRPCSource", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Status) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForConditions += "}"
+	s := strings.Join([]string{`&Status{`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TLS) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TLS{`,
+		`InsecureSkipVerify:` + fmt.Sprintf("%v", this.InsecureSkipVerify) + `,`,
+		`CACertSecret:` + strings.Replace(fmt.Sprintf("%v", this.CACertSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`CertSecret:` + strings.Replace(fmt.Sprintf("%v", this.CertSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`KeySecret:` + strings.Replace(fmt.Sprintf("%v", this.KeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TagConditions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TagConditions{`,
+		`Operator:` + valueToStringGenerated(this.Operator) + `,`,
+		`Values:` + fmt.Sprintf("%v", this.Values) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Templates) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Templates{`,
+		`DaemonTemplate:` + strings.Replace(this.DaemonTemplate.String(), "DaemonTemplate", "DaemonTemplate", 1) + `,`,
+		`JobTemplate:` + strings.Replace(this.JobTemplate.String(), "JobTemplate", "JobTemplate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Transformer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForKWArgs := make([]string, 0, len(this.KWArgs))
+	for k := range this.KWArgs {
+		keysForKWArgs = append(keysForKWArgs, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForKWArgs)
+	mapStringForKWArgs := "map[string]string{"
+	for _, k := range keysForKWArgs {
+		mapStringForKWArgs += fmt.Sprintf("%v: %v,", k, this.KWArgs[k])
+	}
+	mapStringForKWArgs += "}"
+	s := strings.Join([]string{`&Transformer{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
+		`KWArgs:` + mapStringForKWArgs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UDF) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UDF{`,
+		`Container:` + strings.Replace(this.Container.String(), "Container", "Container", 1) + `,`,
+		`Builtin:` + strings.Replace(this.Builtin.String(), "Function", "Function", 1) + `,`,
+		`GroupBy:` + strings.Replace(this.GroupBy.String(), "GroupBy", "GroupBy", 1) + `,`,
+		`Ordering:` + fmt.Sprintf("%v", this.Ordering) + `,`,
+		`Wasm:` + strings.Replace(this.Wasm.String(), "Wasm", "Wasm", 1) + `,`,
+		`Remote:` + strings.Replace(this.Remote.String(), "RemoteUDF", "RemoteUDF", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UDSink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UDSink{`,
+		`Container:` + strings.Replace(strings.Replace(this.Container.String(), "Container", "Container", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UDTransformer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UDTransformer{`,
+		`Container:` + strings.Replace(this.Container.String(), "Container", "Container", 1) + `,`,
+		`Builtin:` + strings.Replace(this.Builtin.String(), "Transformer", "Transformer", 1) + `,`,
+		`Wasm:` + strings.Replace(this.Wasm.String(), "Wasm", "Wasm", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Vertex) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Vertex{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "VertexSpec", "VertexSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "VertexStatus", "VertexStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VertexInstance) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VertexInstance{`,
+		`Vertex:` + strings.Replace(this.Vertex.String(), "Vertex", "Vertex", 1) + `,`,
+		`Hostname:` + fmt.Sprintf("%v", this.Hostname) + `,`,
+		`Replica:` + fmt.Sprintf("%v", this.Replica) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VertexLimits) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VertexLimits{`,
+		`ReadBatchSize:` + valueToStringGenerated(this.ReadBatchSize) + `,`,
+		`ReadTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ReadTimeout), "Duration", "v11.Duration", 1) + `,`,
+		`BufferMaxLength:` + valueToStringGenerated(this.BufferMaxLength) + `,`,
+		`BufferUsageLimit:` + valueToStringGenerated(this.BufferUsageLimit) + `,`,
+		`UDFTimeout:` + strings.Replace(fmt.Sprintf("%v", this.UDFTimeout), "Duration", "v11.Duration", 1) + `,`,
+		`UDFCircuitBreaker:` + strings.Replace(this.UDFCircuitBreaker.String(), "CircuitBreaker", "CircuitBreaker", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VertexList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Vertex{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Vertex", "Vertex", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&VertexList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VertexSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFromEdges := "[]CombinedEdge{"
+	for _, f := range this.FromEdges {
+		repeatedStringForFromEdges += strings.Replace(strings.Replace(f.String(), "CombinedEdge", "CombinedEdge", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForFromEdges += "}"
+	repeatedStringForToEdges := "[]CombinedEdge{"
+	for _, f := range this.ToEdges {
+		repeatedStringForToEdges += strings.Replace(strings.Replace(f.String(), "CombinedEdge", "CombinedEdge", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForToEdges += "}"
+	s := strings.Join([]string{`&VertexSpec{`,
+		`AbstractVertex:` + strings.Replace(strings.Replace(this.AbstractVertex.String(), "AbstractVertex", "AbstractVertex", 1), `&`, ``, 1) + `,`,
+		`PipelineName:` + fmt.Sprintf("%v", this.PipelineName) + `,`,
+		`InterStepBufferServiceName:` + fmt.Sprintf("%v", this.InterStepBufferServiceName) + `,`,
+		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
+		`FromEdges:` + repeatedStringForFromEdges + `,`,
+		`ToEdges:` + repeatedStringForToEdges + `,`,
+		`Watermark:` + strings.Replace(strings.Replace(this.Watermark.String(), "Watermark", "Watermark", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VertexStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VertexStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Replicas:` + fmt.Sprintf("%v", this.Replicas) + `,`,
+		`LastScaledAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastScaledAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`Selector:` + fmt.Sprintf("%v", this.Selector) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Wasm) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Wasm{`,
+		`ConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMap), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`VolumeName:` + fmt.Sprintf("%v", this.VolumeName) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`MemoryLimitPages:` + valueToStringGenerated(this.MemoryLimitPages) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Watermark) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Watermark{`,
+		`Disabled:` + fmt.Sprintf("%v", this.Disabled) + `,`,
+		`MaxDelay:` + strings.Replace(fmt.Sprintf("%v", this.MaxDelay), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Window) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Window{`,
+		`Fixed:` + strings.Replace(this.Fixed.String(), "FixedWindow", "FixedWindow", 1) + `,`,
+		`Sliding:` + strings.Replace(this.Sliding.String(), "SlidingWindow", "SlidingWindow", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AbstractPodTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AbstractPodTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AbstractPodTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecurityContext == nil {
+				m.SecurityContext = &v1.PodSecurityContext{}
+			}
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImagePullSecrets = append(m.ImagePullSecrets, v1.LocalObjectReference{})
+			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Priority = &v
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Affinity == nil {
+				m.Affinity = &v1.Affinity{}
+			}
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.RuntimeClassName = &s
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutomountServiceAccountToken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.AutomountServiceAccountToken = &b
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DNSPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DNSPolicy = k8s_io_api_core_v1.DNSPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DNSConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DNSConfig == nil {
+				m.DNSConfig = &v1.PodDNSConfig{}
+			}
+			if err := m.DNSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AbstractSink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AbstractSink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AbstractSink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Log == nil {
+				m.Log = &Log{}
+			}
+			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kafka == nil {
+				m.Kafka = &KafkaSink{}
+			}
+			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blackhole", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Blackhole == nil {
+				m.Blackhole = &Blackhole{}
+			}
+			if err := m.Blackhole.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UDSink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UDSink == nil {
+				m.UDSink = &UDSink{}
+			}
+			if err := m.UDSink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HTTP == nil {
+				m.HTTP = &HTTPSink{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &FileSink{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.S3 == nil {
+				m.S3 = &S3Sink{}
+			}
+			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SQL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SQL == nil {
+				m.SQL = &SQLSink{}
+			}
+			if err := m.SQL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AbstractVertex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AbstractVertex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AbstractVertex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Source == nil {
+				m.Source = &Source{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sink == nil {
+				m.Sink = &Sink{}
+			}
+			if err := m.Sink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UDF", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UDF == nil {
+				m.UDF = &UDF{}
+			}
+			if err := m.UDF.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContainerTemplate == nil {
+				m.ContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InitContainerTemplate == nil {
+				m.InitContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.InitContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volumes = append(m.Volumes, v1.Volume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limits == nil {
+				m.Limits = &VertexLimits{}
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Scale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitContainers = append(m.InitContainers, v1.Container{})
+			if err := m.InitContainers[len(m.InitContainers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sidecars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sidecars = append(m.Sidecars, v1.Container{})
+			if err := m.Sidecars[len(m.Sidecars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Partitions = &v
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SideInputs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SideInputs = append(m.SideInputs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Authorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Authorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Authorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Token == nil {
+				m.Token = &v1.SecretKeySelector{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BasicAuth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasicAuth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasicAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.User == nil {
+				m.User = &v1.SecretKeySelector{}
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Blackhole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Blackhole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Blackhole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BufferServiceConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BufferServiceConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BufferServiceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Redis == nil {
+				m.Redis = &RedisConfig{}
+			}
+			if err := m.Redis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JetStream == nil {
+				m.JetStream = &JetStreamConfig{}
+			}
+			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureThreshold", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailureThreshold = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OpenDuration == nil {
+				m.OpenDuration = &v11.Duration{}
+			}
+			if err := m.OpenDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CombinedEdge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CombinedEdge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Edge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVertexType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromVertexType = VertexType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVertexPartitionCount", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FromVertexPartitionCount = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVertexLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FromVertexLimits == nil {
+				m.FromVertexLimits = &VertexLimits{}
+			}
+			if err := m.FromVertexLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVertexType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToVertexType = VertexType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVertexPartitionCount", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ToVertexPartitionCount = &v
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVertexLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ToVertexLimits == nil {
+				m.ToVertexLimits = &VertexLimits{}
+			}
+			if err := m.ToVertexLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Container) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Container: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Container: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvFrom = append(m.EnvFrom, v1.EnvFromSource{})
+			if err := m.EnvFrom[len(m.EnvFrom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
+			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecurityContext == nil {
+				m.SecurityContext = &v1.SecurityContext{}
+			}
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			m.ImagePullPolicy = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImagePullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecurityContext == nil {
+				m.SecurityContext = &v1.SecurityContext{}
+			}
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvFrom = append(m.EnvFrom, v1.EnvFromSource{})
+			if err := m.EnvFrom[len(m.EnvFrom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DaemonTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DaemonTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DaemonTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicas = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContainerTemplate == nil {
+				m.ContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InitContainerTemplate == nil {
+				m.InitContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.InitContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Edge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Edge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Edge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Conditions == nil {
+				m.Conditions = &ForwardConditions{}
+			}
+			if err := m.Conditions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnFull", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := BufferFullWritingStrategy(dAtA[iNdEx:postIndex])
+			m.OnFull = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileEventTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileEventTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileEventTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileSink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileSink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileSink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Compression = FileCompression(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionDuration == nil {
+				m.PartitionDuration = &v11.Duration{}
+			}
+			if err := m.PartitionDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitionFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFileSize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxFileSize == nil {
+				m.MaxFileSize = &resource.Quantity{}
+			}
+			if err := m.MaxFileSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RollInterval == nil {
+				m.RollInterval = &v11.Duration{}
+			}
+			if err := m.RollInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = FileSinkFormat(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parquet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Parquet == nil {
+				m.Parquet = &ParquetOptions{}
+			}
+			if err := m.Parquet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = FileFormat(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Follow = &b
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PollInterval == nil {
+				m.PollInterval = &v11.Duration{}
+			}
+			if err := m.PollInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckpointPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EventTime == nil {
+				m.EventTime = &FileEventTime{}
+			}
+			if err := m.EventTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FixedWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FixedWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FixedWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Length == nil {
+				m.Length = &v11.Duration{}
+			}
+			if err := m.Length.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardConditions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardConditions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardConditions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tags == nil {
+				m.Tags = &TagConditions{}
+			}
+			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Function) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Function: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Function: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KWArgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KWArgs == nil {
+				m.KWArgs = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.KWArgs[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GRPCSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GRPCSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GRPCSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auth == nil {
+				m.Auth = &Authorization{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Service = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Window = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GSSAPI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GSSAPI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GSSAPI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Realm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Realm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := KRB5AuthType(dAtA[iNdEx:postIndex])
+			m.AuthType = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeytabSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KeytabSecret == nil {
+				m.KeytabSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KerberosConfigSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KerberosConfigSecret == nil {
+				m.KerberosConfigSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KerberosConfigSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeneratorSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeneratorSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RPU", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RPU = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Duration == nil {
+				m.Duration = &v11.Duration{}
+			}
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgSize", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MsgSize = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyCount", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeyCount = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Value = &v
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Template = &s
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KeyDistribution == nil {
+				m.KeyDistribution = &KeyDistribution{}
+			}
+			if err := m.KeyDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jitter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Jitter == nil {
+				m.Jitter = &v11.Duration{}
+			}
+			if err := m.Jitter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatePercent", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LatePercent = &v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LateBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LateBy == nil {
+				m.LateBy = &v11.Duration{}
+			}
+			if err := m.LateBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *AbstractPodTemplate) Unmarshal(dAtA []byte) error {
+func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9879,17 +18172,17 @@ func (m *AbstractPodTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AbstractPodTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDaemonDeploymentReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AbstractPodTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDaemonDeploymentReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9899,33 +18192,29 @@ func (m *AbstractPodTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9935,124 +18224,29 @@ func (m *AbstractPodTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeSelector == nil {
-				m.NodeSelector = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.NodeSelector[mapkey] = mapvalue
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10062,29 +18256,27 @@ func (m *AbstractPodTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
-			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10111,52 +18303,66 @@ func (m *AbstractPodTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.PodSecurityContext{}
-			}
-			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImagePullSecrets = append(m.ImagePullSecrets, v1.LocalObjectReference{})
-			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetJetStreamServiceSpecReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetJetStreamServiceSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10166,29 +18372,124 @@ func (m *AbstractPodTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterPort", wireType)
 			}
-			var v int32
+			m.ClusterPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10198,17 +18499,16 @@ func (m *AbstractPodTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				m.ClusterPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Priority = &v
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientPort", wireType)
 			}
-			var msglen int
+			m.ClientPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10218,33 +18518,16 @@ func (m *AbstractPodTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ClientPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Affinity == nil {
-				m.Affinity = &v1.Affinity{}
-			}
-			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonitorPort", wireType)
 			}
-			var stringLen uint64
+			m.MonitorPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10254,29 +18537,16 @@ func (m *AbstractPodTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MonitorPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeClassName", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsPort", wireType)
 			}
-			var stringLen uint64
+			m.MetricsPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10286,49 +18556,64 @@ func (m *AbstractPodTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MetricsPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.RuntimeClassName = &s
-			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutomountServiceAccountToken", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			b := bool(v != 0)
-			m.AutomountServiceAccountToken = &b
-		case 12:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetJetStreamStatefulSetSpecReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetJetStreamStatefulSetSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DNSPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10356,11 +18641,11 @@ func (m *AbstractPodTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DNSPolicy = k8s_io_api_core_v1.DNSPolicy(dAtA[iNdEx:postIndex])
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DNSConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10387,66 +18672,107 @@ func (m *AbstractPodTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DNSConfig == nil {
-				m.DNSConfig = &v1.PodDNSConfig{}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			if err := m.DNSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AbstractVertex) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AbstractVertex: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AbstractVertex: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NatsImage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10474,13 +18800,13 @@ func (m *AbstractVertex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.NatsImage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsExporterImage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10490,33 +18816,29 @@ func (m *AbstractVertex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Source == nil {
-				m.Source = &Source{}
-			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MetricsExporterImage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigReloaderImage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10526,33 +18848,86 @@ func (m *AbstractVertex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sink == nil {
-				m.Sink = &Sink{}
+			m.ConfigReloaderImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterPort", wireType)
 			}
-			if err := m.Sink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ClusterPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientPort", wireType)
+			}
+			m.ClientPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonitorPort", wireType)
+			}
+			m.MonitorPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonitorPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UDF", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsPort", wireType)
 			}
-			var msglen int
+			m.MetricsPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10562,33 +18937,16 @@ func (m *AbstractVertex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MetricsPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UDF == nil {
-				m.UDF = &UDF{}
-			}
-			if err := m.UDF.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerAuthSecretName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10598,33 +18956,29 @@ func (m *AbstractVertex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContainerTemplate == nil {
-				m.ContainerTemplate = &ContainerTemplate{}
-			}
-			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServerAuthSecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerEncryptionSecretName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10634,33 +18988,29 @@ func (m *AbstractVertex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InitContainerTemplate == nil {
-				m.InitContainerTemplate = &ContainerTemplate{}
-			}
-			if err := m.InitContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServerEncryptionSecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10670,30 +19020,29 @@ func (m *AbstractVertex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PvcNameIfNeeded", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10703,31 +19052,29 @@ func (m *AbstractVertex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, v1.Volume{})
-			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PvcNameIfNeeded = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartCommand", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10737,31 +19084,77 @@ func (m *AbstractVertex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Limits == nil {
-				m.Limits = &VertexLimits{}
-			}
-			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StartCommand = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRedisServiceSpecReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRedisServiceSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10788,49 +19181,109 @@ func (m *AbstractVertex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Scale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitContainers", wireType)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InitContainers = append(m.InitContainers, v1.Container{})
-			if err := m.InitContainers[len(m.InitContainers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sidecars", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerPort", wireType)
 			}
-			var msglen int
+			m.RedisContainerPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10840,31 +19293,16 @@ func (m *AbstractVertex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RedisContainerPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sidecars = append(m.Sidecars, v1.Container{})
-			if err := m.Sidecars[len(m.Sidecars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerPort", wireType)
 			}
-			var v int32
+			m.SentinelContainerPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10874,12 +19312,11 @@ func (m *AbstractVertex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				m.SentinelContainerPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Partitions = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10901,7 +19338,7 @@ func (m *AbstractVertex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Authorization) Unmarshal(dAtA []byte) error {
+func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10924,17 +19361,17 @@ func (m *Authorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Authorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRedisStatefulSetSpecReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Authorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRedisStatefulSetSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10944,83 +19381,156 @@ func (m *Authorization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Token == nil {
-				m.Token = &v1.SecretKeySelector{}
-			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BasicAuth) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BasicAuth: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BasicAuth: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisImage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11030,33 +19540,29 @@ func (m *BasicAuth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.User == nil {
-				m.User = &v1.SecretKeySelector{}
-			}
-			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RedisImage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelImage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11066,133 +19572,29 @@ func (m *BasicAuth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Password == nil {
-				m.Password = &v1.SecretKeySelector{}
-			}
-			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SentinelImage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Blackhole) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Blackhole: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Blackhole: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BufferServiceConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BufferServiceConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BufferServiceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsExporterImage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11202,33 +19604,29 @@ func (m *BufferServiceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Redis == nil {
-				m.Redis = &RedisConfig{}
-			}
-			if err := m.Redis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MetricsExporterImage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerImage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11238,83 +19636,48 @@ func (m *BufferServiceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JetStream == nil {
-				m.JetStream = &JetStreamConfig{}
-			}
-			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InitContainerImage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerPort", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CombinedEdge: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CombinedEdge: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Edge", wireType)
+			m.RedisContainerPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedisContainerPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerPort", wireType)
+			}
+			m.SentinelContainerPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11324,28 +19687,33 @@ func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SentinelContainerPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisMetricsContainerPort", wireType)
 			}
-			if err := m.Edge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RedisMetricsContainerPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedisMetricsContainerPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromVertexType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSecretName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11373,13 +19741,13 @@ func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromVertexType = VertexType(dAtA[iNdEx:postIndex])
+			m.CredentialSecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromVertexPartitionCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSEnabled", wireType)
 			}
-			var v int32
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11389,17 +19757,17 @@ func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FromVertexPartitionCount = &v
-		case 4:
+			m.TLSEnabled = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromVertexLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PvcNameIfNeeded", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11409,31 +19777,27 @@ func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FromVertexLimits == nil {
-				m.FromVertexLimits = &VertexLimits{}
-			}
-			if err := m.FromVertexLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PvcNameIfNeeded = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToVertexType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfConfigMapName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11461,13 +19825,13 @@ func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToVertexType = VertexType(dAtA[iNdEx:postIndex])
+			m.ConfConfigMapName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToVertexPartitionCount", wireType)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptsConfigMapName", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11477,17 +19841,29 @@ func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ToVertexPartitionCount = &v
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScriptsConfigMapName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToVertexLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthConfigMapName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11497,27 +19873,23 @@ func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ToVertexLimits == nil {
-				m.ToVertexLimits = &VertexLimits{}
-			}
-			if err := m.ToVertexLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HealthConfigMapName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11540,7 +19912,7 @@ func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Container) Unmarshal(dAtA []byte) error {
+func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11563,15 +19935,15 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Container: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSideInputDeploymentReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Container: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSideInputDeploymentReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11599,11 +19971,11 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11631,11 +20003,11 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11663,7 +20035,7 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -11699,45 +20071,61 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnvFrom", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EnvFrom = append(m.EnvFrom, v1.EnvFromSource{})
-			if err := m.EnvFrom[len(m.EnvFrom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetVertexPodSpecReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetVertexPodSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11747,31 +20135,29 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
-			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11781,30 +20167,29 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11814,33 +20199,29 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.SecurityContext{}
-			}
-			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11850,24 +20231,25 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
-			m.ImagePullPolicy = &s
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11890,7 +20272,7 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
+func (m *GroupBy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11913,15 +20295,15 @@ func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: GroupBy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GroupBy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11948,15 +20330,15 @@ func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullPolicy", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keyed", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11966,27 +20348,15 @@ func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ImagePullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Keyed = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedLateness", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12013,16 +20383,16 @@ func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.SecurityContext{}
+			if m.AllowedLateness == nil {
+				m.AllowedLateness = &v11.Duration{}
 			}
-			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AllowedLateness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12049,42 +20419,10 @@ func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnvFrom", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Storage == nil {
+				m.Storage = &PBQStorage{}
 			}
-			m.EnvFrom = append(m.EnvFrom, v1.EnvFromSource{})
-			if err := m.EnvFrom[len(m.EnvFrom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12109,7 +20447,7 @@ func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DaemonTemplate) Unmarshal(dAtA []byte) error {
+func (m *HTTPSink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12132,17 +20470,17 @@ func (m *DaemonTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DaemonTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPSink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DaemonTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPSink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12152,50 +20490,29 @@ func (m *DaemonTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Replicas = &v
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12205,31 +20522,27 @@ func (m *DaemonTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContainerTemplate == nil {
-				m.ContainerTemplate = &ContainerTemplate{}
-			}
-			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12256,68 +20569,109 @@ func (m *DaemonTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InitContainerTemplate == nil {
-				m.InitContainerTemplate = &ContainerTemplate{}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
 			}
-			if err := m.InitContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Headers[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Edge) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Edge: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Edge: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12327,29 +20681,33 @@ func (m *Edge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			if m.Auth == nil {
+				m.Auth = &Authorization{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12359,27 +20717,31 @@ func (m *Edge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			if m.BasicAuth == nil {
+				m.BasicAuth = &BasicAuth{}
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12406,18 +20768,18 @@ func (m *Edge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Conditions == nil {
-				m.Conditions = &ForwardConditions{}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
 			}
-			if err := m.Conditions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnFull", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12427,78 +20789,15 @@ func (m *Edge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := BufferFullWritingStrategy(dAtA[iNdEx:postIndex])
-			m.OnFull = &s
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FixedWindow) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FixedWindow: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FixedWindow: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Batch = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12525,13 +20824,89 @@ func (m *FixedWindow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Length == nil {
-				m.Length = &v11.Duration{}
+			if m.Timeout == nil {
+				m.Timeout = &v11.Duration{}
 			}
-			if err := m.Length.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RetryableStatusCodes = append(m.RetryableStatusCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RetryableStatusCodes) == 0 {
+					m.RetryableStatusCodes = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RetryableStatusCodes = append(m.RetryableStatusCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryableStatusCodes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12553,7 +20928,7 @@ func (m *FixedWindow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ForwardConditions) Unmarshal(dAtA []byte) error {
+func (m *HTTPSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12576,15 +20951,15 @@ func (m *ForwardConditions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ForwardConditions: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ForwardConditions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12611,13 +20986,33 @@ func (m *ForwardConditions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tags == nil {
-				m.Tags = &TagConditions{}
+			if m.Auth == nil {
+				m.Auth = &Authorization{}
 			}
-			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Service = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12639,7 +21034,7 @@ func (m *ForwardConditions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Function) Unmarshal(dAtA []byte) error {
+func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12662,17 +21057,17 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Function: wiretype end group for non-group")
+			return fmt.Errorf("proto: InterStepBufferService: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Function: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InterStepBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12682,29 +21077,30 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12714,27 +21110,28 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KWArgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12761,103 +21158,9 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KWArgs == nil {
-				m.KWArgs = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.KWArgs[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12880,7 +21183,7 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GSSAPI) Unmarshal(dAtA []byte) error {
+func (m *InterStepBufferServiceList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12903,79 +21206,15 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GSSAPI: wiretype end group for non-group")
+			return fmt.Errorf("proto: InterStepBufferServiceList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GSSAPI: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InterStepBufferServiceList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ServiceName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Realm", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Realm = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12995,56 +21234,20 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := KRB5AuthType(dAtA[iNdEx:postIndex])
-			m.AuthType = &s
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13071,16 +21274,64 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, InterStepBufferService{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterStepBufferServiceSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterStepBufferServiceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeytabSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13107,16 +21358,16 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KeytabSecret == nil {
-				m.KeytabSecret = &v1.SecretKeySelector{}
+			if m.Redis == nil {
+				m.Redis = &RedisBufferService{}
 			}
-			if err := m.KeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Redis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KerberosConfigSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13143,10 +21394,10 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KerberosConfigSecret == nil {
-				m.KerberosConfigSecret = &v1.SecretKeySelector{}
+			if m.JetStream == nil {
+				m.JetStream = &JetStreamBufferService{}
 			}
-			if err := m.KerberosConfigSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13171,7 +21422,7 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
+func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13194,17 +21445,17 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeneratorSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: InterStepBufferServiceStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeneratorSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InterStepBufferServiceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RPU", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var v int64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13214,17 +21465,30 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RPU = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13234,33 +21498,29 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Duration == nil {
-				m.Duration = &v11.Duration{}
-			}
-			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = ISBSvcPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13270,17 +21530,29 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MsgSize = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13290,17 +21562,30 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.KeyCount = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var v uint64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13310,12 +21595,24 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Value = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = ISBSvcType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13337,7 +21634,7 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
+func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13356,21 +21653,73 @@ func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetDaemonDeploymentReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDaemonDeploymentReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JetStreamBufferService: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JetStreamBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicas = &v
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13380,29 +21729,33 @@ func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
+			if m.ContainerTemplate == nil {
+				m.ContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReloaderContainerTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13412,29 +21765,33 @@ func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			if m.ReloaderContainerTemplate == nil {
+				m.ReloaderContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ReloaderContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsContainerTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13444,27 +21801,31 @@ func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			if m.MetricsContainerTemplate == nil {
+				m.MetricsContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.MetricsContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13491,64 +21852,16 @@ func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Persistence == nil {
+				m.Persistence = &PersistenceStrategy{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetJetStreamServiceSpecReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetJetStreamServiceSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13575,109 +21888,48 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Settings = &s
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterPort", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartArgs", wireType)
 			}
-			m.ClusterPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13687,16 +21939,29 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterPort |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientPort", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ClientPort = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartArgs = append(m.StartArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferConfig", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13706,16 +21971,30 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClientPort |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.BufferConfig = &s
+			iNdEx = postIndex
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonitorPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Encryption", wireType)
 			}
-			m.MonitorPort = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13725,16 +22004,17 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MonitorPort |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			m.Encryption = bool(v != 0)
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
-			m.MetricsPort = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13744,11 +22024,12 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MetricsPort |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.TLS = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13770,197 +22051,38 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
+func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetJetStreamStatefulSetSpecReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetJetStreamStatefulSetSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ServiceName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			m.Labels[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JetStreamConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JetStreamConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NatsImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13988,13 +22110,13 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NatsImage = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsExporterImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14004,27 +22126,31 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricsExporterImage = string(dAtA[iNdEx:postIndex])
+			if m.Auth == nil {
+				m.Auth = &NatsAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigReloaderImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamConfig", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14052,13 +22178,13 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigReloaderImage = string(dAtA[iNdEx:postIndex])
+			m.StreamConfig = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSEnabled", wireType)
 			}
-			m.ClusterPort = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14068,16 +22194,67 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterPort |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientPort", wireType)
+			m.TLSEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ClientPort = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14087,16 +22264,66 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClientPort |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContainerTemplate == nil {
+				m.ContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonitorPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TTLSecondsAfterFinished", wireType)
 			}
-			m.MonitorPort = 0
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14106,16 +22333,87 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MonitorPort |= int32(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			m.TTLSecondsAfterFinished = &v
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BackoffLimit = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KafkaSink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaSink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaSink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
 			}
-			m.MetricsPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14125,14 +22423,27 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MetricsPort |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerAuthSecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14160,13 +22471,13 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerAuthSecretName = string(dAtA[iNdEx:postIndex])
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerEncryptionSecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14176,27 +22487,31 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerEncryptionSecretName = string(dAtA[iNdEx:postIndex])
+			if m.TLS == nil {
+				m.TLS = &TLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14224,13 +22539,13 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
+			m.Config = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PvcNameIfNeeded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14240,29 +22555,33 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PvcNameIfNeeded = string(dAtA[iNdEx:postIndex])
+			if m.SASL == nil {
+				m.SASL = &SASL{}
+			}
+			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 14:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartCommand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaRegistry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14272,23 +22591,27 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartCommand = string(dAtA[iNdEx:postIndex])
+			if m.SchemaRegistry == nil {
+				m.SchemaRegistry = &SchemaRegistry{}
+			}
+			if err := m.SchemaRegistry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14311,7 +22634,7 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
+func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14334,17 +22657,17 @@ func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRedisServiceSpecReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRedisServiceSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14354,124 +22677,93 @@ func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroupName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerGroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerPort", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
-			m.RedisContainerPort = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14481,16 +22773,33 @@ func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RedisContainerPort |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerPort", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.SentinelContainerPort = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14500,66 +22809,29 @@ func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SentinelContainerPort |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetRedisStatefulSetSpecReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRedisStatefulSetSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Config = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14569,27 +22841,31 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			if m.SASL == nil {
+				m.SASL = &SASL{}
+			}
+			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaRegistry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14616,107 +22892,66 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.SchemaRegistry == nil {
+				m.SchemaRegistry = &SchemaRegistry{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.SchemaRegistry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14744,11 +22979,11 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RedisImage = string(dAtA[iNdEx:postIndex])
+			m.Type = KeyDistributionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ZipfExponent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14776,11 +23011,81 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SentinelImage = string(dAtA[iNdEx:postIndex])
+			m.ZipfExponent = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Lifecycle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Lifecycle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Lifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteGracePeriodSeconds", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeleteGracePeriodSeconds = &v
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsExporterImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPhase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14808,11 +23113,61 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricsExporterImage = string(dAtA[iNdEx:postIndex])
+			m.DesiredPhase = PipelinePhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Log) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Log: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14840,13 +23195,13 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitContainerImage = string(dAtA[iNdEx:postIndex])
+			m.Format = LogFormat(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerPort", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
-			m.RedisContainerPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14856,52 +23211,27 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RedisContainerPort |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerPort", wireType)
-			}
-			m.SentinelContainerPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SentinelContainerPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisMetricsContainerPort", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.RedisMetricsContainerPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RedisMetricsContainerPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 10:
+			m.Fields = append(m.Fields, LogField(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SamplingRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14929,13 +23259,13 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CredentialSecretName = string(dAtA[iNdEx:postIndex])
+			m.SamplingRate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayloadLength", wireType)
 			}
-			var v int
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14945,17 +23275,67 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TLSEnabled = bool(v != 0)
-		case 12:
+			m.MaxPayloadLength = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PvcNameIfNeeded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14965,61 +23345,124 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PvcNameIfNeeded = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfConfigMapName", wireType)
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConfConfigMapName = string(dAtA[iNdEx:postIndex])
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScriptsConfigMapName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15029,55 +23472,118 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScriptsConfigMapName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthConfigMapName", wireType)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HealthConfigMapName = string(dAtA[iNdEx:postIndex])
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15100,7 +23606,7 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
+func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15123,15 +23629,15 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetVertexPodSpecReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: NativeRedis: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetVertexPodSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NativeRedis: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15159,13 +23665,33 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicas = &v
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15175,29 +23701,33 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			if m.RedisContainerTemplate == nil {
+				m.RedisContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.RedisContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15207,27 +23737,31 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			if m.SentinelContainerTemplate == nil {
+				m.SentinelContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.SentinelContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsContainerTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15254,64 +23788,16 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.MetricsContainerTemplate == nil {
+				m.MetricsContainerTemplate = &ContainerTemplate{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.MetricsContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GroupBy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GroupBy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupBy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15338,15 +23824,18 @@ func (m *GroupBy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.InitContainerTemplate == nil {
+				m.InitContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.InitContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keyed", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15356,15 +23845,31 @@ func (m *GroupBy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Keyed = bool(v != 0)
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Persistence == nil {
+				m.Persistence = &PersistenceStrategy{}
+			}
+			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedLateness", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15391,16 +23896,13 @@ func (m *GroupBy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AllowedLateness == nil {
-				m.AllowedLateness = &v11.Duration{}
-			}
-			if err := m.AllowedLateness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15427,10 +23929,10 @@ func (m *GroupBy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Storage == nil {
-				m.Storage = &PBQStorage{}
+			if m.Settings == nil {
+				m.Settings = &RedisSettings{}
 			}
-			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Settings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15455,7 +23957,7 @@ func (m *GroupBy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPSource) Unmarshal(dAtA []byte) error {
+func (m *NatsAuth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15478,15 +23980,15 @@ func (m *HTTPSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: NatsAuth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NatsAuth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15513,18 +24015,18 @@ func (m *HTTPSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &Authorization{}
+			if m.Basic == nil {
+				m.Basic = &BasicAuth{}
 			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15534,12 +24036,64 @@ func (m *HTTPSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Token == nil {
+				m.Token = &v1.SecretKeySelector{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Service = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NKey == nil {
+				m.NKey = &v1.SecretKeySelector{}
+			}
+			if err := m.NKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15561,7 +24115,7 @@ func (m *HTTPSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
+func (m *NatsSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15584,17 +24138,17 @@ func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InterStepBufferService: wiretype end group for non-group")
+			return fmt.Errorf("proto: NatsSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterStepBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NatsSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15604,30 +24158,29 @@ func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15637,30 +24190,29 @@ func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15670,78 +24222,27 @@ func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InterStepBufferServiceList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InterStepBufferServiceList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterStepBufferServiceList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15768,13 +24269,16 @@ func (m *InterStepBufferServiceList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TLS == nil {
+				m.TLS = &TLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15801,8 +24305,10 @@ func (m *InterStepBufferServiceList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, InterStepBufferService{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Auth == nil {
+				m.Auth = &NatsAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15827,7 +24333,7 @@ func (m *InterStepBufferServiceList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
+func (m *PBQStorage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15850,15 +24356,15 @@ func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InterStepBufferServiceSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: PBQStorage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterStepBufferServiceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PBQStorage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistentVolumeClaim", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15885,16 +24391,16 @@ func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Redis == nil {
-				m.Redis = &RedisBufferService{}
+			if m.PersistentVolumeClaim == nil {
+				m.PersistentVolumeClaim = &PersistenceStrategy{}
 			}
-			if err := m.Redis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PersistentVolumeClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmptyDir", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15921,10 +24427,10 @@ func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JetStream == nil {
-				m.JetStream = &JetStreamBufferService{}
+			if m.EmptyDir == nil {
+				m.EmptyDir = &v1.EmptyDirVolumeSource{}
 			}
-			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EmptyDir.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15949,7 +24455,7 @@ func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
+func (m *ParquetOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15972,48 +24478,15 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InterStepBufferServiceStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParquetOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterStepBufferServiceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParquetOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16041,13 +24514,13 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = ISBSvcPhase(dAtA[iNdEx:postIndex])
+			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferSchemaSampleSize", wireType)
 			}
-			var stringLen uint64
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16057,27 +24530,15 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.InferSchemaSampleSize = &v
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RowGroupSize", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16104,41 +24565,12 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.RowGroupSize == nil {
+				m.RowGroupSize = &resource.Quantity{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.RowGroupSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Type = ISBSvcType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16161,7 +24593,7 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
+func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16184,15 +24616,15 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JetStreamBufferService: wiretype end group for non-group")
+			return fmt.Errorf("proto: PersistenceStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JetStreamBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PersistenceStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageClassName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16220,33 +24652,14 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.StorageClassName = &s
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Replicas = &v
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessMode", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16256,31 +24669,28 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContainerTemplate == nil {
-				m.ContainerTemplate = &ContainerTemplate{}
-			}
-			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := k8s_io_api_core_v1.PersistentVolumeAccessMode(dAtA[iNdEx:postIndex])
+			m.AccessMode = &s
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReloaderContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeSize", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16307,16 +24717,66 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReloaderContainerTemplate == nil {
-				m.ReloaderContainerTemplate = &ContainerTemplate{}
+			if m.VolumeSize == nil {
+				m.VolumeSize = &resource.Quantity{}
 			}
-			if err := m.ReloaderContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VolumeSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pipeline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16343,16 +24803,13 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetricsContainerTemplate == nil {
-				m.MetricsContainerTemplate = &ContainerTemplate{}
-			}
-			if err := m.MetricsContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16379,16 +24836,13 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Persistence == nil {
-				m.Persistence = &PersistenceStrategy{}
-			}
-			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16415,48 +24869,65 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Settings = &s
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartArgs", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadBatchSize", wireType)
+			}
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16466,62 +24937,37 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StartArgs = append(m.StartArgs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BufferConfig", wireType)
+			m.ReadBatchSize = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferMaxLength", wireType)
 			}
-			var stringLen uint64
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
 				}
 				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.BufferConfig = &s
-			iNdEx = postIndex
-		case 11:
+			m.BufferMaxLength = &v
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encryption", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferUsageLimit", wireType)
 			}
-			var v int
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16531,17 +24977,17 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Encryption = bool(v != 0)
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			m.BufferUsageLimit = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeout", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16551,12 +24997,28 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TLS = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReadTimeout == nil {
+				m.ReadTimeout = &v11.Duration{}
+			}
+			if err := m.ReadTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16578,7 +25040,7 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
+func (m *PipelineList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16601,17 +25063,17 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JetStreamConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JetStreamConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16621,27 +25083,28 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16668,65 +25131,11 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &NatsAuth{}
-			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Pipeline{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamConfig", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StreamConfig = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TLSEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16748,7 +25157,7 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobTemplate) Unmarshal(dAtA []byte) error {
+func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16771,17 +25180,17 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterStepBufferServiceName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16791,28 +25200,27 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InterStepBufferServiceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16839,38 +25247,16 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContainerTemplate == nil {
-				m.ContainerTemplate = &ContainerTemplate{}
-			}
-			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vertices = append(m.Vertices, AbstractVertex{})
+			if err := m.Vertices[len(m.Vertices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTLSecondsAfterFinished", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TTLSecondsAfterFinished = &v
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackoffLimit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16880,67 +25266,31 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BackoffLimit = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KafkaSink) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Edges = append(m.Edges, Edge{})
+			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaSink: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaSink: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16950,29 +25300,30 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
+			if err := m.Lifecycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16982,27 +25333,31 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			if m.Limits == nil {
+				m.Limits = &PipelineLimits{}
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Watermark", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17029,18 +25384,15 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &TLS{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Watermark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17050,27 +25402,31 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = string(dAtA[iNdEx:postIndex])
+			if m.Templates == nil {
+				m.Templates = &Templates{}
+			}
+			if err := m.Templates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SideInputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17097,10 +25453,8 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SASL == nil {
-				m.SASL = &SASL{}
-			}
-			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SideInputs = append(m.SideInputs, SideInput{})
+			if err := m.SideInputs[len(m.SideInputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17125,7 +25479,7 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KafkaSource) Unmarshal(dAtA []byte) error {
+func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17148,17 +25502,17 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17168,27 +25522,28 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17216,11 +25571,11 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			m.Phase = PipelinePhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17248,11 +25603,11 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerGroupName = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17279,18 +25634,15 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &TLS{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastUpdated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VertexCount", wireType)
 			}
-			var stringLen uint64
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17300,29 +25652,17 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Config = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.VertexCount = &v
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCount", wireType)
 			}
-			var msglen int
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17332,28 +25672,52 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.SourceCount = &v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinkCount", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.SASL == nil {
-				m.SASL = &SASL{}
+			m.SinkCount = &v
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UDFCount", wireType)
 			}
-			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.UDFCount = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17375,7 +25739,7 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Lifecycle) Unmarshal(dAtA []byte) error {
+func (m *RedisBufferService) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17398,17 +25762,17 @@ func (m *Lifecycle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Lifecycle: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedisBufferService: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Lifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedisBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteGracePeriodSeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Native", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17418,17 +25782,33 @@ func (m *Lifecycle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeleteGracePeriodSeconds = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Native == nil {
+				m.Native = &NativeRedis{}
+			}
+			if err := m.Native.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPhase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field External", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17438,74 +25818,28 @@ func (m *Lifecycle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DesiredPhase = PipelinePhase(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Log) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.External == nil {
+				m.External = &RedisConfig{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Log: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.External.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17527,7 +25861,7 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17550,15 +25884,143 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedisConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedisConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SentinelURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17585,107 +26047,16 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelPassword", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17712,103 +26083,12 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.SentinelPassword == nil {
+				m.SentinelPassword = &v1.SecretKeySelector{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.SentinelPassword.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17831,7 +26111,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NativeRedis) Unmarshal(dAtA []byte) error {
+func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17854,15 +26134,15 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NativeRedis: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedisSettings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NativeRedis: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedisSettings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17890,13 +26170,13 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Redis = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17906,15 +26186,141 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Replicas = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Master = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replica = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sentinel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sentinel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedisStreamsSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedisStreamsSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedisStreamsSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17941,18 +26347,15 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RedisContainerTemplate == nil {
-				m.RedisContainerTemplate = &ContainerTemplate{}
-			}
-			if err := m.RedisContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RedisConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17962,33 +26365,29 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SentinelContainerTemplate == nil {
-				m.SentinelContainerTemplate = &ContainerTemplate{}
-			}
-			if err := m.SentinelContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17998,33 +26397,29 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetricsContainerTemplate == nil {
-				m.MetricsContainerTemplate = &ContainerTemplate{}
-			}
-			if err := m.MetricsContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerGroup = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerTemplate", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadFromBeginning", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18034,31 +26429,15 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.InitContainerTemplate == nil {
-				m.InitContainerTemplate = &ContainerTemplate{}
-			}
-			if err := m.InitContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+			m.ReadFromBeginning = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18085,18 +26464,18 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Persistence == nil {
-				m.Persistence = &PersistenceStrategy{}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
 			}
-			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18106,28 +26485,27 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Streams = append(m.Streams, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimIdleTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18154,10 +26532,10 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Settings == nil {
-				m.Settings = &RedisSettings{}
+			if m.ClaimIdleTime == nil {
+				m.ClaimIdleTime = &v11.Duration{}
 			}
-			if err := m.Settings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ClaimIdleTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18182,7 +26560,7 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NatsAuth) Unmarshal(dAtA []byte) error {
+func (m *RemoteUDF) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18205,15 +26583,47 @@ func (m *NatsAuth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NatsAuth: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoteUDF: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NatsAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoteUDF: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18240,16 +26650,16 @@ func (m *NatsAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Basic == nil {
-				m.Basic = &BasicAuth{}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
 			}
-			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18276,16 +26686,16 @@ func (m *NatsAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Token == nil {
-				m.Token = &v1.SecretKeySelector{}
+			if m.Timeout == nil {
+				m.Timeout = &v11.Duration{}
 			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18312,10 +26722,10 @@ func (m *NatsAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NKey == nil {
-				m.NKey = &v1.SecretKeySelector{}
+			if m.CircuitBreaker == nil {
+				m.CircuitBreaker = &CircuitBreaker{}
 			}
-			if err := m.NKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18340,7 +26750,7 @@ func (m *NatsAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NatsSource) Unmarshal(dAtA []byte) error {
+func (m *S3Sink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18363,15 +26773,15 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NatsSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: S3Sink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NatsSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: S3Sink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18399,11 +26809,11 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18431,11 +26841,11 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18463,49 +26873,13 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TLS == nil {
-				m.TLS = &TLS{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18515,81 +26889,27 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Auth == nil {
-				m.Auth = &NatsAuth{}
-			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PBQStorage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PBQStorage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PBQStorage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PersistentVolumeClaim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18616,16 +26936,16 @@ func (m *PBQStorage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PersistentVolumeClaim == nil {
-				m.PersistentVolumeClaim = &PersistenceStrategy{}
+			if m.AccessKey == nil {
+				m.AccessKey = &v1.SecretKeySelector{}
 			}
-			if err := m.PersistentVolumeClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmptyDir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18652,66 +26972,36 @@ func (m *PBQStorage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EmptyDir == nil {
-				m.EmptyDir = &v1.EmptyDirVolumeSource{}
+			if m.SecretKey == nil {
+				m.SecretKey = &v1.SecretKeySelector{}
 			}
-			if err := m.EmptyDir.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForcePathStyle", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PersistenceStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PersistenceStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ForcePathStyle = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18739,14 +27029,13 @@ func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.StorageClassName = &s
+			m.Compression = FileCompression(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessMode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartSize", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18756,28 +27045,31 @@ func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := k8s_io_api_core_v1.PersistentVolumeAccessMode(dAtA[iNdEx:postIndex])
-			m.AccessMode = &s
+			if m.PartSize == nil {
+				m.PartSize = &resource.Quantity{}
+			}
+			if err := m.PartSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18804,10 +27096,10 @@ func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VolumeSize == nil {
-				m.VolumeSize = &resource.Quantity{}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
 			}
-			if err := m.VolumeSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18832,7 +27124,7 @@ func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pipeline) Unmarshal(dAtA []byte) error {
+func (m *SASL) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18855,17 +27147,17 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
+			return fmt.Errorf("proto: SASL: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SASL: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mechanism", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18875,28 +27167,28 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := SASLType(dAtA[iNdEx:postIndex])
+			m.Mechanism = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GSSAPI", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18923,13 +27215,16 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.GSSAPI == nil {
+				m.GSSAPI = &GSSAPI{}
+			}
+			if err := m.GSSAPI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plain", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18956,7 +27251,10 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Plain == nil {
+				m.Plain = &SASLPlain{}
+			}
+			if err := m.Plain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18981,7 +27279,7 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineLimits) Unmarshal(dAtA []byte) error {
+func (m *SASLPlain) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19004,17 +27302,17 @@ func (m *PipelineLimits) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineLimits: wiretype end group for non-group")
+			return fmt.Errorf("proto: SASLPlain: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SASLPlain: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadBatchSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserSecret", wireType)
 			}
-			var v uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19024,55 +27322,31 @@ func (m *PipelineLimits) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReadBatchSize = &v
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BufferMaxLength", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.BufferMaxLength = &v
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BufferUsageLimit", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v uint32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.UserSecret == nil {
+				m.UserSecret = &v1.SecretKeySelector{}
 			}
-			m.BufferUsageLimit = &v
-		case 4:
+			if err := m.UserSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19099,13 +27373,33 @@ func (m *PipelineLimits) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReadTimeout == nil {
-				m.ReadTimeout = &v11.Duration{}
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.ReadTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handshake", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Handshake = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19127,7 +27421,7 @@ func (m *PipelineLimits) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineList) Unmarshal(dAtA []byte) error {
+func (m *SQLArg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19150,17 +27444,17 @@ func (m *PipelineList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineList: wiretype end group for non-group")
+			return fmt.Errorf("proto: SQLArg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SQLArg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19170,30 +27464,29 @@ func (m *PipelineList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19203,25 +27496,23 @@ func (m *PipelineList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Pipeline{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Metadata = SQLArgMetadata(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19244,7 +27535,7 @@ func (m *PipelineList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
+func (m *SQLSink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19267,15 +27558,15 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: SQLSink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SQLSink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterStepBufferServiceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19303,11 +27594,11 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterStepBufferServiceName = string(dAtA[iNdEx:postIndex])
+			m.Driver = SQLDriver(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vertices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DSN", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19334,16 +27625,18 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vertices = append(m.Vertices, AbstractVertex{})
-			if err := m.Vertices[len(m.Vertices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DSN == nil {
+				m.DSN = &v1.SecretKeySelector{}
+			}
+			if err := m.DSN.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Statement", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19353,29 +27646,27 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Edges = append(m.Edges, Edge{})
-			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Statement = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19402,84 +27693,16 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Lifecycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Args = append(m.Args, SQLArg{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Limits == nil {
-				m.Limits = &PipelineLimits{}
-			}
-			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Watermark", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Watermark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenConns", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19489,28 +27712,12 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Templates == nil {
-				m.Templates = &Templates{}
-			}
-			if err := m.Templates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.MaxOpenConns = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19532,7 +27739,7 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
+func (m *Scale) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19555,17 +27762,17 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Scale: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Scale: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19575,94 +27782,17 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Disabled = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Phase = PipelinePhase(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19672,28 +27802,35 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Min = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
-			if err := m.LastUpdated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
+			m.Max = &v
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VertexCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
 			}
 			var v uint32
 			for shift := uint(0); ; shift += 7 {
@@ -19710,10 +27847,10 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.VertexCount = &v
-		case 6:
+			m.LookbackSeconds = &v
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CooldownSeconds", wireType)
 			}
 			var v uint32
 			for shift := uint(0); ; shift += 7 {
@@ -19730,10 +27867,10 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.SourceCount = &v
-		case 7:
+			m.CooldownSeconds = &v
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SinkCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ZeroReplicaSleepSeconds", wireType)
 			}
 			var v uint32
 			for shift := uint(0); ; shift += 7 {
@@ -19750,10 +27887,10 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.SinkCount = &v
-		case 8:
+			m.ZeroReplicaSleepSeconds = &v
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UDFCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetProcessingSeconds", wireType)
 			}
 			var v uint32
 			for shift := uint(0); ; shift += 7 {
@@ -19770,62 +27907,12 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.UDFCount = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RedisBufferService) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RedisBufferService: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedisBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Native", wireType)
+			m.TargetProcessingSeconds = &v
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicasPerScale", wireType)
 			}
-			var msglen int
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19835,33 +27922,17 @@ func (m *RedisBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Native == nil {
-				m.Native = &NativeRedis{}
-			}
-			if err := m.Native.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field External", wireType)
+			m.ReplicasPerScale = &v
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBufferAvailability", wireType)
 			}
-			var msglen int
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19871,28 +27942,12 @@ func (m *RedisBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.External == nil {
-				m.External = &RedisConfig{}
-			}
-			if err := m.External.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.TargetBufferAvailability = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19914,7 +27969,7 @@ func (m *RedisBufferService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedisConfig) Unmarshal(dAtA []byte) error {
+func (m *SchemaRegistry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19937,10 +27992,10 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedisConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaRegistry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedisConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaRegistry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19977,9 +28032,9 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19989,29 +28044,33 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SentinelURL = string(dAtA[iNdEx:postIndex])
+			if m.TLS == nil {
+				m.TLS = &TLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20021,27 +28080,31 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterName = string(dAtA[iNdEx:postIndex])
+			if m.BasicAuth == nil {
+				m.BasicAuth = &BasicAuth{}
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20069,13 +28132,13 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnFailure", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20085,31 +28148,27 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Password == nil {
-				m.Password = &v1.SecretKeySelector{}
-			}
-			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OnFailure = SchemaRegistryFailurePolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelPassword", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheTTL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20136,10 +28195,10 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SentinelPassword == nil {
-				m.SentinelPassword = &v1.SecretKeySelector{}
+			if m.CacheTTL == nil {
+				m.CacheTTL = &v11.Duration{}
 			}
-			if err := m.SentinelPassword.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CacheTTL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20164,7 +28223,7 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedisSettings) Unmarshal(dAtA []byte) error {
+func (m *SideInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20187,17 +28246,49 @@ func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedisSettings: wiretype end group for non-group")
+			return fmt.Errorf("proto: SideInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedisSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SideInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20207,29 +28298,33 @@ func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Redis = string(dAtA[iNdEx:postIndex])
+			if m.Container == nil {
+				m.Container = &Container{}
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20239,29 +28334,31 @@ func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Master = string(dAtA[iNdEx:postIndex])
+			m.Volumes = append(m.Volumes, v1.Volume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20271,29 +28368,33 @@ func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Replica = string(dAtA[iNdEx:postIndex])
+			if m.Trigger == nil {
+				m.Trigger = &SideInputTrigger{}
+			}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sentinel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Builtin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20303,23 +28404,27 @@ func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sentinel = string(dAtA[iNdEx:postIndex])
+			if m.Builtin == nil {
+				m.Builtin = &SideInputBuiltin{}
+			}
+			if err := m.Builtin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20342,7 +28447,7 @@ func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedisStreamsSource) Unmarshal(dAtA []byte) error {
+func (m *SideInputBuiltin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20365,48 +28470,15 @@ func (m *RedisStreamsSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedisStreamsSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SideInputBuiltin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedisStreamsSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SideInputBuiltin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RedisConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20434,13 +28506,13 @@ func (m *RedisStreamsSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stream = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KWArgs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20450,79 +28522,118 @@ func (m *RedisStreamsSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerGroup = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadFromBeginning", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ReadFromBeginning = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			if m.KWArgs == nil {
+				m.KWArgs = make(map[string]string)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TLS == nil {
-				m.TLS = &TLS{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KWArgs[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20545,7 +28656,7 @@ func (m *RedisStreamsSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SASL) Unmarshal(dAtA []byte) error {
+func (m *SideInputTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20568,15 +28679,15 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SASL: wiretype end group for non-group")
+			return fmt.Errorf("proto: SideInputTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SASL: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SideInputTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mechanism", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20604,12 +28715,11 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := SASLType(dAtA[iNdEx:postIndex])
-			m.Mechanism = &s
+			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GSSAPI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20636,18 +28746,18 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GSSAPI == nil {
-				m.GSSAPI = &GSSAPI{}
+			if m.Interval == nil {
+				m.Interval = &v11.Duration{}
 			}
-			if err := m.GSSAPI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20657,27 +28767,24 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plain == nil {
-				m.Plain = &SASLPlain{}
-			}
-			if err := m.Plain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Timezone = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20700,7 +28807,7 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SASLPlain) Unmarshal(dAtA []byte) error {
+func (m *Sink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20723,15 +28830,15 @@ func (m *SASLPlain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SASLPlain: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SASLPlain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractSink", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20758,16 +28865,13 @@ func (m *SASLPlain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UserSecret == nil {
-				m.UserSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.UserSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AbstractSink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20794,18 +28898,18 @@ func (m *SASLPlain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
+			if m.Fallback == nil {
+				m.Fallback = &AbstractSink{}
 			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fallback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Handshake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
 			}
-			var v int
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20815,12 +28919,84 @@ func (m *SASLPlain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Handshake = bool(v != 0)
+			m.MaxRetries = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batching", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Batching == nil {
+				m.Batching = &SinkBatching{}
+			}
+			if err := m.Batching.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimit == nil {
+				m.RateLimit = &SinkRateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20842,7 +29018,7 @@ func (m *SASLPlain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Scale) Unmarshal(dAtA []byte) error {
+func (m *SinkBatching) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20865,17 +29041,17 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Scale: wiretype end group for non-group")
+			return fmt.Errorf("proto: SinkBatching: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Scale: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SinkBatching: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
 			}
-			var v int
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20885,57 +29061,17 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Disabled = bool(v != 0)
+			m.MaxBatchSize = &v
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Min = &v
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Max = &v
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchBytes", wireType)
 			}
-			var v uint32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20945,77 +29081,33 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LookbackSeconds = &v
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CooldownSeconds", wireType)
-			}
-			var v uint32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.CooldownSeconds = &v
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZeroReplicaSleepSeconds", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v uint32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ZeroReplicaSleepSeconds = &v
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetProcessingSeconds", wireType)
+			if m.MaxBatchBytes == nil {
+				m.MaxBatchBytes = &resource.Quantity{}
 			}
-			var v uint32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.MaxBatchBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TargetProcessingSeconds = &v
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicasPerScale", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLatency", wireType)
 			}
-			var v uint32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21025,32 +29117,28 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReplicasPerScale = &v
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBufferAvailability", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v uint32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.TargetBufferAvailability = &v
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxLatency == nil {
+				m.MaxLatency = &v11.Duration{}
+			}
+			if err := m.MaxLatency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21072,7 +29160,7 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Sink) Unmarshal(dAtA []byte) error {
+func (m *SinkRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21095,17 +29183,17 @@ func (m *Sink) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Sink: wiretype end group for non-group")
+			return fmt.Errorf("proto: SinkRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sink: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SinkRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagesPerSecond", wireType)
 			}
-			var msglen int
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21115,33 +29203,17 @@ func (m *Sink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Log == nil {
-				m.Log = &Log{}
-			}
-			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.MessagesPerSecond = &v
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBurst", wireType)
 			}
-			var msglen int
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21151,31 +29223,15 @@ func (m *Sink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Kafka == nil {
-				m.Kafka = &KafkaSink{}
-			}
-			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.MessageBurst = &v
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blackhole", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesPerSecond", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21202,16 +29258,16 @@ func (m *Sink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Blackhole == nil {
-				m.Blackhole = &Blackhole{}
+			if m.BytesPerSecond == nil {
+				m.BytesPerSecond = &resource.Quantity{}
 			}
-			if err := m.Blackhole.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BytesPerSecond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UDSink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteBurst", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21238,10 +29294,10 @@ func (m *Sink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UDSink == nil {
-				m.UDSink = &UDSink{}
+			if m.ByteBurst == nil {
+				m.ByteBurst = &resource.Quantity{}
 			}
-			if err := m.UDSink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ByteBurst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21633,6 +29689,78 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &FileSource{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GRPC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GRPC == nil {
+				m.GRPC = &GRPCSource{}
+			}
+			if err := m.GRPC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22524,10 +30652,114 @@ func (m *UDF) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GroupBy == nil {
-				m.GroupBy = &GroupBy{}
+			if m.GroupBy == nil {
+				m.GroupBy = &GroupBy{}
+			}
+			if err := m.GroupBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ordering = Ordering(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Wasm == nil {
+				m.Wasm = &Wasm{}
+			}
+			if err := m.Wasm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Remote == nil {
+				m.Remote = &RemoteUDF{}
 			}
-			if err := m.GroupBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Remote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22736,6 +30968,42 @@ func (m *UDTransformer) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Wasm == nil {
+				m.Wasm = &Wasm{}
+			}
+			if err := m.Wasm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23168,6 +31436,78 @@ func (m *VertexLimits) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.BufferUsageLimit = &v
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UDFTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UDFTimeout == nil {
+				m.UDFTimeout = &v11.Duration{}
+			}
+			if err := m.UDFTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UDFCircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UDFCircuitBreaker == nil {
+				m.UDFCircuitBreaker = &CircuitBreaker{}
+			}
+			if err := m.UDFCircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23804,6 +32144,176 @@ func (m *VertexStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Wasm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Wasm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Wasm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigMap == nil {
+				m.ConfigMap = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryLimitPages", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MemoryLimitPages = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Watermark) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
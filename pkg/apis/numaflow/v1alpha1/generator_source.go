@@ -17,9 +17,26 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"strconv"
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// +kubebuilder:validation:Enum="";roundRobin;uniform;zipf
+type KeyDistributionType string
+
+const (
+	// KeyDistributionRoundRobin generates the same number of messages for each key in every duration
+	KeyDistributionRoundRobin KeyDistributionType = "roundRobin"
+	// KeyDistributionUniform picks the key of each message randomly
+	KeyDistributionUniform KeyDistributionType = "uniform"
+	// KeyDistributionZipf picks the key of each message following a zipf distribution, a few keys are much hotter than others
+	KeyDistributionZipf KeyDistributionType = "zipf"
+
+	defaultZipfExponent = 1.1
+)
+
 type GeneratorSource struct {
 	// +kubebuilder:default=5
 	// +optional
@@ -35,4 +52,68 @@ type GeneratorSource struct {
 	KeyCount *int32 `json:"keyCount,omitempty" protobuf:"bytes,4,opt,name=keyCount"`
 	// Value is an optional uint64 value to be written in to the payload
 	Value *uint64 `json:"value,omitempty" protobuf:"bytes,5,opt,name=value"`
+	// Template is an optional Go template to generate the payloads, the Sprig functions are supported.
+	// The available fields are .Key, .Seq (sequence number of the message in the replica), .EventTime, .Value and .Replica.
+	// The default JSON payload is used if it's not specified, and MsgSize is ignored if it's specified.
+	// +optional
+	Template *string `json:"template,omitempty" protobuf:"bytes,6,opt,name=template"`
+	// KeyDistribution is how the keys of the messages are distributed, defaults to "roundRobin".
+	// +optional
+	KeyDistribution *KeyDistribution `json:"keyDistribution,omitempty" protobuf:"bytes,7,opt,name=keyDistribution"`
+	// Jitter is the max duration randomly subtracted from the event times, to generate out-of-order event times.
+	// +optional
+	Jitter *metav1.Duration `json:"jitter,omitempty" protobuf:"bytes,8,opt,name=jitter"`
+	// LatePercent is the percentage of the messages generated as late data, from 0 to 100. The event times of
+	// the late messages are LateBy older than the others, and they are not used for the watermark.
+	// +optional
+	LatePercent *uint32 `json:"latePercent,omitempty" protobuf:"varint,9,opt,name=latePercent"`
+	// LateBy is how much older the event times of the late messages are, defaults to 1m.
+	// +optional
+	LateBy *metav1.Duration `json:"lateBy,omitempty" protobuf:"bytes,10,opt,name=lateBy"`
+}
+
+type KeyDistribution struct {
+	// Type of the distribution, "roundRobin", "uniform" or "zipf", defaults to "roundRobin".
+	// +optional
+	Type KeyDistributionType `json:"type,omitempty" protobuf:"bytes,1,opt,name=type,casttype=KeyDistributionType"`
+	// ZipfExponent is the exponent "s" of the zipf distribution, which must be greater than 1, defaults to "1.1".
+	// The larger it is, the more skewed the distribution is.
+	// +optional
+	ZipfExponent string `json:"zipfExponent,omitempty" protobuf:"bytes,2,opt,name=zipfExponent"`
+}
+
+func (g GeneratorSource) GetKeyDistributionType() KeyDistributionType {
+	if g.KeyDistribution == nil || g.KeyDistribution.Type == "" {
+		return KeyDistributionRoundRobin
+	}
+	return g.KeyDistribution.Type
+}
+
+// GetZipfExponent returns the exponent of the zipf distribution, or an error if it's not a valid number.
+func (g GeneratorSource) GetZipfExponent() (float64, error) {
+	if g.KeyDistribution == nil || g.KeyDistribution.ZipfExponent == "" {
+		return defaultZipfExponent, nil
+	}
+	return strconv.ParseFloat(g.KeyDistribution.ZipfExponent, 64)
+}
+
+func (g GeneratorSource) GetJitter() time.Duration {
+	if g.Jitter == nil {
+		return 0
+	}
+	return g.Jitter.Duration
+}
+
+func (g GeneratorSource) GetLatePercent() uint32 {
+	if g.LatePercent == nil {
+		return 0
+	}
+	return *g.LatePercent
+}
+
+func (g GeneratorSource) GetLateBy() time.Duration {
+	if g.LateBy == nil {
+		return time.Minute
+	}
+	return g.LateBy.Duration
 }
@@ -0,0 +1,56 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGeneratorSource_Defaults(t *testing.T) {
+	g := GeneratorSource{}
+	assert.Equal(t, KeyDistributionRoundRobin, g.GetKeyDistributionType())
+	s, err := g.GetZipfExponent()
+	assert.NoError(t, err)
+	assert.Equal(t, 1.1, s)
+	assert.Equal(t, time.Duration(0), g.GetJitter())
+	assert.Equal(t, uint32(0), g.GetLatePercent())
+	assert.Equal(t, time.Minute, g.GetLateBy())
+}
+
+func TestGeneratorSource_Getters(t *testing.T) {
+	latePercent := uint32(10)
+	g := GeneratorSource{
+		KeyDistribution: &KeyDistribution{Type: KeyDistributionZipf, ZipfExponent: "1.5"},
+		Jitter:          &metav1.Duration{Duration: time.Second},
+		LatePercent:     &latePercent,
+		LateBy:          &metav1.Duration{Duration: time.Hour},
+	}
+	assert.Equal(t, KeyDistributionZipf, g.GetKeyDistributionType())
+	s, err := g.GetZipfExponent()
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, s)
+	assert.Equal(t, time.Second, g.GetJitter())
+	assert.Equal(t, uint32(10), g.GetLatePercent())
+	assert.Equal(t, time.Hour, g.GetLateBy())
+	g.KeyDistribution.ZipfExponent = "abc"
+	_, err = g.GetZipfExponent()
+	assert.Error(t, err)
+}
@@ -58,6 +58,13 @@ type GetDaemonDeploymentReq struct {
 	Env        []corev1.EnvVar   `protobuf:"bytes,4,rep,name=env"`
 }
 
+type GetSideInputDeploymentReq struct {
+	ISBSvcType ISBSvcType        `protobuf:"bytes,1,opt,name=isbSvcType"`
+	Image      string            `protobuf:"bytes,2,opt,name=image"`
+	PullPolicy corev1.PullPolicy `protobuf:"bytes,3,opt,name=pullPolicy,casttype=k8s.io/api/core/v1.PullPolicy"`
+	Env        []corev1.EnvVar   `protobuf:"bytes,4,rep,name=env"`
+}
+
 type GetJetStreamStatefulSetSpecReq struct {
 	ServiceName                string            `protobuf:"bytes,1,rep,name=serviceName"`
 	Labels                     map[string]string `protobuf:"bytes,2,rep,name=labels"`
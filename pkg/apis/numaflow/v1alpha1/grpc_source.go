@@ -0,0 +1,45 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+const defaultGRPCSourceWindow = 1000
+
+type GRPCSource struct {
+	// Auth information, the clients need to add "authorization: Bearer <token>" to the metadata of the streams.
+	// +optional
+	Auth *Authorization `json:"auth,omitempty" protobuf:"bytes,1,opt,name=auth"`
+	// TLS configuration of the server, a self-signed certificate is used if it's not specified.
+	// "certSecret" and "keySecret" are the certificate and the private key of the server. If "caCertSecret" is
+	// specified, the clients are required to present certificates signed by it (mTLS).
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,2,opt,name=tls"`
+	// Whether to create a ClusterIP Service
+	// +optional
+	Service bool `json:"service,omitempty" protobuf:"varint,3,opt,name=service"`
+	// Window is the max number of the in-flight messages of each stream, which are received but not acknowledged
+	// yet, defaults to 1000. No more messages are received from the stream when it's reached, or the buffers of the
+	// next vertices are full.
+	// +optional
+	Window *uint32 `json:"window,omitempty" protobuf:"varint,4,opt,name=window"`
+}
+
+func (g GRPCSource) GetWindow() int {
+	if g.Window == nil || *g.Window == 0 {
+		return defaultGRPCSourceWindow
+	}
+	return int(*g.Window)
+}
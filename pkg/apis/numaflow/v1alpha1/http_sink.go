@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"net/http"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type HTTPSink struct {
+	// URL of the endpoint, which is a Go template rendered with the message, the Sprig functions are supported.
+	// The available fields are .Keys, .ID, .EventTime and .Payload, e.g. "https://example.com/users/{{index .Keys 0}}".
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// HTTP method, defaults to POST.
+	// +optional
+	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
+	// Headers of the requests, the values are Go templates rendered the same way as the URL.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty" protobuf:"bytes,3,rep,name=headers"`
+	// Bearer token auth, the token is sent with "Authorization: Bearer <token>" header.
+	// +optional
+	Auth *Authorization `json:"auth,omitempty" protobuf:"bytes,4,opt,name=auth"`
+	// Basic auth.
+	// +optional
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty" protobuf:"bytes,5,opt,name=basicAuth"`
+	// TLS configuration, use "certSecret" and "keySecret" for mTLS.
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,6,opt,name=tls"`
+	// Batch sends the messages with the same URL and headers in one request, with the body of a JSON array of
+	// the payloads. One request is sent for each message if it's false.
+	// +optional
+	Batch bool `json:"batch,omitempty" protobuf:"varint,7,opt,name=batch"`
+	// Timeout of each request, defaults to 30s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,8,opt,name=timeout"`
+	// The response status codes which are retryable, defaults to 408, 429 and 5xx. The messages which get
+	// other non-2xx status codes are dropped.
+	// +optional
+	RetryableStatusCodes []int32 `json:"retryableStatusCodes,omitempty" protobuf:"varint,9,rep,name=retryableStatusCodes"`
+}
+
+func (h HTTPSink) GetMethod() string {
+	if h.Method == "" {
+		return http.MethodPost
+	}
+	return h.Method
+}
+
+func (h HTTPSink) GetTimeout() time.Duration {
+	if h.Timeout == nil {
+		return 30 * time.Second
+	}
+	return h.Timeout.Duration
+}
+
+// IsRetryableStatusCode returns whether the failed request with the status code should be retried.
+func (h HTTPSink) IsRetryableStatusCode(code int) bool {
+	if len(h.RetryableStatusCodes) == 0 {
+		return code == http.StatusRequestTimeout || code == http.StatusTooManyRequests || code >= 500
+	}
+	for _, c := range h.RetryableStatusCodes {
+		if int(c) == code {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestHTTPSink_Defaults(t *testing.T) {
+	h := HTTPSink{}
+	assert.Equal(t, http.MethodPost, h.GetMethod())
+	assert.Equal(t, 30*time.Second, h.GetTimeout())
+	h.Method = http.MethodPut
+	h.Timeout = &metav1.Duration{Duration: time.Second}
+	assert.Equal(t, http.MethodPut, h.GetMethod())
+	assert.Equal(t, time.Second, h.GetTimeout())
+}
+
+func TestHTTPSink_IsRetryableStatusCode(t *testing.T) {
+	h := HTTPSink{}
+	assert.True(t, h.IsRetryableStatusCode(http.StatusTooManyRequests))
+	assert.True(t, h.IsRetryableStatusCode(http.StatusRequestTimeout))
+	assert.True(t, h.IsRetryableStatusCode(http.StatusServiceUnavailable))
+	assert.False(t, h.IsRetryableStatusCode(http.StatusBadRequest))
+	h.RetryableStatusCodes = []int32{http.StatusConflict}
+	assert.True(t, h.IsRetryableStatusCode(http.StatusConflict))
+	assert.False(t, h.IsRetryableStatusCode(http.StatusServiceUnavailable))
+}
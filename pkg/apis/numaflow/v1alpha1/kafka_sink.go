@@ -29,4 +29,7 @@ type KafkaSink struct {
 	// SASL.enable=true default for SASL.
 	// +optional
 	SASL *SASL `json:"sasl" protobuf:"bytes,5,opt,name=sasl"`
+	// SchemaRegistry is used to encode the JSON payloads with the schema registered for the subject.
+	// +optional
+	SchemaRegistry *SchemaRegistry `json:"schemaRegistry,omitempty" protobuf:"bytes,6,opt,name=schemaRegistry"`
 }
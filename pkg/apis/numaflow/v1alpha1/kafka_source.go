@@ -30,4 +30,7 @@ type KafkaSource struct {
 	// SASL.enable=true default for SASL.
 	// +optional
 	SASL *SASL `json:"sasl" protobuf:"bytes,6,opt,name=sasl"`
+	// SchemaRegistry is used to decode the schema registry framed payloads to JSON.
+	// +optional
+	SchemaRegistry *SchemaRegistry `json:"schemaRegistry,omitempty" protobuf:"bytes,7,opt,name=schemaRegistry"`
 }
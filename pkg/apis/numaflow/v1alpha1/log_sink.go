@@ -16,5 +16,71 @@ limitations under the License.
 
 package v1alpha1
 
+import "strconv"
+
+// +kubebuilder:validation:Enum="";human;json
+type LogFormat string
+
+const (
+	LogFormatHuman LogFormat = "human"
+	LogFormatJSON  LogFormat = "json"
+)
+
+// +kubebuilder:validation:Enum=keys;eventTime;id;watermark;isLate
+type LogField string
+
+const (
+	LogFieldKeys      LogField = "keys"
+	LogFieldEventTime LogField = "eventTime"
+	LogFieldID        LogField = "id"
+	LogFieldWatermark LogField = "watermark"
+	LogFieldIsLate    LogField = "isLate"
+)
+
 type Log struct {
+	// Format of the log lines, "human" or "json", defaults to "human".
+	// +optional
+	Format LogFormat `json:"format,omitempty" protobuf:"bytes,1,opt,name=format,casttype=LogFormat"`
+	// Fields of the messages printed besides the payload, "keys", "eventTime", "id", "watermark" and "isLate",
+	// defaults to "keys" and "eventTime". The watermark is the watermark of the batch the message is read in.
+	// +optional
+	Fields []LogField `json:"fields,omitempty" protobuf:"bytes,2,rep,name=fields,casttype=LogField"`
+	// SamplingRate is the ratio of the messages printed, between 0 and 1, e.g. "0.01" prints 1 in every 100 messages.
+	// Defaults to "1", which prints all the messages.
+	// +optional
+	SamplingRate string `json:"samplingRate,omitempty" protobuf:"bytes,3,opt,name=samplingRate"`
+	// MaxPayloadLength is the maximum number of bytes of the payload printed, the longer payloads are truncated.
+	// Defaults to no truncation.
+	// +optional
+	MaxPayloadLength *uint32 `json:"maxPayloadLength,omitempty" protobuf:"varint,4,opt,name=maxPayloadLength"`
+}
+
+func (l Log) GetFormat() LogFormat {
+	if l.Format == "" {
+		return LogFormatHuman
+	}
+	return l.Format
+}
+
+func (l Log) GetFields() []LogField {
+	if len(l.Fields) == 0 {
+		return []LogField{LogFieldKeys, LogFieldEventTime}
+	}
+	return l.Fields
+}
+
+// GetSamplingRate returns the sampling rate, or an error if it's not a valid number.
+func (l Log) GetSamplingRate() (float64, error) {
+	if l.SamplingRate == "" {
+		return 1, nil
+	}
+	return strconv.ParseFloat(l.SamplingRate, 64)
+}
+
+// GetMaxPayloadLength returns the maximum length of the payload printed, 0 means no truncation.
+func (l Log) GetMaxPayloadLength() int {
+	if l.MaxPayloadLength == nil {
+		return 0
+	}
+	return int(*l.MaxPayloadLength)
 }
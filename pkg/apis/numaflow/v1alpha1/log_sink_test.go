@@ -0,0 +1,45 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLog_Getters(t *testing.T) {
+	l := Log{}
+	assert.Equal(t, LogFormatHuman, l.GetFormat())
+	assert.Equal(t, []LogField{LogFieldKeys, LogFieldEventTime}, l.GetFields())
+	r, err := l.GetSamplingRate()
+	assert.NoError(t, err)
+	assert.Equal(t, float64(1), r)
+	assert.Equal(t, 0, l.GetMaxPayloadLength())
+
+	n := uint32(16)
+	l = Log{Format: LogFormatJSON, Fields: []LogField{LogFieldID}, SamplingRate: "0.1", MaxPayloadLength: &n}
+	assert.Equal(t, LogFormatJSON, l.GetFormat())
+	assert.Equal(t, []LogField{LogFieldID}, l.GetFields())
+	r, err = l.GetSamplingRate()
+	assert.NoError(t, err)
+	assert.Equal(t, 0.1, r)
+	assert.Equal(t, 16, l.GetMaxPayloadLength())
+	l.SamplingRate = "all"
+	_, err = l.GetSamplingRate()
+	assert.Error(t, err)
+}
@@ -408,6 +408,11 @@ type PipelineSpec struct {
 	// Templates is used to customize additional kubernetes resources required for the Pipeline
 	// +optional
 	Templates *Templates `json:"templates,omitempty" protobuf:"bytes,7,opt,name=templates"`
+	// SideInputs defines the Side Inputs of the pipeline, which are broadcast to the UDFs of the vertices referencing them.
+	// +optional
+	// +patchStrategy=merge
+	// +patchMergeKey=name
+	SideInputs []SideInput `json:"sideInputs,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,8,rep,name=sideInputs"`
 }
 
 type Watermark struct {
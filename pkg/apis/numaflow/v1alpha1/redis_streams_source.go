@@ -16,13 +16,49 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 type RedisStreamsSource struct {
 	// RedisConfig contains connectivity info
-	RedisConfig   `json:",inline" protobuf:"bytes,1,opt,name=redisConfig"`
-	Stream        string `json:"stream" protobuf:"bytes,2,opt,name=stream"`
+	RedisConfig `json:",inline" protobuf:"bytes,1,opt,name=redisConfig"`
+	// Stream to read from, either stream or streams is required.
+	// +optional
+	Stream        string `json:"stream,omitempty" protobuf:"bytes,2,opt,name=stream"`
 	ConsumerGroup string `json:"consumerGroup" protobuf:"bytes,3,opt,name=consumerGroup"`
 	// if true, stream starts being read from the beginning; otherwise, the latest
 	ReadFromBeginning bool `json:"readFromBeginning" protobuf:"bytes,4,opt,name=readFromBeginning"`
 	// +optional
 	TLS *TLS `json:"tls" protobuf:"bytes,5,opt,name=tls"`
+	// Streams to read from, in addition to stream.
+	// +optional
+	Streams []string `json:"streams,omitempty" protobuf:"bytes,6,rep,name=streams"`
+	// ClaimIdleTime is the idle time after which the pending (delivered but not acknowledged) entries of
+	// other consumers, e.g. a replica which has gone, are claimed and reprocessed. Defaults to 5m, 0 to disable.
+	// +optional
+	ClaimIdleTime *metav1.Duration `json:"claimIdleTime,omitempty" protobuf:"bytes,7,opt,name=claimIdleTime"`
+}
+
+// GetStreams returns all the streams to read from.
+func (rs RedisStreamsSource) GetStreams() []string {
+	var streams []string
+	if rs.Stream != "" {
+		streams = append(streams, rs.Stream)
+	}
+	for _, s := range rs.Streams {
+		if s != "" && s != rs.Stream {
+			streams = append(streams, s)
+		}
+	}
+	return streams
+}
+
+func (rs RedisStreamsSource) GetClaimIdleTime() time.Duration {
+	if rs.ClaimIdleTime == nil {
+		return 5 * time.Minute
+	}
+	return rs.ClaimIdleTime.Duration
 }
@@ -0,0 +1,41 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRedisStreamsSource_GetStreams(t *testing.T) {
+	rs := RedisStreamsSource{}
+	assert.Empty(t, rs.GetStreams())
+	rs.Stream = "s1"
+	assert.Equal(t, []string{"s1"}, rs.GetStreams())
+	rs.Streams = []string{"s2", "s1", ""}
+	assert.Equal(t, []string{"s1", "s2"}, rs.GetStreams())
+}
+
+func TestRedisStreamsSource_GetClaimIdleTime(t *testing.T) {
+	rs := RedisStreamsSource{}
+	assert.Equal(t, 5*time.Minute, rs.GetClaimIdleTime())
+	rs.ClaimIdleTime = &metav1.Duration{Duration: 0}
+	assert.Equal(t, time.Duration(0), rs.GetClaimIdleTime())
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RemoteUDF is a user defined function served by a remote gRPC server, e.g. a shared deployment, instead of a UDF
+// container in the vertex pods.
+type RemoteUDF struct {
+	// Address of the gRPC server, in the gRPC name syntax, e.g. "dns:///my-udf.my-namespace.svc:8443". With the "dns"
+	// scheme and a headless service, the calls are load balanced across all the pods of the service.
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+	// TLS configuration of the gRPC client, e.g. the client cert and key for mTLS. The connection is insecure if it's
+	// not specified.
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,2,opt,name=tls"`
+	// Timeout of each call to the server, defaults to the "udfTimeout" of the vertex limits, or 30s if it's not
+	// specified either. It doesn't apply to the reduce calls, which last as long as the windows.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,3,opt,name=timeout"`
+	// CircuitBreaker stops calling the server after repeated failures, defaults to the "udfCircuitBreaker" of the
+	// vertex limits.
+	// +optional
+	CircuitBreaker *CircuitBreaker `json:"circuitBreaker,omitempty" protobuf:"bytes,4,opt,name=circuitBreaker"`
+}
+
+func (r RemoteUDF) GetTimeout() time.Duration {
+	if r.Timeout == nil {
+		return 30 * time.Second
+	}
+	return r.Timeout.Duration
+}
+
+func (r RemoteUDF) GetCircuitBreaker() CircuitBreaker {
+	if r.CircuitBreaker == nil {
+		return CircuitBreaker{}
+	}
+	return *r.CircuitBreaker
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRemoteUDF_GetTimeout(t *testing.T) {
+	r := RemoteUDF{}
+	assert.Equal(t, 30*time.Second, r.GetTimeout())
+	r.Timeout = &metav1.Duration{Duration: time.Second}
+	assert.Equal(t, time.Second, r.GetTimeout())
+}
+
+func TestRemoteUDF_GetCircuitBreaker(t *testing.T) {
+	r := RemoteUDF{}
+	cb := r.GetCircuitBreaker()
+	assert.Equal(t, 5, cb.GetFailureThreshold())
+	assert.Equal(t, 30*time.Second, cb.GetOpenDuration())
+	failureThreshold := uint32(3)
+	r.CircuitBreaker = &CircuitBreaker{FailureThreshold: &failureThreshold, OpenDuration: &metav1.Duration{Duration: time.Minute}}
+	cb = r.GetCircuitBreaker()
+	assert.Equal(t, 3, cb.GetFailureThreshold())
+	assert.Equal(t, time.Minute, cb.GetOpenDuration())
+}
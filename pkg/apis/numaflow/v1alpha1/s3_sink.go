@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// DefaultS3SinkKey is the default template of the object keys of the S3 sink.
+const DefaultS3SinkKey = `{{ .EventTime.UTC.Format "2006/01/02/15" }}/{{ .Vertex }}-{{ .Replica }}-{{ .BatchID }}{{ .Extension }}`
+
+// S3Sink writes the messages as newline-delimited JSON objects to an S3 compatible object storage. The messages
+// written by each batch are uploaded as objects, and acknowledged after the uploads complete.
+type S3Sink struct {
+	// Endpoint of the S3 compatible service, e.g. "http://minio.minio-dev.svc:9000", defaults to AWS S3.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty" protobuf:"bytes,1,opt,name=endpoint"`
+	// Region of the bucket, defaults to "us-east-1".
+	// +optional
+	Region string `json:"region,omitempty" protobuf:"bytes,2,opt,name=region"`
+	// Bucket name.
+	Bucket string `json:"bucket" protobuf:"bytes,3,opt,name=bucket"`
+	// Key is a Go template of the object keys, rendered with each message, the Sprig functions are supported.
+	// The messages in a batch with the same key are uploaded as one object. The available fields are .EventTime,
+	// .Keys, .Pipeline, .Vertex, .Replica, .BatchID and .Extension, where .BatchID is unique for each batch, and it
+	// should be used to avoid overwriting the objects. Defaults to
+	// `{{ .EventTime.UTC.Format "2006/01/02/15" }}/{{ .Vertex }}-{{ .Replica }}-{{ .BatchID }}{{ .Extension }}`.
+	// +optional
+	Key string `json:"key,omitempty" protobuf:"bytes,4,opt,name=key"`
+	// AccessKey refers to the secret that contains the access key, the default credential chain of AWS is used if
+	// the access key and secret key are not specified, e.g. IAM roles for service accounts.
+	// +optional
+	AccessKey *corev1.SecretKeySelector `json:"accessKey,omitempty" protobuf:"bytes,5,opt,name=accessKey"`
+	// SecretKey refers to the secret that contains the secret key.
+	// +optional
+	SecretKey *corev1.SecretKeySelector `json:"secretKey,omitempty" protobuf:"bytes,6,opt,name=secretKey"`
+	// ForcePathStyle uses the path style addressing, e.g. "http://endpoint/bucket/key", which is required by most
+	// of the S3 compatible services, e.g. MinIO.
+	// +optional
+	ForcePathStyle bool `json:"forcePathStyle,omitempty" protobuf:"varint,7,opt,name=forcePathStyle"`
+	// Compression of the objects, "none", "gzip" or "zstd", defaults to "gzip".
+	// +kubebuilder:default=gzip
+	// +optional
+	Compression FileCompression `json:"compression,omitempty" protobuf:"bytes,8,opt,name=compression,casttype=FileCompression"`
+	// PartSize is the size of the parts of the multipart uploads, the objects larger than it are uploaded in
+	// multiple parts. Defaults to 5Mi, which is the minimum.
+	// +optional
+	PartSize *apiresource.Quantity `json:"partSize,omitempty" protobuf:"bytes,9,opt,name=partSize"`
+	// TLS configuration for the S3 compatible service.
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,10,opt,name=tls"`
+}
+
+func (s S3Sink) GetRegion() string {
+	if s.Region == "" {
+		return "us-east-1"
+	}
+	return s.Region
+}
+
+func (s S3Sink) GetKey() string {
+	if s.Key == "" {
+		return DefaultS3SinkKey
+	}
+	return s.Key
+}
+
+func (s S3Sink) GetCompression() FileCompression {
+	if s.Compression == "" {
+		return FileCompressionGzip
+	}
+	return s.Compression
+}
+
+func (s S3Sink) GetPartSize() int64 {
+	if s.PartSize == nil {
+		return 5 * 1024 * 1024
+	}
+	return s.PartSize.Value()
+}
+
+// GetExtension returns the extension of the objects, e.g. ".ndjson.gz".
+func (s S3Sink) GetExtension() string {
+	return FileSink{Compression: s.GetCompression()}.GetFileExtension()
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestS3Sink_Getters(t *testing.T) {
+	s := S3Sink{}
+	assert.Equal(t, "us-east-1", s.GetRegion())
+	assert.Equal(t, DefaultS3SinkKey, s.GetKey())
+	assert.Equal(t, FileCompressionGzip, s.GetCompression())
+	assert.Equal(t, int64(5*1024*1024), s.GetPartSize())
+	assert.Equal(t, ".ndjson.gz", s.GetExtension())
+	size := apiresource.MustParse("8Mi")
+	s = S3Sink{Region: "eu-west-1", Key: "{{ .BatchID }}", Compression: FileCompressionNone, PartSize: &size}
+	assert.Equal(t, "eu-west-1", s.GetRegion())
+	assert.Equal(t, "{{ .BatchID }}", s.GetKey())
+	assert.Equal(t, int64(8*1024*1024), s.GetPartSize())
+	assert.Equal(t, ".ndjson", s.GetExtension())
+}
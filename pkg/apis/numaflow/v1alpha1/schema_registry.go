@@ -0,0 +1,74 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum=fail;drop
+type SchemaRegistryFailurePolicy string
+
+const (
+	// SchemaRegistryFailurePolicyFail blocks the vertex on a payload that can not be decoded or encoded.
+	SchemaRegistryFailurePolicyFail SchemaRegistryFailurePolicy = "fail"
+	// SchemaRegistryFailurePolicyDrop drops the payload that can not be decoded or encoded.
+	SchemaRegistryFailurePolicyDrop SchemaRegistryFailurePolicy = "drop"
+)
+
+// SchemaRegistry is used to connect to a Confluent compatible schema registry.
+// For sources, the schema registry framed Avro, Protobuf or JSON payloads are decoded to JSON,
+// for sinks, the JSON payloads are encoded with the latest schema registered for the subject.
+type SchemaRegistry struct {
+	// URL of the schema registry, e.g. http://my-schema-registry:8081
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// TLS configuration for the schema registry client.
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,2,opt,name=tls"`
+	// BasicAuth for the schema registry client.
+	// +optional
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty" protobuf:"bytes,3,opt,name=basicAuth"`
+	// Subject is the subject whose latest schema is used to encode messages, only applicable to sinks.
+	// Defaults to "{topic}-value".
+	// +optional
+	Subject string `json:"subject,omitempty" protobuf:"bytes,4,opt,name=subject"`
+	// OnFailure decides what to do with a message which can not be decoded (sources) or encoded (sinks),
+	// either "fail" or "drop", defaults to "fail".
+	// +kubebuilder:default=fail
+	// +optional
+	OnFailure SchemaRegistryFailurePolicy `json:"onFailure,omitempty" protobuf:"bytes,5,opt,name=onFailure,casttype=SchemaRegistryFailurePolicy"`
+	// CacheTTL is how long the latest schema of a subject is cached before it's looked up again, defaults to 5m.
+	// Schemas looked up by ID are immutable and always cached.
+	// +optional
+	CacheTTL *metav1.Duration `json:"cacheTTL,omitempty" protobuf:"bytes,6,opt,name=cacheTTL"`
+}
+
+func (sr SchemaRegistry) GetOnFailure() SchemaRegistryFailurePolicy {
+	if sr.OnFailure == "" {
+		return SchemaRegistryFailurePolicyFail
+	}
+	return sr.OnFailure
+}
+
+func (sr SchemaRegistry) GetCacheTTL() time.Duration {
+	if sr.CacheTTL == nil {
+		return 5 * time.Minute
+	}
+	return sr.CacheTTL.Duration
+}
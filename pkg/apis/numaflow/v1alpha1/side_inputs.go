@@ -0,0 +1,214 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	appv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SideInput defines information of a Side Input, which is slowly changing data generated periodically by a
+// container or a builtin generator, and broadcast to all the UDF containers of the vertices referencing it.
+type SideInput struct {
+	// Name of the Side Input, it's also the name of the file which the value is mounted as in the UDF containers.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Container generates the value of the Side Input, it serves the side input gRPC service on a Unix domain socket.
+	// Either container or builtin should be specified.
+	// +optional
+	Container *Container `json:"container,omitempty" protobuf:"bytes,2,opt,name=container"`
+	// Volumes of the pod of the Side Inputs manager, which can be mounted to the container.
+	// +optional
+	// +patchStrategy=merge
+	// +patchMergeKey=name
+	Volumes []corev1.Volume `json:"volumes,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,3,rep,name=volumes"`
+	// Trigger defines when the value of the Side Input is regenerated.
+	Trigger *SideInputTrigger `json:"trigger" protobuf:"bytes,4,opt,name=trigger"`
+	// Builtin generates the value of the Side Input in the Side Inputs manager, without a container.
+	// Either container or builtin should be specified.
+	// +optional
+	Builtin *SideInputBuiltin `json:"builtin,omitempty" protobuf:"bytes,5,opt,name=builtin"`
+}
+
+// SideInputBuiltin is a builtin generator of a Side Input.
+type SideInputBuiltin struct {
+	// Name of the builtin generator, "http" gets the value from a URL with a GET request.
+	// +kubebuilder:validation:Enum=http
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// KWArgs are the arguments of the builtin generator, e.g. "url" and "timeout" of "http".
+	// +optional
+	KWArgs map[string]string `json:"kwargs,omitempty" protobuf:"bytes,2,rep,name=kwargs"`
+}
+
+type SideInputTrigger struct {
+	// Schedule is a cron expression, e.g. "*/15 * * * *", or a predefined schedule, e.g. "@hourly".
+	// Either schedule or interval should be specified.
+	// +optional
+	Schedule string `json:"schedule,omitempty" protobuf:"bytes,1,opt,name=schedule"`
+	// Interval regenerates the value of the Side Input periodically, e.g. "10m".
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty" protobuf:"bytes,2,opt,name=interval"`
+	// Timezone of the schedule, e.g. "America/Los_Angeles", defaults to UTC.
+	// +optional
+	Timezone *string `json:"timezone,omitempty" protobuf:"bytes,3,opt,name=timezone"`
+}
+
+// GetSchedule returns the cron spec of the trigger, the interval is converted to an "@every" schedule.
+func (t SideInputTrigger) GetSchedule() string {
+	if t.Schedule != "" {
+		if t.Timezone != nil && *t.Timezone != "" {
+			return fmt.Sprintf("CRON_TZ=%s %s", *t.Timezone, t.Schedule)
+		}
+		return t.Schedule
+	}
+	if t.Interval != nil {
+		return fmt.Sprintf("@every %s", t.Interval.Duration)
+	}
+	return ""
+}
+
+// GetInterval returns the interval of the trigger, 0 means it's not specified.
+func (t SideInputTrigger) GetInterval() time.Duration {
+	if t.Interval != nil {
+		return t.Interval.Duration
+	}
+	return 0
+}
+
+// GenerateSideInputsStoreName returns the name of the store keeping the values of the Side Inputs of a pipeline.
+func GenerateSideInputsStoreName(namespace, pipeline string) string {
+	return fmt.Sprintf("%s-%s", namespace, pipeline)
+}
+
+func (p Pipeline) GetSideInputsStoreName() string {
+	return GenerateSideInputsStoreName(p.Namespace, p.Name)
+}
+
+func (p Pipeline) GetSideInputsManagerDeploymentName(sideInputName string) string {
+	return fmt.Sprintf("%s-si-%s", p.Name, sideInputName)
+}
+
+// GetSideInputsManagerDeployments returns the deployments of the Side Inputs managers, one for each Side Input.
+func (p Pipeline) GetSideInputsManagerDeployments(req GetSideInputDeploymentReq) ([]*appv1.Deployment, error) {
+	deployments := []*appv1.Deployment{}
+	for _, si := range p.Spec.SideInputs {
+		deploy, err := p.getSideInputsManagerDeployment(si, req)
+		if err != nil {
+			return nil, err
+		}
+		deployments = append(deployments, deploy)
+	}
+	return deployments, nil
+}
+
+func (p Pipeline) getSideInputsManagerDeployment(si SideInput, req GetSideInputDeploymentReq) (*appv1.Deployment, error) {
+	siBytes, err := json.Marshal(si)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal side input %q", si.Name)
+	}
+	envVars := []corev1.EnvVar{
+		{Name: EnvNamespace, ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.namespace"}}},
+		{Name: EnvPod, ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"}}},
+		{Name: EnvPipelineName, Value: p.Name},
+		{Name: EnvSideInputObject, Value: base64.StdEncoding.EncodeToString(siBytes)},
+		{Name: "GODEBUG", Value: os.Getenv("GODEBUG")},
+	}
+	envVars = append(envVars, req.Env...)
+	varVolumeName := "var-run-numaflow"
+	volumes := []corev1.Volume{
+		{
+			Name: varVolumeName,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{
+				Medium: corev1.StorageMediumMemory,
+			}},
+		},
+	}
+	volumeMounts := []corev1.VolumeMount{{Name: varVolumeName, MountPath: PathVarRun}}
+	mainContainer := corev1.Container{
+		Name:            CtrMain,
+		Image:           req.Image,
+		ImagePullPolicy: req.PullPolicy,
+		Resources:       standardResources,
+		Env:             envVars,
+		VolumeMounts:    volumeMounts,
+		Args:            []string{"side-inputs-manager", "--isbsvc-type=" + string(req.ISBSvcType), "--side-inputs-store=" + p.GetSideInputsStoreName()},
+	}
+	containers := []corev1.Container{mainContainer}
+	defaultContainer := CtrMain
+	if x := si.Container; x != nil {
+		c := containerBuilder{}.
+			name(CtrUdSideInput).
+			imagePullPolicy(req.PullPolicy).
+			appendVolumeMounts(volumeMounts...).
+			image(x.Image)
+		if len(x.Command) > 0 {
+			c = c.command(x.Command...)
+		}
+		if len(x.Args) > 0 {
+			c = c.args(x.Args...)
+		}
+		c = c.appendEnv(x.Env...).appendVolumeMounts(x.VolumeMounts...).resources(x.Resources).securityContext(x.SecurityContext).appendEnvFrom(x.EnvFrom...)
+		if x.ImagePullPolicy != nil {
+			c = c.imagePullPolicy(*x.ImagePullPolicy)
+		}
+		containers = append(containers, c.build())
+		defaultContainer = CtrUdSideInput
+	}
+	labels := map[string]string{
+		KeyPartOf:        Project,
+		KeyManagedBy:     ControllerPipeline,
+		KeyComponent:     ComponentSideInputManager,
+		KeyPipelineName:  p.Name,
+		KeySideInputName: si.Name,
+	}
+	// A single replica generates the values, the old pod is stopped before a new one starts up.
+	replicas := int32(1)
+	spec := appv1.DeploymentSpec{
+		Replicas: &replicas,
+		Strategy: appv1.DeploymentStrategy{Type: appv1.RecreateDeploymentStrategyType},
+		Selector: &metav1.LabelSelector{
+			MatchLabels: labels,
+		},
+		Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      labels,
+				Annotations: map[string]string{KeyDefaultContainer: defaultContainer},
+			},
+			Spec: corev1.PodSpec{
+				Volumes:    append(volumes, si.Volumes...),
+				Containers: containers,
+			},
+		},
+	}
+	return &appv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: p.Namespace,
+			Name:      p.GetSideInputsManagerDeploymentName(si.Name),
+			Labels:    labels,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(p.GetObjectMeta(), PipelineGroupVersionKind),
+			},
+		},
+		Spec: spec,
+	}, nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSideInputTrigger_GetSchedule(t *testing.T) {
+	assert.Equal(t, "", SideInputTrigger{}.GetSchedule())
+	assert.Equal(t, "*/15 * * * *", SideInputTrigger{Schedule: "*/15 * * * *"}.GetSchedule())
+	tz := "America/Los_Angeles"
+	assert.Equal(t, "CRON_TZ=America/Los_Angeles @hourly", SideInputTrigger{Schedule: "@hourly", Timezone: &tz}.GetSchedule())
+	trigger := SideInputTrigger{Interval: &metav1.Duration{Duration: 10 * time.Minute}}
+	assert.Equal(t, "@every 10m0s", trigger.GetSchedule())
+	assert.Equal(t, 10*time.Minute, trigger.GetInterval())
+	assert.Equal(t, time.Duration(0), SideInputTrigger{}.GetInterval())
+}
+
+func TestGetSideInputsStoreName(t *testing.T) {
+	assert.Equal(t, testNamespace+"-"+testPipelineName, testPipeline.GetSideInputsStoreName())
+	assert.Equal(t, testPipeline.GetSideInputsStoreName(), testVertex.GetSideInputsStoreName())
+}
+
+func TestGetSideInputsManagerDeployments(t *testing.T) {
+	req := GetSideInputDeploymentReq{
+		ISBSvcType: ISBSvcTypeJetStream,
+		Image:      testFlowImage,
+		PullPolicy: corev1.PullIfNotPresent,
+		Env: []corev1.EnvVar{
+			{Name: "test-env", Value: "test-val"},
+		},
+	}
+	pl := testPipeline.DeepCopy()
+	deploys, err := pl.GetSideInputsManagerDeployments(req)
+	assert.NoError(t, err)
+	assert.Empty(t, deploys)
+
+	pl.Spec.SideInputs = []SideInput{
+		{
+			Name:      "s1",
+			Container: &Container{Image: "my-image", Args: []string{"a"}},
+			Volumes:   []corev1.Volume{{Name: "my-vol"}},
+			Trigger:   &SideInputTrigger{Schedule: "@hourly"},
+		},
+		{
+			Name:      "s2",
+			Container: &Container{Image: "my-image2"},
+			Trigger:   &SideInputTrigger{Interval: &metav1.Duration{Duration: time.Minute}},
+		},
+	}
+	deploys, err = pl.GetSideInputsManagerDeployments(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(deploys))
+	d := deploys[0]
+	assert.Equal(t, testPipelineName+"-si-s1", d.Name)
+	assert.Equal(t, "s1", d.Labels[KeySideInputName])
+	assert.Equal(t, ComponentSideInputManager, d.Labels[KeyComponent])
+	assert.Equal(t, d.Labels, d.Spec.Selector.MatchLabels)
+	assert.Equal(t, int32(1), *d.Spec.Replicas)
+	assert.Equal(t, appv1.RecreateDeploymentStrategyType, d.Spec.Strategy.Type)
+	podSpec := d.Spec.Template.Spec
+	assert.Equal(t, 2, len(podSpec.Volumes))
+	assert.Equal(t, "my-vol", podSpec.Volumes[1].Name)
+	assert.Equal(t, 2, len(podSpec.Containers))
+	assert.Equal(t, CtrMain, podSpec.Containers[0].Name)
+	assert.Equal(t, testFlowImage, podSpec.Containers[0].Image)
+	assert.Equal(t, []string{"side-inputs-manager", "--isbsvc-type=jetstream", "--side-inputs-store=" + pl.GetSideInputsStoreName()}, podSpec.Containers[0].Args)
+	envNames := []string{}
+	for _, e := range podSpec.Containers[0].Env {
+		envNames = append(envNames, e.Name)
+	}
+	assert.Contains(t, envNames, EnvSideInputObject)
+	assert.Contains(t, envNames, "test-env")
+	assert.Equal(t, CtrUdSideInput, podSpec.Containers[1].Name)
+	assert.Equal(t, "my-image", podSpec.Containers[1].Image)
+	assert.Equal(t, []string{"a"}, podSpec.Containers[1].Args)
+	assert.Equal(t, podSpec.Containers[0].VolumeMounts, podSpec.Containers[1].VolumeMounts)
+	assert.Equal(t, CtrUdSideInput, d.Spec.Template.Annotations[KeyDefaultContainer])
+	assert.Equal(t, testPipelineName+"-si-s2", deploys[1].Name)
+
+	// a builtin generator runs in the main container.
+	pl.Spec.SideInputs = []SideInput{
+		{
+			Name:    "s3",
+			Builtin: &SideInputBuiltin{Name: "http", KWArgs: map[string]string{"url": "http://my-config/config.json"}},
+			Trigger: &SideInputTrigger{Schedule: "@hourly"},
+		},
+	}
+	deploys, err = pl.GetSideInputsManagerDeployments(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(deploys))
+	podSpec = deploys[0].Spec.Template.Spec
+	assert.Equal(t, 1, len(podSpec.Containers))
+	assert.Equal(t, CtrMain, podSpec.Containers[0].Name)
+	assert.Equal(t, CtrMain, deploys[0].Spec.Template.Annotations[KeyDefaultContainer])
+}
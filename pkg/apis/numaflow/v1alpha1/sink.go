@@ -22,22 +22,62 @@ import (
 )
 
 type Sink struct {
+	AbstractSink `json:",inline" protobuf:"bytes,1,opt,name=abstractSink"`
+	// Fallback sink, the messages failed to be written to the primary sink with non-retryable errors, or after
+	// "maxRetries" retries, are written to the fallback sink. A user defined sink can send a message to the fallback
+	// sink by responding a PERMANENT status, see the "numaflow.numaproj.io/sink-status" annotation. The fallback sink can be a user
+	// defined sink only if the primary sink is not one, since there's only one user defined sink container in the pod.
+	// A file sink used as the fallback sink is mounted to a different path, and can not be written to the same
+	// directory as a file sink used as the primary sink.
+	// +optional
+	Fallback *AbstractSink `json:"fallback,omitempty" protobuf:"bytes,2,opt,name=fallback"`
+	// MaxRetries is the maximum number of retries of the messages failed to be written to the primary sink with
+	// retryable errors, before they are written to the fallback sink. It requires the fallback sink, and the messages
+	// are retried forever if it's not specified.
+	// +optional
+	MaxRetries *uint32 `json:"maxRetries,omitempty" protobuf:"varint,3,opt,name=maxRetries"`
+	// Batching accumulates the messages read into larger batches before writing them to the sink, which is
+	// preferred by the sinks like object storages and databases. It's disabled if not specified.
+	// +optional
+	Batching *SinkBatching `json:"batching,omitempty" protobuf:"bytes,4,opt,name=batching"`
+	// RateLimit limits the rate of the messages written to the sink by all the replicas of the vertex.
+	// +optional
+	RateLimit *SinkRateLimit `json:"rateLimit,omitempty" protobuf:"bytes,5,opt,name=rateLimit"`
+}
+
+type AbstractSink struct {
 	Log       *Log       `json:"log,omitempty" protobuf:"bytes,1,opt,name=log"`
 	Kafka     *KafkaSink `json:"kafka,omitempty" protobuf:"bytes,2,opt,name=kafka"`
 	Blackhole *Blackhole `json:"blackhole,omitempty" protobuf:"bytes,3,opt,name=blackhole"`
 	UDSink    *UDSink    `json:"udsink,omitempty" protobuf:"bytes,4,opt,name=udsink"`
+	HTTP      *HTTPSink  `json:"http,omitempty" protobuf:"bytes,5,opt,name=http"`
+	File      *FileSink  `json:"file,omitempty" protobuf:"bytes,6,opt,name=file"`
+	S3        *S3Sink    `json:"s3,omitempty" protobuf:"bytes,7,opt,name=s3"`
+	SQL       *SQLSink   `json:"sql,omitempty" protobuf:"bytes,8,opt,name=sql"`
 }
 
 func (s Sink) getContainers(req getContainerReq) ([]corev1.Container, error) {
 	containers := []corev1.Container{
 		s.getMainContainer(req),
 	}
-	if s.UDSink != nil {
+	if s.GetUDSink() != nil {
 		containers = append(containers, s.getUDSinkContainer(req))
 	}
 	return containers, nil
 }
 
+// GetUDSink returns the user defined sink, which is either the primary sink or the fallback sink, or nil if there's
+// none of them.
+func (s Sink) GetUDSink() *UDSink {
+	if s.UDSink != nil {
+		return s.UDSink
+	}
+	if s.Fallback != nil {
+		return s.Fallback.UDSink
+	}
+	return nil
+}
+
 func (s Sink) getMainContainer(req getContainerReq) corev1.Container {
 	return containerBuilder{}.init(req).args("processor", "--type="+string(VertexTypeSink), "--isbsvc-type="+string(req.isbSvcType)).build()
 }
@@ -47,7 +87,7 @@ func (s Sink) getUDSinkContainer(mainContainerReq getContainerReq) corev1.Contai
 		name(CtrUdsink).
 		imagePullPolicy(mainContainerReq.imagePullPolicy). // Use the same image pull policy as the main container
 		appendVolumeMounts(mainContainerReq.volumeMounts...)
-	x := s.UDSink.Container
+	x := s.GetUDSink().Container
 	c = c.image(x.Image)
 	if len(x.Command) > 0 {
 		c = c.command(x.Command...)
@@ -0,0 +1,62 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SinkBatching accumulates the messages read by the sink, and writes them to the sink in larger batches. A batch is
+// written when it reaches "maxBatchSize" messages or "maxBatchBytes" bytes of payloads, or "maxLatency" after its
+// first message is read, whichever comes first. The messages are acknowledged after the batch is written.
+type SinkBatching struct {
+	// MaxBatchSize is the maximum number of messages in a batch, defaults to 500.
+	// +optional
+	MaxBatchSize *uint64 `json:"maxBatchSize,omitempty" protobuf:"varint,1,opt,name=maxBatchSize"`
+	// MaxBatchBytes is the total size of the payloads a batch is written at, defaults to no limit.
+	// +optional
+	MaxBatchBytes *apiresource.Quantity `json:"maxBatchBytes,omitempty" protobuf:"bytes,2,opt,name=maxBatchBytes"`
+	// MaxLatency is the maximum duration between reading the first message of a batch and writing the batch,
+	// defaults to 1s. It's best-effort, a read from the buffer in progress is not interrupted.
+	// +optional
+	MaxLatency *metav1.Duration `json:"maxLatency,omitempty" protobuf:"bytes,3,opt,name=maxLatency"`
+}
+
+func (sb SinkBatching) GetMaxBatchSize() int64 {
+	if sb.MaxBatchSize == nil {
+		return DefaultReadBatchSize
+	}
+	return int64(*sb.MaxBatchSize)
+}
+
+// GetMaxBatchBytes returns the maximum total size of the payloads of a batch, 0 means no limit.
+func (sb SinkBatching) GetMaxBatchBytes() int64 {
+	if sb.MaxBatchBytes == nil {
+		return 0
+	}
+	return sb.MaxBatchBytes.Value()
+}
+
+func (sb SinkBatching) GetMaxLatency() time.Duration {
+	if sb.MaxLatency == nil {
+		return time.Second
+	}
+	return sb.MaxLatency.Duration
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSinkBatching_Getters(t *testing.T) {
+	sb := SinkBatching{}
+	assert.Equal(t, int64(DefaultReadBatchSize), sb.GetMaxBatchSize())
+	assert.Equal(t, int64(0), sb.GetMaxBatchBytes())
+	assert.Equal(t, time.Second, sb.GetMaxLatency())
+
+	size := uint64(1000)
+	bytes := apiresource.MustParse("1Mi")
+	sb = SinkBatching{
+		MaxBatchSize:  &size,
+		MaxBatchBytes: &bytes,
+		MaxLatency:    &metav1.Duration{Duration: time.Minute},
+	}
+	assert.Equal(t, int64(1000), sb.GetMaxBatchSize())
+	assert.Equal(t, int64(1024*1024), sb.GetMaxBatchBytes())
+	assert.Equal(t, time.Minute, sb.GetMaxLatency())
+}
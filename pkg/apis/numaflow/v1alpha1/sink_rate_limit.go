@@ -0,0 +1,69 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// SinkRateLimit limits the rate of the messages written to the sink, in messages per second, bytes of payloads per
+// second, or both. The limits are shared by all the replicas of the vertex, each replica is limited to an even share
+// of them based on the current number of replicas.
+type SinkRateLimit struct {
+	// MessagesPerSecond is the maximum number of messages written per second.
+	// +optional
+	MessagesPerSecond *uint32 `json:"messagesPerSecond,omitempty" protobuf:"varint,1,opt,name=messagesPerSecond"`
+	// MessageBurst is the maximum number of messages written at once, defaults to "messagesPerSecond".
+	// +optional
+	MessageBurst *uint32 `json:"messageBurst,omitempty" protobuf:"varint,2,opt,name=messageBurst"`
+	// BytesPerSecond is the maximum total size of the payloads written per second.
+	// +optional
+	BytesPerSecond *apiresource.Quantity `json:"bytesPerSecond,omitempty" protobuf:"bytes,3,opt,name=bytesPerSecond"`
+	// ByteBurst is the maximum total size of the payloads written at once, defaults to "bytesPerSecond".
+	// +optional
+	ByteBurst *apiresource.Quantity `json:"byteBurst,omitempty" protobuf:"bytes,4,opt,name=byteBurst"`
+}
+
+// GetMessagesPerSecond returns the message rate limit, 0 means no limit.
+func (rl SinkRateLimit) GetMessagesPerSecond() int64 {
+	if rl.MessagesPerSecond == nil {
+		return 0
+	}
+	return int64(*rl.MessagesPerSecond)
+}
+
+func (rl SinkRateLimit) GetMessageBurst() int64 {
+	if rl.MessageBurst == nil {
+		return rl.GetMessagesPerSecond()
+	}
+	return int64(*rl.MessageBurst)
+}
+
+// GetBytesPerSecond returns the byte rate limit, 0 means no limit.
+func (rl SinkRateLimit) GetBytesPerSecond() int64 {
+	if rl.BytesPerSecond == nil {
+		return 0
+	}
+	return rl.BytesPerSecond.Value()
+}
+
+func (rl SinkRateLimit) GetByteBurst() int64 {
+	if rl.ByteBurst == nil {
+		return rl.GetBytesPerSecond()
+	}
+	return rl.ByteBurst.Value()
+}
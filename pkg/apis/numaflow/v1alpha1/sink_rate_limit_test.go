@@ -0,0 +1,47 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestSinkRateLimit_Getters(t *testing.T) {
+	rl := SinkRateLimit{}
+	assert.Equal(t, int64(0), rl.GetMessagesPerSecond())
+	assert.Equal(t, int64(0), rl.GetMessageBurst())
+	assert.Equal(t, int64(0), rl.GetBytesPerSecond())
+	assert.Equal(t, int64(0), rl.GetByteBurst())
+
+	messages := uint32(100)
+	bytes := apiresource.MustParse("1Mi")
+	rl = SinkRateLimit{MessagesPerSecond: &messages, BytesPerSecond: &bytes}
+	assert.Equal(t, int64(100), rl.GetMessagesPerSecond())
+	assert.Equal(t, int64(100), rl.GetMessageBurst())
+	assert.Equal(t, int64(1024*1024), rl.GetBytesPerSecond())
+	assert.Equal(t, int64(1024*1024), rl.GetByteBurst())
+
+	burst := uint32(10)
+	byteBurst := apiresource.MustParse("2Mi")
+	rl.MessageBurst = &burst
+	rl.ByteBurst = &byteBurst
+	assert.Equal(t, int64(10), rl.GetMessageBurst())
+	assert.Equal(t, int64(2*1024*1024), rl.GetByteBurst())
+}
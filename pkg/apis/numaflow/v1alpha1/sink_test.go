@@ -43,14 +43,16 @@ func Test_Sink_getContainers(t *testing.T) {
 
 func Test_Sink_getUDSinkContainer(t *testing.T) {
 	x := Sink{
-		UDSink: &UDSink{
-			Container: Container{
-				Image:           "my-image",
-				Args:            []string{"my-arg"},
-				SecurityContext: &corev1.SecurityContext{},
-				EnvFrom: []corev1.EnvFromSource{{ConfigMapRef: &corev1.ConfigMapEnvSource{
-					LocalObjectReference: corev1.LocalObjectReference{Name: "test-cm"},
-				}}},
+		AbstractSink: AbstractSink{
+			UDSink: &UDSink{
+				Container: Container{
+					Image:           "my-image",
+					Args:            []string{"my-arg"},
+					SecurityContext: &corev1.SecurityContext{},
+					EnvFrom: []corev1.EnvFromSource{{ConfigMapRef: &corev1.ConfigMapEnvSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "test-cm"},
+					}}},
+				},
 			},
 		},
 	}
@@ -71,3 +73,16 @@ func Test_Sink_getUDSinkContainer(t *testing.T) {
 	assert.Equal(t, testImagePullPolicy, c.ImagePullPolicy)
 	assert.True(t, c.LivenessProbe != nil)
 }
+
+func Test_Sink_GetUDSink(t *testing.T) {
+	s := Sink{}
+	assert.Nil(t, s.GetUDSink())
+	s.Fallback = &AbstractSink{UDSink: &UDSink{Container: Container{Image: "fallback-image"}}}
+	assert.Equal(t, "fallback-image", s.GetUDSink().Container.Image)
+	c, err := s.getContainers(getContainerReq{image: testFlowImage})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(c))
+	assert.Equal(t, "fallback-image", c[1].Image)
+	s.UDSink = &UDSink{Container: Container{Image: "my-image"}}
+	assert.Equal(t, "my-image", s.GetUDSink().Container.Image)
+}
@@ -39,13 +39,17 @@ type Source struct {
 	RedisStreams *RedisStreamsSource `json:"redisStreams,omitempty" protobuf:"bytes,5,opt,name=redisStreams"`
 	// +optional
 	UDTransformer *UDTransformer `json:"transformer,omitempty" protobuf:"bytes,6,opt,name=transformer"`
+	// +optional
+	File *FileSource `json:"file,omitempty" protobuf:"bytes,7,opt,name=file"`
+	// +optional
+	GRPC *GRPCSource `json:"grpc,omitempty" protobuf:"bytes,8,opt,name=grpc"`
 }
 
 func (s Source) getContainers(req getContainerReq) ([]corev1.Container, error) {
 	containers := []corev1.Container{
 		s.getMainContainer(req),
 	}
-	if s.UDTransformer != nil {
+	if s.UDTransformer != nil && s.UDTransformer.Wasm == nil {
 		containers = append(containers, s.getUDTransformerContainer(req))
 	}
 	return containers, nil
@@ -27,8 +27,16 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// +kubebuilder:validation:Enum="";perKey
+type Ordering string
+
+const (
+	// OrderingPerKey processes the messages sharing the same keys serially, in the order they are read.
+	OrderingPerKey Ordering = "perKey"
+)
+
 type Function struct {
-	// +kubebuilder:validation:Enum=cat;filter
+	// +kubebuilder:validation:Enum=cat;filter;jsonTransform;flatten;keyBy;schemaValidate;enrich
 	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 	// +optional
 	Args []string `json:"args,omitempty" protobuf:"bytes,2,rep,name=args"`
@@ -43,9 +51,24 @@ type UDF struct {
 	Builtin *Function `json:"builtin" protobuf:"bytes,2,opt,name=builtin"`
 	// +optional
 	GroupBy *GroupBy `json:"groupBy" protobuf:"bytes,3,opt,name=groupBy"`
+	// Ordering of the messages processed by a map UDF. With "perKey", the messages sharing the same keys are
+	// processed serially in the order they are read, while the messages with different keys are processed in
+	// parallel. By default, all the messages read in a batch are processed in parallel.
+	// +optional
+	Ordering Ordering `json:"ordering,omitempty" protobuf:"bytes,4,opt,name=ordering,casttype=Ordering"`
+	// Wasm is a WebAssembly module of the map function, which is executed in the main container without a UDF
+	// container.
+	// +optional
+	Wasm *Wasm `json:"wasm,omitempty" protobuf:"bytes,5,opt,name=wasm"`
+	// Remote is a UDF served by a remote gRPC server, which is called without a UDF container.
+	// +optional
+	Remote *RemoteUDF `json:"remote,omitempty" protobuf:"bytes,6,opt,name=remote"`
 }
 
 func (in UDF) getContainers(req getContainerReq) ([]corev1.Container, error) {
+	if in.Wasm != nil || in.Remote != nil {
+		return []corev1.Container{in.getMainContainer(req)}, nil
+	}
 	return []corev1.Container{in.getMainContainer(req), in.getUDFContainer(req)}, nil
 }
 
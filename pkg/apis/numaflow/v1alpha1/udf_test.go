@@ -66,6 +66,32 @@ func TestUDF_getContainers(t *testing.T) {
 	assert.True(t, c[1].LivenessProbe != nil)
 }
 
+func TestUDF_getContainers_Wasm(t *testing.T) {
+	x := UDF{
+		Wasm: &Wasm{VolumeName: "my-modules", Path: "my-module.wasm"},
+	}
+	c, err := x.getContainers(getContainerReq{
+		image:           "main-image",
+		imagePullPolicy: corev1.PullAlways,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(c))
+	assert.Equal(t, "main-image", c[0].Image)
+}
+
+func TestUDF_getContainers_Remote(t *testing.T) {
+	x := UDF{
+		Remote: &RemoteUDF{Address: "dns:///my-udf:8443"},
+	}
+	c, err := x.getContainers(getContainerReq{
+		image:           "main-image",
+		imagePullPolicy: corev1.PullAlways,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(c))
+	assert.Equal(t, "main-image", c[0].Image)
+}
+
 func Test_getUDFContainer(t *testing.T) {
 	t.Run("with customized image", func(t *testing.T) {
 		x := UDF{
@@ -21,10 +21,14 @@ type UDTransformer struct {
 	Container *Container `json:"container" protobuf:"bytes,1,opt,name=container"`
 	// +optional
 	Builtin *Transformer `json:"builtin" protobuf:"bytes,2,opt,name=builtin"`
+	// Wasm is a WebAssembly module of the transformer, which is executed in the main container without a
+	// transformer container.
+	// +optional
+	Wasm *Wasm `json:"wasm,omitempty" protobuf:"bytes,3,opt,name=wasm"`
 }
 
 type Transformer struct {
-	// +kubebuilder:validation:Enum=eventTimeExtractor;filter;timeExtractionFilter
+	// +kubebuilder:validation:Enum=eventTimeExtractor;filter;timeExtractionFilter;keyBy
 	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 	// +optional
 	Args []string `json:"args,omitempty" protobuf:"bytes,2,rep,name=args"`
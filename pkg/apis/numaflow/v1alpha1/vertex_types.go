@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -47,6 +49,9 @@ const (
 	VertexTypeReduceUDF VertexType = "ReduceUDF"
 )
 
+// sideInputsVolumeName is the name of the volume with the values of the side inputs in the vertex pods.
+const sideInputsVolumeName = "side-inputs"
+
 // +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:shortName=vtx
@@ -125,6 +130,9 @@ func (v Vertex) GetServiceObjs() []*corev1.Service {
 	if x := v.Spec.Source; x != nil && x.HTTP != nil && x.HTTP.Service {
 		svcs = append(svcs, v.getServiceObj(v.Name, false, VertexHTTPSPort, VertexHTTPSPortName))
 	}
+	if x := v.Spec.Source; x != nil && x.GRPC != nil && x.GRPC.Service {
+		svcs = append(svcs, v.getServiceObj(v.Name, false, VertexGRPCPort, VertexGRPCPortName))
+	}
 	return svcs
 }
 
@@ -215,6 +223,20 @@ func (v Vertex) GetPodSpec(req GetVertexPodSpecReq) (*corev1.PodSpec, error) {
 		},
 	}
 	volumeMounts := []corev1.VolumeMount{{Name: varVolumeName, MountPath: PathVarRun}}
+	if v.Spec.Sink != nil && v.Spec.Sink.RateLimit != nil {
+		// the rate limits are shared by the replicas, the number of replicas is kept in an annotation of the pod.
+		podInfoVolumeName := "podinfo"
+		volumes = append(volumes, corev1.Volume{
+			Name: podInfoVolumeName,
+			VolumeSource: corev1.VolumeSource{DownwardAPI: &corev1.DownwardAPIVolumeSource{
+				Items: []corev1.DownwardAPIVolumeFile{{
+					Path:     PodInfoReplicasFile,
+					FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.annotations['" + KeyReplicas + "']"},
+				}},
+			}},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{Name: podInfoVolumeName, MountPath: PathPodInfoMount})
+	}
 
 	containers, err := v.Spec.getType().getContainers(getContainerReq{
 		isbSvcType:      req.ISBSvcType,
@@ -259,6 +281,23 @@ func (v Vertex) GetPodSpec(req GetVertexPodSpecReq) (*corev1.PodSpec, error) {
 		}
 	}
 
+	if len(v.Spec.SideInputs) > 0 {
+		// the values of the side inputs are written to a shared volume by the init container and the synchronizer,
+		// and the volume is mounted read-only to the udf container.
+		volumes = append(volumes, corev1.Volume{
+			Name: sideInputsVolumeName,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{
+				Medium: corev1.StorageMediumMemory,
+			}},
+		})
+		for i := range containers {
+			if containers[i].Name == CtrUdf {
+				containers[i].VolumeMounts = append(containers[i].VolumeMounts, corev1.VolumeMount{Name: sideInputsVolumeName, MountPath: PathSideInputsMount, ReadOnly: true})
+			}
+		}
+		containers = append(containers, v.getSideInputsContainer(CtrSideInputsWatcher, "side-inputs-synchronizer", req))
+	}
+
 	spec := &corev1.PodSpec{
 		Subdomain:      v.GetHeadlessServiceName(),
 		Volumes:        append(volumes, v.Spec.Volumes...),
@@ -288,12 +327,44 @@ func (v Vertex) getInitContainers(req GetVertexPodSpecReq) []corev1.Container {
 			Args:            []string{"isbsvc-validate", "--isbsvc-type=" + string(req.ISBSvcType)},
 		},
 	}
+	if len(v.Spec.SideInputs) > 0 {
+		initContainers = append(initContainers, v.getSideInputsContainer(CtrInitSideInputs, "side-inputs-init", req))
+	}
 	if v.Spec.InitContainerTemplate != nil {
 		v.Spec.InitContainerTemplate.ApplyToNumaflowContainers(initContainers)
 	}
 	return append(initContainers, v.Spec.InitContainers...)
 }
 
+// getSideInputsContainer returns a container running the given side inputs command, which writes the values of the
+// side inputs of the vertex to the side inputs volume.
+func (v Vertex) getSideInputsContainer(name, command string, req GetVertexPodSpecReq) corev1.Container {
+	envVars := []corev1.EnvVar{
+		{Name: "GODEBUG", Value: os.Getenv("GODEBUG")},
+	}
+	envVars = append(envVars, v.commonEnvs()...)
+	envVars = append(envVars, req.Env...)
+	return corev1.Container{
+		Name:            name,
+		Env:             envVars,
+		Image:           req.Image,
+		ImagePullPolicy: req.PullPolicy,
+		Resources:       standardResources,
+		VolumeMounts:    []corev1.VolumeMount{{Name: sideInputsVolumeName, MountPath: PathSideInputsMount}},
+		Args: []string{
+			command,
+			"--isbsvc-type=" + string(req.ISBSvcType),
+			"--side-inputs-store=" + v.GetSideInputsStoreName(),
+			"--side-inputs=" + strings.Join(v.Spec.SideInputs, ","),
+		},
+	}
+}
+
+// GetSideInputsStoreName returns the name of the store keeping the values of the side inputs of the pipeline.
+func (v Vertex) GetSideInputsStoreName() string {
+	return GenerateSideInputsStoreName(v.Namespace, v.Spec.PipelineName)
+}
+
 func (vs VertexSpec) WithOutReplicas() VertexSpec {
 	zero := int32(0)
 	x := *vs.DeepCopy()
@@ -365,6 +436,26 @@ func (v Vertex) MapUdfStreamEnabled() (bool, error) {
 	return false, nil
 }
 
+// MapUdfBatchEnabled returns true if the read messages are sent to the map UDF in one call.
+func (v Vertex) MapUdfBatchEnabled() (bool, error) {
+	if v.Spec.Metadata != nil && v.Spec.Metadata.Annotations != nil {
+		if mapUdfBatch, existing := v.Spec.Metadata.Annotations[MapUdfBatchKey]; existing {
+			return strconv.ParseBool(mapUdfBatch)
+		}
+	}
+	return false, nil
+}
+
+// UDSinkStatusEnabled returns true if the user defined sink responds a typed status of each message.
+func (v Vertex) UDSinkStatusEnabled() (bool, error) {
+	if v.Spec.Metadata != nil && v.Spec.Metadata.Annotations != nil {
+		if udsinkStatus, existing := v.Spec.Metadata.Annotations[UDSinkStatusKey]; existing {
+			return strconv.ParseBool(udsinkStatus)
+		}
+	}
+	return false, nil
+}
+
 type VertexSpec struct {
 	AbstractVertex `json:",inline" protobuf:"bytes,1,opt,name=abstractVertex"`
 	PipelineName   string `json:"pipelineName" protobuf:"bytes,2,opt,name=pipelineName"`
@@ -418,6 +509,10 @@ type AbstractVertex struct {
 	// It applies to udf and sink vertices only.
 	// +optional
 	Partitions *int32 `json:"partitions,omitempty" protobuf:"bytes,13,rep,name=partitions"`
+	// Names of the pipeline Side Inputs mounted to the UDF container, the vertex starts processing after all of them
+	// are available. It applies to udf vertices only.
+	// +optional
+	SideInputs []string `json:"sideInputs,omitempty" protobuf:"bytes,14,rep,name=sideInputs"`
 }
 
 func (av AbstractVertex) GetVertexType() VertexType {
@@ -452,6 +547,17 @@ func (av AbstractVertex) HasUDTransformer() bool {
 	return av.Source != nil && av.Source.UDTransformer != nil
 }
 
+// GetWasm returns the WebAssembly module of the map UDF or the source transformer, nil if there's no such a module.
+func (av AbstractVertex) GetWasm() *Wasm {
+	if av.UDF != nil && av.UDF.Wasm != nil {
+		return av.UDF.Wasm
+	}
+	if av.HasUDTransformer() && av.Source.UDTransformer.Wasm != nil {
+		return av.Source.UDTransformer.Wasm
+	}
+	return nil
+}
+
 func (av AbstractVertex) IsASink() bool {
 	return av.Sink != nil
 }
@@ -591,6 +697,30 @@ type VertexLimits struct {
 	// It overrides the settings from pipeline limits.
 	// +optional
 	BufferUsageLimit *uint32 `json:"bufferUsageLimit,omitempty" protobuf:"varint,4,opt,name=bufferUsageLimit"`
+	// UDFTimeout is the timeout of each call to the UDF container or the wasm module, which is retried if it times out.
+	// There's no timeout if it's not specified. It doesn't apply to the reduce calls, which last as long as the windows.
+	// +optional
+	UDFTimeout *metav1.Duration `json:"udfTimeout,omitempty" protobuf:"bytes,5,opt,name=udfTimeout"`
+	// UDFCircuitBreaker is the circuit breaker of the calls to the UDF container, the vertex pauses reading while it's
+	// open. Defaults to opening after 5 consecutive failures for 30s.
+	// +optional
+	UDFCircuitBreaker *CircuitBreaker `json:"udfCircuitBreaker,omitempty" protobuf:"bytes,6,opt,name=udfCircuitBreaker"`
+}
+
+// GetUDFTimeout returns the timeout of each call to the UDF container, 0 means no timeout.
+func (vl *VertexLimits) GetUDFTimeout() time.Duration {
+	if vl == nil || vl.UDFTimeout == nil {
+		return 0
+	}
+	return vl.UDFTimeout.Duration
+}
+
+// GetUDFCircuitBreaker returns the settings of the circuit breaker of the calls to the UDF container.
+func (vl *VertexLimits) GetUDFCircuitBreaker() CircuitBreaker {
+	if vl == nil || vl.UDFCircuitBreaker == nil {
+		return CircuitBreaker{}
+	}
+	return *vl.UDFCircuitBreaker
 }
 
 func (v VertexSpec) getType() containerSupplier {
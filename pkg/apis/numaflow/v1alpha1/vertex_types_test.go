@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
@@ -181,6 +182,15 @@ func TestGetServiceObjs(t *testing.T) {
 	assert.Equal(t, s[1].Name, v.Name)
 	assert.Equal(t, 1, len(s[1].Spec.Ports))
 	assert.Equal(t, VertexHTTPSPort, int(s[1].Spec.Ports[0].Port))
+
+	v.Spec.Source = &Source{
+		GRPC: &GRPCSource{Service: true},
+	}
+	s = v.GetServiceObjs()
+	assert.Equal(t, 2, len(s))
+	assert.Equal(t, s[1].Name, v.Name)
+	assert.Equal(t, VertexGRPCPort, int(s[1].Spec.Ports[0].Port))
+	assert.Equal(t, VertexGRPCPortName, s[1].Spec.Ports[0].Name)
 }
 
 func TestGetHeadlessServiceName(t *testing.T) {
@@ -286,14 +296,35 @@ func TestGetPodSpec(t *testing.T) {
 		assert.Equal(t, CtrInit, s.InitContainers[0].Name)
 	})
 
+	t.Run("test rate limited sink", func(t *testing.T) {
+		testObj := testVertex.DeepCopy()
+		messages := uint32(100)
+		testObj.Spec.Sink = &Sink{
+			AbstractSink: AbstractSink{
+				Log: &Log{},
+			},
+			RateLimit: &SinkRateLimit{MessagesPerSecond: &messages},
+		}
+		s, err := testObj.GetPodSpec(req)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(s.Volumes))
+		assert.Equal(t, "podinfo", s.Volumes[1].Name)
+		assert.NotNil(t, s.Volumes[1].DownwardAPI)
+		assert.Equal(t, PodInfoReplicasFile, s.Volumes[1].DownwardAPI.Items[0].Path)
+		assert.Equal(t, "metadata.annotations['"+KeyReplicas+"']", s.Volumes[1].DownwardAPI.Items[0].FieldRef.FieldPath)
+		assert.Contains(t, s.Containers[0].VolumeMounts, corev1.VolumeMount{Name: "podinfo", MountPath: PathPodInfoMount})
+	})
+
 	t.Run("test user defined sink", func(t *testing.T) {
 		testObj := testVertex.DeepCopy()
 		testObj.Spec.Sink = &Sink{
-			UDSink: &UDSink{
-				Container: Container{
-					Image:   "image",
-					Command: []string{"cmd"},
-					Args:    []string{"arg0"},
+			AbstractSink: AbstractSink{
+				UDSink: &UDSink{
+					Container: Container{
+						Image:   "image",
+						Command: []string{"cmd"},
+						Args:    []string{"arg0"},
+					},
 				},
 			},
 		}
@@ -353,6 +384,39 @@ func TestGetPodSpec(t *testing.T) {
 		assert.Contains(t, sidecarEnvNames, EnvCPURequest)
 		assert.Contains(t, sidecarEnvNames, EnvMemoryRequest)
 	})
+
+	t.Run("test udf with side inputs", func(t *testing.T) {
+		testObj := testVertex.DeepCopy()
+		testObj.Spec.UDF = &UDF{
+			Container: &Container{Image: "my-image"},
+		}
+		testObj.Spec.SideInputs = []string{"s1", "s2"}
+		jsReq := req
+		jsReq.ISBSvcType = ISBSvcTypeJetStream
+		s, err := testObj.GetPodSpec(jsReq)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(s.Containers))
+		assert.Equal(t, CtrUdf, s.Containers[1].Name)
+		assert.Contains(t, s.Containers[1].VolumeMounts, corev1.VolumeMount{Name: sideInputsVolumeName, MountPath: PathSideInputsMount, ReadOnly: true})
+		for _, vm := range s.Containers[0].VolumeMounts {
+			assert.NotEqual(t, sideInputsVolumeName, vm.Name)
+		}
+		assert.Equal(t, CtrSideInputsWatcher, s.Containers[2].Name)
+		assert.Equal(t, testFlowImage, s.Containers[2].Image)
+		assert.Equal(t, []string{"side-inputs-synchronizer", "--isbsvc-type=jetstream", "--side-inputs-store=" + testNamespace + "-" + testPipelineName, "--side-inputs=s1,s2"}, s.Containers[2].Args)
+		assert.Equal(t, 2, len(s.InitContainers))
+		assert.Equal(t, CtrInitSideInputs, s.InitContainers[1].Name)
+		assert.Equal(t, "side-inputs-init", s.InitContainers[1].Args[0])
+		assert.Equal(t, []corev1.VolumeMount{{Name: sideInputsVolumeName, MountPath: PathSideInputsMount}}, s.InitContainers[1].VolumeMounts)
+		found := false
+		for _, v := range s.Volumes {
+			if v.Name == sideInputsVolumeName {
+				found = true
+				assert.NotNil(t, v.EmptyDir)
+			}
+		}
+		assert.True(t, found)
+	})
 }
 
 func Test_getType(t *testing.T) {
@@ -504,3 +568,51 @@ func Test_Scale_Parameters(t *testing.T) {
 	s.Max = pointer.Int32(500)
 	assert.Equal(t, int32(500), s.GetMaxReplicas())
 }
+
+func Test_MapUdfBatchEnabled(t *testing.T) {
+	v := Vertex{}
+	enabled, err := v.MapUdfBatchEnabled()
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+	v.Spec.Metadata = &Metadata{Annotations: map[string]string{MapUdfBatchKey: "true"}}
+	enabled, err = v.MapUdfBatchEnabled()
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+	v.Spec.Metadata.Annotations[MapUdfBatchKey] = "abc"
+	_, err = v.MapUdfBatchEnabled()
+	assert.Error(t, err)
+}
+
+func Test_UDSinkStatusEnabled(t *testing.T) {
+	v := Vertex{}
+	enabled, err := v.UDSinkStatusEnabled()
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+	v.Spec.Metadata = &Metadata{Annotations: map[string]string{UDSinkStatusKey: "true"}}
+	enabled, err = v.UDSinkStatusEnabled()
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+	v.Spec.Metadata.Annotations[UDSinkStatusKey] = "abc"
+	_, err = v.UDSinkStatusEnabled()
+	assert.Error(t, err)
+}
+
+func TestVertexLimits_GetUDFTimeout(t *testing.T) {
+	var vl *VertexLimits
+	assert.Equal(t, time.Duration(0), vl.GetUDFTimeout())
+	vl = &VertexLimits{}
+	assert.Equal(t, time.Duration(0), vl.GetUDFTimeout())
+	vl.UDFTimeout = &metav1.Duration{Duration: 10 * time.Second}
+	assert.Equal(t, 10*time.Second, vl.GetUDFTimeout())
+}
+
+func TestVertexLimits_GetUDFCircuitBreaker(t *testing.T) {
+	var vl *VertexLimits
+	assert.Equal(t, 5, vl.GetUDFCircuitBreaker().GetFailureThreshold())
+	vl = &VertexLimits{}
+	assert.Equal(t, 30*time.Second, vl.GetUDFCircuitBreaker().GetOpenDuration())
+	threshold := uint32(3)
+	vl.UDFCircuitBreaker = &CircuitBreaker{FailureThreshold: &threshold}
+	assert.Equal(t, 3, vl.GetUDFCircuitBreaker().GetFailureThreshold())
+	assert.Equal(t, 30*time.Second, vl.GetUDFCircuitBreaker().GetOpenDuration())
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Wasm is a WebAssembly module executed in the main container of the vertex pods, instead of a user defined
+// container. The module is either stored in a ConfigMap, or in a volume of the vertex.
+type Wasm struct {
+	// ConfigMap key selector of the module, which is stored as the binary data of the ConfigMap.
+	// +optional
+	ConfigMap *corev1.ConfigMapKeySelector `json:"configMap,omitempty" protobuf:"bytes,1,opt,name=configMap"`
+	// VolumeName is the name of the volume in the vertex "volumes" which contains the module, it's mounted to the
+	// main container of the vertex pods.
+	// +optional
+	VolumeName string `json:"volumeName,omitempty" protobuf:"bytes,2,opt,name=volumeName"`
+	// Path of the module relative to the root of the volume, e.g. "functions/my-function.wasm".
+	// +optional
+	Path string `json:"path,omitempty" protobuf:"bytes,3,opt,name=path"`
+	// MemoryLimitPages is the maximum number of the 64 KiB pages of the memory of a module instance, it's not more than
+	// 65536 (4 GiB), which is also the default.
+	// +optional
+	MemoryLimitPages *uint32 `json:"memoryLimitPages,omitempty" protobuf:"varint,4,opt,name=memoryLimitPages"`
+}
+
+// GetMemoryLimitPages returns the maximum number of the memory pages of a module instance, 0 means the default.
+func (w Wasm) GetMemoryLimitPages() uint32 {
+	if w.MemoryLimitPages == nil {
+		return 0
+	}
+	return *w.MemoryLimitPages
+}
+
+// GetModulePath returns the path of the module in the main container.
+func (w Wasm) GetModulePath() string {
+	if w.ConfigMap != nil {
+		return filepath.Join(PathWasmMount, WasmConfigMapModuleFile)
+	}
+	return filepath.Join(PathWasmMount, w.Path)
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestWasm_GetModulePath(t *testing.T) {
+	w := Wasm{VolumeName: "my-modules", Path: "functions/my-function.wasm"}
+	assert.Equal(t, "/var/numaflow/wasm/functions/my-function.wasm", w.GetModulePath())
+	w = Wasm{ConfigMap: &corev1.ConfigMapKeySelector{Key: "my-function.wasm"}}
+	assert.Equal(t, "/var/numaflow/wasm/module.wasm", w.GetModulePath())
+}
+
+func TestWasm_GetMemoryLimitPages(t *testing.T) {
+	w := Wasm{}
+	assert.Equal(t, uint32(0), w.GetMemoryLimitPages())
+	pages := uint32(16)
+	w.MemoryLimitPages = &pages
+	assert.Equal(t, uint32(16), w.GetMemoryLimitPages())
+}
@@ -97,6 +97,62 @@ func (in *AbstractPodTemplate) DeepCopy() *AbstractPodTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AbstractSink) DeepCopyInto(out *AbstractSink) {
+	*out = *in
+	if in.Log != nil {
+		in, out := &in.Log, &out.Log
+		*out = new(Log)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Blackhole != nil {
+		in, out := &in.Blackhole, &out.Blackhole
+		*out = new(Blackhole)
+		**out = **in
+	}
+	if in.UDSink != nil {
+		in, out := &in.UDSink, &out.UDSink
+		*out = new(UDSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(FileSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3Sink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SQL != nil {
+		in, out := &in.SQL, &out.SQL
+		*out = new(SQLSink)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AbstractSink.
+func (in *AbstractSink) DeepCopy() *AbstractSink {
+	if in == nil {
+		return nil
+	}
+	out := new(AbstractSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AbstractVertex) DeepCopyInto(out *AbstractVertex) {
 	*out = *in
@@ -158,6 +214,11 @@ func (in *AbstractVertex) DeepCopyInto(out *AbstractVertex) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.SideInputs != nil {
+		in, out := &in.SideInputs, &out.SideInputs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -260,6 +321,32 @@ func (in *BufferServiceConfig) DeepCopy() *BufferServiceConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CircuitBreaker) DeepCopyInto(out *CircuitBreaker) {
+	*out = *in
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.OpenDuration != nil {
+		in, out := &in.OpenDuration, &out.OpenDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreaker.
+func (in *CircuitBreaker) DeepCopy() *CircuitBreaker {
+	if in == nil {
+		return nil
+	}
+	out := new(CircuitBreaker)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CombinedEdge) DeepCopyInto(out *CombinedEdge) {
 	*out = *in
@@ -449,6 +536,89 @@ func (in *Edge) DeepCopy() *Edge {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileEventTime) DeepCopyInto(out *FileEventTime) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileEventTime.
+func (in *FileEventTime) DeepCopy() *FileEventTime {
+	if in == nil {
+		return nil
+	}
+	out := new(FileEventTime)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSink) DeepCopyInto(out *FileSink) {
+	*out = *in
+	if in.PartitionDuration != nil {
+		in, out := &in.PartitionDuration, &out.PartitionDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxFileSize != nil {
+		in, out := &in.MaxFileSize, &out.MaxFileSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.RollInterval != nil {
+		in, out := &in.RollInterval, &out.RollInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Parquet != nil {
+		in, out := &in.Parquet, &out.Parquet
+		*out = new(ParquetOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSink.
+func (in *FileSink) DeepCopy() *FileSink {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSource) DeepCopyInto(out *FileSource) {
+	*out = *in
+	if in.Follow != nil {
+		in, out := &in.Follow, &out.Follow
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PollInterval != nil {
+		in, out := &in.PollInterval, &out.PollInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.EventTime != nil {
+		in, out := &in.EventTime, &out.EventTime
+		*out = new(FileEventTime)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSource.
+func (in *FileSource) DeepCopy() *FileSource {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FixedWindow) DeepCopyInto(out *FixedWindow) {
 	*out = *in
@@ -519,6 +689,37 @@ func (in *Function) DeepCopy() *Function {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCSource) DeepCopyInto(out *GRPCSource) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(Authorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCSource.
+func (in *GRPCSource) DeepCopy() *GRPCSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GSSAPI) DeepCopyInto(out *GSSAPI) {
 	*out = *in
@@ -588,6 +789,31 @@ func (in *GeneratorSource) DeepCopyInto(out *GeneratorSource) {
 		*out = new(uint64)
 		**out = **in
 	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyDistribution != nil {
+		in, out := &in.KeyDistribution, &out.KeyDistribution
+		*out = new(KeyDistribution)
+		**out = **in
+	}
+	if in.Jitter != nil {
+		in, out := &in.Jitter, &out.Jitter
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.LatePercent != nil {
+		in, out := &in.LatePercent, &out.LatePercent
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.LateBy != nil {
+		in, out := &in.LateBy, &out.LateBy
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -716,6 +942,29 @@ func (in *GetRedisStatefulSetSpecReq) DeepCopy() *GetRedisStatefulSetSpecReq {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GetSideInputDeploymentReq) DeepCopyInto(out *GetSideInputDeploymentReq) {
+	*out = *in
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GetSideInputDeploymentReq.
+func (in *GetSideInputDeploymentReq) DeepCopy() *GetSideInputDeploymentReq {
+	if in == nil {
+		return nil
+	}
+	out := new(GetSideInputDeploymentReq)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GetVertexPodSpecReq) DeepCopyInto(out *GetVertexPodSpecReq) {
 	*out = *in
@@ -766,6 +1015,54 @@ func (in *GroupBy) DeepCopy() *GroupBy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPSink) DeepCopyInto(out *HTTPSink) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(Authorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RetryableStatusCodes != nil {
+		in, out := &in.RetryableStatusCodes, &out.RetryableStatusCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSink.
+func (in *HTTPSink) DeepCopy() *HTTPSink {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPSource) DeepCopyInto(out *HTTPSource) {
 	*out = *in
@@ -1020,6 +1317,11 @@ func (in *KafkaSink) DeepCopyInto(out *KafkaSink) {
 		*out = new(SASL)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SchemaRegistry != nil {
+		in, out := &in.SchemaRegistry, &out.SchemaRegistry
+		*out = new(SchemaRegistry)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1051,6 +1353,11 @@ func (in *KafkaSource) DeepCopyInto(out *KafkaSource) {
 		*out = new(SASL)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SchemaRegistry != nil {
+		in, out := &in.SchemaRegistry, &out.SchemaRegistry
+		*out = new(SchemaRegistry)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1064,6 +1371,22 @@ func (in *KafkaSource) DeepCopy() *KafkaSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyDistribution) DeepCopyInto(out *KeyDistribution) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyDistribution.
+func (in *KeyDistribution) DeepCopy() *KeyDistribution {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyDistribution)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Lifecycle) DeepCopyInto(out *Lifecycle) {
 	*out = *in
@@ -1088,6 +1411,16 @@ func (in *Lifecycle) DeepCopy() *Lifecycle {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Log) DeepCopyInto(out *Log) {
 	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]LogField, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxPayloadLength != nil {
+		in, out := &in.MaxPayloadLength, &out.MaxPayloadLength
+		*out = new(uint32)
+		**out = **in
+	}
 	return
 }
 
@@ -1266,6 +1599,32 @@ func (in *PBQStorage) DeepCopy() *PBQStorage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParquetOptions) DeepCopyInto(out *ParquetOptions) {
+	*out = *in
+	if in.InferSchemaSampleSize != nil {
+		in, out := &in.InferSchemaSampleSize, &out.InferSchemaSampleSize
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RowGroupSize != nil {
+		in, out := &in.RowGroupSize, &out.RowGroupSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParquetOptions.
+func (in *ParquetOptions) DeepCopy() *ParquetOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ParquetOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PersistenceStrategy) DeepCopyInto(out *PersistenceStrategy) {
 	*out = *in
@@ -1423,6 +1782,13 @@ func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
 		*out = new(Templates)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SideInputs != nil {
+		in, out := &in.SideInputs, &out.SideInputs
+		*out = make([]SideInput, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1551,8 +1917,18 @@ func (in *RedisStreamsSource) DeepCopyInto(out *RedisStreamsSource) {
 		*out = new(TLS)
 		(*in).DeepCopyInto(*out)
 	}
-	return
-}
+	if in.Streams != nil {
+		in, out := &in.Streams, &out.Streams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClaimIdleTime != nil {
+		in, out := &in.ClaimIdleTime, &out.ClaimIdleTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisStreamsSource.
 func (in *RedisStreamsSource) DeepCopy() *RedisStreamsSource {
@@ -1564,6 +1940,73 @@ func (in *RedisStreamsSource) DeepCopy() *RedisStreamsSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteUDF) DeepCopyInto(out *RemoteUDF) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CircuitBreaker != nil {
+		in, out := &in.CircuitBreaker, &out.CircuitBreaker
+		*out = new(CircuitBreaker)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteUDF.
+func (in *RemoteUDF) DeepCopy() *RemoteUDF {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteUDF)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3Sink) DeepCopyInto(out *S3Sink) {
+	*out = *in
+	if in.AccessKey != nil {
+		in, out := &in.AccessKey, &out.AccessKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKey != nil {
+		in, out := &in.SecretKey, &out.SecretKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PartSize != nil {
+		in, out := &in.PartSize, &out.PartSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Sink.
+func (in *S3Sink) DeepCopy() *S3Sink {
+	if in == nil {
+		return nil
+	}
+	out := new(S3Sink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SASL) DeepCopyInto(out *SASL) {
 	*out = *in
@@ -1621,6 +2064,53 @@ func (in *SASLPlain) DeepCopy() *SASLPlain {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQLArg) DeepCopyInto(out *SQLArg) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLArg.
+func (in *SQLArg) DeepCopy() *SQLArg {
+	if in == nil {
+		return nil
+	}
+	out := new(SQLArg)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQLSink) DeepCopyInto(out *SQLSink) {
+	*out = *in
+	if in.DSN != nil {
+		in, out := &in.DSN, &out.DSN
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]SQLArg, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxOpenConns != nil {
+		in, out := &in.MaxOpenConns, &out.MaxOpenConns
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLSink.
+func (in *SQLSink) DeepCopy() *SQLSink {
+	if in == nil {
+		return nil
+	}
+	out := new(SQLSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Scale) DeepCopyInto(out *Scale) {
 	*out = *in
@@ -1678,26 +2168,145 @@ func (in *Scale) DeepCopy() *Scale {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Sink) DeepCopyInto(out *Sink) {
+func (in *SchemaRegistry) DeepCopyInto(out *SchemaRegistry) {
 	*out = *in
-	if in.Log != nil {
-		in, out := &in.Log, &out.Log
-		*out = new(Log)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CacheTTL != nil {
+		in, out := &in.CacheTTL, &out.CacheTTL
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.Kafka != nil {
-		in, out := &in.Kafka, &out.Kafka
-		*out = new(KafkaSink)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaRegistry.
+func (in *SchemaRegistry) DeepCopy() *SchemaRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SideInput) DeepCopyInto(out *SideInput) {
+	*out = *in
+	if in.Container != nil {
+		in, out := &in.Container, &out.Container
+		*out = new(Container)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Blackhole != nil {
-		in, out := &in.Blackhole, &out.Blackhole
-		*out = new(Blackhole)
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]v1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Trigger != nil {
+		in, out := &in.Trigger, &out.Trigger
+		*out = new(SideInputTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Builtin != nil {
+		in, out := &in.Builtin, &out.Builtin
+		*out = new(SideInputBuiltin)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SideInput.
+func (in *SideInput) DeepCopy() *SideInput {
+	if in == nil {
+		return nil
+	}
+	out := new(SideInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SideInputBuiltin) DeepCopyInto(out *SideInputBuiltin) {
+	*out = *in
+	if in.KWArgs != nil {
+		in, out := &in.KWArgs, &out.KWArgs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SideInputBuiltin.
+func (in *SideInputBuiltin) DeepCopy() *SideInputBuiltin {
+	if in == nil {
+		return nil
+	}
+	out := new(SideInputBuiltin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SideInputTrigger) DeepCopyInto(out *SideInputTrigger) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.UDSink != nil {
-		in, out := &in.UDSink, &out.UDSink
-		*out = new(UDSink)
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SideInputTrigger.
+func (in *SideInputTrigger) DeepCopy() *SideInputTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(SideInputTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Sink) DeepCopyInto(out *Sink) {
+	*out = *in
+	in.AbstractSink.DeepCopyInto(&out.AbstractSink)
+	if in.Fallback != nil {
+		in, out := &in.Fallback, &out.Fallback
+		*out = new(AbstractSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Batching != nil {
+		in, out := &in.Batching, &out.Batching
+		*out = new(SinkBatching)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(SinkRateLimit)
 		(*in).DeepCopyInto(*out)
 	}
 	return
@@ -1713,6 +2322,73 @@ func (in *Sink) DeepCopy() *Sink {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SinkBatching) DeepCopyInto(out *SinkBatching) {
+	*out = *in
+	if in.MaxBatchSize != nil {
+		in, out := &in.MaxBatchSize, &out.MaxBatchSize
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.MaxBatchBytes != nil {
+		in, out := &in.MaxBatchBytes, &out.MaxBatchBytes
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxLatency != nil {
+		in, out := &in.MaxLatency, &out.MaxLatency
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SinkBatching.
+func (in *SinkBatching) DeepCopy() *SinkBatching {
+	if in == nil {
+		return nil
+	}
+	out := new(SinkBatching)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SinkRateLimit) DeepCopyInto(out *SinkRateLimit) {
+	*out = *in
+	if in.MessagesPerSecond != nil {
+		in, out := &in.MessagesPerSecond, &out.MessagesPerSecond
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MessageBurst != nil {
+		in, out := &in.MessageBurst, &out.MessageBurst
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.BytesPerSecond != nil {
+		in, out := &in.BytesPerSecond, &out.BytesPerSecond
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ByteBurst != nil {
+		in, out := &in.ByteBurst, &out.ByteBurst
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SinkRateLimit.
+func (in *SinkRateLimit) DeepCopy() *SinkRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(SinkRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SlidingWindow) DeepCopyInto(out *SlidingWindow) {
 	*out = *in
@@ -1772,6 +2448,16 @@ func (in *Source) DeepCopyInto(out *Source) {
 		*out = new(UDTransformer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(FileSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(GRPCSource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1937,6 +2623,16 @@ func (in *UDF) DeepCopyInto(out *UDF) {
 		*out = new(GroupBy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Wasm != nil {
+		in, out := &in.Wasm, &out.Wasm
+		*out = new(Wasm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Remote != nil {
+		in, out := &in.Remote, &out.Remote
+		*out = new(RemoteUDF)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1980,6 +2676,11 @@ func (in *UDTransformer) DeepCopyInto(out *UDTransformer) {
 		*out = new(Transformer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Wasm != nil {
+		in, out := &in.Wasm, &out.Wasm
+		*out = new(Wasm)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2065,6 +2766,16 @@ func (in *VertexLimits) DeepCopyInto(out *VertexLimits) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.UDFTimeout != nil {
+		in, out := &in.UDFTimeout, &out.UDFTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.UDFCircuitBreaker != nil {
+		in, out := &in.UDFCircuitBreaker, &out.UDFCircuitBreaker
+		*out = new(CircuitBreaker)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2165,6 +2876,32 @@ func (in *VertexStatus) DeepCopy() *VertexStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Wasm) DeepCopyInto(out *Wasm) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MemoryLimitPages != nil {
+		in, out := &in.MemoryLimitPages, &out.MemoryLimitPages
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Wasm.
+func (in *Wasm) DeepCopy() *Wasm {
+	if in == nil {
+		return nil
+	}
+	out := new(Wasm)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Watermark) DeepCopyInto(out *Watermark) {
 	*out = *in
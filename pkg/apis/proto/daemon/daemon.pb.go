@@ -146,10 +146,12 @@ func (m *BufferInfo) GetIsFull() bool {
 
 // VertexMetrics is used to provide information about the vertex including processing rate.
 type VertexMetrics struct {
-	Pipeline             *string            `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
-	Vertex               *string            `protobuf:"bytes,2,req,name=vertex" json:"vertex,omitempty"`
-	ProcessingRates      map[string]float64 `protobuf:"bytes,3,rep,name=processingRates" json:"processingRates,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
-	Pendings             map[string]int64   `protobuf:"bytes,4,rep,name=pendings" json:"pendings,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Pipeline        *string            `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	Vertex          *string            `protobuf:"bytes,2,req,name=vertex" json:"vertex,omitempty"`
+	ProcessingRates map[string]float64 `protobuf:"bytes,3,rep,name=processingRates" json:"processingRates,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	Pendings        map[string]int64   `protobuf:"bytes,4,rep,name=pendings" json:"pendings,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Seconds per second the replicas of a rate limited sink spend waiting for the rate limits, in total.
+	ThrottledRates       map[string]float64 `protobuf:"bytes,5,rep,name=throttledRates" json:"throttledRates,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -216,6 +218,13 @@ func (m *VertexMetrics) GetPendings() map[string]int64 {
 	return nil
 }
 
+func (m *VertexMetrics) GetThrottledRates() map[string]float64 {
+	if m != nil {
+		return m.ThrottledRates
+	}
+	return nil
+}
+
 // PipelineStatus
 type PipelineStatus struct {
 	Status               *string  `protobuf:"bytes,1,req,name=status" json:"status,omitempty"`
@@ -836,6 +845,7 @@ func init() {
 	proto.RegisterType((*VertexMetrics)(nil), "daemon.VertexMetrics")
 	proto.RegisterMapType((map[string]int64)(nil), "daemon.VertexMetrics.PendingsEntry")
 	proto.RegisterMapType((map[string]float64)(nil), "daemon.VertexMetrics.ProcessingRatesEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "daemon.VertexMetrics.ThrottledRatesEntry")
 	proto.RegisterType((*PipelineStatus)(nil), "daemon.PipelineStatus")
 	proto.RegisterType((*ListBuffersRequest)(nil), "daemon.ListBuffersRequest")
 	proto.RegisterType((*ListBuffersResponse)(nil), "daemon.ListBuffersResponse")
@@ -855,64 +865,66 @@ func init() {
 }
 
 var fileDescriptor_93e327fd0d673221 = []byte{
-	// 905 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x5f, 0x6f, 0xdb, 0x54,
-	0x14, 0x97, 0xed, 0xfe, 0x49, 0x4f, 0x09, 0x2b, 0x87, 0xad, 0x78, 0xde, 0x28, 0xc6, 0xeb, 0xc0,
-	0x94, 0x11, 0x43, 0x24, 0xa6, 0x69, 0x93, 0x18, 0xca, 0xc8, 0x2a, 0x44, 0x8b, 0x2a, 0x0f, 0x98,
-	0xc4, 0x9b, 0x93, 0xdc, 0x78, 0x26, 0xfe, 0x87, 0xef, 0x75, 0x4a, 0x35, 0xf5, 0x05, 0x89, 0x67,
-	0x1e, 0xd0, 0xbe, 0x0a, 0x1f, 0x01, 0xf1, 0x88, 0xc4, 0x23, 0x2f, 0xa8, 0xe2, 0x83, 0x20, 0xdf,
-	0x7b, 0x9d, 0xda, 0xa9, 0x93, 0xf6, 0x29, 0xf7, 0x9c, 0xf3, 0x3b, 0xe7, 0xfc, 0xee, 0xf9, 0x73,
-	0x1d, 0xb0, 0xd2, 0x89, 0xef, 0x78, 0x69, 0x40, 0x9d, 0x34, 0x4b, 0x58, 0xe2, 0x8c, 0x3c, 0x12,
-	0x25, 0xb1, 0xfc, 0xe9, 0x70, 0x1d, 0xae, 0x09, 0xc9, 0xb8, 0xed, 0x27, 0x89, 0x1f, 0x92, 0x02,
-	0xee, 0x78, 0x71, 0x9c, 0x30, 0x8f, 0x05, 0x49, 0x4c, 0x05, 0xca, 0xb8, 0x25, 0xad, 0x5c, 0x1a,
-	0xe4, 0x63, 0x87, 0x44, 0x29, 0x3b, 0x11, 0x46, 0xeb, 0x0f, 0x15, 0xa0, 0x97, 0x8f, 0xc7, 0x24,
-	0xfb, 0x32, 0x1e, 0x27, 0x68, 0x40, 0x2b, 0x0d, 0x52, 0x12, 0x06, 0x31, 0xd1, 0x15, 0x53, 0xb5,
-	0x37, 0xdc, 0x99, 0x8c, 0x3b, 0x00, 0x03, 0x8e, 0xfc, 0xda, 0x8b, 0x88, 0xae, 0x72, 0x6b, 0x45,
-	0x83, 0x16, 0xbc, 0x96, 0x92, 0x78, 0x14, 0xc4, 0xfe, 0x93, 0x24, 0x8f, 0x99, 0xae, 0x99, 0xaa,
-	0xad, 0xb9, 0x35, 0x1d, 0xda, 0x70, 0xcd, 0x1b, 0x4e, 0x8e, 0xaa, 0xb0, 0x15, 0x0e, 0x9b, 0x57,
-	0xe3, 0x2e, 0xb4, 0x59, 0xc2, 0xbc, 0xf0, 0x90, 0x50, 0xea, 0xf9, 0x84, 0xea, 0xab, 0x1c, 0x57,
-	0x57, 0x16, 0x39, 0x05, 0x83, 0x03, 0x12, 0xfb, 0xec, 0x85, 0xbe, 0x26, 0x72, 0x56, 0x75, 0xb8,
-	0x07, 0x5b, 0x42, 0xfe, 0xb6, 0xf0, 0x39, 0x08, 0xa2, 0x80, 0xe9, 0xeb, 0xa6, 0x6a, 0x2b, 0xee,
-	0x05, 0x3d, 0x9a, 0xb0, 0x59, 0xd1, 0xe9, 0x2d, 0x0e, 0xab, 0xaa, 0x70, 0x1b, 0xd6, 0x02, 0xfa,
-	0x34, 0x0f, 0x43, 0x7d, 0xc3, 0x54, 0xed, 0x96, 0x2b, 0x25, 0xeb, 0x1f, 0x15, 0xda, 0xdf, 0x91,
-	0x8c, 0x91, 0x9f, 0x0e, 0x09, 0xcb, 0x82, 0x21, 0x5d, 0x5a, 0xcb, 0x6d, 0x58, 0x9b, 0x72, 0xb0,
-	0xac, 0xa3, 0x94, 0xf0, 0x1b, 0xb8, 0x96, 0x66, 0xc9, 0x90, 0x50, 0x1a, 0xc4, 0xbe, 0xeb, 0x31,
-	0x42, 0x75, 0xcd, 0xd4, 0xec, 0xcd, 0xee, 0x5e, 0x47, 0x76, 0xbe, 0x96, 0xa3, 0x73, 0x54, 0x07,
-	0xf7, 0x63, 0x96, 0x9d, 0xb8, 0xf3, 0x21, 0xf0, 0x31, 0xb4, 0x64, 0x17, 0xa8, 0xbe, 0xc2, 0xc3,
-	0xdd, 0x59, 0x10, 0x4e, 0xa2, 0x44, 0x9c, 0x99, 0x93, 0xd1, 0x83, 0xeb, 0x4d, 0x99, 0x70, 0x0b,
-	0xb4, 0x09, 0x39, 0xd1, 0x15, 0x53, 0xb1, 0x37, 0xdc, 0xe2, 0x88, 0xd7, 0x61, 0x75, 0xea, 0x85,
-	0x79, 0x31, 0x1f, 0x8a, 0xad, 0xb8, 0x42, 0x78, 0xa8, 0x3e, 0x50, 0x8c, 0x47, 0xd0, 0xae, 0x85,
-	0xbf, 0xcc, 0x59, 0xab, 0x38, 0x5b, 0x3d, 0x78, 0xfd, 0x48, 0xd6, 0xee, 0x19, 0xf3, 0x58, 0x4e,
-	0x8b, 0x0a, 0x52, 0x7e, 0x92, 0xb5, 0x95, 0x12, 0xea, 0xb0, 0x1e, 0x89, 0xe9, 0x90, 0xa5, 0x2d,
-	0x45, 0xeb, 0x63, 0xc0, 0x83, 0x80, 0x32, 0x31, 0xed, 0xd4, 0x25, 0x3f, 0xe6, 0x84, 0xb2, 0x65,
-	0x5d, 0xb2, 0x9e, 0xc0, 0x9b, 0x35, 0x0f, 0x9a, 0x26, 0x31, 0x25, 0x78, 0x0f, 0xd6, 0xc5, 0x44,
-	0x14, 0xb9, 0x8b, 0x6a, 0x62, 0x59, 0xcd, 0xf3, 0x4d, 0x72, 0x4b, 0x88, 0xf5, 0x14, 0xb6, 0xf6,
-	0x89, 0x8c, 0x71, 0x85, 0xa4, 0xc5, 0xc5, 0x84, 0x6b, 0x39, 0x1a, 0x42, 0xb2, 0x1e, 0xc3, 0x1b,
-	0x95, 0x38, 0x92, 0xca, 0xde, 0x0c, 0x5c, 0x84, 0x69, 0x66, 0x52, 0x06, 0xb8, 0x0f, 0xfa, 0x3e,
-	0x61, 0xf5, 0x32, 0x5e, 0xa5, 0x0a, 0x5f, 0xc1, 0xcd, 0x06, 0x3f, 0x49, 0xa0, 0x53, 0x6b, 0xc3,
-	0x66, 0x77, 0xbb, 0x24, 0x30, 0x87, 0x97, 0x28, 0xeb, 0x10, 0xde, 0xda, 0x27, 0xac, 0x36, 0x75,
-	0x4d, 0x1c, 0xd4, 0x85, 0xfb, 0xa2, 0x55, 0xf7, 0xc5, 0x7a, 0xce, 0xef, 0x34, 0x17, 0x4e, 0x52,
-	0x7b, 0x04, 0xed, 0x69, 0xd5, 0x20, 0x9b, 0x75, 0xa3, 0x71, 0xf4, 0xdd, 0x3a, 0xd6, 0xfa, 0x55,
-	0x81, 0x76, 0x7f, 0xe4, 0x93, 0xe7, 0x1e, 0x23, 0x59, 0xe4, 0x65, 0x93, 0xa5, 0x3d, 0x43, 0x58,
-	0x21, 0xa3, 0xd9, 0xc4, 0xf1, 0x73, 0xf1, 0x5c, 0x1e, 0x97, 0xce, 0x62, 0x8b, 0x35, 0xb7, 0xa2,
-	0xc1, 0x0e, 0x60, 0x40, 0x67, 0xe1, 0xfb, 0xb1, 0x37, 0x08, 0xc9, 0x88, 0xbf, 0x86, 0x2d, 0xb7,
-	0xc1, 0x62, 0x8d, 0xe1, 0xed, 0x4a, 0x1b, 0x66, 0xe6, 0xf3, 0xfb, 0xf6, 0x01, 0xd3, 0x0b, 0xd6,
-	0xf9, 0x4b, 0xd7, 0xee, 0xe4, 0x36, 0x38, 0x58, 0x0f, 0xe1, 0xf6, 0x82, 0x3c, 0x97, 0x8e, 0x4a,
-	0xf7, 0xf7, 0x55, 0x68, 0x7f, 0xc1, 0x13, 0x3d, 0x23, 0xd9, 0x34, 0x18, 0x12, 0x64, 0xb0, 0x59,
-	0x59, 0x21, 0x34, 0x4a, 0x1e, 0x17, 0x37, 0xd1, 0xb8, 0xd5, 0x68, 0x13, 0x97, 0xb3, 0xee, 0xfd,
-	0xfc, 0xf7, 0x7f, 0xbf, 0xa9, 0xef, 0xe1, 0x2e, 0xff, 0xc8, 0x4d, 0x3f, 0x71, 0xca, 0x9c, 0xd4,
-	0x79, 0x59, 0x1e, 0x4f, 0x1d, 0xb9, 0x73, 0x78, 0x0c, 0x1b, 0xb3, 0x5d, 0x41, 0xbd, 0x8c, 0x3b,
-	0xbf, 0x86, 0xc6, 0xcd, 0x06, 0x8b, 0xcc, 0xf7, 0x29, 0xcf, 0xe7, 0xe0, 0x47, 0x57, 0xc9, 0xe7,
-	0xbc, 0x14, 0x87, 0x53, 0x7c, 0xa5, 0xf0, 0x6d, 0xaf, 0x7f, 0x08, 0xde, 0xa9, 0xa4, 0x69, 0x9a,
-	0x7c, 0xc3, 0x5c, 0x0c, 0x90, 0x74, 0x3e, 0xe3, 0x74, 0x1e, 0xe0, 0xfd, 0xa5, 0x74, 0x8a, 0x11,
-	0x0e, 0x86, 0x85, 0x4e, 0x0c, 0xf3, 0xa9, 0x13, 0x49, 0x0a, 0xaf, 0x14, 0xb8, 0xd1, 0xd8, 0x55,
-	0xdc, 0xad, 0xe4, 0x5e, 0xd8, 0x74, 0xe3, 0xee, 0x25, 0x28, 0x49, 0xd3, 0xe1, 0x34, 0x3f, 0xc0,
-	0xf7, 0x97, 0xd2, 0xac, 0x2c, 0xc1, 0x2f, 0x0a, 0x7f, 0xd5, 0xe6, 0xde, 0x76, 0xb3, 0x21, 0x5b,
-	0xed, 0xbd, 0x32, 0xde, 0x5d, 0x82, 0x90, 0x5c, 0x3e, 0xe4, 0x5c, 0xee, 0xe2, 0x9d, 0xa5, 0x5c,
-	0xc4, 0xb3, 0xd4, 0xfb, 0xfc, 0xcf, 0xb3, 0x1d, 0xe5, 0xaf, 0xb3, 0x1d, 0xe5, 0xdf, 0xb3, 0x1d,
-	0xe5, 0xfb, 0xae, 0x1f, 0xb0, 0x17, 0xf9, 0xa0, 0x33, 0x4c, 0x22, 0x27, 0xce, 0x23, 0x2f, 0xcd,
-	0x92, 0x1f, 0xf8, 0x61, 0x1c, 0x26, 0xc7, 0x4e, 0xe3, 0x1f, 0xb3, 0xff, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x90, 0x5c, 0xf8, 0xf5, 0xb0, 0x09, 0x00, 0x00,
+	// 937 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x5f, 0x6f, 0xdc, 0x44,
+	0x10, 0x97, 0xed, 0xfc, 0xb9, 0x4c, 0xb8, 0x36, 0x9d, 0xb6, 0xc1, 0x75, 0x4b, 0x30, 0x6e, 0x0a,
+	0xd7, 0x50, 0xce, 0x10, 0x89, 0xaa, 0x6a, 0x25, 0x0a, 0x29, 0x69, 0x84, 0x48, 0x50, 0x70, 0x0b,
+	0x95, 0x78, 0x73, 0xee, 0xf6, 0x1c, 0x13, 0xff, 0xc3, 0xbb, 0xbe, 0x10, 0x55, 0x79, 0x41, 0xe2,
+	0x99, 0x07, 0xd4, 0xaf, 0xc2, 0x47, 0x40, 0x3c, 0x22, 0xf1, 0x05, 0x50, 0xc4, 0x17, 0xe0, 0x1b,
+	0x20, 0xef, 0xae, 0xaf, 0xeb, 0x3b, 0xdf, 0x25, 0x3c, 0x65, 0x67, 0xe6, 0x37, 0xbf, 0xf9, 0xad,
+	0x67, 0x66, 0x2f, 0xe0, 0x64, 0x47, 0x81, 0xeb, 0x67, 0x21, 0x75, 0xb3, 0x3c, 0x65, 0xa9, 0xdb,
+	0xf7, 0x49, 0x9c, 0x26, 0xf2, 0x4f, 0x97, 0xfb, 0x70, 0x41, 0x58, 0xd6, 0xad, 0x20, 0x4d, 0x83,
+	0x88, 0x94, 0x70, 0xd7, 0x4f, 0x92, 0x94, 0xf9, 0x2c, 0x4c, 0x13, 0x2a, 0x50, 0xd6, 0x4d, 0x19,
+	0xe5, 0xd6, 0x41, 0x31, 0x70, 0x49, 0x9c, 0xb1, 0x13, 0x11, 0x74, 0x7e, 0xd7, 0x01, 0xb6, 0x8a,
+	0xc1, 0x80, 0xe4, 0x5f, 0x24, 0x83, 0x14, 0x2d, 0x68, 0x65, 0x61, 0x46, 0xa2, 0x30, 0x21, 0xa6,
+	0x66, 0xeb, 0x9d, 0x25, 0x6f, 0x64, 0xe3, 0x1a, 0xc0, 0x01, 0x47, 0x7e, 0xe5, 0xc7, 0xc4, 0xd4,
+	0x79, 0x54, 0xf1, 0xa0, 0x03, 0x6f, 0x64, 0x24, 0xe9, 0x87, 0x49, 0xf0, 0x24, 0x2d, 0x12, 0x66,
+	0x1a, 0xb6, 0xde, 0x31, 0xbc, 0x9a, 0x0f, 0x3b, 0x70, 0xd9, 0xef, 0x1d, 0xed, 0xab, 0xb0, 0x39,
+	0x0e, 0x1b, 0x77, 0xe3, 0x3a, 0xb4, 0x59, 0xca, 0xfc, 0x68, 0x8f, 0x50, 0xea, 0x07, 0x84, 0x9a,
+	0xf3, 0x1c, 0x57, 0x77, 0x96, 0x35, 0x85, 0x82, 0x5d, 0x92, 0x04, 0xec, 0xd0, 0x5c, 0x10, 0x35,
+	0x55, 0x1f, 0x6e, 0xc0, 0x8a, 0xb0, 0xbf, 0x29, 0x73, 0x76, 0xc3, 0x38, 0x64, 0xe6, 0xa2, 0xad,
+	0x77, 0x34, 0x6f, 0xc2, 0x8f, 0x36, 0x2c, 0x2b, 0x3e, 0xb3, 0xc5, 0x61, 0xaa, 0x0b, 0x57, 0x61,
+	0x21, 0xa4, 0x4f, 0x8b, 0x28, 0x32, 0x97, 0x6c, 0xbd, 0xd3, 0xf2, 0xa4, 0xe5, 0xfc, 0x6b, 0x40,
+	0xfb, 0x5b, 0x92, 0x33, 0xf2, 0xe3, 0x1e, 0x61, 0x79, 0xd8, 0xa3, 0x33, 0xbf, 0xe5, 0x2a, 0x2c,
+	0x0c, 0x39, 0x58, 0x7e, 0x47, 0x69, 0xe1, 0x73, 0xb8, 0x9c, 0xe5, 0x69, 0x8f, 0x50, 0x1a, 0x26,
+	0x81, 0xe7, 0x33, 0x42, 0x4d, 0xc3, 0x36, 0x3a, 0xcb, 0x9b, 0x1b, 0x5d, 0xd9, 0xf9, 0x5a, 0x8d,
+	0xee, 0x7e, 0x1d, 0xbc, 0x9d, 0xb0, 0xfc, 0xc4, 0x1b, 0xa7, 0xc0, 0xc7, 0xd0, 0x92, 0x5d, 0xa0,
+	0xe6, 0x1c, 0xa7, 0xbb, 0x3d, 0x85, 0x4e, 0xa2, 0x04, 0xcf, 0x28, 0x09, 0xbf, 0x86, 0x4b, 0xec,
+	0x30, 0x4f, 0x19, 0x8b, 0x48, 0x5f, 0xa8, 0x9a, 0xe7, 0x34, 0x77, 0x9b, 0x69, 0x9e, 0xd7, 0xb0,
+	0x82, 0x6c, 0x8c, 0xc0, 0xda, 0x82, 0x6b, 0x4d, 0xe2, 0x71, 0x05, 0x8c, 0x23, 0x72, 0x62, 0x6a,
+	0xb6, 0xd6, 0x59, 0xf2, 0xca, 0x23, 0x5e, 0x83, 0xf9, 0xa1, 0x1f, 0x15, 0xe5, 0xc8, 0x69, 0x1d,
+	0xcd, 0x13, 0xc6, 0x43, 0xfd, 0x81, 0x66, 0x3d, 0x82, 0x76, 0x4d, 0xf1, 0x79, 0xc9, 0x86, 0x9a,
+	0xfc, 0x19, 0x5c, 0x6d, 0xd0, 0xf9, 0x7f, 0xea, 0x3b, 0x5b, 0x70, 0x69, 0x5f, 0x76, 0xf4, 0x19,
+	0xf3, 0x59, 0x41, 0xcb, 0xbe, 0x52, 0x7e, 0x92, 0x1d, 0x97, 0x16, 0x9a, 0xb0, 0x18, 0x8b, 0x99,
+	0x95, 0x0d, 0xaf, 0x4c, 0xe7, 0x43, 0xc0, 0xdd, 0x90, 0x32, 0xb1, 0x83, 0xd4, 0x23, 0x3f, 0x14,
+	0x84, 0xb2, 0x59, 0xb3, 0xe3, 0x3c, 0x81, 0xab, 0xb5, 0x0c, 0x9a, 0xa5, 0x09, 0x25, 0x78, 0x0f,
+	0x16, 0xc5, 0x9c, 0x96, 0xb5, 0xcb, 0xe6, 0x60, 0xd5, 0x9c, 0xd7, 0xfb, 0xed, 0x55, 0x10, 0xe7,
+	0x29, 0xac, 0xec, 0x10, 0xc9, 0x71, 0x81, 0xa2, 0xe5, 0xc5, 0x44, 0x6a, 0x35, 0xb0, 0xc2, 0x72,
+	0x1e, 0xc3, 0x15, 0x85, 0x47, 0x4a, 0xd9, 0x18, 0x81, 0x4b, 0x9a, 0x66, 0x25, 0x15, 0xc1, 0x7d,
+	0x30, 0x77, 0x08, 0xab, 0x7f, 0xc6, 0x8b, 0x7c, 0x85, 0x2f, 0xe1, 0x46, 0x43, 0x9e, 0x14, 0xd0,
+	0xad, 0xb5, 0x61, 0x79, 0x73, 0xb5, 0x12, 0x30, 0x86, 0x97, 0x28, 0x67, 0x0f, 0xde, 0xdc, 0x21,
+	0xac, 0x36, 0xc4, 0x4d, 0x1a, 0xf4, 0xa9, 0x5b, 0x6c, 0xa8, 0x5b, 0xec, 0xbc, 0x00, 0x73, 0x92,
+	0x4e, 0x4a, 0x7b, 0x04, 0xed, 0xa1, 0x1a, 0x90, 0xcd, 0xba, 0xde, 0xb8, 0x49, 0x5e, 0x1d, 0xeb,
+	0xfc, 0xa2, 0x41, 0x7b, 0xbb, 0x1f, 0x90, 0x17, 0x3e, 0x23, 0x79, 0xec, 0xe7, 0x47, 0x33, 0x7b,
+	0x86, 0x30, 0x47, 0xfa, 0xa3, 0x89, 0xe3, 0xe7, 0xf2, 0x11, 0x3f, 0xae, 0x92, 0xc5, 0xdb, 0x62,
+	0x78, 0x8a, 0x07, 0xbb, 0x80, 0x21, 0x1d, 0xd1, 0x6f, 0x27, 0xfe, 0x41, 0x44, 0xfa, 0xfc, 0x8d,
+	0x6e, 0x79, 0x0d, 0x11, 0x67, 0x00, 0x6f, 0x29, 0x6d, 0x18, 0x85, 0x5f, 0xdf, 0x77, 0x1b, 0x30,
+	0x9b, 0x88, 0x8e, 0x5f, 0xba, 0x76, 0x27, 0xaf, 0x21, 0xc1, 0x79, 0x08, 0xb7, 0xa6, 0xd4, 0x39,
+	0x77, 0x54, 0x36, 0x7f, 0x9b, 0x87, 0xf6, 0xe7, 0xbc, 0xd0, 0x33, 0x92, 0x0f, 0xc3, 0x1e, 0x41,
+	0x06, 0xcb, 0xca, 0x0a, 0xa1, 0x55, 0xe9, 0x98, 0xdc, 0x44, 0xeb, 0x66, 0x63, 0x4c, 0x5c, 0xce,
+	0xb9, 0xf7, 0xd3, 0x5f, 0xff, 0xfc, 0xaa, 0xbf, 0x8b, 0xeb, 0xfc, 0xa7, 0x77, 0xf8, 0x91, 0x5b,
+	0xd5, 0xa4, 0xee, 0xcb, 0xea, 0x78, 0xea, 0xca, 0x9d, 0xc3, 0x63, 0x58, 0x1a, 0xed, 0x0a, 0x9a,
+	0x15, 0xef, 0xf8, 0x1a, 0x5a, 0x37, 0x1a, 0x22, 0xb2, 0xde, 0xc7, 0xbc, 0x9e, 0x8b, 0x1f, 0x5c,
+	0xa4, 0x9e, 0xfb, 0x52, 0x1c, 0x4e, 0xf1, 0x95, 0xc6, 0xb7, 0xbd, 0xfe, 0xf3, 0xf4, 0xb6, 0x52,
+	0xa6, 0x69, 0xf2, 0x2d, 0x7b, 0x3a, 0x40, 0xca, 0xf9, 0x84, 0xcb, 0x79, 0x80, 0xf7, 0x67, 0xca,
+	0x29, 0x47, 0x38, 0xec, 0x95, 0x3e, 0x31, 0xcc, 0xa7, 0x6e, 0x2c, 0x25, 0xbc, 0xd2, 0xe0, 0x7a,
+	0x63, 0x57, 0x71, 0x5d, 0xa9, 0x3d, 0xb5, 0xe9, 0xd6, 0x9d, 0x73, 0x50, 0x52, 0xa6, 0xcb, 0x65,
+	0xde, 0xc5, 0xf7, 0x66, 0xca, 0x54, 0x96, 0xe0, 0x67, 0x0d, 0xae, 0x28, 0x94, 0xf2, 0x6d, 0xb7,
+	0x1b, 0xaa, 0xd5, 0xde, 0x2b, 0xeb, 0x9d, 0x19, 0x08, 0xa9, 0xe5, 0x7d, 0xae, 0xe5, 0x0e, 0xde,
+	0x9e, 0xa9, 0x45, 0x3c, 0x4b, 0x5b, 0x9f, 0xfe, 0x71, 0xb6, 0xa6, 0xfd, 0x79, 0xb6, 0xa6, 0xfd,
+	0x7d, 0xb6, 0xa6, 0x7d, 0xb7, 0x19, 0x84, 0xec, 0xb0, 0x38, 0xe8, 0xf6, 0xd2, 0xd8, 0x4d, 0x8a,
+	0xd8, 0xcf, 0xf2, 0xf4, 0x7b, 0x7e, 0x18, 0x44, 0xe9, 0xb1, 0xdb, 0xf8, 0xef, 0xe2, 0x7f, 0x03,
+	0x00, 0xee, 0x10, 0x60, 0x29, 0x46, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1266,6 +1278,24 @@ func (m *VertexMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ThrottledRates) > 0 {
+		for k := range m.ThrottledRates {
+			v := m.ThrottledRates[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDaemon(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDaemon(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Pendings) > 0 {
 		for k := range m.Pendings {
 			v := m.Pendings[k]
@@ -1916,6 +1946,14 @@ func (m *VertexMetrics) Size() (n int) {
 			n += mapEntrySize + 1 + sovDaemon(uint64(mapEntrySize))
 		}
 	}
+	if len(m.ThrottledRates) > 0 {
+		for k, v := range m.ThrottledRates {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDaemon(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovDaemon(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2746,6 +2784,112 @@ func (m *VertexMetrics) Unmarshal(dAtA []byte) error {
 			}
 			m.Pendings[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrottledRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ThrottledRates == nil {
+				m.ThrottledRates = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDaemon
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDaemon
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDaemon
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDaemon
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDaemon(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDaemon
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ThrottledRates[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
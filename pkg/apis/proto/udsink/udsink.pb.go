@@ -0,0 +1,1180 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pkg/apis/proto/udsink/udsink.proto
+
+package udsink
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Status is the outcome of writing a message to the user defined sink.
+type Status int32
+
+const (
+	// The message failed to be written and should be retried. It's the default, so that a response without a status
+	// is retried rather than lost.
+	Status_RETRYABLE Status = 0
+	// The message is written successfully.
+	Status_SUCCESS Status = 1
+	// The message failed to be written and should not be retried, it's written to the fallback sink if there is one,
+	// otherwise it's dropped.
+	Status_PERMANENT Status = 2
+)
+
+var Status_name = map[int32]string{
+	0: "RETRYABLE",
+	1: "SUCCESS",
+	2: "PERMANENT",
+}
+
+var Status_value = map[string]int32{
+	"RETRYABLE": 0,
+	"SUCCESS":   1,
+	"PERMANENT": 2,
+}
+
+func (x Status) String() string {
+	return proto.EnumName(Status_name, int32(x))
+}
+
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dba6cb1a1868ff7d, []int{0}
+}
+
+// DatumRequest is a message sent to the user defined sink.
+type DatumRequest struct {
+	// ID of the message, the response of the message is sent back with the same ID.
+	Id    string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Keys  []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Value []byte   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// Event time of the message in milliseconds since epoch.
+	EventTime int64 `protobuf:"varint,4,opt,name=eventTime,proto3" json:"eventTime,omitempty"`
+	// Watermark of the message in milliseconds since epoch.
+	Watermark            int64    `protobuf:"varint,5,opt,name=watermark,proto3" json:"watermark,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatumRequest) Reset()         { *m = DatumRequest{} }
+func (m *DatumRequest) String() string { return proto.CompactTextString(m) }
+func (*DatumRequest) ProtoMessage()    {}
+func (*DatumRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dba6cb1a1868ff7d, []int{0}
+}
+func (m *DatumRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DatumRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DatumRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DatumRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatumRequest.Merge(m, src)
+}
+func (m *DatumRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DatumRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatumRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatumRequest proto.InternalMessageInfo
+
+func (m *DatumRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DatumRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *DatumRequest) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *DatumRequest) GetEventTime() int64 {
+	if m != nil {
+		return m.EventTime
+	}
+	return 0
+}
+
+func (m *DatumRequest) GetWatermark() int64 {
+	if m != nil {
+		return m.Watermark
+	}
+	return 0
+}
+
+// Response is the outcome of a message sent to the user defined sink.
+type Response struct {
+	// ID of the message the response is for.
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status Status `protobuf:"varint,2,opt,name=status,proto3,enum=udsink.Status" json:"status,omitempty"`
+	// Error message of a failed message.
+	ErrMsg string `protobuf:"bytes,3,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	// Backoff of a retryable message in milliseconds, the message is not retried within the backoff. The exponential
+	// backoff of the forwarder is used if it's not set.
+	BackoffMillis        int64    `protobuf:"varint,4,opt,name=backoffMillis,proto3" json:"backoffMillis,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Response) Reset()         { *m = Response{} }
+func (m *Response) String() string { return proto.CompactTextString(m) }
+func (*Response) ProtoMessage()    {}
+func (*Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dba6cb1a1868ff7d, []int{1}
+}
+func (m *Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Response.Merge(m, src)
+}
+func (m *Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Response proto.InternalMessageInfo
+
+func (m *Response) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Response) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_RETRYABLE
+}
+
+func (m *Response) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+func (m *Response) GetBackoffMillis() int64 {
+	if m != nil {
+		return m.BackoffMillis
+	}
+	return 0
+}
+
+// ResponseList contains the responses of all the messages sent on the stream.
+type ResponseList struct {
+	Responses            []*Response `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ResponseList) Reset()         { *m = ResponseList{} }
+func (m *ResponseList) String() string { return proto.CompactTextString(m) }
+func (*ResponseList) ProtoMessage()    {}
+func (*ResponseList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dba6cb1a1868ff7d, []int{2}
+}
+func (m *ResponseList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseList.Merge(m, src)
+}
+func (m *ResponseList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseList proto.InternalMessageInfo
+
+func (m *ResponseList) GetResponses() []*Response {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("udsink.Status", Status_name, Status_value)
+	proto.RegisterType((*DatumRequest)(nil), "udsink.DatumRequest")
+	proto.RegisterType((*Response)(nil), "udsink.Response")
+	proto.RegisterType((*ResponseList)(nil), "udsink.ResponseList")
+}
+
+func init() {
+	proto.RegisterFile("pkg/apis/proto/udsink/udsink.proto", fileDescriptor_dba6cb1a1868ff7d)
+}
+
+var fileDescriptor_dba6cb1a1868ff7d = []byte{
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcb, 0xab, 0xd3, 0x40,
+	0x14, 0xc6, 0x9d, 0xe4, 0xde, 0x68, 0xce, 0xed, 0x2d, 0x61, 0x28, 0x12, 0x44, 0x4a, 0x08, 0x22,
+	0xc1, 0x45, 0x02, 0x29, 0xb8, 0x94, 0xbe, 0xe2, 0xaa, 0x2d, 0x32, 0x69, 0x17, 0xba, 0x9b, 0xb6,
+	0xd3, 0x3a, 0xe6, 0x69, 0x66, 0xd2, 0xe2, 0xce, 0x85, 0x7f, 0x9c, 0x4b, 0xff, 0x04, 0xe9, 0x5f,
+	0x22, 0x79, 0x59, 0x1f, 0x77, 0x75, 0xce, 0xf7, 0x7d, 0x07, 0xbe, 0x5f, 0xc8, 0x80, 0x9d, 0x47,
+	0x47, 0x8f, 0xe6, 0x5c, 0x78, 0x79, 0x91, 0xc9, 0xcc, 0x2b, 0xf7, 0x82, 0xa7, 0x51, 0x3b, 0xdc,
+	0xda, 0xc3, 0x5a, 0xa3, 0xec, 0x6f, 0x08, 0x7a, 0x73, 0x2a, 0xcb, 0x84, 0xb0, 0xcf, 0x25, 0x13,
+	0x12, 0xf7, 0x41, 0xe1, 0x7b, 0x13, 0x59, 0xc8, 0xd1, 0x89, 0xc2, 0xf7, 0x18, 0xc3, 0x4d, 0xc4,
+	0xbe, 0x08, 0x53, 0xb1, 0x54, 0x47, 0x27, 0xf5, 0x8e, 0x07, 0x70, 0x7b, 0xa2, 0x71, 0xc9, 0x4c,
+	0xd5, 0x42, 0x4e, 0x8f, 0x34, 0x02, 0x3f, 0x07, 0x9d, 0x9d, 0x58, 0x2a, 0xd7, 0x3c, 0x61, 0xe6,
+	0x8d, 0x85, 0x1c, 0x95, 0x5c, 0x8d, 0x2a, 0x3d, 0x53, 0xc9, 0x8a, 0x84, 0x16, 0x91, 0x79, 0xdb,
+	0xa4, 0xbf, 0x0d, 0xfb, 0x2b, 0x82, 0x27, 0x84, 0x89, 0x3c, 0x4b, 0x05, 0xfb, 0x0f, 0xe1, 0x25,
+	0x68, 0x42, 0x52, 0x59, 0x56, 0x10, 0xc8, 0xe9, 0xfb, 0x7d, 0xb7, 0xfd, 0x94, 0xb0, 0x76, 0x49,
+	0x9b, 0xe2, 0xa7, 0xa0, 0xb1, 0xa2, 0x58, 0x8a, 0x63, 0xcd, 0xa5, 0x93, 0x56, 0xe1, 0x17, 0x70,
+	0xbf, 0xa5, 0xbb, 0x28, 0x3b, 0x1c, 0x96, 0x3c, 0x8e, 0xb9, 0x68, 0xe1, 0xfe, 0x36, 0xed, 0x37,
+	0xd0, 0xeb, 0x08, 0x16, 0x5c, 0x48, 0xec, 0x82, 0x5e, 0xb4, 0x5a, 0x98, 0xc8, 0x52, 0x9d, 0x3b,
+	0xdf, 0xe8, 0x8a, 0xbb, 0x43, 0x72, 0x3d, 0x79, 0x35, 0x02, 0xad, 0xe1, 0xc1, 0xf7, 0xa0, 0x93,
+	0x60, 0x4d, 0xde, 0x4f, 0xa6, 0x8b, 0xc0, 0x78, 0x84, 0xef, 0xe0, 0x71, 0xb8, 0x99, 0xcd, 0x82,
+	0x30, 0x34, 0x50, 0x95, 0xbd, 0x0b, 0xc8, 0x72, 0xb2, 0x0a, 0x56, 0x6b, 0x43, 0xf1, 0xc7, 0xa0,
+	0x6d, 0xe6, 0x21, 0x4f, 0x23, 0xfc, 0x1a, 0xb4, 0x6a, 0xbe, 0x4d, 0xf1, 0xa0, 0x6b, 0xf9, 0xf3,
+	0xbf, 0x3c, 0x1b, 0xfc, 0xdb, 0x5d, 0x41, 0x3a, 0x68, 0x3a, 0xfe, 0x7e, 0x19, 0xa2, 0x1f, 0x97,
+	0x21, 0xfa, 0x79, 0x19, 0xa2, 0x0f, 0xfe, 0x91, 0xcb, 0x8f, 0xe5, 0xd6, 0xdd, 0x65, 0x89, 0x97,
+	0x96, 0x09, 0xcd, 0x8b, 0xec, 0x53, 0xbd, 0x1c, 0xe2, 0xec, 0xec, 0x3d, 0xf8, 0x2e, 0xb6, 0x5a,
+	0xad, 0x46, 0xbf, 0x06, 0x00, 0x64, 0x65, 0x36, 0x3e, 0x37, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// UDSinkClient is the client API for UDSink service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type UDSinkClient interface {
+	// SinkFn writes the messages sent on the stream to the sink, and responds the status of each message once the
+	// client closes the stream.
+	SinkFn(ctx context.Context, opts ...grpc.CallOption) (UDSink_SinkFnClient, error)
+}
+
+type uDSinkClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewUDSinkClient(cc *grpc.ClientConn) UDSinkClient {
+	return &uDSinkClient{cc}
+}
+
+func (c *uDSinkClient) SinkFn(ctx context.Context, opts ...grpc.CallOption) (UDSink_SinkFnClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_UDSink_serviceDesc.Streams[0], "/udsink.UDSink/SinkFn", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &uDSinkSinkFnClient{stream}
+	return x, nil
+}
+
+type UDSink_SinkFnClient interface {
+	Send(*DatumRequest) error
+	CloseAndRecv() (*ResponseList, error)
+	grpc.ClientStream
+}
+
+type uDSinkSinkFnClient struct {
+	grpc.ClientStream
+}
+
+func (x *uDSinkSinkFnClient) Send(m *DatumRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *uDSinkSinkFnClient) CloseAndRecv() (*ResponseList, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ResponseList)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UDSinkServer is the server API for UDSink service.
+type UDSinkServer interface {
+	// SinkFn writes the messages sent on the stream to the sink, and responds the status of each message once the
+	// client closes the stream.
+	SinkFn(UDSink_SinkFnServer) error
+}
+
+// UnimplementedUDSinkServer can be embedded to have forward compatible implementations.
+type UnimplementedUDSinkServer struct {
+}
+
+func (*UnimplementedUDSinkServer) SinkFn(srv UDSink_SinkFnServer) error {
+	return status.Errorf(codes.Unimplemented, "method SinkFn not implemented")
+}
+
+func RegisterUDSinkServer(s *grpc.Server, srv UDSinkServer) {
+	s.RegisterService(&_UDSink_serviceDesc, srv)
+}
+
+func _UDSink_SinkFn_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UDSinkServer).SinkFn(&uDSinkSinkFnServer{stream})
+}
+
+type UDSink_SinkFnServer interface {
+	SendAndClose(*ResponseList) error
+	Recv() (*DatumRequest, error)
+	grpc.ServerStream
+}
+
+type uDSinkSinkFnServer struct {
+	grpc.ServerStream
+}
+
+func (x *uDSinkSinkFnServer) SendAndClose(m *ResponseList) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *uDSinkSinkFnServer) Recv() (*DatumRequest, error) {
+	m := new(DatumRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _UDSink_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "udsink.UDSink",
+	HandlerType: (*UDSinkServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SinkFn",
+			Handler:       _UDSink_SinkFn_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "pkg/apis/proto/udsink/udsink.proto",
+}
+
+func (m *DatumRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatumRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Watermark != 0 {
+		i = encodeVarintUdsink(dAtA, i, uint64(m.Watermark))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EventTime != 0 {
+		i = encodeVarintUdsink(dAtA, i, uint64(m.EventTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintUdsink(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintUdsink(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUdsink(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BackoffMillis != 0 {
+		i = encodeVarintUdsink(dAtA, i, uint64(m.BackoffMillis))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ErrMsg) > 0 {
+		i -= len(m.ErrMsg)
+		copy(dAtA[i:], m.ErrMsg)
+		i = encodeVarintUdsink(dAtA, i, uint64(len(m.ErrMsg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintUdsink(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUdsink(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUdsink(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUdsink(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUdsink(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DatumRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUdsink(uint64(l))
+	}
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovUdsink(uint64(l))
+		}
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovUdsink(uint64(l))
+	}
+	if m.EventTime != 0 {
+		n += 1 + sovUdsink(uint64(m.EventTime))
+	}
+	if m.Watermark != 0 {
+		n += 1 + sovUdsink(uint64(m.Watermark))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUdsink(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovUdsink(uint64(m.Status))
+	}
+	l = len(m.ErrMsg)
+	if l > 0 {
+		n += 1 + l + sovUdsink(uint64(l))
+	}
+	if m.BackoffMillis != 0 {
+		n += 1 + sovUdsink(uint64(m.BackoffMillis))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovUdsink(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovUdsink(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUdsink(x uint64) (n int) {
+	return sovUdsink(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DatumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUdsink
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUdsink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUdsink
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUdsink
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUdsink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUdsink
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUdsink
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUdsink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUdsink
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUdsink
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTime", wireType)
+			}
+			m.EventTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUdsink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Watermark", wireType)
+			}
+			m.Watermark = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUdsink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Watermark |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUdsink(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUdsink
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUdsink
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUdsink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUdsink
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUdsink
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUdsink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrMsg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUdsink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUdsink
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUdsink
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrMsg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffMillis", wireType)
+			}
+			m.BackoffMillis = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUdsink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackoffMillis |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUdsink(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUdsink
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUdsink
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUdsink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUdsink
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUdsink
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, &Response{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUdsink(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUdsink
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUdsink(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUdsink
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUdsink
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUdsink
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUdsink
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUdsink
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUdsink
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUdsink        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUdsink          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUdsink = fmt.Errorf("proto: unexpected end of group")
+)
@@ -178,6 +178,10 @@ func (ps *pipelineMetadataQuery) GetVertexMetrics(ctx context.Context, req *daem
 		}
 		// get the processing rate for each partition
 		vm.ProcessingRates = ps.rater.GetRates(req.GetVertex(), partitionName)
+		if abstractVertex.Sink != nil && abstractVertex.Sink.RateLimit != nil {
+			// the rate limits are shared by all the partitions of the vertex.
+			vm.ThrottledRates = ps.rater.GetRates(req.GetVertex(), server.ThrottledSecondsKey)
+		}
 		vm.Pendings = partitionPendingInfo[partitionName]
 		metricsArr[idx] = vm
 	}
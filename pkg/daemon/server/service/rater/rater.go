@@ -41,6 +41,10 @@ type Ratable interface {
 // e.g. if the current time is 12:00:07, the retrieved count will be tracked in the 12:00:00-12:00:10 time window using 12:00:10 as the timestamp
 const CountWindow = time.Second * 10
 
+// ThrottledSecondsKey is the key of the time spent waiting for the rate limits of a sink pod, which is tracked along with
+// the read counts of the partitions, so that its rate is the number of seconds per second the pods are throttled.
+const ThrottledSecondsKey = "__throttled_seconds__"
+
 // metricsHttpClient interface for the GET/HEAD call to metrics endpoint.
 // Had to add this an interface for testing
 type metricsHttpClient interface {
@@ -249,6 +253,11 @@ func (r *Rater) getPodReadCounts(vertexName, vertexType, podName string) *PodRea
 			}
 			partitionReadCount[partitionName] = ele.Counter.GetValue()
 		}
+		if value, ok := result["sink_rate_limit_throttled_seconds_total"]; ok && value != nil {
+			for _, ele := range value.GetMetric() {
+				partitionReadCount[ThrottledSecondsKey] += ele.Counter.GetValue()
+			}
+		}
 		podReadCount := &PodReadCount{podName, partitionReadCount}
 		return podReadCount
 	} else {
@@ -29,6 +29,13 @@ type MapApplier interface {
 	ApplyMapStream(ctx context.Context, message *isb.ReadMessage, writeMessageCh chan<- isb.WriteMessage) error
 }
 
+// MapBatchApplier applies the UDF on a batch of read messages in one call, and gives back the write messages of each
+// read message, in the same order as the read messages. Any UserError will be retried here, while InternalErr can be
+// returned and could be retried by the callee.
+type MapBatchApplier interface {
+	ApplyMapBatch(ctx context.Context, messages []*isb.ReadMessage) ([][]*isb.WriteMessage, error)
+}
+
 // ApplyMapFunc utility function used to create an Applier implementation
 type ApplyMapFunc struct {
 	applyMap       func(context.Context, *isb.ReadMessage) ([]*isb.WriteMessage, error)
@@ -36,13 +36,18 @@ import (
 	"github.com/numaproj/numaflow/pkg/forward/applier"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/circuitbreaker"
 	"github.com/numaproj/numaflow/pkg/shared/idlehandler"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shuffle"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/publish"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 )
 
+// udfPauseInterval is how long the reading is paused each time the circuit breaker of the UDF calls is found open.
+const udfPauseInterval = time.Second
+
 // InterStepDataForward forwards the data from previous step to the current step via inter-step buffer.
 type InterStepDataForward struct {
 	// I have my reasons for overriding the default principle https://github.com/golang/go/issues/22602
@@ -55,6 +60,11 @@ type InterStepDataForward struct {
 	toBuffers map[string][]isb.BufferWriter
 	FSD       ToWhichStepDecider
 	UDF       applier.MapApplier
+	// keyShuffle assigns the messages to the UDF processors by the keys, it's only set when the per key ordering is
+	// enabled.
+	keyShuffle *shuffle.Shuffle
+	// batchUDF applies the UDF on all the read messages in one call, it's only set when the UDF batch is enabled.
+	batchUDF  applier.MapBatchApplier
 	wmFetcher fetch.Fetcher
 	// wmPublishers stores the vertex to publisher mapping
 	wmPublishers map[string]publish.Publisher
@@ -117,12 +127,33 @@ func NewInterStepDataForward(vertex *dfv1.Vertex,
 		if isdf.opts.enableMapUdfStream {
 			return nil, fmt.Errorf("stream is not supported for source data transformer")
 		}
+		if isdf.opts.enableMapUdfBatch {
+			return nil, fmt.Errorf("batch is not supported for source data transformer")
+		}
 	}
 
 	if isdf.opts.enableMapUdfStream && isdf.opts.readBatchSize != 1 {
 		return nil, fmt.Errorf("batch size is not 1 with UDF streaming")
 	}
 
+	if isdf.opts.perKeyOrdering {
+		isdf.keyShuffle = shuffle.NewShuffle(vertex.Spec.Name, isdf.opts.udfConcurrency)
+	}
+
+	if isdf.opts.enableMapUdfBatch {
+		if isdf.opts.enableMapUdfStream {
+			return nil, fmt.Errorf("UDF batch and streaming can not be enabled at the same time")
+		}
+		if isdf.opts.perKeyOrdering {
+			return nil, fmt.Errorf("UDF batch and per key ordering can not be enabled at the same time")
+		}
+		batchUDF, ok := applyUDF.(applier.MapBatchApplier)
+		if !ok {
+			return nil, fmt.Errorf("the UDF does not support batch")
+		}
+		isdf.batchUDF = batchUDF
+	}
+
 	return &isdf, nil
 }
 
@@ -174,6 +205,13 @@ func (isdf *InterStepDataForward) Start() <-chan struct{} {
 				}
 			}
 		}
+		if w := isdf.opts.fallbackWriter; w != nil {
+			if err := w.Close(); err != nil {
+				log.Errorw("Failed to close fallback writer, shutdown anyways...", zap.Error(err))
+			} else {
+				log.Info("Closed fallback writer")
+			}
+		}
 
 		// stop watermark fetcher
 		if err := isdf.wmFetcher.Close(); err != nil {
@@ -204,6 +242,13 @@ type readWriteMessagePair struct {
 // the message after forwarding, barring any platform errors. The platform errors include buffer-full,
 // buffer-not-reachable, etc., but does not include errors due to user code UDFs, WhereTo, etc.
 func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) {
+	// pause reading while the circuit breaker of the UDF calls is open, the messages read would be stuck in the
+	// retries of the UDF calls anyway. It's half-open once the open duration elapses, which lets a trial call through.
+	if cb := isdf.opts.udfCircuitBreaker; cb != nil && cb.State() == circuitbreaker.Open {
+		isdf.opts.logger.Warn("Circuit breaker of the UDF calls is open, pausing reading")
+		time.Sleep(udfPauseInterval)
+		return
+	}
 	start := time.Now()
 	// There is a chance that we have read the message and the container got forcefully terminated before processing. To provide
 	// at-least-once semantics for reading, during restart we will have to reprocess all unacknowledged messages. It is the
@@ -258,6 +303,19 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) {
 		}
 	}
 
+	if isdf.opts.rateLimiter != nil {
+		var bytes int
+		for _, m := range dataMessages {
+			bytes += len(m.Payload)
+		}
+		// waiting for the rate limiter holds the following reads, which surfaces as the back pressure of the buffer.
+		if err := isdf.opts.rateLimiter.Wait(ctx, len(dataMessages), bytes); err != nil {
+			// the messages are not acknowledged, they will be read again.
+			isdf.opts.logger.Warnw("Failed to wait for the rate limiter", zap.Error(err))
+			return
+		}
+	}
+
 	var processorWM wmb.Watermark
 	if isdf.opts.vertexType == dfv1.VertexTypeSource {
 		// for source vertex, the udf is the source data transformer.
@@ -282,39 +340,59 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) {
 			messageToStep[toVertex] = make([][]isb.Message, len(isdf.toBuffers[toVertex]))
 		}
 
-		// udf concurrent processing request channel
-		udfCh := make(chan *readWriteMessagePair)
 		// udfResults stores the results after UDF processing for all read messages. It indexes
 		// a read message to the corresponding write message
 		udfResults := make([]readWriteMessagePair, len(dataMessages))
-		// applyUDF, if there is an Internal error it is a blocking call and will return only if shutdown has been initiated.
-
-		// create a pool of UDF Processors
-		var wg sync.WaitGroup
-		for i := 0; i < isdf.opts.udfConcurrency; i++ {
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				isdf.concurrentApplyUDF(ctx, udfCh)
-			}()
-		}
-		concurrentUDFProcessingStart := time.Now()
-
-		// send to UDF only the data messages
 		for idx, m := range dataMessages {
 			// emit message size metric
 			readBytesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Add(float64(len(m.Payload)))
 			// assign watermark to the message. assign time.UnixMilli(-1) as watermark when we are at source vertex.
 			m.Watermark = time.Time(processorWM)
-			// send UDF processing work to the channel
 			udfResults[idx].readMessage = m
-			udfCh <- &udfResults[idx]
 		}
-		// let the go routines know that there is no more work
-		close(udfCh)
-		// wait till the processing is done. this will not be an infinite wait because the UDF processing will exit if
-		// context.Done() is closed.
-		wg.Wait()
+		concurrentUDFProcessingStart := time.Now()
+		if isdf.opts.enableMapUdfBatch {
+			// send all the data messages to the UDF in one call.
+			isdf.batchApplyUDF(ctx, udfResults)
+		} else {
+			// udf concurrent processing request channels. All the UDF processors share one channel, unless the per key
+			// ordering is enabled, in which case each processor has its own channel, and the messages sharing the
+			// same keys are always sent to the same processor.
+			udfChs := []chan *readWriteMessagePair{make(chan *readWriteMessagePair)}
+			if isdf.opts.perKeyOrdering {
+				for i := 1; i < isdf.opts.udfConcurrency; i++ {
+					udfChs = append(udfChs, make(chan *readWriteMessagePair))
+				}
+			}
+			// applyUDF, if there is an Internal error it is a blocking call and will return only if shutdown has been initiated.
+
+			// create a pool of UDF Processors
+			var wg sync.WaitGroup
+			for i := 0; i < isdf.opts.udfConcurrency; i++ {
+				wg.Add(1)
+				go func(udfCh <-chan *readWriteMessagePair) {
+					defer wg.Done()
+					isdf.concurrentApplyUDF(ctx, udfCh)
+				}(udfChs[i%len(udfChs)])
+			}
+
+			// send to UDF only the data messages
+			for idx := range udfResults {
+				udfCh := udfChs[0]
+				if len(udfChs) > 1 {
+					udfCh = udfChs[isdf.keyShuffle.Shuffle(udfResults[idx].readMessage.Keys)]
+				}
+				// send UDF processing work to the channel
+				udfCh <- &udfResults[idx]
+			}
+			// let the go routines know that there is no more work
+			for _, udfCh := range udfChs {
+				close(udfCh)
+			}
+			// wait till the processing is done. this will not be an infinite wait because the UDF processing will exit if
+			// context.Done() is closed.
+			wg.Wait()
+		}
 		isdf.opts.logger.Debugw("concurrent applyUDF completed", zap.Int("concurrency", isdf.opts.udfConcurrency), zap.Duration("took", time.Since(concurrentUDFProcessingStart)))
 		concurrentUDFProcessingTime.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Observe(float64(time.Since(concurrentUDFProcessingStart).Microseconds()))
 		// UDF processing is done.
@@ -619,31 +697,48 @@ func (isdf *InterStepDataForward) writeToBuffers(
 }
 
 // writeToBuffer forwards an array of messages to a single buffer and is a blocking call or until shutdown has been initiated.
+// If a fallback writer is set, the messages failed with non-retryable errors, or after the max retries, are written to
+// the fallback writer instead of being dropped or retried.
 func (isdf *InterStepDataForward) writeToBuffer(ctx context.Context, toBufferPartition isb.BufferWriter, messages []isb.Message) (writeOffsets []isb.Offset, err error) {
 	var (
-		totalCount int
-		writeCount int
-		writeBytes float64
-		dropBytes  float64
+		totalCount       int
+		writeCount       int
+		writeBytes       float64
+		dropBytes        float64
+		retries          int
+		fallbackMessages []isb.Message
+		retryInterval    = isdf.opts.retryInterval
 	)
 	totalCount = len(messages)
 	writeOffsets = make([]isb.Offset, 0, totalCount)
+	// the messages written to the fallback writer are not sent to the fallback writer again.
+	fallback := isdf.opts.fallbackWriter != nil && toBufferPartition != isdf.opts.fallbackWriter
 
 	for {
 		_writeOffsets, errs := toBufferPartition.Write(ctx, messages)
 		// Note: this is an unwanted memory allocation during a happy path. We want only minimal allocation since using failedMessages is an unlikely path.
 		var failedMessages []isb.Message
 		needRetry := false
+		// the longest backoff requested by the failed messages
+		var backoff time.Duration
 		for idx, msg := range messages {
 			if err := errs[idx]; err != nil {
 				// ATM there are no user defined errors during write, all are InternalErrors.
 				if errors.As(err, &isb.NoRetryableBufferWriteErr{}) {
-					// If toBufferPartition returns us a NoRetryableBufferWriteErr, we drop the message.
-					dropBytes += float64(len(msg.Payload))
+					if fallback {
+						fallbackMessages = append(fallbackMessages, msg)
+					} else {
+						// If toBufferPartition returns us a NoRetryableBufferWriteErr, we drop the message.
+						dropBytes += float64(len(msg.Payload))
+					}
 				} else {
 					needRetry = true
 					// we retry only failed messages
 					failedMessages = append(failedMessages, msg)
+					var retryableErr isb.RetryableBufferWriteErr
+					if errors.As(err, &retryableErr) && retryableErr.Backoff > backoff {
+						backoff = retryableErr.Backoff
+					}
 					writeMessagesError.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: toBufferPartition.GetName()}).Inc()
 					// a shutdown can break the blocking loop caused due to InternalErr
 					if ok, _ := isdf.IsShuttingDown(); ok {
@@ -661,7 +756,17 @@ func (isdf *InterStepDataForward) writeToBuffer(ctx context.Context, toBufferPar
 			}
 		}
 
-		if needRetry {
+		if needRetry && fallback && isdf.opts.maxRetries >= 0 && retries >= isdf.opts.maxRetries {
+			isdf.opts.logger.Errorw("Retries exhausted, writing failed messages to the fallback sink",
+				zap.Any("errors", errorArrayToMap(errs)),
+				zap.String(metrics.LabelPipeline, isdf.pipelineName),
+				zap.String(metrics.LabelVertex, isdf.vertexName),
+				zap.String(metrics.LabelPartitionName, toBufferPartition.GetName()),
+			)
+			fallbackMessages = append(fallbackMessages, failedMessages...)
+			break
+		} else if needRetry {
+			retries++
 			isdf.opts.logger.Errorw("Retrying failed messages",
 				zap.Any("errors", errorArrayToMap(errs)),
 				zap.String(metrics.LabelPipeline, isdf.pipelineName),
@@ -670,17 +775,35 @@ func (isdf *InterStepDataForward) writeToBuffer(ctx context.Context, toBufferPar
 			)
 			// set messages to failed for the retry
 			messages = failedMessages
-			// TODO: implement retry with backoff etc.
-			time.Sleep(isdf.opts.retryInterval)
+			if backoff < retryInterval {
+				backoff = retryInterval
+			}
+			select {
+			case <-ctx.Done():
+			case <-time.After(backoff):
+			}
+			if retryInterval < isdf.opts.maxRetryInterval {
+				retryInterval *= 2
+				if retryInterval > isdf.opts.maxRetryInterval {
+					retryInterval = isdf.opts.maxRetryInterval
+				}
+			}
 		} else {
 			break
 		}
 	}
 
-	dropMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: toBufferPartition.GetName()}).Add(float64(totalCount - writeCount))
+	dropMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: toBufferPartition.GetName()}).Add(float64(totalCount - writeCount - len(fallbackMessages)))
 	dropBytesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: toBufferPartition.GetName()}).Add(dropBytes)
 	writeMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: toBufferPartition.GetName()}).Add(float64(writeCount))
 	writeBytesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: toBufferPartition.GetName()}).Add(writeBytes)
+	if len(fallbackMessages) > 0 {
+		fallbackMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: toBufferPartition.GetName()}).Add(float64(len(fallbackMessages)))
+		// the offsets of the fallback writer are not returned, since they don't belong to the toBufferPartition.
+		if _, err := isdf.writeToBuffer(ctx, isdf.opts.fallbackWriter, fallbackMessages); err != nil {
+			return writeOffsets, fmt.Errorf("failed to write to the fallback sink, %w", err)
+		}
+	}
 	return writeOffsets, nil
 }
 
@@ -717,15 +840,56 @@ func (isdf *InterStepDataForward) applyUDF(ctx context.Context, readMessage *isb
 			}
 			continue
 		} else {
-			// if we do not get a time from UDF, we set it to the time from (N-1)th vertex
-			for index, m := range writeMessages {
-				// add partition to the ID, this is to make sure that the ID is unique across partitions
-				m.ID = fmt.Sprintf("%s-%d-%d", readMessage.ReadOffset.String(), isdf.fromBufferPartition.GetPartitionIdx(), index)
-				if m.EventTime.IsZero() {
-					m.EventTime = readMessage.EventTime
+			isdf.populateWriteMessages(readMessage, writeMessages)
+			return writeMessages, nil
+		}
+	}
+}
+
+// batchApplyUDF applies the UDF on all the read messages in one call, and stores the write messages of each read
+// message in the results. Like applyUDF, it will block if there is any InternalErr, and the errors are only stored
+// if shutdown has been initiated.
+func (isdf *InterStepDataForward) batchApplyUDF(ctx context.Context, udfResults []readWriteMessagePair) {
+	start := time.Now()
+	readMessages := make([]*isb.ReadMessage, len(udfResults))
+	for idx := range udfResults {
+		readMessages[idx] = udfResults[idx].readMessage
+	}
+	udfReadMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Add(float64(len(readMessages)))
+	for {
+		results, err := isdf.batchUDF.ApplyMapBatch(ctx, readMessages)
+		if err != nil {
+			isdf.opts.logger.Errorw("UDF.ApplyBatch error", zap.Error(err))
+			time.Sleep(isdf.opts.retryInterval)
+			if ok, _ := isdf.IsShuttingDown(); ok {
+				isdf.opts.logger.Errorw("UDF.ApplyBatch, Stop called while stuck on an internal error", zap.Error(err))
+				platformError.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName}).Inc()
+				for idx := range udfResults {
+					udfResults[idx].udfError = err
 				}
+				return
 			}
-			return writeMessages, nil
+			continue
+		}
+		for idx, writeMessages := range results {
+			isdf.populateWriteMessages(readMessages[idx], writeMessages)
+			udfWriteMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Add(float64(len(writeMessages)))
+			udfResults[idx].writeMessages = append(udfResults[idx].writeMessages, writeMessages...)
+		}
+		break
+	}
+	udfProcessingTime.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Observe(float64(time.Since(start).Microseconds()))
+}
+
+// populateWriteMessages assigns the IDs to the write messages generated from the read message, and the event time of
+// the read message if we do not get a time from UDF.
+func (isdf *InterStepDataForward) populateWriteMessages(readMessage *isb.ReadMessage, writeMessages []*isb.WriteMessage) {
+	for index, m := range writeMessages {
+		// add partition to the ID, this is to make sure that the ID is unique across partitions
+		m.ID = fmt.Sprintf("%s-%d-%d", readMessage.ReadOffset.String(), isdf.fromBufferPartition.GetPartitionIdx(), index)
+		// if we do not get a time from UDF, we set it to the time from (N-1)th vertex
+		if m.EventTime.IsZero() {
+			m.EventTime = readMessage.EventTime
 		}
 	}
 }
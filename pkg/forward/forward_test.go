@@ -30,6 +30,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	"github.com/numaproj/numaflow/pkg/shared/circuitbreaker"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	udfapplier "github.com/numaproj/numaflow/pkg/udf/function"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
@@ -1440,6 +1441,317 @@ func TestWriteToBuffer(t *testing.T) {
 	}
 }
 
+func TestWriteToBufferWithFallback(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy dfv1.BufferFullWritingStrategy
+	}{
+		{
+			// the message failed with a non-retryable error is written to the fallback.
+			name:     "test-discard-latest",
+			strategy: dfv1.DiscardLatest,
+		},
+		{
+			// the message is written to the fallback after the max retries.
+			name:     "test-retry-until-success",
+			strategy: dfv1.RetryUntilSuccess,
+		},
+	}
+	for _, value := range tests {
+		t.Run(value.name, func(t *testing.T) {
+			batchSize := int64(10)
+			fromStep := simplebuffer.NewInMemoryBuffer("from", 5*batchSize, 0)
+			buffer := simplebuffer.NewInMemoryBuffer("to1", batchSize, 0, simplebuffer.WithBufferFullWritingStrategy(value.strategy))
+			fallback := simplebuffer.NewInMemoryBuffer("fallback", batchSize, 0)
+			toSteps := map[string][]isb.BufferWriter{
+				"to1": {buffer},
+			}
+			vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+				PipelineName: "testPipeline",
+				AbstractVertex: dfv1.AbstractVertex{
+					Name: "testVertex",
+				},
+			}}
+			fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+			f, err := NewInterStepDataForward(vertex, fromStep, toSteps, myForwardTest{}, myForwardTest{}, fetchWatermark, publishWatermark, WithReadBatchSize(batchSize), WithFallbackWriter(fallback), WithMaxRetries(2))
+			assert.NoError(t, err)
+
+			writeMessages := testutils.BuildTestWriteMessages(batchSize+1, testStartTime)
+			_, err = f.writeToBuffer(context.Background(), buffer, writeMessages)
+			assert.NoError(t, err)
+			assert.True(t, buffer.IsFull())
+			readMessages, err := fallback.Read(context.Background(), batchSize)
+			assert.NoError(t, err)
+			assert.Len(t, readMessages, 1)
+			assert.Equal(t, writeMessages[batchSize].Payload, readMessages[0].Payload)
+		})
+	}
+}
+
+// retryTestWriter fails the messages of each write with the errors in order, and records the time of the writes.
+type retryTestWriter struct {
+	errs       [][]error
+	writeSizes []int
+	writeTimes []time.Time
+}
+
+func (w *retryTestWriter) GetName() string {
+	return "retry"
+}
+
+func (w *retryTestWriter) GetPartitionIdx() int32 {
+	return 0
+}
+
+func (w *retryTestWriter) Close() error {
+	return nil
+}
+
+func (w *retryTestWriter) Write(_ context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	w.writeSizes = append(w.writeSizes, len(messages))
+	w.writeTimes = append(w.writeTimes, time.Now())
+	errs := make([]error, len(messages))
+	if len(w.errs) > 0 {
+		copy(errs, w.errs[0])
+		w.errs = w.errs[1:]
+	}
+	return nil, errs
+}
+
+func TestWriteToBufferWithRetryBackoff(t *testing.T) {
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 10, 0)
+	writer := &retryTestWriter{errs: [][]error{
+		{nil, isb.RetryableBufferWriteErr{Name: "retry", Message: "rate limited", Backoff: 50 * time.Millisecond}, fmt.Errorf("unavailable"), isb.NoRetryableBufferWriteErr{Name: "retry", Message: "invalid"}},
+		{fmt.Errorf("unavailable"), fmt.Errorf("unavailable")},
+	}}
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {writer},
+	}
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "testPipeline",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "testVertex",
+		},
+	}}
+	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+	f, err := NewInterStepDataForward(vertex, fromStep, toSteps, myForwardTest{}, myForwardTest{}, fetchWatermark, publishWatermark, WithRetryBackoff(10*time.Millisecond, 15*time.Millisecond))
+	assert.NoError(t, err)
+
+	_, err = f.writeToBuffer(context.Background(), writer, testutils.BuildTestWriteMessages(4, testStartTime))
+	assert.NoError(t, err)
+	// only the failed messages with retryable errors are retried.
+	assert.Equal(t, []int{4, 2, 2}, writer.writeSizes)
+	// the first retry honors the backoff of the error, and the retry interval doubles up to the max after each retry.
+	assert.GreaterOrEqual(t, writer.writeTimes[1].Sub(writer.writeTimes[0]), 50*time.Millisecond)
+	assert.GreaterOrEqual(t, writer.writeTimes[2].Sub(writer.writeTimes[1]), 15*time.Millisecond)
+}
+
+type testRateLimiter struct {
+	messages int
+	bytes    int
+}
+
+func (l *testRateLimiter) Wait(_ context.Context, messages int, bytes int) error {
+	l.messages += messages
+	l.bytes += bytes
+	return nil
+}
+
+func TestForwardWithRateLimiter(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 10, 0, simplebuffer.WithReadTimeOut(100*time.Millisecond))
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 10, 0)
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "testPipeline",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "testVertex",
+		},
+	}}
+	limiter := &testRateLimiter{}
+	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+	f, err := NewInterStepDataForward(vertex, fromStep, toSteps, myForwardTest{}, myForwardTest{}, fetchWatermark, publishWatermark, WithReadBatchSize(5), WithRateLimiter(limiter))
+	assert.NoError(t, err)
+
+	writeMessages := testutils.BuildTestWriteMessages(5, testStartTime)
+	_, errs := fromStep.Write(ctx, writeMessages)
+	assert.Equal(t, make([]error, 5), errs)
+	f.forwardAChunk(ctx)
+	assert.Equal(t, 5, limiter.messages)
+	bytes := 0
+	for _, m := range writeMessages {
+		bytes += len(m.Payload)
+	}
+	assert.Equal(t, bytes, limiter.bytes)
+	readMessages, err := to1.Read(ctx, 5)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 5)
+}
+
+func TestForwardWithUDFCircuitBreaker(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 10, 0, simplebuffer.WithReadTimeOut(100*time.Millisecond))
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 10, 0)
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "testPipeline",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "testVertex",
+		},
+	}}
+	cb := circuitbreaker.New(1, 500*time.Millisecond)
+	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+	f, err := NewInterStepDataForward(vertex, fromStep, toSteps, myForwardTest{}, myForwardTest{}, fetchWatermark, publishWatermark, WithReadBatchSize(5), WithUDFCircuitBreaker(cb))
+	assert.NoError(t, err)
+
+	writeMessages := testutils.BuildTestWriteMessages(5, testStartTime)
+	_, errs := fromStep.Write(ctx, writeMessages)
+	assert.Equal(t, make([]error, 5), errs)
+	assert.NoError(t, cb.Allow())
+	cb.Failure()
+	// the reading is paused while the circuit breaker is open.
+	f.forwardAChunk(ctx)
+	assert.True(t, to1.IsEmpty())
+	assert.False(t, fromStep.IsEmpty())
+	// the pause is longer than the open duration, so it's half-open now.
+	assert.Equal(t, circuitbreaker.HalfOpen, cb.State())
+	f.forwardAChunk(ctx)
+	readMessages, err := to1.Read(ctx, 5)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 5)
+}
+
+func TestForwardWithUDFBatch(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 10, 0, simplebuffer.WithReadTimeOut(100*time.Millisecond))
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 10, 0)
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "testPipeline",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "testVertex",
+		},
+	}}
+	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+
+	// the UDF has to support batch.
+	_, err := NewInterStepDataForward(vertex, fromStep, toSteps, myForwardTest{}, myForwardTest{}, fetchWatermark, publishWatermark, WithReadBatchSize(5), WithUDFBatch(true))
+	assert.Error(t, err)
+
+	udf := &myForwardBatchTest{}
+	f, err := NewInterStepDataForward(vertex, fromStep, toSteps, myForwardTest{}, udf, fetchWatermark, publishWatermark, WithReadBatchSize(5), WithUDFBatch(true))
+	assert.NoError(t, err)
+
+	writeMessages := testutils.BuildTestWriteMessages(5, testStartTime)
+	_, errs := fromStep.Write(ctx, writeMessages)
+	assert.Equal(t, make([]error, 5), errs)
+	f.forwardAChunk(ctx)
+	// all the messages are sent to the UDF in one call.
+	assert.Equal(t, []int{5}, udf.batches)
+	readMessages, err := to1.Read(ctx, 5)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 5)
+	for i, m := range readMessages {
+		assert.Equal(t, writeMessages[i].Payload, m.Payload)
+		// the IDs are generated from the offsets of the from buffer, which are the same as the ones of the to buffer.
+		assert.Equal(t, fmt.Sprintf("%s-0-0", m.ReadOffset.String()), m.ID)
+	}
+}
+
+func TestForwardWithPerKeyOrdering(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 20, 0, simplebuffer.WithReadTimeOut(100*time.Millisecond))
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 20, 0)
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "testPipeline",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "testVertex",
+		},
+	}}
+	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+	udf := &myForwardOrderingTest{processed: map[string][]string{}, running: map[string]int{}}
+	f, err := NewInterStepDataForward(vertex, fromStep, toSteps, myForwardTest{}, udf, fetchWatermark, publishWatermark, WithReadBatchSize(20), WithPerKeyOrdering(true))
+	assert.NoError(t, err)
+
+	writeMessages := testutils.BuildTestWriteMessages(20, testStartTime)
+	expected := map[string][]string{}
+	for i := range writeMessages {
+		key := fmt.Sprintf("key-%d", i%4)
+		writeMessages[i].Keys = []string{key}
+		expected[key] = append(expected[key], writeMessages[i].ID)
+	}
+	_, errs := fromStep.Write(ctx, writeMessages)
+	assert.Equal(t, make([]error, 20), errs)
+	f.forwardAChunk(ctx)
+	// the messages sharing the same keys are processed serially in the order they are read.
+	assert.False(t, udf.overlapped)
+	assert.Equal(t, expected, udf.processed)
+	// the writes preserve the order of the messages read.
+	readMessages, err := to1.Read(ctx, 20)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 20)
+	for i, m := range readMessages {
+		assert.Equal(t, writeMessages[i].Payload, m.Payload)
+	}
+}
+
+// myForwardOrderingTest records the order of the messages processed for each key, and whether the messages sharing the
+// same keys are processed concurrently.
+type myForwardOrderingTest struct {
+	myForwardTest
+	lock       sync.Mutex
+	processed  map[string][]string
+	running    map[string]int
+	overlapped bool
+}
+
+func (f *myForwardOrderingTest) ApplyMap(ctx context.Context, message *isb.ReadMessage) ([]*isb.WriteMessage, error) {
+	key := strings.Join(message.Keys, ",")
+	f.lock.Lock()
+	f.processed[key] = append(f.processed[key], message.ID)
+	f.running[key]++
+	if f.running[key] > 1 {
+		f.overlapped = true
+	}
+	f.lock.Unlock()
+	time.Sleep(10 * time.Millisecond)
+	f.lock.Lock()
+	f.running[key]--
+	f.lock.Unlock()
+	return testutils.CopyUDFTestApply(ctx, message)
+}
+
+type myForwardBatchTest struct {
+	myForwardTest
+	batches []int
+}
+
+func (f *myForwardBatchTest) ApplyMapBatch(ctx context.Context, messages []*isb.ReadMessage) ([][]*isb.WriteMessage, error) {
+	f.batches = append(f.batches, len(messages))
+	results := make([][]*isb.WriteMessage, len(messages))
+	for i, message := range messages {
+		writeMessages, err := testutils.CopyUDFTestApply(ctx, message)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = writeMessages
+	}
+	return results, nil
+}
+
 type myForwardDropTest struct {
 }
 
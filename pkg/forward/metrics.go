@@ -79,6 +79,13 @@ var dropBytesCount = promauto.NewCounterVec(prometheus.CounterOpts{
 	Help:      "Total number of Bytes Dropped",
 }, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelPartitionName})
 
+// fallbackMessagesCount is used to indicate the number of messages written to the fallback sink
+var fallbackMessagesCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "forwarder",
+	Name:      "fallback_total",
+	Help:      "Total number of Messages written to the fallback sink",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelPartitionName})
+
 // ackMessagesCount is used to indicate the number of  messages acknowledged
 var ackMessagesCount = promauto.NewCounterVec(prometheus.CounterOpts{
 	Subsystem: "forwarder",
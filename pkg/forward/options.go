@@ -17,12 +17,14 @@ limitations under the License.
 package forward
 
 import (
+	"context"
 	"time"
 
 	"go.uber.org/zap"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/circuitbreaker"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 )
 
@@ -34,6 +36,9 @@ type options struct {
 	udfConcurrency int
 	// retryInterval is the time.Duration to sleep before retrying
 	retryInterval time.Duration
+	// maxRetryInterval is the maximum retry interval, the retry interval doubles after each retry until it's reached.
+	// The retry interval is fixed if it's not greater than retryInterval.
+	maxRetryInterval time.Duration
 	// vertexType indicates the type of the vertex
 	vertexType dfv1.VertexType
 	// srcWatermarkPublisher is used to publish source watermark
@@ -42,6 +47,25 @@ type options struct {
 	logger *zap.SugaredLogger
 	// enableMapUdfStream indicates whether the message streaming is enabled or not for UDF processing
 	enableMapUdfStream bool
+	// enableMapUdfBatch indicates whether the read messages are sent to the UDF in one call or not
+	enableMapUdfBatch bool
+	// perKeyOrdering indicates whether the messages sharing the same keys are processed serially or not
+	perKeyOrdering bool
+	// fallbackWriter is the writer of the fallback sink
+	fallbackWriter isb.BufferWriter
+	// maxRetries is the maximum number of retries before writing the failed messages to the fallback sink,
+	// a negative value means retrying forever
+	maxRetries int
+	// rateLimiter limits the rate of the messages forwarded, nil means no limit
+	rateLimiter RateLimiter
+	// udfCircuitBreaker is the circuit breaker of the UDF calls, the reading is paused while it's open
+	udfCircuitBreaker *circuitbreaker.CircuitBreaker
+}
+
+// RateLimiter limits the rate of the messages forwarded, e.g. the messages written to a rate limited sink.
+type RateLimiter interface {
+	// Wait blocks until the messages of the total bytes are allowed to be forwarded, or the context is done.
+	Wait(ctx context.Context, messages int, bytes int) error
 }
 
 type Option func(*options) error
@@ -53,6 +77,7 @@ func DefaultOptions() *options {
 		retryInterval:      time.Millisecond,
 		logger:             logging.NewLogger(),
 		enableMapUdfStream: false,
+		maxRetries:         -1,
 	}
 }
 
@@ -64,6 +89,24 @@ func WithRetryInterval(f time.Duration) Option {
 	}
 }
 
+// WithRetryBackoff sets the exponential backoff of the retries, the retry interval starts from initial, and doubles
+// after each retry until it reaches max.
+func WithRetryBackoff(initial, max time.Duration) Option {
+	return func(o *options) error {
+		o.retryInterval = initial
+		o.maxRetryInterval = max
+		return nil
+	}
+}
+
+// WithRateLimiter sets the rate limiter of the messages forwarded
+func WithRateLimiter(l RateLimiter) Option {
+	return func(o *options) error {
+		o.rateLimiter = l
+		return nil
+	}
+}
+
 // WithReadBatchSize sets the read batch size
 func WithReadBatchSize(f int64) Option {
 	return func(o *options) error {
@@ -111,3 +154,44 @@ func WithUDFStreaming(f bool) Option {
 		return nil
 	}
 }
+
+// WithUDFBatch sets sending the read messages to the UDF in one call for UDF processing
+func WithUDFBatch(f bool) Option {
+	return func(o *options) error {
+		o.enableMapUdfBatch = f
+		return nil
+	}
+}
+
+// WithPerKeyOrdering sets processing the messages sharing the same keys serially for UDF processing
+func WithPerKeyOrdering(f bool) Option {
+	return func(o *options) error {
+		o.perKeyOrdering = f
+		return nil
+	}
+}
+
+// WithFallbackWriter sets the writer of the fallback sink, which receives the messages failed to be written with
+// non-retryable errors, or after the max retries
+func WithFallbackWriter(w isb.BufferWriter) Option {
+	return func(o *options) error {
+		o.fallbackWriter = w
+		return nil
+	}
+}
+
+// WithMaxRetries sets the maximum number of retries before writing the failed messages to the fallback sink
+func WithMaxRetries(n uint32) Option {
+	return func(o *options) error {
+		o.maxRetries = int(n)
+		return nil
+	}
+}
+
+// WithUDFCircuitBreaker sets the circuit breaker of the UDF calls, the reading is paused while it's open
+func WithUDFCircuitBreaker(cb *circuitbreaker.CircuitBreaker) Option {
+	return func(o *options) error {
+		o.udfCircuitBreaker = cb
+		return nil
+	}
+}
@@ -16,7 +16,10 @@ limitations under the License.
 
 package isb
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // MessageWriteErr is associated with message write errors.
 type MessageWriteErr struct {
@@ -106,3 +109,14 @@ type NoRetryableBufferWriteErr struct {
 func (e NoRetryableBufferWriteErr) Error() string {
 	return fmt.Sprintf("(%s) %s %#v", e.Name, e.Message, e)
 }
+
+// RetryableBufferWriteErr indicates that the write is retryable, and should not be retried within the backoff.
+type RetryableBufferWriteErr struct {
+	Name    string
+	Message string
+	Backoff time.Duration
+}
+
+func (e RetryableBufferWriteErr) Error() string {
+	return fmt.Sprintf("(%s) %s, retry after %s", e.Name, e.Message, e.Backoff)
+}
@@ -150,6 +150,11 @@ func (jw *jetStreamWriter) GetPartitionIdx() int32 {
 	return jw.partitionIdx
 }
 
+// IsFull returns whether the buffer is full. It could be approximate.
+func (jw *jetStreamWriter) IsFull() bool {
+	return jw.isFull.Load()
+}
+
 func (jw *jetStreamWriter) Close() error {
 	if jw.conn != nil && !jw.conn.IsClosed() {
 		jw.conn.Close()
@@ -60,8 +60,8 @@ func WithJetStreamClient(jsClient jsclient.JetStreamClient) JSServiceOption {
 	}
 }
 
-func (jss *jetStreamSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, opts ...CreateOption) error {
-	if len(buffers) == 0 && len(buckets) == 0 {
+func (jss *jetStreamSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, opts ...CreateOption) error {
+	if len(buffers) == 0 && len(buckets) == 0 && len(sideInputsStore) == 0 {
 		return nil
 	}
 	log := logging.FromContext(ctx)
@@ -164,11 +164,31 @@ func (jss *jetStreamSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, b
 			}
 		}
 	}
+
+	if len(sideInputsStore) > 0 {
+		// Only the latest value of each side input is kept, the revision of it is used as the version.
+		bucket := JetStreamSideInputsStoreKVName(sideInputsStore)
+		if _, err := js.KeyValue(bucket); err != nil {
+			if !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+				return fmt.Errorf("failed to query information of bucket %q during side inputs store creating, %w", bucket, err)
+			}
+			if _, err := js.CreateKeyValue(&nats.KeyValueConfig{
+				Bucket:    bucket,
+				History:   1,
+				Storage:   nats.StorageType(v.GetInt("otBucket.storage")),
+				Replicas:  v.GetInt("otBucket.replicas"),
+				Placement: nil,
+			}); err != nil {
+				return fmt.Errorf("failed to create side inputs store %q, %w", bucket, err)
+			}
+			log.Infow("Succeeded to create a side inputs store", zap.String("bucket", bucket))
+		}
+	}
 	return nil
 }
 
-func (jss *jetStreamSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, buckets []string) error {
-	if len(buffers) == 0 && len(buckets) == 0 {
+func (jss *jetStreamSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string) error {
+	if len(buffers) == 0 && len(buckets) == 0 && len(sideInputsStore) == 0 {
 		return nil
 	}
 	log := logging.FromContext(ctx)
@@ -200,11 +220,18 @@ func (jss *jetStreamSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, b
 		}
 		log.Infow("Succeeded to delete a processor bucket", zap.String("bucket", procBucket))
 	}
+	if len(sideInputsStore) > 0 {
+		bucket := JetStreamSideInputsStoreKVName(sideInputsStore)
+		if err := js.DeleteKeyValue(bucket); err != nil && !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+			return fmt.Errorf("failed to delete side inputs store %q, %w", bucket, err)
+		}
+		log.Infow("Succeeded to delete a side inputs store", zap.String("bucket", bucket))
+	}
 	return nil
 }
 
-func (jss *jetStreamSvc) ValidateBuffersAndBuckets(ctx context.Context, buffers, buckets []string) error {
-	if len(buffers) == 0 && len(buckets) == 0 {
+func (jss *jetStreamSvc) ValidateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string) error {
+	if len(buffers) == 0 && len(buckets) == 0 && len(sideInputsStore) == 0 {
 		return nil
 	}
 	nc, err := jsclient.NewInClusterJetStreamClient().Connect(ctx)
@@ -233,6 +260,12 @@ func (jss *jetStreamSvc) ValidateBuffersAndBuckets(ctx context.Context, buffers,
 			return fmt.Errorf("failed to query processor bucket %q, %w", procBucket, err)
 		}
 	}
+	if len(sideInputsStore) > 0 {
+		bucket := JetStreamSideInputsStoreKVName(sideInputsStore)
+		if _, err := js.KeyValue(bucket); err != nil {
+			return fmt.Errorf("failed to query side inputs store %q, %w", bucket, err)
+		}
+	}
 	return nil
 }
 
@@ -325,3 +358,7 @@ func JetStreamOTBucket(bucketName string) string {
 func JetStreamProcessorBucket(bucketName string) string {
 	return fmt.Sprintf("%s_PROCESSORS", bucketName)
 }
+
+func JetStreamSideInputsStoreKVName(sideInputStoreName string) string {
+	return fmt.Sprintf("%s_SIDE_INPUTS", sideInputStoreName)
+}
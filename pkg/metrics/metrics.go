@@ -32,6 +32,7 @@ import (
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/circuitbreaker"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedqueue "github.com/numaproj/numaflow/pkg/shared/queue"
 	sharedtls "github.com/numaproj/numaflow/pkg/shared/tls"
@@ -45,7 +46,8 @@ const (
 	LabelVertexReplicaIndex = "replica"
 	LabelPartitionName      = "partition_name"
 
-	VertexPendingMessages = "vertex_pending_messages"
+	VertexPendingMessages        = "vertex_pending_messages"
+	VertexUDFCircuitBreakerState = "vertex_udf_circuit_breaker_state"
 )
 
 var (
@@ -54,6 +56,11 @@ var (
 		Help: "Average pending messages in the last period of seconds. It is the pending messages of a vertex, not a pod.",
 	}, []string{LabelPipeline, LabelVertex, LabelPeriod, LabelPartitionName})
 
+	udfCircuitBreakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: VertexUDFCircuitBreakerState,
+		Help: "State of the circuit breaker of the UDF calls, 0 for closed, 1 for open, and 2 for half-open.",
+	}, []string{LabelPipeline, LabelVertex})
+
 	// fixedLookbackSeconds Always expose metrics of following lookback seconds (1m, 5m, 15m)
 	fixedLookbackSeconds = map[string]int64{"1m": 60, "5m": 300, "15m": 900}
 )
@@ -79,6 +86,9 @@ type metricsServer struct {
 	partitionPendingInfo map[string]*sharedqueue.OverflowQueue[timestampedPending]
 	// Functions that health check executes
 	healthCheckExecutors []func() error
+	// udfCircuitBreaker is the circuit breaker of the UDF calls, the vertex is not ready and the UDF container is not
+	// live while it's tripped.
+	udfCircuitBreaker *circuitbreaker.CircuitBreaker
 }
 
 type Option func(*metricsServer)
@@ -111,6 +121,13 @@ func WithHealthCheckExecutor(f func() error) Option {
 	}
 }
 
+// WithUDFCircuitBreaker sets the circuit breaker of the UDF calls
+func WithUDFCircuitBreaker(cb *circuitbreaker.CircuitBreaker) Option {
+	return func(m *metricsServer) {
+		m.udfCircuitBreaker = cb
+	}
+}
+
 // NewMetricsOptions returns a metrics option list.
 func NewMetricsOptions(ctx context.Context, vertex *dfv1.Vertex, serverHandler HealthChecker, readers []isb.BufferReader) []Option {
 	metricsOpts := []Option{
@@ -236,6 +253,34 @@ func (ms *metricsServer) calculatePending(seconds int64, partitionName string) i
 	return result
 }
 
+// Expose the state of the circuit breaker of the UDF calls
+func (ms *metricsServer) exposeUDFCircuitBreakerState(ctx context.Context) {
+	if ms.udfCircuitBreaker == nil {
+		return
+	}
+	ticker := time.NewTicker(ms.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			udfCircuitBreakerState.WithLabelValues(ms.vertex.Spec.PipelineName, ms.vertex.Spec.Name).Set(float64(ms.udfCircuitBreaker.State()))
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// checkUDFCircuitBreaker returns an error if the circuit breaker of the UDF calls is tripped
+func (ms *metricsServer) checkUDFCircuitBreaker() error {
+	if ms.udfCircuitBreaker == nil {
+		return nil
+	}
+	if ms.udfCircuitBreaker.Tripped() {
+		return fmt.Errorf("circuit breaker of the UDF calls is tripped, the UDF keeps failing")
+	}
+	return nil
+}
+
 // Start function starts the HTTPS service to expose metrics, it returns a shutdown function and an error if any
 func (ms *metricsServer) Start(ctx context.Context) (func(ctx context.Context) error, error) {
 	log := logging.FromContext(ctx)
@@ -247,12 +292,24 @@ func (ms *metricsServer) Start(ctx context.Context) (func(ctx context.Context) e
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.Handler())
 	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		if err := ms.checkUDFCircuitBreaker(); err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
 		w.WriteHeader(http.StatusNoContent)
 	})
 	mux.HandleFunc("/livez", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 	mux.HandleFunc("/sidecar-livez", func(w http.ResponseWriter, r *http.Request) {
+		// restart the UDF container if it keeps failing, e.g. hung.
+		if err := ms.checkUDFCircuitBreaker(); err != nil {
+			log.Errorw("Failed to execute sidecar health check", zap.Error(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
 		if len(ms.healthCheckExecutors) > 0 {
 			for _, ex := range ms.healthCheckExecutors {
 				if err := ex(); err != nil {
@@ -285,6 +342,8 @@ func (ms *metricsServer) Start(ctx context.Context) (func(ctx context.Context) e
 	go ms.buildupPendingInfo(ctx)
 	// Expose pending metrics
 	go ms.exposePendingMetrics(ctx)
+	// Expose the state of the circuit breaker of the UDF calls
+	go ms.exposeUDFCircuitBreakerState(ctx)
 	go func() {
 		log.Info("Starting metrics HTTPS server")
 		if err := httpServer.ListenAndServeTLS("", ""); err != nil && err != http.ErrServerClosed {
@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/circuitbreaker"
 )
 
 func Test_StartMetricsServer(t *testing.T) {
@@ -48,3 +49,14 @@ func Test_StartMetricsServer(t *testing.T) {
 	err = s(context.TODO())
 	assert.NoError(t, err)
 }
+
+func Test_checkUDFCircuitBreaker(t *testing.T) {
+	ms := NewMetricsServer(&dfv1.Vertex{})
+	assert.NoError(t, ms.checkUDFCircuitBreaker())
+	cb := circuitbreaker.New(1, time.Hour)
+	ms = NewMetricsServer(&dfv1.Vertex{}, WithUDFCircuitBreaker(cb))
+	assert.NoError(t, ms.checkUDFCircuitBreaker())
+	assert.NoError(t, cb.Allow())
+	cb.Failure()
+	assert.Error(t, ms.checkUDFCircuitBreaker())
+}
@@ -183,6 +183,11 @@ func (r *pipelineReconciler) reconcileNonLifecycleChanges(ctx context.Context, p
 		log.Errorw("ISB Service is not in ready status", zap.String("isbsvc", isbSvcName), zap.Error(err))
 		return ctrl.Result{}, fmt.Errorf("isbsvc not ready")
 	}
+	if len(pl.Spec.SideInputs) > 0 && isbSvc.Spec.JetStream == nil {
+		pl.Status.MarkDeployFailed("SideInputsNotSupported", "Side inputs are only supported with JetStream ISB Service.")
+		log.Errorw("Side inputs are only supported with JetStream ISB Service", zap.String("isbsvc", isbSvcName))
+		return ctrl.Result{}, fmt.Errorf("side inputs are only supported with jetstream isbsvc")
+	}
 
 	existingObjs, err := r.findExistingVertices(ctx, pl)
 	if err != nil {
@@ -219,6 +224,19 @@ func (r *pipelineReconciler) reconcileNonLifecycleChanges(ctx context.Context, p
 			newBuckets[b] = b
 		}
 	}
+	// The side inputs store is created with the first side inputs manager, and deleted with the last one.
+	existingSideInputsManagers, err := r.findExistingSideInputsManagers(ctx, pl)
+	if err != nil {
+		log.Errorw("Failed to find existing side inputs managers", zap.Error(err))
+		pl.Status.MarkDeployFailed("ListSideInputsManagersFailed", err.Error())
+		return ctrl.Result{}, err
+	}
+	newSideInputsStore, oldSideInputsStore := "", ""
+	if len(pl.Spec.SideInputs) > 0 && len(existingSideInputsManagers) == 0 {
+		newSideInputsStore = pl.GetSideInputsStoreName()
+	} else if len(pl.Spec.SideInputs) == 0 && len(existingSideInputsManagers) > 0 {
+		oldSideInputsStore = pl.GetSideInputsStoreName()
+	}
 	newObjs := buildVertices(pl)
 	for vertexName, newObj := range newObjs {
 		if oldObj, existing := existingObjs[vertexName]; !existing {
@@ -252,7 +270,7 @@ func (r *pipelineReconciler) reconcileNonLifecycleChanges(ctx context.Context, p
 	}
 
 	// create batch job
-	if len(newBuffers) > 0 || len(newBuckets) > 0 {
+	if len(newBuffers) > 0 || len(newBuckets) > 0 || len(newSideInputsStore) > 0 {
 		bfs := []string{}
 		for k := range newBuffers {
 			bfs = append(bfs, k)
@@ -262,6 +280,9 @@ func (r *pipelineReconciler) reconcileNonLifecycleChanges(ctx context.Context, p
 			bks = append(bks, k)
 		}
 		args := []string{fmt.Sprintf("--buffers=%s", strings.Join(bfs, ",")), fmt.Sprintf("--buckets=%s", strings.Join(bks, ","))}
+		if len(newSideInputsStore) > 0 {
+			args = append(args, fmt.Sprintf("--side-inputs-store=%s", newSideInputsStore))
+		}
 		batchJob := buildISBBatchJob(pl, r.image, isbSvc.Status.Config, "isbsvc-create", args, "create")
 		if err := r.client.Create(ctx, batchJob); err != nil && !apierrors.IsAlreadyExists(err) {
 			pl.Status.MarkDeployFailed("CreateISBSvcCreatingJobFailed", err.Error())
@@ -270,7 +291,7 @@ func (r *pipelineReconciler) reconcileNonLifecycleChanges(ctx context.Context, p
 		log.Infow("Created ISB Svc creating job successfully", zap.Any("buffers", bfs), zap.Any("buckets", bks))
 	}
 
-	if len(oldBuffers) > 0 || len(oldBuckets) > 0 {
+	if len(oldBuffers) > 0 || len(oldBuckets) > 0 || len(oldSideInputsStore) > 0 {
 		bfs := []string{}
 		for k := range oldBuffers {
 			bfs = append(bfs, k)
@@ -280,6 +301,9 @@ func (r *pipelineReconciler) reconcileNonLifecycleChanges(ctx context.Context, p
 			bks = append(bks, k)
 		}
 		args := []string{fmt.Sprintf("--buffers=%s", strings.Join(bfs, ",")), fmt.Sprintf("--buckets=%s", strings.Join(bks, ","))}
+		if len(oldSideInputsStore) > 0 {
+			args = append(args, fmt.Sprintf("--side-inputs-store=%s", oldSideInputsStore))
+		}
 		batchJob := buildISBBatchJob(pl, r.image, isbSvc.Status.Config, "isbsvc-delete", args, "delete")
 		if err := r.client.Create(ctx, batchJob); err != nil && !apierrors.IsAlreadyExists(err) {
 			pl.Status.MarkDeployFailed("CreateISBSvcDeletingJobFailed", err.Error())
@@ -296,6 +320,10 @@ func (r *pipelineReconciler) reconcileNonLifecycleChanges(ctx context.Context, p
 	if err := r.createOrUpdateDaemonDeployment(ctx, pl, isbSvc.Status.Config); err != nil {
 		return ctrl.Result{}, err
 	}
+	// Side inputs manager deployments
+	if err := r.createOrUpdateSideInputsManagers(ctx, pl, isbSvc.Status.Config, existingSideInputsManagers); err != nil {
+		return ctrl.Result{}, err
+	}
 
 	pl.Status.MarkDeployed()
 	pl.Status.SetPhase(pl.Spec.Lifecycle.GetDesiredPhase(), "")
@@ -380,6 +408,71 @@ func (r *pipelineReconciler) createOrUpdateDaemonDeployment(ctx context.Context,
 	return nil
 }
 
+func (r *pipelineReconciler) createOrUpdateSideInputsManagers(ctx context.Context, pl *dfv1.Pipeline, isbSvcConfig dfv1.BufferServiceConfig, existingDeploys map[string]appv1.Deployment) error {
+	log := logging.FromContext(ctx)
+	isbSvcType, envs := sharedutil.GetIsbSvcEnvVars(isbSvcConfig)
+	req := dfv1.GetSideInputDeploymentReq{
+		ISBSvcType: isbSvcType,
+		Image:      r.image,
+		PullPolicy: corev1.PullPolicy(sharedutil.LookupEnvStringOr(dfv1.EnvImagePullPolicy, "")),
+		Env:        envs,
+	}
+	deploys, err := pl.GetSideInputsManagerDeployments(req)
+	if err != nil {
+		pl.Status.MarkDeployFailed("BuildSideInputsManagerDeployFailed", err.Error())
+		return fmt.Errorf("failed to build side inputs manager deployment specs, %w", err)
+	}
+	for _, deploy := range deploys {
+		deployHash := sharedutil.MustHash(deploy.Spec)
+		deploy.Annotations = map[string]string{dfv1.KeyHash: deployHash}
+		if existingDeploy, existing := existingDeploys[deploy.Name]; !existing {
+			if err := r.client.Create(ctx, deploy); err != nil && !apierrors.IsAlreadyExists(err) {
+				log.Errorw("Failed to create a side inputs manager deployment", zap.String("deployment", deploy.Name), zap.Error(err))
+				pl.Status.MarkDeployFailed("CreateSideInputsManagerDeployFailed", err.Error())
+				return fmt.Errorf("failed to create a side inputs manager deployment, %w", err)
+			}
+			log.Infow("Succeeded to create a side inputs manager deployment", zap.String("deployment", deploy.Name))
+		} else {
+			if existingDeploy.GetAnnotations()[dfv1.KeyHash] != deployHash {
+				if existingDeploy.Annotations == nil {
+					existingDeploy.Annotations = map[string]string{}
+				}
+				existingDeploy.Annotations[dfv1.KeyHash] = deployHash
+				existingDeploy.Spec = deploy.Spec
+				if err := r.client.Update(ctx, &existingDeploy); err != nil {
+					log.Errorw("Failed to update a side inputs manager deployment", zap.String("deployment", existingDeploy.Name), zap.Error(err))
+					pl.Status.MarkDeployFailed("UpdateSideInputsManagerDeployFailed", err.Error())
+					return fmt.Errorf("failed to update a side inputs manager deployment, %w", err)
+				}
+				log.Infow("Succeeded to update a side inputs manager deployment", zap.String("deployment", existingDeploy.Name))
+			}
+			delete(existingDeploys, deploy.Name)
+		}
+	}
+	for _, d := range existingDeploys {
+		if err := r.client.Delete(ctx, &d); err != nil && !apierrors.IsNotFound(err) {
+			log.Errorw("Failed to delete a stale side inputs manager deployment", zap.String("deployment", d.Name), zap.Error(err))
+			pl.Status.MarkDeployFailed("DeleteStaleSideInputsManagerDeployFailed", err.Error())
+			return fmt.Errorf("failed to delete a stale side inputs manager deployment, %w", err)
+		}
+		log.Infow("Deleted a stale side inputs manager deployment", zap.String("deployment", d.Name))
+	}
+	return nil
+}
+
+func (r *pipelineReconciler) findExistingSideInputsManagers(ctx context.Context, pl *dfv1.Pipeline) (map[string]appv1.Deployment, error) {
+	deploys := &appv1.DeploymentList{}
+	selector, _ := labels.Parse(dfv1.KeyPipelineName + "=" + pl.Name + "," + dfv1.KeyComponent + "=" + dfv1.ComponentSideInputManager)
+	if err := r.client.List(ctx, deploys, &client.ListOptions{Namespace: pl.Namespace, LabelSelector: selector}); err != nil {
+		return nil, fmt.Errorf("failed to list existing side inputs manager deployments: %w", err)
+	}
+	result := make(map[string]appv1.Deployment)
+	for _, d := range deploys.Items {
+		result[d.Name] = d
+	}
+	return result, nil
+}
+
 func (r *pipelineReconciler) findExistingVertices(ctx context.Context, pl *dfv1.Pipeline) (map[string]dfv1.Vertex, error) {
 	vertices := &dfv1.VertexList{}
 	selector, _ := labels.Parse(dfv1.KeyPipelineName + "=" + pl.Name)
@@ -396,7 +489,7 @@ func (r *pipelineReconciler) findExistingVertices(ctx context.Context, pl *dfv1.
 func (r *pipelineReconciler) cleanUpBuffers(ctx context.Context, pl *dfv1.Pipeline, log *zap.SugaredLogger) error {
 	allBuffers := pl.GetAllBuffers()
 	allBuckets := pl.GetAllBuckets()
-	if len(allBuffers) > 0 || len(allBuckets) > 0 {
+	if len(allBuffers) > 0 || len(allBuckets) > 0 || len(pl.Spec.SideInputs) > 0 {
 		isbSvc := &dfv1.InterStepBufferService{}
 		isbSvcName := dfv1.DefaultISBSvcName
 		if len(pl.Spec.InterStepBufferServiceName) > 0 {
@@ -414,6 +507,9 @@ func (r *pipelineReconciler) cleanUpBuffers(ctx context.Context, pl *dfv1.Pipeli
 		args := []string{}
 		args = append(args, fmt.Sprintf("--buffers=%s", strings.Join(allBuffers, ",")))
 		args = append(args, fmt.Sprintf("--buckets=%s", strings.Join(allBuckets, ",")))
+		if len(pl.Spec.SideInputs) > 0 {
+			args = append(args, fmt.Sprintf("--side-inputs-store=%s", pl.GetSideInputsStoreName()))
+		}
 
 		batchJob := buildISBBatchJob(pl, r.image, isbSvc.Status.Config, "isbsvc-delete", args, "cleanup")
 		batchJob.OwnerReferences = []metav1.OwnerReference{}
@@ -507,6 +603,8 @@ func mergeLimits(plLimits dfv1.PipelineLimits, vLimits *dfv1.VertexLimits) dfv1.
 		result.BufferUsageLimit = vLimits.BufferUsageLimit
 		result.ReadBatchSize = vLimits.ReadBatchSize
 		result.ReadTimeout = vLimits.ReadTimeout
+		result.UDFTimeout = vLimits.UDFTimeout
+		result.UDFCircuitBreaker = vLimits.UDFCircuitBreaker
 	}
 	if result.ReadBatchSize == nil {
 		result.ReadBatchSize = plLimits.ReadBatchSize
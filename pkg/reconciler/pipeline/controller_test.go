@@ -18,6 +18,7 @@ package pipeline
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/goccy/go-json"
@@ -182,7 +183,7 @@ func Test_copyVertexLimits(t *testing.T) {
 	assert.Equal(t, int64(one), int64(*v1.Limits.ReadBatchSize))
 	assert.Equal(t, "2s", v1.Limits.ReadTimeout.Duration.String())
 	two := uint64(2)
-	vertexLimitJson := `{"readTimeout": "3s"}`
+	vertexLimitJson := `{"readTimeout": "3s", "udfTimeout": "10s", "udfCircuitBreaker": {"failureThreshold": 3}}`
 	var vertexLimit dfv1.VertexLimits
 	err = json.Unmarshal([]byte(vertexLimitJson), &vertexLimit)
 	assert.NoError(t, err)
@@ -191,6 +192,8 @@ func Test_copyVertexLimits(t *testing.T) {
 	copyVertexLimits(pl, v)
 	assert.Equal(t, two, *v.Limits.ReadBatchSize)
 	assert.Equal(t, "3s", v.Limits.ReadTimeout.Duration.String())
+	assert.Equal(t, "10s", v.Limits.UDFTimeout.Duration.String())
+	assert.Equal(t, 3, v.Limits.GetUDFCircuitBreaker().GetFailureThreshold())
 }
 
 func Test_copyEdges(t *testing.T) {
@@ -416,3 +419,93 @@ func TestCreateOrUpdateDaemon(t *testing.T) {
 		assert.Equal(t, "test-pl-daemon", deployList.Items[0].Name)
 	})
 }
+
+func Test_reconcileSideInputs(t *testing.T) {
+	newPipeline := func() *dfv1.Pipeline {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.SideInputs = []dfv1.SideInput{
+			{
+				Name:      "s1",
+				Container: &dfv1.Container{Image: "my-image"},
+				Trigger:   &dfv1.SideInputTrigger{Schedule: "@hourly"},
+			},
+		}
+		testObj.Spec.Vertices[1].UDF = &dfv1.UDF{Container: &dfv1.Container{Image: "my-udf"}}
+		testObj.Spec.Vertices[1].SideInputs = []string{"s1"}
+		return testObj
+	}
+	sideInputsManagers := func(t *testing.T, cl client.Client) []appv1.Deployment {
+		t.Helper()
+		selector, _ := labels.Parse(dfv1.KeyComponent + "=" + dfv1.ComponentSideInputManager)
+		deploys := &appv1.DeploymentList{}
+		assert.NoError(t, cl.List(context.TODO(), deploys, &client.ListOptions{Namespace: testNamespace, LabelSelector: selector}))
+		return deploys.Items
+	}
+	jobArgs := func(t *testing.T, cl client.Client, jobType string) []string {
+		t.Helper()
+		jobs := &batchv1.JobList{}
+		assert.NoError(t, cl.List(context.TODO(), jobs, &client.ListOptions{Namespace: testNamespace}))
+		for _, j := range jobs.Items {
+			if strings.Contains(j.Name, "-"+jobType+"-") {
+				return j.Spec.Template.Spec.Containers[0].Args
+			}
+		}
+		return nil
+	}
+
+	t.Run("test reconcile side inputs", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		ctx := context.TODO()
+		testIsbSvc := &dfv1.InterStepBufferService{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: dfv1.DefaultISBSvcName},
+			Spec:       dfv1.InterStepBufferServiceSpec{JetStream: &dfv1.JetStreamBufferService{Version: "latest"}},
+		}
+		testIsbSvc.Status.MarkConfigured()
+		testIsbSvc.Status.MarkDeployed()
+		testIsbSvc.Status.Config = dfv1.BufferServiceConfig{JetStream: &dfv1.JetStreamConfig{URL: "nats://xxx", StreamConfig: "xxx"}}
+		assert.NoError(t, cl.Create(ctx, testIsbSvc))
+		r := &pipelineReconciler{
+			client: cl,
+			scheme: scheme.Scheme,
+			config: fakeConfig,
+			image:  testFlowImage,
+			logger: zaptest.NewLogger(t).Sugar(),
+		}
+		testObj := newPipeline()
+		_, err := r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		managers := sideInputsManagers(t, cl)
+		assert.Len(t, managers, 1)
+		assert.Equal(t, "test-pl-si-s1", managers[0].Name)
+		assert.Contains(t, jobArgs(t, cl, "create"), "--side-inputs-store="+testObj.GetSideInputsStoreName())
+
+		// side inputs removed
+		testObj.Spec.SideInputs = nil
+		testObj.Spec.Vertices[1].SideInputs = nil
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		assert.Len(t, sideInputsManagers(t, cl), 0)
+		assert.Contains(t, jobArgs(t, cl, "delete"), "--side-inputs-store="+testObj.GetSideInputsStoreName())
+	})
+
+	t.Run("test reconcile side inputs with redis", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		ctx := context.TODO()
+		testIsbSvc := testNativeRedisIsbSvc.DeepCopy()
+		testIsbSvc.Status.MarkConfigured()
+		testIsbSvc.Status.MarkDeployed()
+		assert.NoError(t, cl.Create(ctx, testIsbSvc))
+		r := &pipelineReconciler{
+			client: cl,
+			scheme: scheme.Scheme,
+			config: fakeConfig,
+			image:  testFlowImage,
+			logger: zaptest.NewLogger(t).Sugar(),
+		}
+		testObj := newPipeline()
+		_, err := r.reconcile(ctx, testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "side inputs are only supported with jetstream isbsvc")
+		assert.Len(t, sideInputsManagers(t, cl), 0)
+	})
+}
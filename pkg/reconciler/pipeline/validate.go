@@ -18,10 +18,18 @@ package pipeline
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
+	"text/template"
 
+	"github.com/Masterminds/sprig/v3"
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/parquet"
+	"github.com/numaproj/numaflow/pkg/sideinputs/builtin"
 )
 
 func ValidatePipeline(pl *dfv1.Pipeline) error {
@@ -91,7 +99,11 @@ func ValidatePipeline(pl *dfv1.Pipeline) error {
 
 	for k, t := range udTransformers {
 		transformer := t.Source.UDTransformer
-		if transformer.Container != nil {
+		if transformer.Wasm != nil {
+			if transformer.Container != nil || transformer.Builtin != nil {
+				return fmt.Errorf("invalid source vertex %q, can not specify a wasm module together with builtin transformer, or a customized image", k)
+			}
+		} else if transformer.Container != nil {
 			if transformer.Container.Image == "" && transformer.Builtin == nil {
 				return fmt.Errorf("invalid source vertex %q, either specify a builtin transformer, or a customized image", k)
 			}
@@ -104,7 +116,15 @@ func ValidatePipeline(pl *dfv1.Pipeline) error {
 	}
 
 	for k, u := range mapUdfs {
-		if u.UDF.Container != nil {
+		if u.UDF.Remote != nil {
+			if u.UDF.Container != nil || u.UDF.Builtin != nil || u.UDF.Wasm != nil {
+				return fmt.Errorf("invalid vertex %q, can not specify a remote udf together with builtin function, wasm module, or a customized image", k)
+			}
+		} else if u.UDF.Wasm != nil {
+			if u.UDF.Container != nil || u.UDF.Builtin != nil {
+				return fmt.Errorf("invalid vertex %q, can not specify a wasm module together with builtin function, or a customized image", k)
+			}
+		} else if u.UDF.Container != nil {
 			if u.UDF.Container.Image == "" && u.UDF.Builtin == nil {
 				return fmt.Errorf("invalid vertex %q, either specify a builtin function, or a customized image", k)
 			}
@@ -121,6 +141,12 @@ func ValidatePipeline(pl *dfv1.Pipeline) error {
 			// No builtin function supported for reduce vertices.
 			return fmt.Errorf("invalid vertex %q, there's no buildin function support in reduce vertices", k)
 		}
+		if u.UDF.Wasm != nil {
+			return fmt.Errorf("invalid vertex %q, there's no wasm module support in reduce vertices", k)
+		}
+		if u.UDF.Remote != nil && u.UDF.Container != nil {
+			return fmt.Errorf("invalid vertex %q, can not specify both a remote udf, and a customized image", k)
+		}
 		if u.UDF.Container != nil {
 			if u.UDF.Container.Image == "" {
 				return fmt.Errorf("invalid vertex %q, a customized image is required", k)
@@ -164,6 +190,39 @@ func ValidatePipeline(pl *dfv1.Pipeline) error {
 		toInEdges[e.To] = true
 	}
 
+	sideInputs := make(map[string]bool)
+	for _, si := range pl.Spec.SideInputs {
+		if sideInputs[si.Name] {
+			return fmt.Errorf("duplicate side input name %q", si.Name)
+		}
+		sideInputs[si.Name] = true
+		if err := validateSideInput(si); err != nil {
+			return err
+		}
+		// The length of the side inputs manager deployment name "{pipeline}-si-{side input}" can not be longer than 63.
+		if errs := k8svalidation.IsDNS1035Label(pl.GetSideInputsManagerDeploymentName(si.Name)); len(errs) > 0 {
+			return fmt.Errorf("the length of the pipeline name plus the side input name is over the max limit. (%s), %v", pl.GetSideInputsManagerDeploymentName(si.Name), errs)
+		}
+	}
+	for _, v := range pl.Spec.Vertices {
+		if len(v.SideInputs) == 0 {
+			continue
+		}
+		if v.UDF == nil || v.UDF.Wasm != nil || v.UDF.Remote != nil {
+			return fmt.Errorf("invalid vertex %q, side inputs are only supported by the udf vertices with a udf container", v.Name)
+		}
+		referenced := make(map[string]bool)
+		for _, name := range v.SideInputs {
+			if !sideInputs[name] {
+				return fmt.Errorf("invalid vertex %q, side input %q is not defined in the pipeline", v.Name, name)
+			}
+			if referenced[name] {
+				return fmt.Errorf("invalid vertex %q, duplicate side input %q", v.Name, name)
+			}
+			referenced[name] = true
+		}
+	}
+
 	for _, v := range pl.Spec.Vertices {
 		if err := validateVertex(v); err != nil {
 			return err
@@ -204,6 +263,22 @@ func validateVertex(v dfv1.AbstractVertex) error {
 			return fmt.Errorf("vertex %q: partitions should not > 1 for source vertices", v.Name)
 		}
 	}
+	if x := v.Limits; x != nil && x.UDFTimeout != nil {
+		if v.UDF == nil {
+			return fmt.Errorf(`vertex %q: "limits.udfTimeout" is only supported by udf vertices`, v.Name)
+		}
+		if x.UDFTimeout.Duration <= 0 {
+			return fmt.Errorf(`vertex %q: invalid "limits.udfTimeout", it should be greater than 0`, v.Name)
+		}
+	}
+	if x := v.Limits; x != nil && x.UDFCircuitBreaker != nil {
+		if v.UDF == nil {
+			return fmt.Errorf(`vertex %q: "limits.udfCircuitBreaker" is only supported by udf vertices`, v.Name)
+		}
+		if err := validateCircuitBreaker(*x.UDFCircuitBreaker, "limits.udfCircuitBreaker"); err != nil {
+			return fmt.Errorf("vertex %q: %w", v.Name, err)
+		}
+	}
 	for _, ic := range v.InitContainers {
 		if isReservedContainerName(ic.Name) {
 			return fmt.Errorf("vertex %q: init container name %q is reserved for containers created by numaflow", v.Name, ic.Name)
@@ -217,14 +292,394 @@ func validateVertex(v dfv1.AbstractVertex) error {
 			return fmt.Errorf("vertex %q: sidecar container name %q is reserved for containers created by numaflow", v.Name, sc.Name)
 		}
 	}
+	if v.Source != nil && v.Source.Generator != nil {
+		if err := validateGeneratorSource(*v.Source.Generator); err != nil {
+			return fmt.Errorf("vertex %q: %w", v.Name, err)
+		}
+	}
+	if v.Source != nil && v.Source.GRPC != nil {
+		if err := validateGRPCSource(*v.Source.GRPC); err != nil {
+			return fmt.Errorf("vertex %q: %w", v.Name, err)
+		}
+	}
+	if v.Source != nil && v.Source.File != nil {
+		if err := validateFileSource(v); err != nil {
+			return fmt.Errorf("vertex %q: %w", v.Name, err)
+		}
+	}
+	if v.Sink != nil {
+		if err := validateSink(v); err != nil {
+			return fmt.Errorf("vertex %q: %w", v.Name, err)
+		}
+	}
+	if v.HasUDTransformer() && v.Source.UDTransformer.Wasm != nil {
+		if err := validateWasm(v, *v.Source.UDTransformer.Wasm, "source.transformer.wasm"); err != nil {
+			return fmt.Errorf("vertex %q: %w", v.Name, err)
+		}
+	}
+	if v.UDF != nil && v.UDF.Wasm != nil {
+		if err := validateWasm(v, *v.UDF.Wasm, "udf.wasm"); err != nil {
+			return fmt.Errorf("vertex %q: %w", v.Name, err)
+		}
+	}
+	if v.UDF != nil && v.UDF.Remote != nil {
+		if err := validateRemoteUDF(*v.UDF.Remote); err != nil {
+			return fmt.Errorf("vertex %q: %w", v.Name, err)
+		}
+	}
 	if v.UDF != nil {
 		return validateUDF(*v.UDF)
 	}
 	return nil
 }
 
+func validateGeneratorSource(g dfv1.GeneratorSource) error {
+	if g.Template != nil {
+		if _, err := template.New("payload").Funcs(sprig.TxtFuncMap()).Parse(*g.Template); err != nil {
+			return fmt.Errorf(`invalid "source.generator.template", %w`, err)
+		}
+	}
+	if g.GetKeyDistributionType() == dfv1.KeyDistributionZipf {
+		if s, err := g.GetZipfExponent(); err != nil || s <= 1 {
+			return fmt.Errorf(`invalid "source.generator.keyDistribution.zipfExponent" %q, it should be a number greater than 1`, g.KeyDistribution.ZipfExponent)
+		}
+	}
+	if g.GetLatePercent() > 100 {
+		return fmt.Errorf(`invalid "source.generator.latePercent" %d, it should not be greater than 100`, g.GetLatePercent())
+	}
+	return nil
+}
+
+func validateGRPCSource(g dfv1.GRPCSource) error {
+	if x := g.TLS; x != nil && (x.CertSecret == nil) != (x.KeySecret == nil) {
+		return fmt.Errorf(`invalid "source.grpc.tls", "certSecret" and "keySecret" should be specified together`)
+	}
+	return nil
+}
+
+func validateSink(v dfv1.AbstractVertex) error {
+	if err := validateAbstractSink(v.Sink.AbstractSink); err != nil {
+		return err
+	}
+	if v.Sink.File != nil {
+		if err := validateFileSink(*v.Sink.File, v.Volumes); err != nil {
+			return err
+		}
+	}
+	if x := v.Sink.Batching; x != nil {
+		if x.GetMaxBatchSize() <= 0 {
+			return fmt.Errorf(`invalid "sink.batching.maxBatchSize", it should be greater than 0`)
+		}
+		if x.GetMaxBatchBytes() < 0 {
+			return fmt.Errorf(`invalid "sink.batching.maxBatchBytes", it should not be negative`)
+		}
+		if x.GetMaxLatency() <= 0 {
+			return fmt.Errorf(`invalid "sink.batching.maxLatency", it should be greater than 0`)
+		}
+	}
+	if x := v.Sink.RateLimit; x != nil {
+		if x.GetMessagesPerSecond() <= 0 && x.GetBytesPerSecond() <= 0 {
+			return fmt.Errorf(`invalid "sink.rateLimit", "messagesPerSecond" or "bytesPerSecond" is required`)
+		}
+		if x.GetBytesPerSecond() < 0 || x.GetByteBurst() < 0 {
+			return fmt.Errorf(`invalid "sink.rateLimit", "bytesPerSecond" and "byteBurst" should not be negative`)
+		}
+		if (x.MessageBurst != nil && x.GetMessageBurst() == 0) || (x.ByteBurst != nil && x.GetByteBurst() == 0) {
+			return fmt.Errorf(`invalid "sink.rateLimit", "messageBurst" and "byteBurst" should be greater than 0`)
+		}
+	}
+	fallback := v.Sink.Fallback
+	if fallback == nil {
+		if v.Sink.MaxRetries != nil {
+			return fmt.Errorf(`invalid "sink", "maxRetries" can only be specified with "fallback"`)
+		}
+		return nil
+	}
+	if fallback.Log == nil && fallback.Kafka == nil && fallback.Blackhole == nil && fallback.UDSink == nil && fallback.HTTP == nil && fallback.File == nil && fallback.S3 == nil && fallback.SQL == nil {
+		return fmt.Errorf(`invalid "sink.fallback", a sink type is missing`)
+	}
+	if fallback.UDSink != nil && v.Sink.UDSink != nil {
+		// there's only one user defined sink container in the pod.
+		return fmt.Errorf(`invalid "sink.fallback", it can not be a user defined sink when the primary sink is a user defined sink`)
+	}
+	if err := validateAbstractSink(*fallback); err != nil {
+		return fmt.Errorf(`invalid "sink.fallback", %w`, err)
+	}
+	if x := fallback.File; x != nil {
+		if err := validateFileSink(*x, v.Volumes); err != nil {
+			return fmt.Errorf(`invalid "sink.fallback", %w`, err)
+		}
+		if y := v.Sink.File; y != nil && y.VolumeName == x.VolumeName && filepath.Clean(y.Path) == filepath.Clean(x.Path) {
+			return fmt.Errorf(`invalid "sink.fallback.file", it can not be written to the same directory as the primary file sink`)
+		}
+	}
+	return nil
+}
+
+// validateAbstractSink validates the sink types which don't depend on the other fields of the vertex.
+func validateAbstractSink(s dfv1.AbstractSink) error {
+	if s.Log != nil {
+		if err := validateLogSink(*s.Log); err != nil {
+			return err
+		}
+	}
+	if s.HTTP != nil {
+		if err := validateHTTPSink(*s.HTTP); err != nil {
+			return err
+		}
+	}
+	if s.S3 != nil {
+		if err := validateS3Sink(*s.S3); err != nil {
+			return err
+		}
+	}
+	if s.SQL != nil {
+		if err := validateSQLSink(*s.SQL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateLogSink(l dfv1.Log) error {
+	if f := l.GetFormat(); f != dfv1.LogFormatHuman && f != dfv1.LogFormatJSON {
+		return fmt.Errorf(`invalid "sink.log.format" %q, it should be "human" or "json"`, f)
+	}
+	for _, f := range l.GetFields() {
+		switch f {
+		case dfv1.LogFieldKeys, dfv1.LogFieldEventTime, dfv1.LogFieldID, dfv1.LogFieldWatermark, dfv1.LogFieldIsLate:
+		default:
+			return fmt.Errorf(`invalid "sink.log.fields", unsupported field %q`, f)
+		}
+	}
+	r, err := l.GetSamplingRate()
+	if err != nil {
+		return fmt.Errorf(`invalid "sink.log.samplingRate" %q, %w`, l.SamplingRate, err)
+	}
+	if r < 0 || r > 1 {
+		return fmt.Errorf(`invalid "sink.log.samplingRate" %q, it should be between 0 and 1`, l.SamplingRate)
+	}
+	return nil
+}
+
+func validateHTTPSink(h dfv1.HTTPSink) error {
+	if h.URL == "" {
+		return fmt.Errorf(`invalid "sink.http", "url" is missing`)
+	}
+	if _, err := template.New("url").Funcs(sprig.TxtFuncMap()).Parse(h.URL); err != nil {
+		return fmt.Errorf(`invalid "sink.http.url", %w`, err)
+	}
+	for k, v := range h.Headers {
+		if _, err := template.New(k).Funcs(sprig.TxtFuncMap()).Parse(v); err != nil {
+			return fmt.Errorf(`invalid "sink.http.headers" %q, %w`, k, err)
+		}
+	}
+	if h.Auth != nil && h.BasicAuth != nil {
+		return fmt.Errorf(`invalid "sink.http", "auth" and "basicAuth" can not be specified together`)
+	}
+	if x := h.TLS; x != nil && (x.CertSecret == nil) != (x.KeySecret == nil) {
+		return fmt.Errorf(`invalid "sink.http.tls", "certSecret" and "keySecret" should be specified together`)
+	}
+	for _, c := range h.RetryableStatusCodes {
+		if c < 100 || c > 599 {
+			return fmt.Errorf(`invalid "sink.http.retryableStatusCodes", %d is not a valid status code`, c)
+		}
+	}
+	return nil
+}
+
+func validateFileSink(f dfv1.FileSink, volumes []corev1.Volume) error {
+	if f.GetPartitionDuration() <= 0 {
+		return fmt.Errorf(`invalid "sink.file.partitionDuration", it should be greater than 0`)
+	}
+	if f.GetMaxFileSize() <= 0 {
+		return fmt.Errorf(`invalid "sink.file.maxFileSize", it should be greater than 0`)
+	}
+	if f.GetRollInterval() <= 0 {
+		return fmt.Errorf(`invalid "sink.file.rollInterval", it should be greater than 0`)
+	}
+	if f.Parquet != nil && f.GetFormat() != dfv1.FileSinkFormatParquet {
+		return fmt.Errorf(`invalid "sink.file", "parquet" can only be specified with the format "parquet"`)
+	}
+	if f.Parquet != nil && f.Parquet.Schema != "" {
+		if _, err := parquet.SchemaFromJSONSchema([]byte(f.Parquet.Schema)); err != nil {
+			return fmt.Errorf(`invalid "sink.file.parquet.schema", %w`, err)
+		}
+	}
+	if filepath.IsAbs(f.Path) || strings.HasPrefix(filepath.Clean(f.Path), "..") {
+		return fmt.Errorf(`invalid "sink.file.path" %q, it should be a relative path in the volume`, f.Path)
+	}
+	for _, vol := range volumes {
+		if vol.Name == f.VolumeName {
+			return nil
+		}
+	}
+	return fmt.Errorf(`invalid "sink.file", volume %q is not found in "volumes"`, f.VolumeName)
+}
+
+func validateS3Sink(s dfv1.S3Sink) error {
+	if s.Bucket == "" {
+		return fmt.Errorf(`invalid "sink.s3", "bucket" is missing`)
+	}
+	if _, err := template.New("key").Funcs(sprig.TxtFuncMap()).Parse(s.GetKey()); err != nil {
+		return fmt.Errorf(`invalid "sink.s3.key", %w`, err)
+	}
+	if !strings.Contains(s.GetKey(), ".BatchID") {
+		return fmt.Errorf(`invalid "sink.s3.key", it should contain "{{ .BatchID }}" to avoid overwriting the objects`)
+	}
+	if (s.AccessKey == nil) != (s.SecretKey == nil) {
+		return fmt.Errorf(`invalid "sink.s3", "accessKey" and "secretKey" should be specified together`)
+	}
+	if s.GetPartSize() < 5*1024*1024 {
+		return fmt.Errorf(`invalid "sink.s3.partSize", it should not be less than 5Mi`)
+	}
+	if x := s.TLS; x != nil && (x.CertSecret == nil) != (x.KeySecret == nil) {
+		return fmt.Errorf(`invalid "sink.s3.tls", "certSecret" and "keySecret" should be specified together`)
+	}
+	return nil
+}
+
+func validateSQLSink(s dfv1.SQLSink) error {
+	if s.Driver != dfv1.SQLDriverPostgres && s.Driver != dfv1.SQLDriverMySQL {
+		return fmt.Errorf(`invalid "sink.sql.driver" %q, it should be "postgres" or "mysql"`, s.Driver)
+	}
+	if s.DSN == nil {
+		return fmt.Errorf(`invalid "sink.sql", "dsn" is missing`)
+	}
+	if strings.TrimSpace(s.Statement) == "" {
+		return fmt.Errorf(`invalid "sink.sql", "statement" is missing`)
+	}
+	if s.GetMaxOpenConns() <= 0 {
+		return fmt.Errorf(`invalid "sink.sql.maxOpenConns", it should be greater than 0`)
+	}
+	for i, a := range s.Args {
+		if (a.Field == "") == (a.Metadata == "") {
+			return fmt.Errorf(`invalid "sink.sql.args[%d]", exactly one of "field" and "metadata" should be specified`, i)
+		}
+		switch a.Metadata {
+		case "", dfv1.SQLArgMetadataEventTime, dfv1.SQLArgMetadataID, dfv1.SQLArgMetadataKey, dfv1.SQLArgMetadataKeys:
+		default:
+			return fmt.Errorf(`invalid "sink.sql.args[%d].metadata" %q`, i, a.Metadata)
+		}
+	}
+	return nil
+}
+
+func validateFileSource(v dfv1.AbstractVertex) error {
+	f := v.Source.File
+	if f.Path == "" {
+		return fmt.Errorf(`invalid "source.file", "path" is missing`)
+	}
+	if _, err := filepath.Match(f.Path, ""); err != nil {
+		return fmt.Errorf(`invalid "source.file.path" %q, %w`, f.Path, err)
+	}
+	if f.EventTime != nil && f.EventTime.Expression == "" {
+		return fmt.Errorf(`invalid "source.file.eventTime", "expression" is missing`)
+	}
+	if v.Scale.GetMaxReplicas() > 1 {
+		// the checkpoint is not shared by the replicas.
+		return fmt.Errorf(`invalid "source.file", "scale.max" should be 1`)
+	}
+	for _, vol := range v.Volumes {
+		if vol.Name == f.VolumeName {
+			return nil
+		}
+	}
+	return fmt.Errorf(`invalid "source.file", volume %q is not found in "volumes"`, f.VolumeName)
+}
+
+func validateWasm(v dfv1.AbstractVertex, w dfv1.Wasm, field string) error {
+	if (w.ConfigMap == nil) == (w.VolumeName == "") {
+		return fmt.Errorf(`invalid %q, either "configMap" or "volumeName" should be specified`, field)
+	}
+	if x := w.MemoryLimitPages; x != nil && (*x == 0 || *x > 65536) {
+		return fmt.Errorf(`invalid "%s.memoryLimitPages", it should be between 1 and 65536`, field)
+	}
+	if w.ConfigMap != nil {
+		if w.Path != "" {
+			return fmt.Errorf(`invalid %q, "path" is only supported with "volumeName"`, field)
+		}
+		return nil
+	}
+	if w.Path == "" || filepath.IsAbs(w.Path) {
+		return fmt.Errorf(`invalid "%s.path" %q, a path relative to the root of the volume is required`, field, w.Path)
+	}
+	for _, vol := range v.Volumes {
+		if vol.Name == w.VolumeName {
+			return nil
+		}
+	}
+	return fmt.Errorf(`invalid %q, volume %q is not found in "volumes"`, field, w.VolumeName)
+}
+
+func validateRemoteUDF(r dfv1.RemoteUDF) error {
+	if r.Address == "" {
+		return fmt.Errorf(`invalid "udf.remote", "address" is required`)
+	}
+	if r.Timeout != nil && r.Timeout.Duration <= 0 {
+		return fmt.Errorf(`invalid "udf.remote.timeout", it should be greater than 0`)
+	}
+	if x := r.TLS; x != nil && (x.CertSecret == nil) != (x.KeySecret == nil) {
+		return fmt.Errorf(`invalid "udf.remote.tls", "certSecret" and "keySecret" should be specified together`)
+	}
+	if x := r.CircuitBreaker; x != nil {
+		return validateCircuitBreaker(*x, "udf.remote.circuitBreaker")
+	}
+	return nil
+}
+
+func validateCircuitBreaker(cb dfv1.CircuitBreaker, field string) error {
+	if cb.FailureThreshold != nil && *cb.FailureThreshold == 0 {
+		return fmt.Errorf(`invalid "%s.failureThreshold", it should be greater than 0`, field)
+	}
+	if cb.OpenDuration != nil && cb.OpenDuration.Duration <= 0 {
+		return fmt.Errorf(`invalid "%s.openDuration", it should be greater than 0`, field)
+	}
+	return nil
+}
+
+func validateSideInput(si dfv1.SideInput) error {
+	if errs := k8svalidation.IsDNS1035Label(si.Name); len(errs) > 0 {
+		return fmt.Errorf("invalid side input name %q, %v", si.Name, errs)
+	}
+	if (si.Container == nil) == (si.Builtin == nil) {
+		return fmt.Errorf(`invalid side input %q, either "container" or "builtin" should be specified`, si.Name)
+	}
+	if si.Container != nil && si.Container.Image == "" {
+		return fmt.Errorf(`invalid side input %q, "container.image" is required`, si.Name)
+	}
+	if si.Builtin != nil {
+		if len(si.Volumes) > 0 {
+			return fmt.Errorf(`invalid side input %q, "volumes" are only supported with "container"`, si.Name)
+		}
+		if _, err := builtin.New(*si.Builtin); err != nil {
+			return fmt.Errorf(`invalid side input %q, invalid "builtin", %w`, si.Name, err)
+		}
+	}
+	t := si.Trigger
+	if t == nil {
+		return fmt.Errorf(`invalid side input %q, "trigger" is required`, si.Name)
+	}
+	if (t.Schedule == "") == (t.Interval == nil) {
+		return fmt.Errorf(`invalid side input %q, either "trigger.schedule" or "trigger.interval" should be specified`, si.Name)
+	}
+	if t.Interval != nil && t.Interval.Duration <= 0 {
+		return fmt.Errorf(`invalid side input %q, "trigger.interval" should be greater than 0`, si.Name)
+	}
+	if t.Timezone != nil && t.Schedule == "" {
+		return fmt.Errorf(`invalid side input %q, "trigger.timezone" is only supported with "trigger.schedule"`, si.Name)
+	}
+	if _, err := cron.ParseStandard(t.GetSchedule()); err != nil {
+		return fmt.Errorf(`invalid side input %q, invalid "trigger", %w`, si.Name, err)
+	}
+	return nil
+}
+
 func validateUDF(udf dfv1.UDF) error {
 	if udf.GroupBy != nil {
+		if udf.Ordering != "" {
+			return fmt.Errorf(`invalid "ordering", it's only supported by map vertices`)
+		}
 		f := udf.GroupBy.Window.Fixed
 		s := udf.GroupBy.Window.Sliding
 		storage := udf.GroupBy.Storage
@@ -264,5 +719,7 @@ func isReservedContainerName(name string) bool {
 		name == dfv1.CtrMain ||
 		name == dfv1.CtrUdf ||
 		name == dfv1.CtrUdsink ||
-		name == dfv1.CtrUdtransformer
+		name == dfv1.CtrUdtransformer ||
+		name == dfv1.CtrInitSideInputs ||
+		name == dfv1.CtrSideInputsWatcher
 }
@@ -23,6 +23,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
@@ -248,6 +249,26 @@ func TestValidatePipeline(t *testing.T) {
 		assert.Contains(t, err.Error(), "can not specify both builtin function, and a customized image")
 	})
 
+	t.Run("udf both wasm and builtin specified", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[1].UDF.Wasm = &dfv1.Wasm{ConfigMap: &corev1.ConfigMapKeySelector{Key: "module.wasm"}}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "can not specify a wasm module together with builtin function, or a customized image")
+		testObj.Spec.Vertices[1].UDF.Builtin = nil
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("udf both remote and builtin specified", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[1].UDF.Remote = &dfv1.RemoteUDF{Address: "dns:///my-udf:8443"}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "can not specify a remote udf together with builtin function, wasm module, or a customized image")
+		testObj.Spec.Vertices[1].UDF.Builtin = nil
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
 	t.Run("edge - invalid vertex name", func(t *testing.T) {
 		testObj := testPipeline.DeepCopy()
 		testObj.Spec.Edges = append(testObj.Spec.Edges, dfv1.Edge{From: "a", To: "b"})
@@ -514,6 +535,429 @@ func TestValidateVertex(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), `"sidecars" are not supported for source vertices`)
 	})
+
+	t.Run("file source", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Source: &dfv1.Source{
+				File: &dfv1.FileSource{VolumeName: "files"},
+			},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"path" is missing`)
+		v.Source.File.Path = "[a-"
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "source.file.path"`)
+		v.Source.File.Path = "*.log"
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"scale.max" should be 1`)
+		v.Scale.Max = pointer.Int32(2)
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"scale.max" should be 1`)
+		v.Scale.Max = pointer.Int32(1)
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `volume "files" is not found`)
+		v.Volumes = []corev1.Volume{{Name: "files"}}
+		assert.NoError(t, validateVertex(v))
+	})
+
+	t.Run("wasm", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			UDF:  &dfv1.UDF{Wasm: &dfv1.Wasm{}},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `either "configMap" or "volumeName" should be specified`)
+		v.UDF.Wasm.ConfigMap = &corev1.ConfigMapKeySelector{Key: "module.wasm"}
+		assert.NoError(t, validateVertex(v))
+		v.UDF.Wasm.Path = "module.wasm"
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"path" is only supported with "volumeName"`)
+		v.UDF.Wasm = &dfv1.Wasm{VolumeName: "modules", Path: "/module.wasm"}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "udf.wasm.path"`)
+		v.UDF.Wasm.Path = "module.wasm"
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `volume "modules" is not found`)
+		v.Volumes = []corev1.Volume{{Name: "modules"}}
+		assert.NoError(t, validateVertex(v))
+		pages := uint32(65537)
+		v.UDF.Wasm.MemoryLimitPages = &pages
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "udf.wasm.memoryLimitPages"`)
+		pages = 256
+		assert.NoError(t, validateVertex(v))
+		v.UDF = nil
+		v.Source = &dfv1.Source{
+			Generator:     &dfv1.GeneratorSource{},
+			UDTransformer: &dfv1.UDTransformer{Wasm: &dfv1.Wasm{VolumeName: "modules"}},
+		}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "source.transformer.wasm.path"`)
+	})
+
+	t.Run("remote udf", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			UDF:  &dfv1.UDF{Remote: &dfv1.RemoteUDF{}},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"address" is required`)
+		v.UDF.Remote.Address = "dns:///my-udf:8443"
+		assert.NoError(t, validateVertex(v))
+		v.UDF.Remote.Timeout = &metav1.Duration{}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "udf.remote.timeout"`)
+		v.UDF.Remote.Timeout = &metav1.Duration{Duration: time.Second}
+		v.UDF.Remote.TLS = &dfv1.TLS{CertSecret: &corev1.SecretKeySelector{Key: "tls.crt"}}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"certSecret" and "keySecret" should be specified together`)
+		v.UDF.Remote.TLS.KeySecret = &corev1.SecretKeySelector{Key: "tls.key"}
+		assert.NoError(t, validateVertex(v))
+		failureThreshold := uint32(0)
+		v.UDF.Remote.CircuitBreaker = &dfv1.CircuitBreaker{FailureThreshold: &failureThreshold}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "udf.remote.circuitBreaker.failureThreshold"`)
+	})
+
+	t.Run("udf timeout", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name:   "my-vertex",
+			Sink:   &dfv1.Sink{AbstractSink: dfv1.AbstractSink{Log: &dfv1.Log{}}},
+			Limits: &dfv1.VertexLimits{UDFTimeout: &metav1.Duration{Duration: time.Second}},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"limits.udfTimeout" is only supported by udf vertices`)
+		v.Sink = nil
+		v.UDF = &dfv1.UDF{Container: &dfv1.Container{Image: "my-image"}}
+		assert.NoError(t, validateVertex(v))
+		v.Limits.UDFTimeout.Duration = 0
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "limits.udfTimeout"`)
+	})
+
+	t.Run("udf circuit breaker", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name:   "my-vertex",
+			Sink:   &dfv1.Sink{AbstractSink: dfv1.AbstractSink{Log: &dfv1.Log{}}},
+			Limits: &dfv1.VertexLimits{UDFCircuitBreaker: &dfv1.CircuitBreaker{OpenDuration: &metav1.Duration{Duration: time.Minute}}},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"limits.udfCircuitBreaker" is only supported by udf vertices`)
+		v.Sink = nil
+		v.UDF = &dfv1.UDF{Container: &dfv1.Container{Image: "my-image"}}
+		assert.NoError(t, validateVertex(v))
+		v.Limits.UDFCircuitBreaker.OpenDuration.Duration = 0
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "limits.udfCircuitBreaker.openDuration"`)
+	})
+
+	t.Run("grpc source", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Source: &dfv1.Source{
+				GRPC: &dfv1.GRPCSource{TLS: &dfv1.TLS{CertSecret: &corev1.SecretKeySelector{Key: "tls.crt"}}},
+			},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"certSecret" and "keySecret" should be specified together`)
+		v.Source.GRPC.TLS.KeySecret = &corev1.SecretKeySelector{Key: "tls.key"}
+		assert.NoError(t, validateVertex(v))
+	})
+
+	t.Run("file sink", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Sink: &dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					File: &dfv1.FileSink{VolumeName: "archive", Path: "../out"},
+				},
+			},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "sink.file.path"`)
+		v.Sink.File.Path = "out"
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `volume "archive" is not found`)
+		v.Volumes = []corev1.Volume{{Name: "archive"}}
+		assert.NoError(t, validateVertex(v))
+		v.Sink.File.PartitionDuration = &metav1.Duration{Duration: 0}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "sink.file.partitionDuration"`)
+		v.Sink.File.PartitionDuration = nil
+		v.Sink.File.Parquet = &dfv1.ParquetOptions{Schema: `{"type": "string"}`}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"parquet" can only be specified with the format "parquet"`)
+		v.Sink.File.Format = dfv1.FileSinkFormatParquet
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "sink.file.parquet.schema"`)
+		v.Sink.File.Parquet.Schema = `{"type": "object", "properties": {"id": {"type": "integer"}}}`
+		assert.NoError(t, validateVertex(v))
+	})
+
+	t.Run("http sink", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Sink: &dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					HTTP: &dfv1.HTTPSink{},
+				},
+			},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"url" is missing`)
+		v.Sink.HTTP.URL = "http://example.com/{{index .Keys 0}"
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "sink.http.url"`)
+		v.Sink.HTTP.URL = "http://example.com/{{index .Keys 0}}"
+		v.Sink.HTTP.Headers = map[string]string{"X-ID": "{{.ID}}"}
+		assert.NoError(t, validateVertex(v))
+		v.Sink.HTTP.RetryableStatusCodes = []int32{503, 1000}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "sink.http.retryableStatusCodes"`)
+	})
+
+	t.Run("log sink", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Sink: &dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					Log: &dfv1.Log{},
+				},
+			},
+		}
+		assert.NoError(t, validateVertex(v))
+		v.Sink.Log.Format = "xml"
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "sink.log.format"`)
+		v.Sink.Log.Format = dfv1.LogFormatJSON
+		v.Sink.Log.Fields = []dfv1.LogField{dfv1.LogFieldID, "offset"}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `unsupported field "offset"`)
+		v.Sink.Log.Fields = []dfv1.LogField{dfv1.LogFieldID, dfv1.LogFieldWatermark}
+		v.Sink.Log.SamplingRate = "1.5"
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `it should be between 0 and 1`)
+		v.Sink.Log.SamplingRate = "0.01"
+		assert.NoError(t, validateVertex(v))
+	})
+
+	t.Run("sink batching", func(t *testing.T) {
+		size := uint64(0)
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Sink: &dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					Log: &dfv1.Log{},
+				},
+				Batching: &dfv1.SinkBatching{},
+			},
+		}
+		assert.NoError(t, validateVertex(v))
+		v.Sink.Batching.MaxBatchSize = &size
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "sink.batching.maxBatchSize"`)
+		size = 1000
+		v.Sink.Batching.MaxLatency = &metav1.Duration{Duration: 0}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "sink.batching.maxLatency"`)
+		v.Sink.Batching.MaxLatency = &metav1.Duration{Duration: 5 * time.Second}
+		assert.NoError(t, validateVertex(v))
+	})
+
+	t.Run("sink rate limit", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Sink: &dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					Log: &dfv1.Log{},
+				},
+				RateLimit: &dfv1.SinkRateLimit{},
+			},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"messagesPerSecond" or "bytesPerSecond" is required`)
+		bytes := resource.MustParse("1Mi")
+		v.Sink.RateLimit.BytesPerSecond = &bytes
+		assert.NoError(t, validateVertex(v))
+		burst := uint32(0)
+		v.Sink.RateLimit.MessageBurst = &burst
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `should be greater than 0`)
+	})
+
+	t.Run("s3 sink", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Sink: &dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					S3: &dfv1.S3Sink{},
+				},
+			},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"bucket" is missing`)
+		v.Sink.S3.Bucket = "my-bucket"
+		assert.NoError(t, validateVertex(v))
+		v.Sink.S3.Key = "{{ index .Keys 0 }}.json"
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `it should contain "{{ .BatchID }}"`)
+		v.Sink.S3.Key = "{{ index .Keys 0 }}/{{ .BatchID }}.json"
+		v.Sink.S3.AccessKey = &corev1.SecretKeySelector{Key: "accesskey"}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"accessKey" and "secretKey" should be specified together`)
+		v.Sink.S3.SecretKey = &corev1.SecretKeySelector{Key: "secretkey"}
+		assert.NoError(t, validateVertex(v))
+		partSize := resource.MustParse("1Mi")
+		v.Sink.S3.PartSize = &partSize
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "sink.s3.partSize"`)
+	})
+
+	t.Run("sql sink", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Sink: &dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					SQL: &dfv1.SQLSink{Driver: "oracle"},
+				},
+			},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "sink.sql.driver"`)
+		v.Sink.SQL.Driver = dfv1.SQLDriverPostgres
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"dsn" is missing`)
+		v.Sink.SQL.DSN = &corev1.SecretKeySelector{Key: "dsn"}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"statement" is missing`)
+		v.Sink.SQL.Statement = "INSERT INTO totals (id, total) VALUES ($1, $2)"
+		v.Sink.SQL.Args = []dfv1.SQLArg{{Metadata: dfv1.SQLArgMetadataKey}, {Field: "total", Metadata: dfv1.SQLArgMetadataID}}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "sink.sql.args[1]"`)
+		v.Sink.SQL.Args[1].Metadata = ""
+		assert.NoError(t, validateVertex(v))
+	})
+
+	t.Run("fallback sink", func(t *testing.T) {
+		maxRetries := uint32(3)
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Sink: &dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					UDSink: &dfv1.UDSink{},
+				},
+				MaxRetries: &maxRetries,
+			},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"maxRetries" can only be specified with "fallback"`)
+		v.Sink.Fallback = &dfv1.AbstractSink{}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "sink.fallback", a sink type is missing`)
+		v.Sink.Fallback.UDSink = &dfv1.UDSink{}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `it can not be a user defined sink when the primary sink is a user defined sink`)
+		v.Sink.Fallback = &dfv1.AbstractSink{HTTP: &dfv1.HTTPSink{}}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "sink.fallback", invalid "sink.http", "url" is missing`)
+		v.Sink.Fallback.HTTP.URL = "http://example.com/dlq"
+		assert.NoError(t, validateVertex(v))
+
+		// a user defined sink fallback with a primary sink of another type.
+		v.Sink.UDSink = nil
+		v.Sink.Log = &dfv1.Log{}
+		v.Sink.Fallback = &dfv1.AbstractSink{UDSink: &dfv1.UDSink{}}
+		assert.NoError(t, validateVertex(v))
+
+		// file sinks
+		v.Volumes = []corev1.Volume{{Name: "data"}}
+		v.Sink.Fallback = &dfv1.AbstractSink{File: &dfv1.FileSink{VolumeName: "nope", Path: "dlq"}}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "sink.fallback", invalid "sink.file", volume "nope" is not found`)
+		v.Sink.Fallback.File.VolumeName = "data"
+		assert.NoError(t, validateVertex(v))
+		v.Sink.Log = nil
+		v.Sink.File = &dfv1.FileSink{VolumeName: "data", Path: "dlq/"}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `can not be written to the same directory as the primary file sink`)
+		v.Sink.File.Path = "out"
+		assert.NoError(t, validateVertex(v))
+	})
+
+	t.Run("generator source", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Source: &dfv1.Source{
+				Generator: &dfv1.GeneratorSource{Template: pointer.String(`{"id": {{.Seq}`)},
+			},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "source.generator.template"`)
+		v.Source.Generator.Template = pointer.String(`{"id": {{.Seq}}, "n": {{randInt 0 10}}}`)
+		assert.NoError(t, validateVertex(v))
+		v.Source.Generator.KeyDistribution = &dfv1.KeyDistribution{Type: dfv1.KeyDistributionZipf, ZipfExponent: "0.5"}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "source.generator.keyDistribution.zipfExponent"`)
+		v.Source.Generator.KeyDistribution.ZipfExponent = ""
+		assert.NoError(t, validateVertex(v))
+		latePercent := uint32(101)
+		v.Source.Generator.LatePercent = &latePercent
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "source.generator.latePercent"`)
+	})
 }
 
 func TestValidateUDF(t *testing.T) {
@@ -540,4 +984,128 @@ func TestValidateUDF(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), `"length" is missing`)
 	})
+
+	t.Run("ordering", func(t *testing.T) {
+		udf := dfv1.UDF{
+			Container: &dfv1.Container{Image: "my-image"},
+			Ordering:  dfv1.OrderingPerKey,
+		}
+		assert.NoError(t, validateUDF(udf))
+		udf.GroupBy = &dfv1.GroupBy{}
+		err := validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"ordering", it's only supported by map vertices`)
+	})
+}
+
+func TestValidateSideInputs(t *testing.T) {
+	newPipeline := func() *dfv1.Pipeline {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.SideInputs = []dfv1.SideInput{
+			{
+				Name:      "s1",
+				Container: &dfv1.Container{Image: "my-image"},
+				Trigger:   &dfv1.SideInputTrigger{Schedule: "*/15 * * * *"},
+			},
+		}
+		testObj.Spec.Vertices[1].SideInputs = []string{"s1"}
+		return testObj
+	}
+
+	t.Run("valid side inputs", func(t *testing.T) {
+		testObj := newPipeline()
+		assert.NoError(t, ValidatePipeline(testObj))
+		tz := "America/Los_Angeles"
+		testObj.Spec.SideInputs[0].Trigger.Timezone = &tz
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.SideInputs[0].Trigger = &dfv1.SideInputTrigger{Interval: &metav1.Duration{Duration: time.Minute}}
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.SideInputs[0].Container = nil
+		testObj.Spec.SideInputs[0].Builtin = &dfv1.SideInputBuiltin{Name: "http", KWArgs: map[string]string{"url": "https://my-config/config.json"}}
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("duplicate side inputs", func(t *testing.T) {
+		testObj := newPipeline()
+		testObj.Spec.SideInputs = append(testObj.Spec.SideInputs, testObj.Spec.SideInputs[0])
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `duplicate side input name "s1"`)
+		testObj = newPipeline()
+		testObj.Spec.Vertices[1].SideInputs = []string{"s1", "s1"}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `duplicate side input "s1"`)
+	})
+
+	t.Run("invalid side input", func(t *testing.T) {
+		tz := "Nowhere/Nowhere"
+		testCases := []struct {
+			update func(si *dfv1.SideInput)
+			errMsg string
+		}{
+			{func(si *dfv1.SideInput) { si.Name = "S_1" }, `invalid side input name "S_1"`},
+			{func(si *dfv1.SideInput) { si.Container = nil }, `either "container" or "builtin" should be specified`},
+			{func(si *dfv1.SideInput) { si.Builtin = &dfv1.SideInputBuiltin{Name: "http"} }, `either "container" or "builtin" should be specified`},
+			{func(si *dfv1.SideInput) {
+				si.Container = nil
+				si.Builtin = &dfv1.SideInputBuiltin{Name: "http"}
+			}, `invalid "builtin", missing "url"`},
+			{func(si *dfv1.SideInput) {
+				si.Container = nil
+				si.Builtin = &dfv1.SideInputBuiltin{Name: "http", KWArgs: map[string]string{"url": "https://my-config"}}
+				si.Volumes = []corev1.Volume{{Name: "my-vol"}}
+			}, `"volumes" are only supported with "container"`},
+			{func(si *dfv1.SideInput) { si.Container.Image = "" }, `"container.image" is required`},
+			{func(si *dfv1.SideInput) { si.Trigger = nil }, `"trigger" is required`},
+			{func(si *dfv1.SideInput) { si.Trigger.Schedule = "" }, `either "trigger.schedule" or "trigger.interval" should be specified`},
+			{func(si *dfv1.SideInput) { si.Trigger.Interval = &metav1.Duration{Duration: time.Minute} }, `either "trigger.schedule" or "trigger.interval" should be specified`},
+			{func(si *dfv1.SideInput) {
+				si.Trigger = &dfv1.SideInputTrigger{Interval: &metav1.Duration{Duration: -time.Minute}}
+			}, `"trigger.interval" should be greater than 0`},
+			{func(si *dfv1.SideInput) {
+				si.Trigger = &dfv1.SideInputTrigger{Interval: &metav1.Duration{Duration: time.Minute}, Timezone: &tz}
+			}, `"trigger.timezone" is only supported with "trigger.schedule"`},
+			{func(si *dfv1.SideInput) { si.Trigger.Schedule = "* *" }, `invalid "trigger"`},
+			{func(si *dfv1.SideInput) { si.Trigger.Timezone = &tz }, `invalid "trigger"`},
+		}
+		for _, tc := range testCases {
+			testObj := newPipeline()
+			tc.update(&testObj.Spec.SideInputs[0])
+			testObj.Spec.Vertices[1].SideInputs = nil
+			err := ValidatePipeline(testObj)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tc.errMsg)
+		}
+	})
+
+	t.Run("too long side input name", func(t *testing.T) {
+		testObj := newPipeline()
+		testObj.Spec.SideInputs[0].Name = "a-very-long-side-input-name-which-is-more-than-sixty-chars"
+		testObj.Spec.Vertices[1].SideInputs = []string{testObj.Spec.SideInputs[0].Name}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "the length of the pipeline name plus the side input name is over the max limit")
+	})
+
+	t.Run("undefined side input", func(t *testing.T) {
+		testObj := newPipeline()
+		testObj.Spec.Vertices[1].SideInputs = []string{"s2"}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `side input "s2" is not defined in the pipeline`)
+	})
+
+	t.Run("side inputs in non udf vertices", func(t *testing.T) {
+		testObj := newPipeline()
+		testObj.Spec.Vertices[2].SideInputs = []string{"s1"}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid vertex "output", side inputs are only supported by the udf vertices with a udf container`)
+		testObj = newPipeline()
+		testObj.Spec.Vertices[1].UDF = &dfv1.UDF{Remote: &dfv1.RemoteUDF{Address: "my-udf:9090"}}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid vertex "p1", side inputs are only supported by the udf vertices with a udf container`)
+	})
 }
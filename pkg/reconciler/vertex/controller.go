@@ -18,6 +18,7 @@ package vertex
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -169,6 +170,7 @@ func (r *vertexReconciler) reconcile(ctx context.Context, vertex *dfv1.Vertex) (
 		vertex.Status.MarkPhaseFailed("FindExistingPodFailed", err.Error())
 		return ctrl.Result{}, err
 	}
+	rateLimited := vertex.Spec.Sink != nil && vertex.Spec.Sink.RateLimit != nil
 	for replica := 0; replica < desiredReplicas; replica++ {
 		podSpec, err := r.buildPodSpec(vertex, pipeline, isbSvc.Status.Config, replica)
 		if err != nil {
@@ -184,6 +186,15 @@ func (r *vertexReconciler) reconcile(ctx context.Context, vertex *dfv1.Vertex) (
 				if existingPod.GetAnnotations()[dfv1.KeyHash] == hash && existingPod.Status.Phase != corev1.PodFailed {
 					needToCreate = false
 					delete(existingPods, existingPodName)
+					if rateLimited && existingPod.GetAnnotations()[dfv1.KeyReplicas] != strconv.Itoa(desiredReplicas) {
+						// the rate limited sinks share the limits based on the number of replicas, which is set to the vertex
+						// status below. The pods can't read the vertex status, so it's passed down with the annotation.
+						if err := r.patchPodReplicas(ctx, existingPod, desiredReplicas); err != nil {
+							log.Errorw("Failed to update the replicas annotation of the pod", zap.String("pod", existingPodName), zap.Error(err))
+							vertex.Status.MarkPhaseFailed("PatchPodFailed", err.Error())
+							return ctrl.Result{}, err
+						}
+					}
 				}
 				break
 			}
@@ -206,11 +217,15 @@ func (r *vertexReconciler) reconcile(ctx context.Context, vertex *dfv1.Vertex) (
 			labels[dfv1.KeyVertexName] = vertex.Spec.Name
 			annotations[dfv1.KeyHash] = hash
 			annotations[dfv1.KeyReplica] = strconv.Itoa(replica)
-			if vertex.IsMapUDF() || vertex.IsReduceUDF() {
+			if rateLimited {
+				annotations[dfv1.KeyReplicas] = strconv.Itoa(desiredReplicas)
+			}
+			// There's no UDF or transformer container if it's a wasm module, which is executed in the main container.
+			if (vertex.IsMapUDF() || vertex.IsReduceUDF()) && vertex.Spec.UDF.Wasm == nil && vertex.Spec.UDF.Remote == nil {
 				annotations[dfv1.KeyDefaultContainer] = dfv1.CtrUdf
-			} else if vertex.IsUDSink() {
+			} else if vertex.IsASink() && vertex.Spec.Sink.GetUDSink() != nil {
 				annotations[dfv1.KeyDefaultContainer] = dfv1.CtrUdsink
-			} else if vertex.HasUDTransformer() {
+			} else if vertex.HasUDTransformer() && vertex.Spec.Source.UDTransformer.Wasm == nil {
 				// Once we have UDSource in place, replace it with UDSource?
 				annotations[dfv1.KeyDefaultContainer] = dfv1.CtrUdtransformer
 			}
@@ -343,6 +358,49 @@ func (r *vertexReconciler) buildPodSpec(vertex *dfv1.Vertex, pl *dfv1.Pipeline,
 	podSpec.Volumes = append(podSpec.Volumes, vols...)
 	podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, volMounts...)
 
+	if x := vertex.Spec.Source; x != nil && x.File != nil {
+		// Mount the volume with the files to the main container
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      x.File.VolumeName,
+			MountPath: dfv1.PathFileSourceMount,
+		})
+	}
+	if x := vertex.Spec.Sink; x != nil && x.File != nil {
+		// Mount the volume where the files are written to the main container
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      x.File.VolumeName,
+			MountPath: dfv1.PathFileSinkMount,
+		})
+	}
+	if x := vertex.Spec.Sink; x != nil && x.Fallback != nil && x.Fallback.File != nil {
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      x.Fallback.File.VolumeName,
+			MountPath: dfv1.PathFallbackFileSinkMount,
+		})
+	}
+	if x := vertex.Spec.GetWasm(); x != nil {
+		// Mount the wasm module to the main container, where it's executed
+		volName := x.VolumeName
+		if x.ConfigMap != nil {
+			volName = "wasm-module"
+			podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+				Name: volName,
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: x.ConfigMap.LocalObjectReference,
+						Items:                []corev1.KeyToPath{{Key: x.ConfigMap.Key, Path: dfv1.WasmConfigMapModuleFile}},
+						Optional:             x.ConfigMap.Optional,
+					},
+				},
+			})
+		}
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      volName,
+			MountPath: dfv1.PathWasmMount,
+			ReadOnly:  true,
+		})
+	}
+
 	if vertex.IsReduceUDF() {
 		// Add pvc for reduce vertex pods
 		storage := vertex.Spec.UDF.GroupBy.Storage
@@ -396,6 +454,23 @@ func (r *vertexReconciler) findExistingPods(ctx context.Context, vertex *dfv1.Ve
 	return result, nil
 }
 
+// patchPodReplicas updates the annotation of the number of replicas of the vertex on the pod, which is read by the pod
+// through the downward API volume.
+func (r *vertexReconciler) patchPodReplicas(ctx context.Context, pod corev1.Pod, replicas int) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{dfv1.KeyReplicas: strconv.Itoa(replicas)},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if err := r.client.Patch(ctx, &pod, client.RawPatch(types.MergePatchType, body)); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
 func (r *vertexReconciler) findExistingServices(ctx context.Context, vertex *dfv1.Vertex) (map[string]corev1.Service, error) {
 	svcs := &corev1.ServiceList{}
 	selector, _ := labels.Parse(dfv1.KeyPipelineName + "=" + vertex.Spec.PipelineName + "," + dfv1.KeyVertexName + "=" + vertex.Spec.Name)
@@ -230,6 +230,56 @@ func Test_BuildPodSpec(t *testing.T) {
 		assert.Equal(t, 2, len(spec.Containers))
 	})
 
+	t.Run("test file source", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		r := &vertexReconciler{
+			client: cl,
+			scheme: scheme.Scheme,
+			config: fakeConfig,
+			image:  testFlowImage,
+			logger: zaptest.NewLogger(t).Sugar(),
+		}
+		testObj := testSrcVertex.DeepCopy()
+		testObj.Spec.Source = &dfv1.Source{
+			File: &dfv1.FileSource{VolumeName: "my-files", Path: "*.log"},
+		}
+		testObj.Spec.Volumes = []corev1.Volume{{Name: "my-files", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}}
+		spec, err := r.buildPodSpec(testObj, testPipeline, fakeIsbSvcConfig, 0)
+		assert.NoError(t, err)
+		assert.Contains(t, spec.Containers[0].VolumeMounts, corev1.VolumeMount{Name: "my-files", MountPath: dfv1.PathFileSourceMount})
+	})
+
+	t.Run("test file sink", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		r := &vertexReconciler{
+			client: cl,
+			scheme: scheme.Scheme,
+			config: fakeConfig,
+			image:  testFlowImage,
+			logger: zaptest.NewLogger(t).Sugar(),
+		}
+		testObj := testVertex.DeepCopy()
+		testObj.Name = "test-pl-output"
+		testObj.Spec.Name = "output"
+		testObj.Spec.Sink = &dfv1.Sink{
+			AbstractSink: dfv1.AbstractSink{
+				File: &dfv1.FileSink{VolumeName: "my-archive"},
+			},
+		}
+		testObj.Spec.FromEdges = []dfv1.CombinedEdge{{Edge: dfv1.Edge{From: "p1", To: "output"}}}
+		testObj.Spec.ToEdges = []dfv1.CombinedEdge{}
+		testObj.Spec.Volumes = []corev1.Volume{{Name: "my-archive", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}}
+		spec, err := r.buildPodSpec(testObj, testPipeline, fakeIsbSvcConfig, 0)
+		assert.NoError(t, err)
+		assert.Contains(t, spec.Containers[0].VolumeMounts, corev1.VolumeMount{Name: "my-archive", MountPath: dfv1.PathFileSinkMount})
+
+		// the fallback file sink is mounted to a different path.
+		testObj.Spec.Sink.Fallback = &dfv1.AbstractSink{File: &dfv1.FileSink{VolumeName: "my-archive", Path: "dlq"}}
+		spec, err = r.buildPodSpec(testObj, testPipeline, fakeIsbSvcConfig, 0)
+		assert.NoError(t, err)
+		assert.Contains(t, spec.Containers[0].VolumeMounts, corev1.VolumeMount{Name: "my-archive", MountPath: dfv1.PathFallbackFileSinkMount})
+	})
+
 	t.Run("test sink", func(t *testing.T) {
 		cl := fake.NewClientBuilder().Build()
 		r := &vertexReconciler{
@@ -280,11 +330,13 @@ func Test_BuildPodSpec(t *testing.T) {
 		testObj.Name = "test-pl-output"
 		testObj.Spec.Name = "output"
 		testObj.Spec.Sink = &dfv1.Sink{
-			UDSink: &dfv1.UDSink{
-				Container: dfv1.Container{
-					Image:   "image",
-					Command: []string{"cmd"},
-					Args:    []string{"arg0"},
+			AbstractSink: dfv1.AbstractSink{
+				UDSink: &dfv1.UDSink{
+					Container: dfv1.Container{
+						Image:   "image",
+						Command: []string{"cmd"},
+						Args:    []string{"arg0"},
+					},
 				},
 			},
 		}
@@ -338,6 +390,96 @@ func Test_BuildPodSpec(t *testing.T) {
 		assert.Contains(t, argStr, strings.Join(testObj.GetToBuckets(), ","))
 	})
 
+	t.Run("test wasm map udf", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		r := &vertexReconciler{
+			client: cl,
+			scheme: scheme.Scheme,
+			config: fakeConfig,
+			image:  testFlowImage,
+			logger: zaptest.NewLogger(t).Sugar(),
+		}
+		testObj := testVertex.DeepCopy()
+		testObj.Spec.UDF = &dfv1.UDF{
+			Wasm: &dfv1.Wasm{
+				ConfigMap: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "my-module"},
+					Key:                  "filter.wasm",
+				},
+			},
+		}
+		spec, err := r.buildPodSpec(testObj, testPipeline, fakeIsbSvcConfig, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(spec.Containers))
+		assert.Contains(t, spec.Containers[0].VolumeMounts, corev1.VolumeMount{Name: "wasm-module", MountPath: dfv1.PathWasmMount, ReadOnly: true})
+		assert.Contains(t, spec.Volumes, corev1.Volume{
+			Name: "wasm-module",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "my-module"},
+					Items:                []corev1.KeyToPath{{Key: "filter.wasm", Path: dfv1.WasmConfigMapModuleFile}},
+				},
+			},
+		})
+	})
+
+	t.Run("test remote udf", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		r := &vertexReconciler{
+			client: cl,
+			scheme: scheme.Scheme,
+			config: fakeConfig,
+			image:  testFlowImage,
+			logger: zaptest.NewLogger(t).Sugar(),
+		}
+		testObj := testVertex.DeepCopy()
+		testObj.Spec.UDF = &dfv1.UDF{
+			Remote: &dfv1.RemoteUDF{
+				Address: "dns:///my-udf:8443",
+				TLS: &dfv1.TLS{
+					CACertSecret: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "my-udf-tls"},
+						Key:                  "ca.crt",
+					},
+				},
+			},
+		}
+		spec, err := r.buildPodSpec(testObj, testPipeline, fakeIsbSvcConfig, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(spec.Containers))
+		assert.Equal(t, dfv1.CtrMain, spec.Containers[0].Name)
+		var secretMounted bool
+		for _, v := range spec.Volumes {
+			if v.Secret != nil && v.Secret.SecretName == "my-udf-tls" {
+				secretMounted = true
+			}
+		}
+		assert.True(t, secretMounted)
+	})
+
+	t.Run("test wasm source transformer", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		r := &vertexReconciler{
+			client: cl,
+			scheme: scheme.Scheme,
+			config: fakeConfig,
+			image:  testFlowImage,
+			logger: zaptest.NewLogger(t).Sugar(),
+		}
+		testObj := testSrcVertex.DeepCopy()
+		testObj.Spec.Source = &dfv1.Source{
+			Generator: &dfv1.GeneratorSource{},
+			UDTransformer: &dfv1.UDTransformer{
+				Wasm: &dfv1.Wasm{VolumeName: "my-modules", Path: "transformer.wasm"},
+			},
+		}
+		testObj.Spec.Volumes = []corev1.Volume{{Name: "my-modules", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}}
+		spec, err := r.buildPodSpec(testObj, testPipeline, fakeIsbSvcConfig, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(spec.Containers))
+		assert.Contains(t, spec.Containers[0].VolumeMounts, corev1.VolumeMount{Name: "my-modules", MountPath: dfv1.PathWasmMount, ReadOnly: true})
+	})
+
 	t.Run("test reduce udf", func(t *testing.T) {
 		cl := fake.NewClientBuilder().Build()
 		r := &vertexReconciler{
@@ -466,6 +608,55 @@ func Test_reconcile(t *testing.T) {
 		assert.Equal(t, 1, len(pods.Items[0].Spec.Containers))
 	})
 
+	t.Run("test reconcile rate limited sink", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		ctx := context.TODO()
+		testIsbSvc := testNativeRedisIsbSvc.DeepCopy()
+		testIsbSvc.Status.MarkConfigured()
+		testIsbSvc.Status.MarkDeployed()
+		err := cl.Create(ctx, testIsbSvc)
+		assert.Nil(t, err)
+		testPl := testPipeline.DeepCopy()
+		err = cl.Create(ctx, testPl)
+		assert.Nil(t, err)
+		r := &vertexReconciler{
+			client: cl,
+			scheme: scheme.Scheme,
+			config: fakeConfig,
+			image:  testFlowImage,
+			scaler: scaling.NewScaler(cl),
+			logger: zaptest.NewLogger(t).Sugar(),
+		}
+		messages := uint32(100)
+		testObj := testVertex.DeepCopy()
+		testObj.Spec.Sink = &dfv1.Sink{
+			AbstractSink: dfv1.AbstractSink{
+				Log: &dfv1.Log{},
+			},
+			RateLimit: &dfv1.SinkRateLimit{MessagesPerSecond: &messages},
+		}
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		pods := &corev1.PodList{}
+		selector, _ := labels.Parse(dfv1.KeyPipelineName + "=" + testPipelineName + "," + dfv1.KeyVertexName + "=" + testVertexSpecName)
+		err = r.client.List(ctx, pods, &client.ListOptions{Namespace: testNamespace, LabelSelector: selector})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(pods.Items))
+		assert.Equal(t, "1", pods.Items[0].Annotations[dfv1.KeyReplicas])
+
+		// the existing pod is updated with the new number of replicas.
+		replicas := int32(2)
+		testObj.Spec.Replicas = &replicas
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		err = r.client.List(ctx, pods, &client.ListOptions{Namespace: testNamespace, LabelSelector: selector})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(pods.Items))
+		for _, pod := range pods.Items {
+			assert.Equal(t, "2", pod.Annotations[dfv1.KeyReplicas])
+		}
+	})
+
 	t.Run("test reconcile udf", func(t *testing.T) {
 		cl := fake.NewClientBuilder().Build()
 		ctx := context.TODO()
@@ -235,7 +235,10 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 	partitionPending := make([]int64, 0)
 	totalRate := float64(0)
 	totalPending := int64(0)
+	throttledRate := float64(0)
 	for _, m := range vMetrics {
+		// the throttled rate is of the vertex, it's the same in all the partitions.
+		throttledRate = m.ThrottledRates["default"]
 		rate, existing := m.ProcessingRates["default"]
 		// If rate is not available, we skip scaling.
 		if !existing || rate < 0 { // Rate not available
@@ -312,6 +315,10 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 		return s.patchVertexReplicas(ctx, vertex, current-diff) // We scale down gradually
 	}
 	if desired > current {
+		if rateLimitReached(vertex, totalRate, throttledRate) {
+			log.Debugf("Vertex %s has reached its rate limit, skip scaling", key)
+			return nil
+		}
 		// When scaling up, need to check back pressure
 		directPressure, downstreamPressure := s.hasBackPressure(*pl, *vertex)
 		if directPressure {
@@ -335,6 +342,22 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 	return nil
 }
 
+// rateLimitReached returns whether a rate limited sink reaches its rate limits, in which case scaling up doesn't help
+// since the limits are shared by the replicas. It's reached when the processing rate reaches the message rate limit,
+// or when the replicas spend most of the time waiting for the limits, e.g. the bytes rate reaches the bytes rate limit.
+// The throttledRate is the total seconds per second the replicas are throttled.
+func rateLimitReached(vertex *dfv1.Vertex, rate float64, throttledRate float64) bool {
+	if vertex.Spec.Sink == nil || vertex.Spec.Sink.RateLimit == nil {
+		return false
+	}
+	limit := vertex.Spec.Sink.RateLimit.GetMessagesPerSecond()
+	// the processing rate is an average, which could be a bit lower than the limit when it's throttled.
+	if limit > 0 && rate >= float64(limit)*0.9 {
+		return true
+	}
+	return vertex.Status.Replicas > 0 && throttledRate >= float64(vertex.Status.Replicas)*0.5
+}
+
 func (s *Scaler) desiredReplicas(ctx context.Context, vertex *dfv1.Vertex, partitionProcessingRate []float64, partitionPending []int64, partitionBufferLengths []int64, partitionAvailableBufferLengths []int64) int32 {
 	maxDesired := int32(1)
 	// We calculate the max desired replicas based on the pending messages and processing rate for each partition.
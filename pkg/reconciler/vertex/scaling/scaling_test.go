@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -109,3 +110,46 @@ func Test_desiredReplicasMultiplePartitions(t *testing.T) {
 	assert.Equal(t, int32(4), s.desiredReplicas(context.TODO(), udf, []float64{5000, 3000, 5000}, []int64{0, 30000, 1}, []int64{24000, 24000, 24000}, []int64{15000, 15000, 15000}))
 	assert.Equal(t, int32(4), s.desiredReplicas(context.TODO(), udf, []float64{1000, 3000, 1000}, []int64{0, 27000, 3000}, []int64{24000, 24000, 24000}, []int64{15000, 15000, 15000}))
 }
+
+func Test_rateLimitReached(t *testing.T) {
+	messages := uint32(100)
+	v := &dfv1.Vertex{
+		Spec: dfv1.VertexSpec{
+			AbstractVertex: dfv1.AbstractVertex{
+				Sink: &dfv1.Sink{
+					AbstractSink: dfv1.AbstractSink{
+						Log: &dfv1.Log{},
+					},
+				},
+			},
+		},
+	}
+	assert.False(t, rateLimitReached(v, 1000, 0))
+	v.Spec.Sink.RateLimit = &dfv1.SinkRateLimit{}
+	assert.False(t, rateLimitReached(v, 1000, 0))
+	v.Spec.Sink.RateLimit.MessagesPerSecond = &messages
+	assert.False(t, rateLimitReached(v, 50, 0))
+	assert.True(t, rateLimitReached(v, 95, 0))
+}
+
+func Test_rateLimitReached_bytes(t *testing.T) {
+	bytes := resource.MustParse("1Mi")
+	v := &dfv1.Vertex{
+		Spec: dfv1.VertexSpec{
+			AbstractVertex: dfv1.AbstractVertex{
+				Sink: &dfv1.Sink{
+					AbstractSink: dfv1.AbstractSink{
+						Log: &dfv1.Log{},
+					},
+					RateLimit: &dfv1.SinkRateLimit{BytesPerSecond: &bytes},
+				},
+			},
+		},
+		Status: dfv1.VertexStatus{Replicas: 2},
+	}
+	// the message rate doesn't matter without a message rate limit.
+	assert.False(t, rateLimitReached(v, 100000, 0))
+	assert.False(t, rateLimitReached(v, 10, 0.5))
+	// the 2 replicas are throttled 1.5 seconds per second in total.
+	assert.True(t, rateLimitReached(v, 10, 1.5))
+}
@@ -44,6 +44,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/reduce/pbq"
 	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
 	"github.com/numaproj/numaflow/pkg/reduce/pnf"
+	"github.com/numaproj/numaflow/pkg/shared/circuitbreaker"
 	"github.com/numaproj/numaflow/pkg/shared/idlehandler"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
@@ -53,6 +54,9 @@ import (
 	"github.com/numaproj/numaflow/pkg/window/keyed"
 )
 
+// udfPauseInterval is how long the reading is paused each time the circuit breaker of the UDF calls is found open.
+const udfPauseInterval = time.Second
+
 // DataForward is responsible for reading and forwarding the message from ISB to PBQ.
 type DataForward struct {
 	ctx                   context.Context
@@ -179,6 +183,13 @@ func (df *DataForward) ReplayPersistedMessages(ctx context.Context) error {
 // forwardAChunk reads a chunk of messages from isb and assigns watermark to messages
 // and writes the messages to pbq
 func (df *DataForward) forwardAChunk(ctx context.Context) {
+	// pause reading while the circuit breaker of the UDF calls is open, the windows closed by the messages read would
+	// be stuck in the retries of the UDF calls anyway. It's half-open once the open duration elapses.
+	if cb := df.opts.udfCircuitBreaker; cb != nil && cb.State() == circuitbreaker.Open {
+		df.log.Warn("Circuit breaker of the UDF calls is open, pausing reading")
+		time.Sleep(udfPauseInterval)
+		return
+	}
 	readMessages, err := df.fromBufferPartition.Read(ctx, df.opts.readBatchSize)
 	totalBytes := 0
 	if err != nil {
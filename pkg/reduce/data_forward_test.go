@@ -37,6 +37,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/reduce/pbq"
 	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
 	"github.com/numaproj/numaflow/pkg/reduce/pbq/store/memory"
+	"github.com/numaproj/numaflow/pkg/shared/circuitbreaker"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/processor"
 	"github.com/numaproj/numaflow/pkg/watermark/publish"
@@ -1108,6 +1109,49 @@ func TestDataForward_WithContextClose(t *testing.T) {
 
 }
 
+func TestDataForward_WithUDFCircuitBreaker(t *testing.T) {
+	var (
+		ctx, cancel  = context.WithTimeout(context.Background(), 10*time.Second)
+		pipelineName = "test-reduce-pipeline"
+		err          error
+	)
+	defer cancel()
+
+	fromBuffer := simplebuffer.NewInMemoryBuffer("source-reduce-buffer", 10, 0, simplebuffer.WithReadTimeOut(100*time.Millisecond))
+	buffer := simplebuffer.NewInMemoryBuffer("reduce-to-vertex", 10, 0)
+	toBuffer := map[string][]isb.BufferWriter{
+		"reduce-to-vertex": {buffer},
+	}
+	pbqManager, err := pbq.NewManager(ctx, "reduce", pipelineName, 0, memory.NewMemoryStores(memory.WithStoreSize(1000)),
+		pbq.WithReadTimeout(1*time.Second), pbq.WithChannelBufferSize(10))
+	assert.NoError(t, err)
+	f, _ := fetcherAndPublisher(ctx, fromBuffer, t.Name())
+	publishersMap, _ := buildPublisherMapAndOTStore(ctx, toBuffer, pipelineName)
+	window := fixed.NewFixed(5 * time.Minute)
+	idleManager := wmb.NewIdleManager(len(toBuffer))
+	op := pnf.NewOrderedProcessor(ctx, keyedVertex, CounterReduceTest{}, toBuffer, pbqManager, CounterReduceTest{}, publishersMap, idleManager)
+
+	cb := circuitbreaker.New(1, 500*time.Millisecond)
+	reduceDataForward, err := NewDataForward(ctx, keyedVertex, fromBuffer, toBuffer, pbqManager, CounterReduceTest{}, f, publishersMap,
+		window, idleManager, op, WithReadBatchSize(1), WithUDFCircuitBreaker(cb))
+	assert.NoError(t, err)
+
+	_, errs := fromBuffer.Write(ctx, []isb.Message{{
+		Header: isb.Header{MessageInfo: isb.MessageInfo{EventTime: time.UnixMilli(60000)}, ID: "1", Keys: []string{"even"}},
+		Body:   isb.Body{Payload: []byte("1")},
+	}})
+	assert.NoError(t, errs[0])
+	assert.NoError(t, cb.Allow())
+	cb.Failure()
+	// the reading is paused while the circuit breaker is open.
+	reduceDataForward.forwardAChunk(ctx)
+	assert.False(t, fromBuffer.IsEmpty())
+	// the pause is longer than the open duration, so it's half-open now.
+	assert.Equal(t, circuitbreaker.HalfOpen, cb.State())
+	reduceDataForward.forwardAChunk(ctx)
+	assert.True(t, fromBuffer.IsEmpty())
+}
+
 // Max operation with 5 minutes window and two keys and writing to two partitions
 func TestReduceDataForward_SumMultiPartitions(t *testing.T) {
 	var (
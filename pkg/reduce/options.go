@@ -20,6 +20,7 @@ import (
 	"time"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/circuitbreaker"
 )
 
 // Options for forwarding the message
@@ -28,6 +29,8 @@ type Options struct {
 	readBatchSize int64
 	// allowedLateness is the time.Duration it waits after the watermark has progressed for late-date to be included
 	allowedLateness time.Duration
+	// udfCircuitBreaker is the circuit breaker of the UDF calls, the reading is paused while it's open
+	udfCircuitBreaker *circuitbreaker.CircuitBreaker
 }
 
 type Option func(*Options) error
@@ -54,3 +57,11 @@ func WithAllowedLateness(t time.Duration) Option {
 		return nil
 	}
 }
+
+// WithUDFCircuitBreaker sets the circuit breaker of the UDF calls, the reading is paused while it's open
+func WithUDFCircuitBreaker(cb *circuitbreaker.CircuitBreaker) Option {
+	return func(o *Options) error {
+		o.udfCircuitBreaker = cb
+		return nil
+	}
+}
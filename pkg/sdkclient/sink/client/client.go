@@ -13,12 +13,15 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/numaproj/numaflow/pkg/apis/proto/udsink"
 )
 
 // client contains the grpc connection and the grpc client.
 type client struct {
-	conn    *grpc.ClientConn
-	grpcClt sinkpb.UserDefinedSinkClient
+	conn      *grpc.ClientConn
+	grpcClt   sinkpb.UserDefinedSinkClient
+	statusClt udsink.UDSinkClient
 }
 
 var _ Client = (*client)(nil)
@@ -61,6 +64,7 @@ func New(inputOptions ...Option) (*client, error) {
 	}
 	c.conn = conn
 	c.grpcClt = sinkpb.NewUserDefinedSinkClient(conn)
+	c.statusClt = udsink.NewUDSinkClient(conn)
 	return c, nil
 }
 
@@ -96,3 +100,22 @@ func (c *client) SinkFn(ctx context.Context, datumList []*sinkpb.DatumRequest) (
 
 	return responseList.GetResponses(), nil
 }
+
+// SinkStatusFn writes a list of datum elements to the sink, and returns the typed status of each of them.
+func (c *client) SinkStatusFn(ctx context.Context, datumList []*udsink.DatumRequest) ([]*udsink.Response, error) {
+	stream, err := c.statusClt.SinkFn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute c.statusClt.SinkFn(): %w", err)
+	}
+	for _, datum := range datumList {
+		if err := stream.Send(datum); err != nil {
+			return nil, fmt.Errorf("failed to execute stream.Send(%v): %w", datum, err)
+		}
+	}
+	responseList, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute stream.CloseAndRecv(): %w", err)
+	}
+
+	return responseList.GetResponses(), nil
+}
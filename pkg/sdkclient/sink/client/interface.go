@@ -4,6 +4,8 @@ import (
 	"context"
 	sinkpb "github.com/numaproj/numaflow-go/pkg/apis/proto/sink/v1"
 	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/numaproj/numaflow/pkg/apis/proto/udsink"
 )
 
 // Client contains methods to call a gRPC client.
@@ -11,4 +13,5 @@ type Client interface {
 	CloseConn(ctx context.Context) error
 	IsReady(ctx context.Context, in *emptypb.Empty) (bool, error)
 	SinkFn(ctx context.Context, datumList []*sinkpb.DatumRequest) ([]*sinkpb.Response, error)
+	SinkStatusFn(ctx context.Context, datumList []*udsink.DatumRequest) ([]*udsink.Response, error)
 }
@@ -8,18 +8,26 @@ import (
 	sinkpb "github.com/numaproj/numaflow-go/pkg/apis/proto/sink/v1"
 	"github.com/numaproj/numaflow-go/pkg/apis/proto/sink/v1/sinkmock"
 	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/numaproj/numaflow/pkg/apis/proto/udsink"
 )
 
 // client contains the grpc client for testing.
 type client struct {
-	grpcClt sinkpb.UserDefinedSinkClient
+	grpcClt   sinkpb.UserDefinedSinkClient
+	statusClt udsink.UDSinkClient
 }
 
 var _ sinksdk.Client = (*client)(nil)
 
 // New creates a new mock client object.
 func New(c *sinkmock.MockUserDefinedSinkClient) (*client, error) {
-	return &client{c}, nil
+	return &client{grpcClt: c}, nil
+}
+
+// NewWithStatusClient creates a new mock client object with the given typed status client.
+func NewWithStatusClient(c *sinkmock.MockUserDefinedSinkClient, statusClt udsink.UDSinkClient) (*client, error) {
+	return &client{grpcClt: c, statusClt: statusClt}, nil
 }
 
 // CloseConn closes the grpc client connection.
@@ -54,3 +62,22 @@ func (c *client) SinkFn(ctx context.Context, datumList []*sinkpb.DatumRequest) (
 
 	return responseList.GetResponses(), nil
 }
+
+// SinkStatusFn writes a list of datum elements to the sink, and returns the typed status of each of them.
+func (c *client) SinkStatusFn(ctx context.Context, datumList []*udsink.DatumRequest) ([]*udsink.Response, error) {
+	stream, err := c.statusClt.SinkFn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute c.statusClt.SinkFn(): %w", err)
+	}
+	for _, datum := range datumList {
+		if err := stream.Send(datum); err != nil {
+			return nil, fmt.Errorf("failed to execute stream.Send(%v): %w", datum, err)
+		}
+	}
+	responseList, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute stream.CloseAndRecv(): %w", err)
+	}
+
+	return responseList.GetResponses(), nil
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/numaproj/numaflow/pkg/shared/circuitbreaker"
+)
+
+// circuitBreakerDialOptions returns the dial options rejecting the calls with an Unavailable error while the circuit
+// breaker is open, and reporting the results of the calls to it.
+func circuitBreakerDialOptions(cb *circuitbreaker.CircuitBreaker) []grpc.DialOption {
+	if cb == nil {
+		return nil
+	}
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(unaryCircuitBreakerInterceptor(cb)),
+		grpc.WithChainStreamInterceptor(streamCircuitBreakerInterceptor(cb)),
+	}
+}
+
+// isReadyMethod is the readiness check, which is neither rejected nor reported, a hung UDF can still be ready.
+const isReadyMethod = "/function.v1.UserDefinedFunction/IsReady"
+
+func unaryCircuitBreakerInterceptor(cb *circuitbreaker.CircuitBreaker) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if method == isReadyMethod {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		if err := cb.Allow(); err != nil {
+			return status.Error(codes.Unavailable, err.Error())
+		}
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		reportResult(cb, err)
+		return err
+	}
+}
+
+func streamCircuitBreakerInterceptor(cb *circuitbreaker.CircuitBreaker) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if err := cb.Allow(); err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		s, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			reportResult(cb, err)
+			return nil, err
+		}
+		return &circuitBreakerStream{ClientStream: s, cb: cb}, nil
+	}
+}
+
+// circuitBreakerStream reports the result of a stream to the circuit breaker when it ends, which is when RecvMsg
+// returns an error, or io.EOF if it succeeds.
+type circuitBreakerStream struct {
+	grpc.ClientStream
+	cb   *circuitbreaker.CircuitBreaker
+	once sync.Once
+}
+
+func (s *circuitBreakerStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.once.Do(func() {
+			if errors.Is(err, io.EOF) {
+				s.cb.Success()
+			} else {
+				reportResult(s.cb, err)
+			}
+		})
+	}
+	return err
+}
+
+// reportResult reports the result of a call to the circuit breaker, the calls canceled by the caller are neither
+// successes nor failures.
+func reportResult(cb *circuitbreaker.CircuitBreaker, err error) {
+	switch {
+	case err == nil:
+		cb.Success()
+	case status.Code(err) == codes.Canceled:
+		cb.Release()
+	default:
+		cb.Failure()
+	}
+}
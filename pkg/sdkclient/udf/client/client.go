@@ -6,6 +6,7 @@ import (
 	"io"
 	"log"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/sync/errgroup"
@@ -19,13 +20,17 @@ import (
 	functionpb "github.com/numaproj/numaflow-go/pkg/apis/proto/function/v1"
 	"github.com/numaproj/numaflow-go/pkg/function"
 	"github.com/numaproj/numaflow-go/pkg/info"
+	"github.com/numaproj/numaflow/pkg/apis/proto/mapbatch"
 	sdkerr "github.com/numaproj/numaflow/pkg/sdkclient/error"
 )
 
 // client contains the grpc connection and the grpc client.
 type client struct {
-	conn    *grpc.ClientConn
-	grpcClt functionpb.UserDefinedFunctionClient
+	conn     *grpc.ClientConn
+	grpcClt  functionpb.UserDefinedFunctionClient
+	batchClt mapbatch.MapBatchClient
+	// callTimeout is the deadline of each call except the reduce calls, no deadline if it's 0.
+	callTimeout time.Duration
 }
 
 // New creates a new client object.
@@ -73,22 +78,28 @@ func New(inputOptions ...Option) (*client, error) {
 		log.Println("Multiprocessing TCP Client:", sockAddr)
 		conn, err = grpc.Dial(
 			fmt.Sprintf("%s:///%s", custScheme, custServiceName),
-			// This sets the initial load balancing policy as Round Robin
-			grpc.WithDefaultServiceConfig(`{"loadBalancingConfig": [{"round_robin":{}}]}`),
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
-			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(opts.maxMessageSize), grpc.MaxCallSendMsgSize(opts.maxMessageSize)),
+			append([]grpc.DialOption{
+				// This sets the initial load balancing policy as Round Robin
+				grpc.WithDefaultServiceConfig(`{"loadBalancingConfig": [{"round_robin":{}}]}`),
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(opts.maxMessageSize), grpc.MaxCallSendMsgSize(opts.maxMessageSize)),
+			}, circuitBreakerDialOptions(opts.circuitBreaker)...)...,
 		)
 	} else {
 		sockAddr = fmt.Sprintf("%s:%s", function.UDS, opts.udsSockAddr)
 		log.Println("UDS Client:", sockAddr)
-		conn, err = grpc.Dial(sockAddr, grpc.WithTransportCredentials(insecure.NewCredentials()),
-			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(opts.maxMessageSize), grpc.MaxCallSendMsgSize(opts.maxMessageSize)))
+		conn, err = grpc.Dial(sockAddr, append([]grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(opts.maxMessageSize), grpc.MaxCallSendMsgSize(opts.maxMessageSize)),
+		}, circuitBreakerDialOptions(opts.circuitBreaker)...)...)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute grpc.Dial(%q): %w", sockAddr, err)
 	}
 	c.conn = conn
+	c.callTimeout = opts.callTimeout
 	c.grpcClt = functionpb.NewUserDefinedFunctionClient(conn)
+	c.batchClt = mapbatch.NewMapBatchClient(conn)
 	return c, nil
 }
 
@@ -99,6 +110,8 @@ func (c *client) CloseConn(ctx context.Context) error {
 
 // IsReady returns true if the grpc connection is ready to use.
 func (c *client) IsReady(ctx context.Context, in *emptypb.Empty) (bool, error) {
+	ctx, cancel := c.withCallTimeout(ctx)
+	defer cancel()
 	resp, err := c.grpcClt.IsReady(ctx, in)
 	if err != nil {
 		return false, err
@@ -108,6 +121,8 @@ func (c *client) IsReady(ctx context.Context, in *emptypb.Empty) (bool, error) {
 
 // MapFn applies a function to each datum element.
 func (c *client) MapFn(ctx context.Context, datum *functionpb.DatumRequest) ([]*functionpb.DatumResponse, error) {
+	ctx, cancel := c.withCallTimeout(ctx)
+	defer cancel()
 	mappedDatumList, err := c.grpcClt.MapFn(ctx, datum)
 	err = toUDFErr("c.grpcClt.MapFn", err)
 	if err != nil {
@@ -119,15 +134,17 @@ func (c *client) MapFn(ctx context.Context, datum *functionpb.DatumRequest) ([]*
 // MapStreamFn applies a function to each datum element and returns a stream.
 func (c *client) MapStreamFn(ctx context.Context, datum *functionpb.DatumRequest, datumCh chan<- *functionpb.DatumResponse) error {
 	defer close(datumCh)
+	ctx, deadline, cancel := c.withRecvDeadline(ctx)
+	defer cancel()
 	stream, err := c.grpcClt.MapStreamFn(ctx, datum)
 	if err != nil {
-		return fmt.Errorf("failed to execute c.grpcClt.MapStreamFn(): %w", err)
+		return fmt.Errorf("failed to execute c.grpcClt.MapStreamFn(): %w", deadline.err(err))
 	}
 
 	for {
 		select {
 		case <-ctx.Done():
-			return ctx.Err()
+			return deadline.err(ctx.Err())
 		default:
 			var resp *functionpb.DatumResponse
 			resp, err = stream.Recv()
@@ -135,9 +152,12 @@ func (c *client) MapStreamFn(ctx context.Context, datum *functionpb.DatumRequest
 				return nil
 			}
 			if err != nil {
-				return err
+				return deadline.err(err)
 			}
+			// the deadline doesn't include the time the response waits to be consumed.
+			deadline.stop()
 			datumCh <- resp
+			deadline.reset()
 		}
 	}
 }
@@ -146,6 +166,8 @@ func (c *client) MapStreamFn(ctx context.Context, datum *functionpb.DatumRequest
 // In addition to map function, MapTFn also supports assigning a new event time to datum.
 // MapTFn can be used only at source vertex by source data transformer.
 func (c *client) MapTFn(ctx context.Context, datum *functionpb.DatumRequest) ([]*functionpb.DatumResponse, error) {
+	ctx, cancel := c.withCallTimeout(ctx)
+	defer cancel()
 	mappedDatumList, err := c.grpcClt.MapTFn(ctx, datum)
 	err = toUDFErr("c.grpcClt.MapTFn", err)
 	if err != nil {
@@ -154,6 +176,65 @@ func (c *client) MapTFn(ctx context.Context, datum *functionpb.DatumRequest) ([]
 	return mappedDatumList.GetElements(), nil
 }
 
+// MapBatchFn applies a function to a batch of datum elements in one streaming call. The responses are returned in
+// the order they are received, each of them carries the ID of the datum it's generated from. The call timeout is the
+// deadline of receiving the response of each datum, instead of the whole batch.
+func (c *client) MapBatchFn(ctx context.Context, datums []*mapbatch.DatumRequest) ([]*mapbatch.DatumResponse, error) {
+	ctx, deadline, cancel := c.withRecvDeadline(ctx)
+	defer cancel()
+	var g errgroup.Group
+	responses := make([]*mapbatch.DatumResponse, 0, len(datums))
+
+	stream, err := c.batchClt.MapBatchFn(ctx)
+	err = toUDFErr("c.batchClt.MapBatchFn", err)
+	if err != nil {
+		return nil, err
+	}
+	// stream the messages to server
+	g.Go(func() error {
+		for _, datum := range datums {
+			select {
+			case <-ctx.Done():
+				return status.FromContextError(ctx.Err()).Err()
+			default:
+				if sendErr := stream.Send(datum); sendErr != nil {
+					// gRPC closes the stream on errors.
+					return sendErr
+				}
+			}
+		}
+		return stream.CloseSend()
+	})
+
+	// read the response from the server stream
+outputLoop:
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, toUDFErr("MapBatchFn OutputLoop", deadline.err(status.FromContextError(ctx.Err()).Err()))
+		default:
+			var resp *mapbatch.DatumResponse
+			resp, err = stream.Recv()
+			if err == io.EOF {
+				break outputLoop
+			}
+			err = toUDFErr("MapBatchFn stream.Recv()", deadline.err(err))
+			if err != nil {
+				return nil, err
+			}
+			deadline.reset()
+			responses = append(responses, resp)
+		}
+	}
+
+	err = g.Wait()
+	err = toUDFErr("MapBatchFn errorGroup", deadline.err(err))
+	if err != nil {
+		return nil, err
+	}
+	return responses, nil
+}
+
 // ReduceFn applies a reduce function to a datum stream.
 func (c *client) ReduceFn(ctx context.Context, datumStreamCh <-chan *functionpb.DatumRequest) ([]*functionpb.DatumResponse, error) {
 	var g errgroup.Group
@@ -211,6 +292,60 @@ outputLoop:
 	return datumList, nil
 }
 
+// withCallTimeout returns a context with the deadline of a call if there's a call timeout.
+func (c *client) withCallTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.callTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.callTimeout)
+}
+
+// recvDeadline cancels a streaming call if the next response isn't received within the call timeout.
+type recvDeadline struct {
+	timeout time.Duration
+	timer   *time.Timer
+	expired atomic.Bool
+}
+
+// withRecvDeadline returns a context canceled if a response of the streaming call isn't received within the call
+// timeout, which starts when the call starts, and restarts after each response received.
+func (c *client) withRecvDeadline(ctx context.Context) (context.Context, *recvDeadline, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	d := &recvDeadline{timeout: c.callTimeout}
+	if d.timeout > 0 {
+		d.timer = time.AfterFunc(d.timeout, func() {
+			d.expired.Store(true)
+			cancel()
+		})
+	}
+	return ctx, d, func() {
+		d.stop()
+		cancel()
+	}
+}
+
+// reset restarts the deadline of the next response.
+func (d *recvDeadline) reset() {
+	if d.timer != nil && !d.expired.Load() {
+		d.timer.Reset(d.timeout)
+	}
+}
+
+// stop stops the deadline until it's reset.
+func (d *recvDeadline) stop() {
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+}
+
+// err returns a DeadlineExceeded error instead of the error of the call if the deadline expired.
+func (d *recvDeadline) err(err error) error {
+	if err != nil && d.expired.Load() {
+		return status.Errorf(codes.DeadlineExceeded, "no response received within %v", d.timeout)
+	}
+	return err
+}
+
 func toUDFErr(name string, err error) error {
 	if err == nil {
 		return nil
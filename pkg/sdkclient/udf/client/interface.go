@@ -4,6 +4,8 @@ import (
 	"context"
 	functionpb "github.com/numaproj/numaflow-go/pkg/apis/proto/function/v1"
 	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/numaproj/numaflow/pkg/apis/proto/mapbatch"
 )
 
 // Client contains methods to call a gRPC client.
@@ -12,6 +14,7 @@ type Client interface {
 	IsReady(ctx context.Context, in *emptypb.Empty) (bool, error)
 	MapFn(ctx context.Context, datum *functionpb.DatumRequest) ([]*functionpb.DatumResponse, error)
 	MapStreamFn(ctx context.Context, datum *functionpb.DatumRequest, datumCh chan<- *functionpb.DatumResponse) error
+	MapBatchFn(ctx context.Context, datums []*mapbatch.DatumRequest) ([]*mapbatch.DatumResponse, error)
 	MapTFn(ctx context.Context, datum *functionpb.DatumRequest) ([]*functionpb.DatumResponse, error)
 	ReduceFn(ctx context.Context, datumStreamCh <-chan *functionpb.DatumRequest) ([]*functionpb.DatumResponse, error)
 }
@@ -1,6 +1,11 @@
 package client
 
-import "time"
+import (
+	"crypto/tls"
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/shared/circuitbreaker"
+)
 
 type options struct {
 	tcpSockAddr                string
@@ -8,6 +13,9 @@ type options struct {
 	maxMessageSize             int
 	serverInfoFilePath         string
 	serverInfoReadinessTimeout time.Duration
+	tlsConfig                  *tls.Config
+	callTimeout                time.Duration
+	circuitBreaker             *circuitbreaker.CircuitBreaker
 }
 
 // Option is the interface to apply options.
@@ -47,3 +55,24 @@ func WithServerInfoReadinessTimeout(t time.Duration) Option {
 		o.serverInfoReadinessTimeout = t
 	}
 }
+
+// WithTLSConfig sets the TLS config of the connection to a remote server.
+func WithTLSConfig(c *tls.Config) Option {
+	return func(o *options) {
+		o.tlsConfig = c
+	}
+}
+
+// WithCallTimeout sets the deadline of each call except the reduce calls, which last as long as the windows.
+func WithCallTimeout(t time.Duration) Option {
+	return func(o *options) {
+		o.callTimeout = t
+	}
+}
+
+// WithCircuitBreaker rejects the calls with an Unavailable error while the given circuit breaker is open.
+func WithCircuitBreaker(cb *circuitbreaker.CircuitBreaker) Option {
+	return func(o *options) {
+		o.circuitBreaker = cb
+	}
+}
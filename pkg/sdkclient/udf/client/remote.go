@@ -0,0 +1,52 @@
+package client
+
+import (
+	"fmt"
+	"log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	// register the client side health checking, the servers without the health service are considered healthy.
+	_ "google.golang.org/grpc/health"
+
+	functionpb "github.com/numaproj/numaflow-go/pkg/apis/proto/function/v1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/mapbatch"
+)
+
+// remoteServiceConfig balances the calls across all the addresses the target resolves to, skipping the ones not
+// serving according to the gRPC health checking protocol.
+const remoteServiceConfig = `{"loadBalancingConfig": [{"round_robin":{}}], "healthCheckConfig": {"serviceName": ""}}`
+
+// NewRemote creates a new client object calling a remote gRPC server at the given target, instead of a UDF container
+// in the same pod. The target is in the gRPC name syntax, e.g. "dns:///my-udf.my-namespace.svc:8443".
+func NewRemote(target string, inputOptions ...Option) (*client, error) {
+	var opts = &options{
+		maxMessageSize: 1024 * 1024 * 64, // 64 MB
+	}
+	for _, inputOption := range inputOptions {
+		inputOption(opts)
+	}
+
+	creds := insecure.NewCredentials()
+	if opts.tlsConfig != nil {
+		creds = credentials.NewTLS(opts.tlsConfig)
+	}
+	dialOpts := []grpc.DialOption{
+		grpc.WithDefaultServiceConfig(remoteServiceConfig),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(opts.maxMessageSize), grpc.MaxCallSendMsgSize(opts.maxMessageSize)),
+	}
+	dialOpts = append(dialOpts, circuitBreakerDialOptions(opts.circuitBreaker)...)
+	log.Println("Remote Client:", target)
+	conn, err := grpc.Dial(target, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute grpc.Dial(%q): %w", target, err)
+	}
+	return &client{
+		conn:        conn,
+		grpcClt:     functionpb.NewUserDefinedFunctionClient(conn),
+		batchClt:    mapbatch.NewMapBatchClient(conn),
+		callTimeout: opts.callTimeout,
+	}, nil
+}
@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	functionpb "github.com/numaproj/numaflow-go/pkg/apis/proto/function/v1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/mapbatch"
+	sdkerr "github.com/numaproj/numaflow/pkg/sdkclient/error"
+	"github.com/numaproj/numaflow/pkg/shared/circuitbreaker"
+)
+
+// remoteServer echoes the datum, sleeps for the datum with the "slow" key, and fails the datum with the "fail" key.
+// The streaming calls send the responses with a delay between each of them, and block on the datum with the "slow"
+// key.
+type remoteServer struct {
+	functionpb.UnimplementedUserDefinedFunctionServer
+	calls atomic.Int32
+}
+
+const streamDelay = 100 * time.Millisecond
+
+func (s *remoteServer) IsReady(context.Context, *emptypb.Empty) (*functionpb.ReadyResponse, error) {
+	return &functionpb.ReadyResponse{Ready: true}, nil
+}
+
+func (s *remoteServer) MapFn(ctx context.Context, d *functionpb.DatumRequest) (*functionpb.DatumResponseList, error) {
+	s.calls.Add(1)
+	switch d.GetKeys()[0] {
+	case "slow":
+		<-ctx.Done()
+		return nil, ctx.Err()
+	case "fail":
+		return nil, status.Error(codes.Internal, "mock failure")
+	}
+	return &functionpb.DatumResponseList{Elements: []*functionpb.DatumResponse{{Keys: d.GetKeys(), Value: d.GetValue()}}}, nil
+}
+
+func (s *remoteServer) MapStreamFn(d *functionpb.DatumRequest, stream functionpb.UserDefinedFunction_MapStreamFnServer) error {
+	for i := 0; i < 3; i++ {
+		if i > 0 && d.GetKeys()[0] == "slow" {
+			<-stream.Context().Done()
+			return stream.Context().Err()
+		}
+		time.Sleep(streamDelay)
+		if err := stream.Send(&functionpb.DatumResponse{Keys: d.GetKeys(), Value: d.GetValue()}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *remoteServer) MapBatchFn(stream mapbatch.MapBatch_MapBatchFnServer) error {
+	for {
+		d, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if d.GetKeys()[0] == "slow" {
+			<-stream.Context().Done()
+			return stream.Context().Err()
+		}
+		time.Sleep(streamDelay)
+		if err := stream.Send(&mapbatch.DatumResponse{Id: d.GetId(), Results: []*mapbatch.Result{{Keys: d.GetKeys(), Value: d.GetValue()}}}); err != nil {
+			return err
+		}
+	}
+}
+
+func startRemoteServer(t *testing.T) (string, *remoteServer) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	svr := grpc.NewServer()
+	s := &remoteServer{}
+	functionpb.RegisterUserDefinedFunctionServer(svr, s)
+	mapbatch.RegisterMapBatchServer(svr, s)
+	go func() { _ = svr.Serve(lis) }()
+	t.Cleanup(svr.Stop)
+	return lis.Addr().String(), s
+}
+
+func TestNewRemote_CallTimeout(t *testing.T) {
+	addr, _ := startRemoteServer(t)
+	c, err := NewRemote(addr, WithCallTimeout(200*time.Millisecond))
+	require.NoError(t, err)
+	defer func() { _ = c.CloseConn(context.Background()) }()
+	ctx := context.Background()
+
+	ready, err := c.IsReady(ctx, &emptypb.Empty{})
+	assert.NoError(t, err)
+	assert.True(t, ready)
+
+	resp, err := c.MapFn(ctx, &functionpb.DatumRequest{Keys: []string{"k"}, Value: []byte("hello")})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("hello"), resp[0].GetValue())
+
+	start := time.Now()
+	_, err = c.MapFn(ctx, &functionpb.DatumRequest{Keys: []string{"slow"}})
+	assert.Less(t, time.Since(start), 5*time.Second)
+	var udfErr *sdkerr.UDFError
+	require.True(t, errors.As(err, &udfErr))
+	assert.Equal(t, sdkerr.Retryable, udfErr.ErrorKind())
+}
+
+func TestNewRemote_StreamCallTimeout(t *testing.T) {
+	addr, _ := startRemoteServer(t)
+	// the timeout applies to each response, the streams taking longer than it in total succeed.
+	c, err := NewRemote(addr, WithCallTimeout(2*streamDelay))
+	require.NoError(t, err)
+	defer func() { _ = c.CloseConn(context.Background()) }()
+	ctx := context.Background()
+
+	datumCh := make(chan *functionpb.DatumResponse, 3)
+	assert.NoError(t, c.MapStreamFn(ctx, &functionpb.DatumRequest{Keys: []string{"k"}}, datumCh))
+	assert.Len(t, datumCh, 3)
+
+	datumCh = make(chan *functionpb.DatumResponse, 3)
+	err = c.MapStreamFn(ctx, &functionpb.DatumRequest{Keys: []string{"slow"}}, datumCh)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Len(t, datumCh, 1)
+
+	datums := []*mapbatch.DatumRequest{{Id: "1", Keys: []string{"k"}}, {Id: "2", Keys: []string{"k"}}, {Id: "3", Keys: []string{"k"}}}
+	resp, err := c.MapBatchFn(ctx, datums)
+	assert.NoError(t, err)
+	assert.Len(t, resp, 3)
+
+	datums[1].Keys = []string{"slow"}
+	_, err = c.MapBatchFn(ctx, datums)
+	var udfErr *sdkerr.UDFError
+	require.True(t, errors.As(err, &udfErr))
+	assert.Equal(t, sdkerr.Retryable, udfErr.ErrorKind())
+}
+
+func TestNewRemote_CircuitBreaker(t *testing.T) {
+	addr, s := startRemoteServer(t)
+	cb := circuitbreaker.New(2, time.Hour)
+	c, err := NewRemote(addr, WithCircuitBreaker(cb))
+	require.NoError(t, err)
+	defer func() { _ = c.CloseConn(context.Background()) }()
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, err = c.MapFn(ctx, &functionpb.DatumRequest{Keys: []string{"fail"}})
+		assert.Error(t, err)
+	}
+	assert.Equal(t, circuitbreaker.Open, cb.State())
+	// the server isn't called while the circuit breaker is open.
+	_, err = c.MapFn(ctx, &functionpb.DatumRequest{Keys: []string{"k"}})
+	assert.ErrorContains(t, err, circuitbreaker.ErrOpen.Error())
+	assert.Equal(t, int32(2), s.calls.Load())
+	// the readiness check isn't rejected.
+	ready, err := c.IsReady(ctx, &emptypb.Empty{})
+	assert.NoError(t, err)
+	assert.True(t, ready)
+}
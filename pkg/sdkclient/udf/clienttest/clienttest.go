@@ -14,17 +14,24 @@ import (
 
 	functionpb "github.com/numaproj/numaflow-go/pkg/apis/proto/function/v1"
 	"github.com/numaproj/numaflow-go/pkg/apis/proto/function/v1/funcmock"
+	"github.com/numaproj/numaflow/pkg/apis/proto/mapbatch"
 	sdkerr "github.com/numaproj/numaflow/pkg/sdkclient/error"
 )
 
 // client contains the grpc client for testing.
 type client struct {
-	grpcClt functionpb.UserDefinedFunctionClient
+	grpcClt  functionpb.UserDefinedFunctionClient
+	batchClt mapbatch.MapBatchClient
 }
 
 // New creates a new mock client object.
 func New(c *funcmock.MockUserDefinedFunctionClient) (*client, error) {
-	return &client{c}, nil
+	return &client{grpcClt: c}, nil
+}
+
+// NewWithMapBatchClient creates a new mock client object with the given map batch client.
+func NewWithMapBatchClient(c *funcmock.MockUserDefinedFunctionClient, batchClt mapbatch.MapBatchClient) (*client, error) {
+	return &client{grpcClt: c, batchClt: batchClt}, nil
 }
 
 // CloseConn closes the grpc client connection.
@@ -89,6 +96,61 @@ func (c *client) MapTFn(ctx context.Context, datum *functionpb.DatumRequest) ([]
 	return mappedDatumList.GetElements(), nil
 }
 
+// MapBatchFn applies a function to a batch of datum elements in one streaming call. The responses are returned in
+// the order they are received, each of them carries the ID of the datum it's generated from.
+func (c *client) MapBatchFn(ctx context.Context, datums []*mapbatch.DatumRequest) ([]*mapbatch.DatumResponse, error) {
+	var g errgroup.Group
+	responses := make([]*mapbatch.DatumResponse, 0, len(datums))
+
+	stream, err := c.batchClt.MapBatchFn(ctx)
+	err = toUDFErr("c.batchClt.MapBatchFn", err)
+	if err != nil {
+		return nil, err
+	}
+	// stream the messages to server
+	g.Go(func() error {
+		for _, datum := range datums {
+			select {
+			case <-ctx.Done():
+				return status.FromContextError(ctx.Err()).Err()
+			default:
+				if sendErr := stream.Send(datum); sendErr != nil {
+					// gRPC closes the stream on errors.
+					return sendErr
+				}
+			}
+		}
+		return stream.CloseSend()
+	})
+
+	// read the response from the server stream
+outputLoop:
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, toUDFErr("MapBatchFn OutputLoop", status.FromContextError(ctx.Err()).Err())
+		default:
+			var resp *mapbatch.DatumResponse
+			resp, err = stream.Recv()
+			if err == io.EOF {
+				break outputLoop
+			}
+			err = toUDFErr("MapBatchFn stream.Recv()", err)
+			if err != nil {
+				return nil, err
+			}
+			responses = append(responses, resp)
+		}
+	}
+
+	err = g.Wait()
+	err = toUDFErr("MapBatchFn errorGroup", err)
+	if err != nil {
+		return nil, err
+	}
+	return responses, nil
+}
+
 // ReduceFn applies a reduce function to a datum stream.
 func (c *client) ReduceFn(ctx context.Context, datumStreamCh <-chan *functionpb.DatumRequest) ([]*functionpb.DatumResponse, error) {
 	var g errgroup.Group
@@ -0,0 +1,188 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package circuitbreaker implements a circuit breaker, which stops the calls to a failing dependency for a while,
+// instead of piling up the calls that are likely to fail.
+package circuitbreaker
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// State is the state of a circuit breaker.
+type State int
+
+const (
+	// Closed lets all the calls through.
+	Closed State = iota
+	// Open rejects all the calls.
+	Open
+	// HalfOpen lets one trial call through, which closes the circuit breaker if it succeeds, or opens it again if it
+	// fails.
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// ErrOpen is returned by Allow when the circuit breaker rejects a call.
+var ErrOpen = errors.New("circuit breaker is open")
+
+// CircuitBreaker opens after a number of consecutive failures, and stays open for a while before letting a trial
+// call through.
+type CircuitBreaker struct {
+	failureThreshold int
+	openDuration     time.Duration
+	lock             sync.Mutex
+	state            State
+	failures         int
+	openedAt         time.Time
+	// trialing is true when the trial call of the half-open state is in flight.
+	trialing      bool
+	onStateChange func(from, to State)
+	now           func() time.Time
+}
+
+type Option func(*CircuitBreaker)
+
+// WithOnStateChange sets a function called when the state changes, e.g. to update the metrics. It's called with the
+// lock of the circuit breaker held, so it must not call the circuit breaker.
+func WithOnStateChange(f func(from, to State)) Option {
+	return func(cb *CircuitBreaker) {
+		cb.onStateChange = f
+	}
+}
+
+// New returns a closed circuit breaker, which opens after failureThreshold consecutive failures, and stays open for
+// openDuration.
+func New(failureThreshold int, openDuration time.Duration, opts ...Option) *CircuitBreaker {
+	if failureThreshold < 1 {
+		failureThreshold = 1
+	}
+	cb := &CircuitBreaker{
+		failureThreshold: failureThreshold,
+		openDuration:     openDuration,
+		state:            Closed,
+		now:              time.Now,
+	}
+	for _, o := range opts {
+		o(cb)
+	}
+	return cb
+}
+
+// Allow returns ErrOpen if the call should be rejected, otherwise the result of the call should be reported with
+// Success or Failure.
+func (cb *CircuitBreaker) Allow() error {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	cb.halfOpenIfExpired()
+	switch cb.state {
+	case Open:
+		return ErrOpen
+	case HalfOpen:
+		if cb.trialing {
+			return ErrOpen
+		}
+		cb.trialing = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// Success reports a successful call.
+func (cb *CircuitBreaker) Success() {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	cb.failures = 0
+	cb.trialing = false
+	if cb.state != Closed {
+		cb.setState(Closed)
+	}
+}
+
+// Failure reports a failed call.
+func (cb *CircuitBreaker) Failure() {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	cb.failures++
+	cb.trialing = false
+	switch cb.state {
+	case HalfOpen:
+		cb.open()
+	case Closed:
+		if cb.failures >= cb.failureThreshold {
+			cb.open()
+		}
+	}
+}
+
+// Release reports a call whose result tells nothing about the dependency, e.g. a call canceled by the caller.
+func (cb *CircuitBreaker) Release() {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	cb.trialing = false
+}
+
+// State returns the current state, it's half-open once the open duration elapses, until the trial call is reported.
+func (cb *CircuitBreaker) State() State {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	cb.halfOpenIfExpired()
+	return cb.state
+}
+
+// Tripped returns true if the circuit breaker is open, or its trial call is in flight, which means the dependency has
+// been failing and hasn't recovered yet. A half-open circuit breaker without calls isn't tripped, there's nothing
+// showing the dependency is still failing.
+func (cb *CircuitBreaker) Tripped() bool {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	cb.halfOpenIfExpired()
+	return cb.state == Open || (cb.state == HalfOpen && cb.trialing)
+}
+
+func (cb *CircuitBreaker) halfOpenIfExpired() {
+	if cb.state == Open && cb.now().Sub(cb.openedAt) >= cb.openDuration {
+		cb.setState(HalfOpen)
+	}
+}
+
+func (cb *CircuitBreaker) open() {
+	cb.openedAt = cb.now()
+	cb.setState(Open)
+}
+
+func (cb *CircuitBreaker) setState(s State) {
+	from := cb.state
+	cb.state = s
+	if cb.onStateChange != nil && from != s {
+		cb.onStateChange(from, s)
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package circuitbreaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Unix(1661169600, 0)
+	var transitions []string
+	cb := New(3, time.Minute, WithOnStateChange(func(from, to State) {
+		transitions = append(transitions, from.String()+"->"+to.String())
+	}))
+	cb.now = func() time.Time { return now }
+
+	// the failures have to be consecutive.
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, cb.Allow())
+		cb.Failure()
+	}
+	assert.NoError(t, cb.Allow())
+	cb.Success()
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, Closed, cb.State())
+		assert.NoError(t, cb.Allow())
+		cb.Failure()
+	}
+	assert.Equal(t, Open, cb.State())
+	assert.Equal(t, ErrOpen, cb.Allow())
+
+	// only one trial call is let through after the open duration.
+	now = now.Add(time.Minute)
+	assert.NoError(t, cb.Allow())
+	assert.Equal(t, HalfOpen, cb.State())
+	assert.Equal(t, ErrOpen, cb.Allow())
+	// a released trial lets another one through.
+	cb.Release()
+	assert.Equal(t, HalfOpen, cb.State())
+	assert.NoError(t, cb.Allow())
+	// a failed trial opens it again.
+	cb.Failure()
+	assert.Equal(t, Open, cb.State())
+	assert.Equal(t, ErrOpen, cb.Allow())
+
+	assert.True(t, cb.Tripped())
+	now = now.Add(time.Minute)
+	assert.Equal(t, HalfOpen, cb.State())
+	assert.False(t, cb.Tripped())
+	assert.NoError(t, cb.Allow())
+	assert.True(t, cb.Tripped())
+	cb.Success()
+	assert.False(t, cb.Tripped())
+	assert.Equal(t, Closed, cb.State())
+	assert.NoError(t, cb.Allow())
+
+	assert.Equal(t, []string{"closed->open", "open->half-open", "half-open->open", "open->half-open", "half-open->closed"}, transitions)
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/redis/go-redis/v9"
@@ -180,6 +181,37 @@ func (br *RedisStreamsRead) Pending(_ context.Context) (int64, error) {
 	return isb.PendingNotAvailable, fmt.Errorf("ConsumerGroup %q not found in XInfoGroups result %+v", br.Group, groups)
 }
 
+// ClaimIdlePending transfers the pending entries of the other consumers in the group which have been idle longer
+// than minIdleTime to this consumer, e.g. the entries read by a consumer which has gone. Because the claimed entries
+// are in the pending list of this consumer afterwards, the backlog is checked again in the next Read to reprocess them.
+// It returns the number of claimed entries.
+func (br *RedisStreamsRead) ClaimIdlePending(ctx context.Context, minIdleTime time.Duration, count int64) (int, error) {
+	claimed := 0
+	start := "0-0"
+	for {
+		ids, next, err := br.Client.XAutoClaimJustID(ctx, &redis.XAutoClaimArgs{
+			Stream:   br.Stream,
+			Group:    br.Group,
+			Consumer: br.Consumer,
+			MinIdle:  minIdleTime,
+			Start:    start,
+			Count:    count,
+		}).Result()
+		if err != nil {
+			return claimed, fmt.Errorf("XAutoClaim failed, %w", err)
+		}
+		claimed += len(ids)
+		if next == "0-0" || next == "" {
+			break
+		}
+		start = next
+	}
+	if claimed > 0 {
+		br.CheckBackLog = true
+	}
+	return claimed, nil
+}
+
 // processXReadResult is used to process the results of XREADGROUP
 func (br *RedisStreamsRead) processXReadResult(startIndex string, count int64) ([]redis.XStream, error) {
 	result := br.Client.XReadGroup(RedisContext, &redis.XReadGroupArgs{
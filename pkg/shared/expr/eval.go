@@ -0,0 +1,73 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package expr
+
+import (
+	"fmt"
+
+	"github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/vm"
+)
+
+// Program is a compiled expression, which can be evaluated on the messages repeatedly without compiling it again.
+type Program struct {
+	expression string
+	program    *vm.Program
+}
+
+// Compile compiles the given expression, which uses the same environment as EvalBool and EvalStr.
+func Compile(expression string) (*Program, error) {
+	env := getFuncMap(map[string]interface{}{root: ""})
+	program, err := expr.Compile(expression, expr.Env(env))
+	if err != nil {
+		return nil, fmt.Errorf("unable to compile expression '%s': %s", expression, err)
+	}
+	return &Program{expression: expression, program: program}, nil
+}
+
+// Eval evaluates the compiled expression on the input message, and returns the result as it is.
+func (p *Program) Eval(msg []byte) (interface{}, error) {
+	env := getFuncMap(map[string]interface{}{root: string(msg)})
+	result, err := expr.Run(p.program, env)
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate expression '%s': %s", p.expression, err)
+	}
+	return result, nil
+}
+
+// EvalStrings evaluates the compiled expression on the input message to a string or a list of strings, e.g. the keys
+// or the tags of a message.
+func (p *Program) EvalStrings(msg []byte) ([]string, error) {
+	v, err := p.Eval(msg)
+	if err != nil {
+		return nil, err
+	}
+	switch w := v.(type) {
+	case nil:
+		return nil, nil
+	case []string:
+		return w, nil
+	case []interface{}:
+		result := make([]string, 0, len(w))
+		for _, x := range w {
+			result = append(result, fmt.Sprintf("%v", x))
+		}
+		return result, nil
+	default:
+		return []string{fmt.Sprintf("%v", w)}, nil
+	}
+}
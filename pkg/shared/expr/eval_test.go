@@ -0,0 +1,58 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package expr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgram_Eval(t *testing.T) {
+	p, err := Compile(`json(payload).a`)
+	assert.NoError(t, err)
+	v, err := p.Eval([]byte(`{"a": 1.5}`))
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, v)
+	v, err = p.Eval([]byte(`{"a": {"b": "c"}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"b": "c"}, v)
+	_, err = p.Eval([]byte(`not json`))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to evaluate expression")
+
+	p, err = Compile(`sprig.upper(string(json(payload).a))`)
+	assert.NoError(t, err)
+	v, err = p.Eval([]byte(`{"a": "b"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "B", v)
+
+	p, err = Compile(`[json(payload).a, json(payload).b]`)
+	assert.NoError(t, err)
+	s, err := p.EvalStrings([]byte(`{"a": "x", "b": 1}`))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"x", "1"}, s)
+	p, err = Compile(`json(payload).a`)
+	assert.NoError(t, err)
+	s, err = p.EvalStrings([]byte(`{"a": "x"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"x"}, s)
+
+	_, err = Compile(`ab\na`)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to compile expression")
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ndjson encodes the message payloads as newline-delimited JSON, optionally compressed.
+package ndjson
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+
+	"github.com/klauspost/compress/zstd"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// WriteLine writes a payload as a line of JSON, the payloads which are not valid JSON are written as JSON strings.
+func WriteLine(buf *bytes.Buffer, payload []byte) {
+	if err := json.Compact(buf, payload); err != nil {
+		b, _ := json.Marshal(string(payload))
+		buf.Write(b)
+	}
+	buf.WriteByte('\n')
+}
+
+// Encoder compresses the data of each call as a complete gzip member or zstd frame. The concatenated outputs are
+// valid to be decompressed as a whole.
+type Encoder struct {
+	compression dfv1.FileCompression
+	gzip        *gzip.Writer
+	zstd        *zstd.Encoder
+}
+
+func NewEncoder(compression dfv1.FileCompression) (*Encoder, error) {
+	e := &Encoder{compression: compression}
+	switch compression {
+	case dfv1.FileCompressionGzip:
+		e.gzip = gzip.NewWriter(nil)
+	case dfv1.FileCompressionZstd:
+		enc, err := zstd.NewWriter(nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create zstd encoder, %w", err)
+		}
+		e.zstd = enc
+	}
+	return e, nil
+}
+
+// Encode returns the compressed data, it's not safe to be called concurrently.
+func (e *Encoder) Encode(data []byte) ([]byte, error) {
+	switch e.compression {
+	case dfv1.FileCompressionGzip:
+		var buf bytes.Buffer
+		e.gzip.Reset(&buf)
+		if _, err := e.gzip.Write(data); err != nil {
+			return nil, err
+		}
+		if err := e.gzip.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case dfv1.FileCompressionZstd:
+		return e.zstd.EncodeAll(data, nil), nil
+	default:
+		return data, nil
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ndjson
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func TestWriteLine(t *testing.T) {
+	var buf bytes.Buffer
+	WriteLine(&buf, []byte(`{"a": 1,
+		"b": [1, 2]}`))
+	WriteLine(&buf, []byte("hello"))
+	assert.Equal(t, "{\"a\":1,\"b\":[1,2]}\n\"hello\"\n", buf.String())
+}
+
+func TestEncoder(t *testing.T) {
+	for _, compression := range []dfv1.FileCompression{dfv1.FileCompressionNone, dfv1.FileCompressionGzip, dfv1.FileCompressionZstd} {
+		t.Run(string(compression), func(t *testing.T) {
+			e, err := NewEncoder(compression)
+			require.NoError(t, err)
+			var out []byte
+			for _, s := range []string{"a\n", "b\n"} {
+				b, err := e.Encode([]byte(s))
+				require.NoError(t, err)
+				out = append(out, b...)
+			}
+			var r io.Reader = bytes.NewReader(out)
+			switch compression {
+			case dfv1.FileCompressionGzip:
+				r, err = gzip.NewReader(r)
+				require.NoError(t, err)
+			case dfv1.FileCompressionZstd:
+				zr, err := zstd.NewReader(r)
+				require.NoError(t, err)
+				defer zr.Close()
+				r = zr
+			}
+			data, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, "a\nb\n", string(data))
+		})
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parquet
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	pq "github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+func TestSchemaFromJSONSchema(t *testing.T) {
+	s, err := SchemaFromJSONSchema([]byte(`{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string"},
+			"age": {"type": ["null", "integer"]},
+			"score": {"type": "number"},
+			"active": {"type": "boolean"},
+			"createdAt": {"type": "string", "format": "date-time"},
+			"tags": {"type": "array", "items": {"type": "string"}}
+		}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, Schema{
+		{Name: "active", Type: ColumnTypeBoolean},
+		{Name: "age", Type: ColumnTypeInt64},
+		{Name: "createdAt", Type: ColumnTypeTimestamp},
+		{Name: "name", Type: ColumnTypeString},
+		{Name: "score", Type: ColumnTypeDouble},
+		{Name: "tags", Type: ColumnTypeJSON},
+	}, s)
+
+	_, err = SchemaFromJSONSchema([]byte(`{"type": "array"}`))
+	assert.Error(t, err)
+	_, err = SchemaFromJSONSchema([]byte(`{"type": "object"}`))
+	assert.Error(t, err)
+	_, err = SchemaFromJSONSchema([]byte(`{`))
+	assert.Error(t, err)
+}
+
+func TestInferSchema(t *testing.T) {
+	s := InferSchema([][]byte{
+		[]byte(`{"a": 1, "b": "x", "c": 1, "d": {"e": 1}, "f": null}`),
+		[]byte(`"not an object"`),
+		[]byte(`{"a": 2, "b": 3, "c": 1.5, "g": true}`),
+	})
+	assert.Equal(t, Schema{
+		{Name: "a", Type: ColumnTypeInt64},
+		{Name: "b", Type: ColumnTypeString},
+		{Name: "c", Type: ColumnTypeDouble},
+		{Name: "d", Type: ColumnTypeJSON},
+		{Name: "g", Type: ColumnTypeBoolean},
+	}, s)
+}
+
+func TestWriter(t *testing.T) {
+	schema := Schema{
+		{Name: "age", Type: ColumnTypeInt64},
+		{Name: "createdAt", Type: ColumnTypeTimestamp},
+		{Name: "name", Type: ColumnTypeString},
+		{Name: "tags", Type: ColumnTypeJSON},
+	}
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, schema, WithCompression("zstd"))
+	require.NoError(t, err)
+	require.NoError(t, w.Write([]byte(`{"name": "a", "age": 10, "createdAt": "2026-10-16T09:00:00Z", "tags": ["x"], "other": 1}`)))
+	require.NoError(t, w.Write([]byte(`{"name": 1, "age": "unknown"}`)))
+	assert.Error(t, w.Write([]byte(`[1, 2]`)))
+	require.NoError(t, w.Close())
+
+	f, err := buffer.NewBufferFile(buf.Bytes())
+	require.NoError(t, err)
+	r, err := reader.NewParquetReader(f, nil, 1)
+	require.NoError(t, err)
+	defer r.ReadStop()
+	assert.Equal(t, int64(2), r.GetNumRows())
+	var names []string
+	for _, info := range r.SchemaHandler.Infos[1:] {
+		names = append(names, info.ExName)
+	}
+	assert.Equal(t, []string{"age", "createdAt", "name", "tags"}, names)
+	assert.Equal(t, pq.CompressionCodec_ZSTD, r.Footer.RowGroups[0].Columns[0].MetaData.Codec)
+	rows, err := r.ReadByNumber(2)
+	require.NoError(t, err)
+	b, err := json.Marshal(rows)
+	require.NoError(t, err)
+	createdAt := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC).UnixMilli()
+	assert.JSONEq(t, fmt.Sprintf(`[
+		{"Age": 10, "CreatedAt": %d, "Name": "a", "Tags": "[\"x\"]"},
+		{"Age": null, "CreatedAt": null, "Name": "1", "Tags": null}
+	]`, createdAt), string(b))
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package parquet converts JSON messages to Parquet files, with the flat schemas which are either derived from JSON
+// schemas or inferred from the messages.
+package parquet
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ColumnType is the type of the values in a column.
+type ColumnType string
+
+const (
+	ColumnTypeString    ColumnType = "string"
+	ColumnTypeInt64     ColumnType = "int64"
+	ColumnTypeDouble    ColumnType = "double"
+	ColumnTypeBoolean   ColumnType = "boolean"
+	ColumnTypeTimestamp ColumnType = "timestamp"
+	// ColumnTypeJSON is used for the nested objects and arrays, they are written as JSON encoded strings.
+	ColumnTypeJSON ColumnType = "json"
+)
+
+// Column is a top level field of the JSON messages, all the columns are optional.
+type Column struct {
+	Name string
+	Type ColumnType
+}
+
+// Schema is the columns of a Parquet file, sorted by the names.
+type Schema []Column
+
+func newSchema(columns map[string]ColumnType) Schema {
+	s := make(Schema, 0, len(columns))
+	for name, typ := range columns {
+		if !validColumnName(name) {
+			continue
+		}
+		s = append(s, Column{Name: name, Type: typ})
+	}
+	sort.Slice(s, func(i, j int) bool { return s[i].Name < s[j].Name })
+	return s
+}
+
+// validColumnName returns whether the field name can be used as a column name, the names with the separators of
+// the schema tags are not supported.
+func validColumnName(name string) bool {
+	return name != "" && strings.TrimSpace(name) == name && !strings.ContainsAny(name, ",=")
+}
+
+// jsonSchema is the part of a JSON schema used to derive the columns.
+type jsonSchema struct {
+	Type       interface{}            `json:"type"`
+	Format     string                 `json:"format"`
+	Properties map[string]*jsonSchema `json:"properties"`
+}
+
+// typeName returns the type of the JSON schema, the first type which is not "null" is used if there are multiple.
+func (js *jsonSchema) typeName() string {
+	switch t := js.Type.(type) {
+	case string:
+		return t
+	case []interface{}:
+		for _, v := range t {
+			if s, ok := v.(string); ok && s != "null" {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+// SchemaFromJSONSchema derives the columns from the top level properties of a JSON schema of objects.
+func SchemaFromJSONSchema(doc []byte) (Schema, error) {
+	var js jsonSchema
+	if err := json.Unmarshal(doc, &js); err != nil {
+		return nil, fmt.Errorf("invalid json schema, %w", err)
+	}
+	if t := js.typeName(); t != "" && t != "object" {
+		return nil, fmt.Errorf("invalid json schema, the type should be \"object\", got %q", t)
+	}
+	if len(js.Properties) == 0 {
+		return nil, fmt.Errorf("invalid json schema, no properties defined")
+	}
+	columns := make(map[string]ColumnType, len(js.Properties))
+	for name, p := range js.Properties {
+		if p == nil {
+			columns[name] = ColumnTypeJSON
+			continue
+		}
+		switch p.typeName() {
+		case "string":
+			if p.Format == "date-time" {
+				columns[name] = ColumnTypeTimestamp
+			} else {
+				columns[name] = ColumnTypeString
+			}
+		case "integer":
+			columns[name] = ColumnTypeInt64
+		case "number":
+			columns[name] = ColumnTypeDouble
+		case "boolean":
+			columns[name] = ColumnTypeBoolean
+		default:
+			columns[name] = ColumnTypeJSON
+		}
+	}
+	return newSchema(columns), nil
+}
+
+// InferSchema infers the columns from the top level fields of the JSON objects, the records which are not JSON
+// objects are ignored. A field is a string column if its values are of different types.
+func InferSchema(records [][]byte) Schema {
+	columns := make(map[string]ColumnType)
+	for _, r := range records {
+		obj, err := decodeObject(r)
+		if err != nil {
+			continue
+		}
+		for name, v := range obj {
+			typ, ok := valueType(v)
+			if !ok {
+				continue
+			}
+			existing, found := columns[name]
+			switch {
+			case !found || existing == typ:
+				columns[name] = typ
+			case (existing == ColumnTypeInt64 && typ == ColumnTypeDouble) || (existing == ColumnTypeDouble && typ == ColumnTypeInt64):
+				columns[name] = ColumnTypeDouble
+			default:
+				columns[name] = ColumnTypeString
+			}
+		}
+	}
+	return newSchema(columns)
+}
+
+// valueType returns the column type of a JSON value, and false if it's null.
+func valueType(v interface{}) (ColumnType, bool) {
+	switch x := v.(type) {
+	case nil:
+		return "", false
+	case string:
+		return ColumnTypeString, true
+	case bool:
+		return ColumnTypeBoolean, true
+	case json.Number:
+		if _, err := x.Int64(); err == nil {
+			return ColumnTypeInt64, true
+		}
+		return ColumnTypeDouble, true
+	default:
+		return ColumnTypeJSON, true
+	}
+}
+
+func decodeObject(data []byte) (map[string]interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var obj map[string]interface{}
+	if err := dec.Decode(&obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, fmt.Errorf("not a json object")
+	}
+	return obj, nil
+}
+
+// parquetSchema returns the Parquet schema of the columns in the JSON format of the writer. The columns are named
+// by their indexes in the messages passed to the writer, and renamed when the file is closed.
+func (s Schema) parquetSchema() (string, error) {
+	type field struct {
+		Tag string `json:"Tag"`
+	}
+	root := struct {
+		Tag    string  `json:"Tag"`
+		Fields []field `json:"Fields"`
+	}{Tag: "name=message, repetitiontype=REQUIRED"}
+	for i, c := range s {
+		var typ string
+		switch c.Type {
+		case ColumnTypeInt64:
+			typ = "type=INT64"
+		case ColumnTypeDouble:
+			typ = "type=DOUBLE"
+		case ColumnTypeBoolean:
+			typ = "type=BOOLEAN"
+		case ColumnTypeTimestamp:
+			typ = "type=INT64, convertedtype=TIMESTAMP_MILLIS"
+		default:
+			// the JSON columns are written as UTF8 strings, the JSON converted type is not supported by the writer
+			typ = "type=BYTE_ARRAY, convertedtype=UTF8"
+		}
+		root.Fields = append(root.Fields, field{Tag: fmt.Sprintf("name=%s, inname=%s, %s, repetitiontype=OPTIONAL", c.Name, columnKey(i), typ)})
+	}
+	b, err := json.Marshal(root)
+	return string(b), err
+}
+
+// columnKey returns the key of the column in the messages passed to the writer.
+func columnKey(i int) string {
+	return fmt.Sprintf("C%d", i)
+}
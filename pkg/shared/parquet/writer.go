@@ -0,0 +1,160 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parquet
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	pq "github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// Writer writes the JSON objects as the rows of a Parquet file.
+type Writer struct {
+	schema Schema
+	writer *writer.JSONWriter
+}
+
+type options struct {
+	rowGroupSize int64
+	codec        pq.CompressionCodec
+}
+
+type Option func(*options)
+
+// WithRowGroupSize sets the size of the row groups in bytes.
+func WithRowGroupSize(size int64) Option {
+	return func(o *options) {
+		o.rowGroupSize = size
+	}
+}
+
+// WithCompression sets the compression codec of the columns, "none", "snappy", "gzip" or "zstd".
+func WithCompression(codec string) Option {
+	return func(o *options) {
+		switch codec {
+		case "snappy":
+			o.codec = pq.CompressionCodec_SNAPPY
+		case "gzip":
+			o.codec = pq.CompressionCodec_GZIP
+		case "zstd":
+			o.codec = pq.CompressionCodec_ZSTD
+		default:
+			o.codec = pq.CompressionCodec_UNCOMPRESSED
+		}
+	}
+}
+
+// NewWriter returns a Writer writing to the output, Close must be called to write the footer of the file.
+func NewWriter(output io.Writer, schema Schema, opts ...Option) (*Writer, error) {
+	if len(schema) == 0 {
+		return nil, fmt.Errorf("no columns in the schema")
+	}
+	o := &options{rowGroupSize: 64 * 1024 * 1024, codec: pq.CompressionCodec_UNCOMPRESSED}
+	for _, opt := range opts {
+		opt(o)
+	}
+	ps, err := schema.parquetSchema()
+	if err != nil {
+		return nil, err
+	}
+	w, err := writer.NewJSONWriterFromWriter(ps, output, 1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create parquet writer, %w", err)
+	}
+	w.RowGroupSize = o.rowGroupSize
+	w.CompressionType = o.codec
+	return &Writer{schema: schema, writer: w}, nil
+}
+
+// Write writes a JSON object as a row, the fields which are not in the schema are ignored, and the values which can
+// not be converted to the types of the columns are written as nulls. An error is returned if the data is not a JSON
+// object.
+func (w *Writer) Write(data []byte) error {
+	obj, err := decodeObject(data)
+	if err != nil {
+		return err
+	}
+	row := make(map[string]interface{}, len(w.schema))
+	for i, c := range w.schema {
+		v, _ := toValue(c.Type, obj[c.Name])
+		row[columnKey(i)] = v
+	}
+	b, err := json.Marshal(row)
+	if err != nil {
+		return err
+	}
+	return w.writer.Write(string(b))
+}
+
+// Close flushes the rows and writes the footer.
+func (w *Writer) Close() error {
+	return w.writer.WriteStop()
+}
+
+// toValue converts a JSON value to the type of the column, and returns false if it's null or can't be converted.
+func toValue(typ ColumnType, v interface{}) (interface{}, bool) {
+	if v == nil {
+		return nil, false
+	}
+	switch typ {
+	case ColumnTypeInt64:
+		if n, ok := v.(json.Number); ok {
+			if i, err := n.Int64(); err == nil {
+				return i, true
+			}
+			if f, err := n.Float64(); err == nil {
+				return int64(f), true
+			}
+		}
+	case ColumnTypeDouble:
+		if n, ok := v.(json.Number); ok {
+			if f, err := n.Float64(); err == nil {
+				return f, true
+			}
+		}
+	case ColumnTypeBoolean:
+		if b, ok := v.(bool); ok {
+			return b, true
+		}
+	case ColumnTypeTimestamp:
+		switch x := v.(type) {
+		case string:
+			if t, err := time.Parse(time.RFC3339Nano, x); err == nil {
+				return t.UnixMilli(), true
+			}
+		case json.Number:
+			// epoch milliseconds
+			if i, err := x.Int64(); err == nil {
+				return i, true
+			}
+		}
+	default:
+		if s, ok := v.(string); ok && typ == ColumnTypeString {
+			return s, true
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, false
+		}
+		return string(b), true
+	}
+	return nil, false
+}
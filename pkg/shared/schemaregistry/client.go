@@ -0,0 +1,257 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schemaregistry implements a client of the Confluent compatible schema registry, which is used to
+// convert the schema registry framed Avro, Protobuf and JSON payloads from and to JSON.
+package schemaregistry
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+// SchemaType is the type of registered schema.
+type SchemaType string
+
+const (
+	SchemaTypeAvro     SchemaType = "AVRO"
+	SchemaTypeProtobuf SchemaType = "PROTOBUF"
+	SchemaTypeJSON     SchemaType = "JSON"
+)
+
+// Schema is a schema fetched from the registry, with its compiled codec.
+type Schema struct {
+	ID     int32
+	Type   SchemaType
+	Schema string
+	codec  codec
+}
+
+// schemaResponse is the payload returned by the registry for both
+// "/schemas/ids/{id}" and "/subjects/{subject}/versions/latest".
+type schemaResponse struct {
+	ID         int32             `json:"id"`
+	Schema     string            `json:"schema"`
+	SchemaType SchemaType        `json:"schemaType"`
+	References []json.RawMessage `json:"references"`
+}
+
+type subjectEntry struct {
+	schema    *Schema
+	fetchedAt time.Time
+}
+
+// Client looks up schemas from a schema registry, and caches them.
+type Client struct {
+	url        string
+	httpClient *http.Client
+	user       string
+	password   string
+	cacheTTL   time.Duration
+	lock       sync.RWMutex
+	// schemas by ID are immutable, so they are cached forever.
+	byID map[int32]*Schema
+	// latest schemas by subject are refreshed after cacheTTL.
+	bySubject map[string]*subjectEntry
+}
+
+type Option func(*Client)
+
+// WithBasicAuth sets the basic auth credentials used to talk to the registry.
+func WithBasicAuth(user, password string) Option {
+	return func(c *Client) {
+		c.user = user
+		c.password = password
+	}
+}
+
+// WithTLSConfig sets the TLS config of the http client.
+func WithTLSConfig(t *tls.Config) Option {
+	return func(c *Client) {
+		c.httpClient.Transport = &http.Transport{TLSClientConfig: t}
+	}
+}
+
+// WithCacheTTL sets how long the latest schema of a subject is cached.
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(c *Client) {
+		c.cacheTTL = ttl
+	}
+}
+
+// NewClient returns a schema registry client.
+func NewClient(registryURL string, opts ...Option) *Client {
+	c := &Client{
+		url:        strings.TrimSuffix(registryURL, "/"),
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		cacheTTL:   5 * time.Minute,
+		byID:       make(map[int32]*Schema),
+		bySubject:  make(map[string]*subjectEntry),
+	}
+	for _, o := range opts {
+		o(c)
+	}
+	return c
+}
+
+// NewClientFromSpec returns a schema registry client based on the spec, the secrets are read from the mounted volumes.
+func NewClientFromSpec(spec *dfv1.SchemaRegistry) (*Client, error) {
+	if spec == nil || spec.URL == "" {
+		return nil, fmt.Errorf("schema registry url is required")
+	}
+	opts := []Option{WithCacheTTL(spec.GetCacheTTL())}
+	if spec.TLS != nil {
+		c, err := sharedutil.GetTLSConfig(spec.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get schema registry tls config, %w", err)
+		}
+		opts = append(opts, WithTLSConfig(c))
+	}
+	if a := spec.BasicAuth; a != nil {
+		var user, password string
+		var err error
+		if a.User != nil {
+			if user, err = sharedutil.GetSecretFromVolume(a.User); err != nil {
+				return nil, fmt.Errorf("failed to get schema registry user, %w", err)
+			}
+		}
+		if a.Password != nil {
+			if password, err = sharedutil.GetSecretFromVolume(a.Password); err != nil {
+				return nil, fmt.Errorf("failed to get schema registry password, %w", err)
+			}
+		}
+		opts = append(opts, WithBasicAuth(user, password))
+	}
+	return NewClient(spec.URL, opts...), nil
+}
+
+// GetSchemaByID returns the schema with the given ID.
+func (c *Client) GetSchemaByID(ctx context.Context, id int32) (*Schema, error) {
+	c.lock.RLock()
+	s, ok := c.byID[id]
+	c.lock.RUnlock()
+	if ok {
+		return s, nil
+	}
+	resp, err := c.get(ctx, fmt.Sprintf("/schemas/ids/%d", id))
+	if err != nil {
+		return nil, err
+	}
+	resp.ID = id
+	if s, err = newSchema(resp); err != nil {
+		return nil, err
+	}
+	c.lock.Lock()
+	c.byID[id] = s
+	c.lock.Unlock()
+	return s, nil
+}
+
+// GetLatestSchema returns the latest schema registered for the subject.
+func (c *Client) GetLatestSchema(ctx context.Context, subject string) (*Schema, error) {
+	c.lock.RLock()
+	e, ok := c.bySubject[subject]
+	c.lock.RUnlock()
+	if ok && time.Since(e.fetchedAt) < c.cacheTTL {
+		return e.schema, nil
+	}
+	resp, err := c.get(ctx, fmt.Sprintf("/subjects/%s/versions/latest", url.PathEscape(subject)))
+	if err != nil {
+		if ok {
+			// serve the stale schema rather than failing if the registry is temporarily unavailable.
+			return e.schema, nil
+		}
+		return nil, err
+	}
+	c.lock.RLock()
+	s, cached := c.byID[resp.ID]
+	c.lock.RUnlock()
+	if !cached {
+		if s, err = newSchema(resp); err != nil {
+			return nil, err
+		}
+	}
+	c.lock.Lock()
+	c.byID[s.ID] = s
+	c.bySubject[subject] = &subjectEntry{schema: s, fetchedAt: time.Now()}
+	c.lock.Unlock()
+	return s, nil
+}
+
+func (c *Client) get(ctx context.Context, path string) (*schemaResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.schemaregistry.v1+json")
+	if c.user != "" || c.password != "" {
+		req.SetBasicAuth(c.user, c.password)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request schema registry, %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema registry response, %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("schema registry returned status %d for %q, %s", resp.StatusCode, path, string(body))
+	}
+	result := &schemaResponse{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal schema registry response, %w", err)
+	}
+	return result, nil
+}
+
+func newSchema(resp *schemaResponse) (*Schema, error) {
+	if len(resp.References) > 0 {
+		return nil, fmt.Errorf("schema %d has references, which are not supported", resp.ID)
+	}
+	s := &Schema{ID: resp.ID, Type: resp.SchemaType, Schema: resp.Schema}
+	if s.Type == "" {
+		// the registry omits the type for avro schemas.
+		s.Type = SchemaTypeAvro
+	}
+	var err error
+	switch s.Type {
+	case SchemaTypeAvro:
+		s.codec, err = newAvroCodec(s.Schema)
+	case SchemaTypeProtobuf:
+		s.codec, err = newProtobufCodec(s.Schema)
+	case SchemaTypeJSON:
+		s.codec = jsonCodec{}
+	default:
+		err = fmt.Errorf("unsupported schema type %q", s.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile schema %d, %w", s.ID, err)
+	}
+	return s, nil
+}
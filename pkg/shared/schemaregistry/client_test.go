@@ -0,0 +1,200 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemaregistry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+const (
+	testAvroSchema  = `{"type":"record","name":"User","fields":[{"name":"name","type":"string"},{"name":"age","type":"int"}]}`
+	testProtoSchema = `syntax = "proto3";
+message User {
+  string name = 1;
+  int32 age = 2;
+  message Address {
+    string city = 1;
+  }
+}`
+)
+
+type mockRegistry struct {
+	*httptest.Server
+	requests atomic.Int32
+}
+
+// newMockRegistry starts a registry serving the schemas, the subject "{type}-value" points to the schema with the same index.
+func newMockRegistry(t *testing.T, schemas map[int32]schemaResponse) *mockRegistry {
+	t.Helper()
+	m := &mockRegistry{}
+	m.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.requests.Add(1)
+		if u, p, ok := r.BasicAuth(); ok && (u != "user" || p != "password") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var id int32
+		if _, err := fmt.Sscanf(r.URL.Path, "/schemas/ids/%d", &id); err == nil {
+			if s, ok := schemas[id]; ok {
+				_ = json.NewEncoder(w).Encode(s)
+				return
+			}
+		}
+		if strings.HasPrefix(r.URL.Path, "/subjects/") && strings.HasSuffix(r.URL.Path, "/versions/latest") {
+			subject := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/subjects/"), "/versions/latest")
+			for id, s := range schemas {
+				if subject == fmt.Sprintf("%s-value", strings.ToLower(string(s.SchemaType))) {
+					s.ID = id
+					_ = json.NewEncoder(w).Encode(s)
+					return
+				}
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error_code":40403,"message":"Schema not found"}`))
+	}))
+	t.Cleanup(m.Close)
+	return m
+}
+
+func testSchemas() map[int32]schemaResponse {
+	return map[int32]schemaResponse{
+		1: {SchemaType: SchemaTypeAvro, Schema: testAvroSchema},
+		2: {SchemaType: SchemaTypeProtobuf, Schema: testProtoSchema},
+		3: {SchemaType: SchemaTypeJSON, Schema: `{"type":"object"}`},
+	}
+}
+
+func TestClient_EncodeDecode(t *testing.T) {
+	m := newMockRegistry(t, testSchemas())
+	c := NewClient(m.URL)
+	ctx := context.Background()
+	tests := []struct {
+		subject string
+		id      int32
+	}{
+		{subject: "avro-value", id: 1},
+		{subject: "protobuf-value", id: 2},
+		{subject: "json-value", id: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.subject, func(t *testing.T) {
+			encoded, err := c.Encode(ctx, tt.subject, []byte(`{"name":"numa","age":3}`))
+			require.NoError(t, err)
+			assert.Equal(t, byte(0), encoded[0])
+			assert.Equal(t, []byte{0, 0, 0, byte(tt.id)}, encoded[1:5])
+			decoded, err := c.Decode(ctx, encoded)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"name":"numa","age":3}`, string(decoded))
+		})
+	}
+}
+
+func TestClient_DecodeNestedProtobuf(t *testing.T) {
+	m := newMockRegistry(t, testSchemas())
+	c := NewClient(m.URL)
+	// magic byte, schema id 2, message indexes [0, 0] (User.Address), then field 1 "sf".
+	data := []byte{0, 0, 0, 0, 2, 4, 0, 0, 0x0a, 2, 's', 'f'}
+	decoded, err := c.Decode(context.Background(), data)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"city":"sf"}`, string(decoded))
+}
+
+func TestClient_DecodeErrors(t *testing.T) {
+	m := newMockRegistry(t, testSchemas())
+	c := NewClient(m.URL)
+	ctx := context.Background()
+	_, err := c.Decode(ctx, []byte(`{"name":"numa"}`))
+	assert.ErrorContains(t, err, "wire format")
+	_, err = c.Decode(ctx, []byte{0, 0, 0, 0, 9, 1})
+	assert.ErrorContains(t, err, "status 404")
+	_, err = c.Decode(ctx, []byte{0, 0, 0, 0, 1, 0xff})
+	assert.ErrorContains(t, err, "avro")
+	_, err = c.Encode(ctx, "avro-value", []byte(`{"name":"numa"}`))
+	assert.Error(t, err)
+	_, err = c.Encode(ctx, "unknown-value", []byte(`{}`))
+	assert.Error(t, err)
+}
+
+func TestClient_Cache(t *testing.T) {
+	m := newMockRegistry(t, testSchemas())
+	c := NewClient(m.URL, WithCacheTTL(time.Hour))
+	ctx := context.Background()
+	encoded, err := c.Encode(ctx, "avro-value", []byte(`{"name":"numa","age":3}`))
+	require.NoError(t, err)
+	_, err = c.Encode(ctx, "avro-value", []byte(`{"name":"numa","age":4}`))
+	require.NoError(t, err)
+	// the schema by ID is cached when looking up the subject.
+	_, err = c.Decode(ctx, encoded)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), m.requests.Load())
+
+	c = NewClient(m.URL, WithCacheTTL(0))
+	for i := 0; i < 2; i++ {
+		_, err = c.GetLatestSchema(ctx, "avro-value")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(3), m.requests.Load())
+
+	// stale schemas are served if the registry is not reachable.
+	m.Close()
+	s, err := c.GetLatestSchema(ctx, "avro-value")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), s.ID)
+}
+
+func TestClient_BasicAuth(t *testing.T) {
+	m := newMockRegistry(t, testSchemas())
+	_, err := NewClient(m.URL, WithBasicAuth("user", "wrong")).GetSchemaByID(context.Background(), 1)
+	assert.ErrorContains(t, err, "status 401")
+	_, err = NewClient(m.URL, WithBasicAuth("user", "password")).GetSchemaByID(context.Background(), 1)
+	assert.NoError(t, err)
+}
+
+func TestNewSchema(t *testing.T) {
+	s, err := newSchema(&schemaResponse{ID: 1, Schema: testAvroSchema})
+	require.NoError(t, err)
+	assert.Equal(t, SchemaTypeAvro, s.Type)
+	_, err = newSchema(&schemaResponse{ID: 1, Schema: testAvroSchema, References: []json.RawMessage{[]byte(`{}`)}})
+	assert.ErrorContains(t, err, "references")
+	_, err = newSchema(&schemaResponse{ID: 1, SchemaType: SchemaTypeProtobuf, Schema: "syntax = \"proto3\";"})
+	assert.ErrorContains(t, err, "no message type")
+	_, err = newSchema(&schemaResponse{ID: 1, SchemaType: "XML", Schema: "<a/>"})
+	assert.ErrorContains(t, err, "unsupported")
+}
+
+func TestNewClientFromSpec(t *testing.T) {
+	_, err := NewClientFromSpec(&dfv1.SchemaRegistry{})
+	assert.Error(t, err)
+	c, err := NewClientFromSpec(&dfv1.SchemaRegistry{URL: "http://localhost:8081/"})
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:8081", c.url)
+	assert.Equal(t, 5*time.Minute, c.cacheTTL)
+}
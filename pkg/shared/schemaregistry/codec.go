@@ -0,0 +1,249 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemaregistry
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/linkedin/goavro/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// magicByte is the first byte of every schema registry framed payload.
+const magicByte byte = 0
+
+// codec converts the payload (without framing) between its wire format and JSON.
+type codec interface {
+	// toJSON decodes the payload, the message indexes are only used by protobuf.
+	toJSON(indexes []int, data []byte) ([]byte, error)
+	// fromJSON encodes the JSON payload, and returns the message indexes (protobuf only) and the encoded bytes.
+	fromJSON(data []byte) ([]int, []byte, error)
+}
+
+// Decode decodes a schema registry framed payload to JSON, the schema is looked up by the ID in the framing.
+func (c *Client) Decode(ctx context.Context, data []byte) ([]byte, error) {
+	if len(data) < 5 || data[0] != magicByte {
+		return nil, fmt.Errorf("invalid payload, not in the schema registry wire format")
+	}
+	id := int32(binary.BigEndian.Uint32(data[1:5]))
+	s, err := c.GetSchemaByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	payload := data[5:]
+	var indexes []int
+	if s.Type == SchemaTypeProtobuf {
+		if indexes, payload, err = readMessageIndexes(payload); err != nil {
+			return nil, err
+		}
+	}
+	return s.codec.toJSON(indexes, payload)
+}
+
+// Encode encodes the JSON payload with the latest schema of the subject, and frames it with the schema ID.
+func (c *Client) Encode(ctx context.Context, subject string, data []byte) ([]byte, error) {
+	s, err := c.GetLatestSchema(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	indexes, payload, err := s.codec.fromJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	buf := bytes.NewBuffer(make([]byte, 0, len(payload)+6))
+	buf.WriteByte(magicByte)
+	_ = binary.Write(buf, binary.BigEndian, s.ID)
+	if s.Type == SchemaTypeProtobuf {
+		writeMessageIndexes(buf, indexes)
+	}
+	buf.Write(payload)
+	return buf.Bytes(), nil
+}
+
+// readMessageIndexes reads the protobuf message indexes, which locate the message type in the schema.
+// A single 0 is a shortcut of [0], the first message type.
+func readMessageIndexes(data []byte) ([]int, []byte, error) {
+	r := bytes.NewReader(data)
+	n, err := binary.ReadVarint(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid protobuf message indexes, %w", err)
+	}
+	if n == 0 {
+		return []int{0}, data[len(data)-r.Len():], nil
+	}
+	if n < 0 || n > int64(r.Len()) {
+		return nil, nil, fmt.Errorf("invalid protobuf message indexes count %d", n)
+	}
+	indexes := make([]int, n)
+	for i := range indexes {
+		idx, err := binary.ReadVarint(r)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid protobuf message indexes, %w", err)
+		}
+		indexes[i] = int(idx)
+	}
+	return indexes, data[len(data)-r.Len():], nil
+}
+
+func writeMessageIndexes(w io.ByteWriter, indexes []int) {
+	if len(indexes) == 1 && indexes[0] == 0 {
+		_ = w.WriteByte(0)
+		return
+	}
+	buf := make([]byte, binary.MaxVarintLen64)
+	write := func(v int64) {
+		n := binary.PutVarint(buf, v)
+		for _, b := range buf[:n] {
+			_ = w.WriteByte(b)
+		}
+	}
+	write(int64(len(indexes)))
+	for _, idx := range indexes {
+		write(int64(idx))
+	}
+}
+
+type avroCodec struct {
+	codec *goavro.Codec
+}
+
+func newAvroCodec(schema string) (codec, error) {
+	c, err := goavro.NewCodecForStandardJSONFull(schema)
+	if err != nil {
+		return nil, err
+	}
+	return &avroCodec{codec: c}, nil
+}
+
+func (a *avroCodec) toJSON(_ []int, data []byte) ([]byte, error) {
+	native, _, err := a.codec.NativeFromBinary(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode avro payload, %w", err)
+	}
+	result, err := a.codec.TextualFromNative(nil, native)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert avro payload to json, %w", err)
+	}
+	return result, nil
+}
+
+func (a *avroCodec) fromJSON(data []byte) ([]int, []byte, error) {
+	native, _, err := a.codec.NativeFromTextual(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to convert json to avro, %w", err)
+	}
+	result, err := a.codec.BinaryFromNative(nil, native)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode avro payload, %w", err)
+	}
+	return nil, result, nil
+}
+
+type protobufCodec struct {
+	file protoreflect.FileDescriptor
+}
+
+func newProtobufCodec(schema string) (codec, error) {
+	const fileName = "schema.proto"
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(map[string]string{fileName: schema}),
+		}),
+	}
+	files, err := compiler.Compile(context.Background(), fileName)
+	if err != nil {
+		return nil, err
+	}
+	if files[0].Messages().Len() == 0 {
+		return nil, fmt.Errorf("no message type defined in the protobuf schema")
+	}
+	return &protobufCodec{file: files[0]}, nil
+}
+
+// messageDescriptor walks through the (nested) message types by the indexes.
+func (p *protobufCodec) messageDescriptor(indexes []int) (protoreflect.MessageDescriptor, error) {
+	msgs := p.file.Messages()
+	var md protoreflect.MessageDescriptor
+	for _, idx := range indexes {
+		if idx < 0 || idx >= msgs.Len() {
+			return nil, fmt.Errorf("protobuf message index %v not found in the schema", indexes)
+		}
+		md = msgs.Get(idx)
+		msgs = md.Messages()
+	}
+	if md == nil {
+		return nil, fmt.Errorf("empty protobuf message indexes")
+	}
+	return md, nil
+}
+
+func (p *protobufCodec) toJSON(indexes []int, data []byte) ([]byte, error) {
+	md, err := p.messageDescriptor(indexes)
+	if err != nil {
+		return nil, err
+	}
+	msg := dynamicpb.NewMessage(md)
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, fmt.Errorf("failed to decode protobuf payload, %w", err)
+	}
+	result, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert protobuf payload to json, %w", err)
+	}
+	return result, nil
+}
+
+// fromJSON always encodes with the first message type defined in the schema.
+func (p *protobufCodec) fromJSON(data []byte) ([]int, []byte, error) {
+	indexes := []int{0}
+	md, _ := p.messageDescriptor(indexes)
+	msg := dynamicpb.NewMessage(md)
+	if err := protojson.Unmarshal(data, msg); err != nil {
+		return nil, nil, fmt.Errorf("failed to convert json to protobuf, %w", err)
+	}
+	result, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode protobuf payload, %w", err)
+	}
+	return indexes, result, nil
+}
+
+// jsonCodec only takes care of the framing, the payload is JSON already.
+type jsonCodec struct{}
+
+func (jsonCodec) toJSON(_ []int, data []byte) ([]byte, error) {
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("invalid json payload")
+	}
+	return data, nil
+}
+
+func (jsonCodec) fromJSON(data []byte) ([]int, []byte, error) {
+	if !json.Valid(data) {
+		return nil, nil, fmt.Errorf("invalid json payload")
+	}
+	return nil, data, nil
+}
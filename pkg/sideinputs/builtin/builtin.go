@@ -0,0 +1,37 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package builtin provides the builtin generators of the Side Inputs, which run in the Side Inputs manager instead of
+// a user defined container. A generator implements the client of the Side Input service in-process, so the manager
+// calls it the same way as a user defined container.
+package builtin
+
+import (
+	"fmt"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	sideinputpb "github.com/numaproj/numaflow/pkg/apis/proto/sideinput"
+)
+
+// New returns the client of the builtin generator.
+func New(b dfv1.SideInputBuiltin) (sideinputpb.SideInputClient, error) {
+	switch b.Name {
+	case "http":
+		return newHTTPGenerator(b.KWArgs)
+	default:
+		return nil, fmt.Errorf("unrecognized builtin %q", b.Name)
+	}
+}
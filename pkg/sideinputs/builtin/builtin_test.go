@@ -0,0 +1,39 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builtin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	sideinputpb "github.com/numaproj/numaflow/pkg/apis/proto/sideinput"
+)
+
+func TestNew(t *testing.T) {
+	_, err := New(dfv1.SideInputBuiltin{Name: "ftp"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `unrecognized builtin "ftp"`)
+
+	c, err := New(dfv1.SideInputBuiltin{Name: "http", KWArgs: map[string]string{"url": "http://my-config/config.json"}})
+	assert.NoError(t, err)
+	resp, err := c.IsReady(context.Background(), &sideinputpb.SideInputRequest{})
+	assert.NoError(t, err)
+	assert.True(t, resp.GetReady())
+}
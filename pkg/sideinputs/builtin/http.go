@@ -0,0 +1,101 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"google.golang.org/grpc"
+
+	sideinputpb "github.com/numaproj/numaflow/pkg/apis/proto/sideinput"
+)
+
+const defaultHTTPTimeout = 30 * time.Second
+
+// httpGenerator gets the value of the Side Input from a URL with a GET request, the response body is the value. The
+// arguments are:
+//
+//   - "url": the URL to get the value from, required.
+//   - "timeout": timeout of each request, defaults to 30s.
+type httpGenerator struct {
+	url    string
+	client *http.Client
+}
+
+func newHTTPGenerator(args map[string]string) (*httpGenerator, error) {
+	g := &httpGenerator{client: &http.Client{Timeout: defaultHTTPTimeout}}
+	for k, v := range args {
+		switch k {
+		case "url":
+			u, err := url.ParseRequestURI(v)
+			if err != nil {
+				return nil, fmt.Errorf(`invalid "url", %w`, err)
+			}
+			if u.Scheme != "http" && u.Scheme != "https" {
+				return nil, fmt.Errorf(`invalid "url" %q, the scheme should be either "http" or "https"`, v)
+			}
+			g.url = v
+		case "timeout":
+			timeout, err := time.ParseDuration(v)
+			if err != nil {
+				return nil, fmt.Errorf(`invalid "timeout", %w`, err)
+			}
+			if timeout <= 0 {
+				return nil, fmt.Errorf(`invalid "timeout" %q, it should be greater than 0`, v)
+			}
+			g.client.Timeout = timeout
+		default:
+			return nil, fmt.Errorf("unrecognized argument %q", k)
+		}
+	}
+	if g.url == "" {
+		return nil, fmt.Errorf(`missing "url"`)
+	}
+	return g, nil
+}
+
+// RetrieveSideInput gets the value from the URL, a failed request fails the retrieval, so the UDFs keep using the
+// previous value.
+func (g *httpGenerator) RetrieveSideInput(ctx context.Context, _ *sideinputpb.SideInputRequest, _ ...grpc.CallOption) (*sideinputpb.SideInputResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the request, %w", err)
+	}
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %q, %w", g.url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("failed to get %q, status %q", g.url, resp.Status)
+	}
+	value, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response of %q, %w", g.url, err)
+	}
+	return &sideinputpb.SideInputResponse{Value: value}, nil
+}
+
+// IsReady is always true, the URL is checked by the retrievals.
+func (g *httpGenerator) IsReady(context.Context, *sideinputpb.SideInputRequest, ...grpc.CallOption) (*sideinputpb.ReadyResponse, error) {
+	return &sideinputpb.ReadyResponse{Ready: true}, nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builtin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sideinputpb "github.com/numaproj/numaflow/pkg/apis/proto/sideinput"
+)
+
+func TestNewHTTPGenerator(t *testing.T) {
+	for name, args := range map[string]map[string]string{
+		`missing "url"`:                  {},
+		`invalid "url"`:                  {"url": "my-config"},
+		`the scheme should be`:           {"url": "ftp://my-config/config.json"},
+		`invalid "timeout"`:              {"url": "http://my-config", "timeout": "10"},
+		`it should be greater than 0`:    {"url": "http://my-config", "timeout": "0s"},
+		`unrecognized argument "method"`: {"url": "http://my-config", "method": "POST"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := newHTTPGenerator(args)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), name)
+		})
+	}
+}
+
+func TestHTTPGenerator_RetrieveSideInput(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"threshold": 10}`))
+	}))
+	defer server.Close()
+	g, err := newHTTPGenerator(map[string]string{"url": server.URL + "/config.json", "timeout": "5s"})
+	assert.NoError(t, err)
+
+	resp, err := g.RetrieveSideInput(context.Background(), &sideinputpb.SideInputRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`{"threshold": 10}`), resp.GetValue())
+	assert.False(t, resp.GetNoBroadcast())
+
+	status = http.StatusNotFound
+	_, err = g.RetrieveSideInput(context.Background(), &sideinputpb.SideInputRequest{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "404")
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package manager generates the value of a Side Input by calling the Side Input container when it's triggered, and
+// stores the value in the side inputs store, from where it's broadcast to the vertices.
+package manager
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	sideinputpb "github.com/numaproj/numaflow/pkg/apis/proto/sideinput"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// SideInputSocketPath is the path of the Unix domain socket, on which the Side Input container serves.
+const SideInputSocketPath = dfv1.PathVarRun + "/sideinput.sock"
+
+// readyCheckInterval is the interval to check if the Side Input container is ready.
+const readyCheckInterval = time.Second
+
+type sideInputsManager struct {
+	sideInput *dfv1.SideInput
+	kv        nats.KeyValue
+	client    sideinputpb.SideInputClient
+}
+
+// NewSideInputsManager returns a manager of the given Side Input, which stores the values retrieved with the client
+// in the kv store.
+func NewSideInputsManager(sideInput *dfv1.SideInput, kv nats.KeyValue, client sideinputpb.SideInputClient) *sideInputsManager {
+	return &sideInputsManager{
+		sideInput: sideInput,
+		kv:        kv,
+		client:    client,
+	}
+}
+
+// Start waits for the Side Input container to be ready, generates the value once, and then every time the trigger
+// fires, until the context is canceled.
+func (m *sideInputsManager) Start(ctx context.Context) error {
+	log := logging.FromContext(ctx).With("sideInput", m.sideInput.Name)
+	if m.sideInput.Trigger == nil {
+		return fmt.Errorf("trigger of side input %q is not specified", m.sideInput.Name)
+	}
+	c := cron.New(cron.WithChain(cron.SkipIfStillRunning(cron.DiscardLogger)))
+	if _, err := c.AddFunc(m.sideInput.Trigger.GetSchedule(), func() {
+		if err := m.update(ctx); err != nil {
+			log.Errorw("Failed to update the side input", zap.Error(err))
+		}
+	}); err != nil {
+		return fmt.Errorf("invalid trigger of side input %q, %w", m.sideInput.Name, err)
+	}
+	if err := m.waitUntilReady(ctx); err != nil {
+		return err
+	}
+	// Generate the value at start up, the vertices don't process until the side inputs are available.
+	if err := m.update(ctx); err != nil {
+		log.Errorw("Failed to update the side input", zap.Error(err))
+	}
+	c.Start()
+	log.Infow("Side inputs manager is started", zap.String("schedule", m.sideInput.Trigger.GetSchedule()))
+	<-ctx.Done()
+	<-c.Stop().Done()
+	log.Info("Side inputs manager is stopped")
+	return nil
+}
+
+func (m *sideInputsManager) waitUntilReady(ctx context.Context) error {
+	log := logging.FromContext(ctx)
+	for {
+		resp, err := m.client.IsReady(ctx, &sideinputpb.SideInputRequest{})
+		if err == nil && resp.GetReady() {
+			return nil
+		}
+		log.Infow("Waiting for the side input container to be ready...", zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(readyCheckInterval):
+		}
+	}
+}
+
+// update retrieves the value of the Side Input and stores it if it's changed. The revision of the stored value is the
+// version of the Side Input.
+func (m *sideInputsManager) update(ctx context.Context) error {
+	log := logging.FromContext(ctx)
+	resp, err := m.client.RetrieveSideInput(ctx, &sideinputpb.SideInputRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to retrieve side input %q, %w", m.sideInput.Name, err)
+	}
+	if resp.GetNoBroadcast() {
+		log.Infow("The side input value is not broadcast", zap.String("sideInput", m.sideInput.Name))
+		return nil
+	}
+	entry, err := m.kv.Get(m.sideInput.Name)
+	if err != nil && !errors.Is(err, nats.ErrKeyNotFound) {
+		return fmt.Errorf("failed to get side input %q from the store, %w", m.sideInput.Name, err)
+	}
+	if entry != nil && bytes.Equal(entry.Value(), resp.GetValue()) {
+		log.Debugw("The side input value is not changed", zap.String("sideInput", m.sideInput.Name))
+		return nil
+	}
+	version, err := m.kv.Put(m.sideInput.Name, resp.GetValue())
+	if err != nil {
+		return fmt.Errorf("failed to store side input %q, %w", m.sideInput.Name, err)
+	}
+	log.Infow("Side input is updated", zap.String("sideInput", m.sideInput.Name), zap.Uint64("version", version))
+	return nil
+}
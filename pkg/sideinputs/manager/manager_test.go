@@ -0,0 +1,140 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	sideinputpb "github.com/numaproj/numaflow/pkg/apis/proto/sideinput"
+	natstest "github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
+)
+
+type fakeSideInputClient struct {
+	sync.Mutex
+	resp  *sideinputpb.SideInputResponse
+	err   error
+	ready bool
+	calls int
+}
+
+func (f *fakeSideInputClient) RetrieveSideInput(_ context.Context, _ *sideinputpb.SideInputRequest, _ ...grpc.CallOption) (*sideinputpb.SideInputResponse, error) {
+	f.Lock()
+	defer f.Unlock()
+	f.calls++
+	return f.resp, f.err
+}
+
+func (f *fakeSideInputClient) IsReady(_ context.Context, _ *sideinputpb.SideInputRequest, _ ...grpc.CallOption) (*sideinputpb.ReadyResponse, error) {
+	f.Lock()
+	defer f.Unlock()
+	return &sideinputpb.ReadyResponse{Ready: f.ready}, nil
+}
+
+func (f *fakeSideInputClient) set(resp *sideinputpb.SideInputResponse, err error) {
+	f.Lock()
+	defer f.Unlock()
+	f.resp, f.err = resp, err
+}
+
+func newTestKV(t *testing.T) nats.KeyValue {
+	t.Helper()
+	s := natstest.RunJetStreamServer(t)
+	t.Cleanup(func() { natstest.ShutdownJetStreamServer(t, s) })
+	conn, err := natstest.JetStreamClient(t, s).Connect(context.Background())
+	assert.NoError(t, err)
+	t.Cleanup(conn.Close)
+	js, err := conn.JetStream()
+	assert.NoError(t, err)
+	kv, err := js.CreateKeyValue(&nats.KeyValueConfig{Bucket: "test_SIDE_INPUTS", History: 1})
+	assert.NoError(t, err)
+	return kv
+}
+
+var testSideInput = &dfv1.SideInput{
+	Name:      "si",
+	Container: &dfv1.Container{Image: "my-image"},
+	Trigger:   &dfv1.SideInputTrigger{Interval: &metav1.Duration{Duration: time.Hour}},
+}
+
+func TestSideInputsManager_update(t *testing.T) {
+	ctx := context.Background()
+	kv := newTestKV(t)
+	client := &fakeSideInputClient{}
+	m := NewSideInputsManager(testSideInput, kv, client)
+
+	client.set(&sideinputpb.SideInputResponse{Value: []byte("v1")}, nil)
+	assert.NoError(t, m.update(ctx))
+	entry, err := kv.Get("si")
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", string(entry.Value()))
+	version := entry.Revision()
+
+	// an unchanged value is not stored again
+	assert.NoError(t, m.update(ctx))
+	entry, err = kv.Get("si")
+	assert.NoError(t, err)
+	assert.Equal(t, version, entry.Revision())
+
+	// a value not to be broadcast is ignored
+	client.set(&sideinputpb.SideInputResponse{Value: []byte("v2"), NoBroadcast: true}, nil)
+	assert.NoError(t, m.update(ctx))
+	entry, err = kv.Get("si")
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", string(entry.Value()))
+
+	client.set(nil, fmt.Errorf("unavailable"))
+	assert.Error(t, m.update(ctx))
+
+	client.set(&sideinputpb.SideInputResponse{Value: []byte("v2")}, nil)
+	assert.NoError(t, m.update(ctx))
+	entry, err = kv.Get("si")
+	assert.NoError(t, err)
+	assert.Equal(t, "v2", string(entry.Value()))
+	assert.Greater(t, entry.Revision(), version)
+}
+
+func TestSideInputsManager_Start(t *testing.T) {
+	kv := newTestKV(t)
+	client := &fakeSideInputClient{ready: true, resp: &sideinputpb.SideInputResponse{Value: []byte("v1")}}
+	m := NewSideInputsManager(testSideInput, kv, client)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- m.Start(ctx)
+	}()
+	// the value is generated at start up
+	assert.Eventually(t, func() bool {
+		entry, err := kv.Get("si")
+		return err == nil && string(entry.Value()) == "v1"
+	}, 5*time.Second, 50*time.Millisecond)
+	cancel()
+	assert.NoError(t, <-done)
+
+	invalid := testSideInput.DeepCopy()
+	invalid.Trigger = &dfv1.SideInputTrigger{Schedule: "invalid"}
+	assert.Error(t, NewSideInputsManager(invalid, kv, client).Start(context.Background()))
+}
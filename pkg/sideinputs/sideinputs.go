@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sideinputs implements the Side Inputs of a pipeline. The value of each Side Input is generated by a manager
+// and kept in the side inputs store of the pipeline, where the key is the Side Input name and the revision of the
+// value is its version. The values are written to files in the vertex pods and mounted to the UDF containers.
+package sideinputs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// versionFileSuffix is the suffix of the file keeping the version of a Side Input value.
+const versionFileSuffix = ".version"
+
+// GetSideInputFilePath returns the path of the file with the value of a Side Input.
+func GetSideInputFilePath(mountPath, name string) string {
+	return filepath.Join(mountPath, name)
+}
+
+func getVersionFilePath(mountPath, name string) string {
+	return filepath.Join(mountPath, name+versionFileSuffix)
+}
+
+// GetSideInputVersion returns the version of the Side Input value written in the mount path, 0 means it's not
+// written yet.
+func GetSideInputVersion(mountPath, name string) (uint64, error) {
+	b, err := os.ReadFile(getVersionFilePath(mountPath, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to read the version of side input %q, %w", name, err)
+	}
+	version, err := strconv.ParseUint(strings.TrimSpace(string(b)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid version of side input %q, %w", name, err)
+	}
+	return version, nil
+}
+
+// UpdateSideInputFile writes the value of a Side Input and its version to the mount path, if the version is newer than
+// the one written. Both files are written to temporary files and renamed, so the readers never see a partial value,
+// and the version file is updated after the value file. It returns true if the value is updated.
+func UpdateSideInputFile(mountPath, name string, value []byte, version uint64) (bool, error) {
+	current, err := GetSideInputVersion(mountPath, name)
+	if err != nil {
+		return false, err
+	}
+	if version <= current {
+		return false, nil
+	}
+	if err := writeFileAtomically(GetSideInputFilePath(mountPath, name), value); err != nil {
+		return false, fmt.Errorf("failed to write the value of side input %q, %w", name, err)
+	}
+	if err := writeFileAtomically(getVersionFilePath(mountPath, name), []byte(strconv.FormatUint(version, 10))); err != nil {
+		return false, fmt.Errorf("failed to write the version of side input %q, %w", name, err)
+	}
+	return true, nil
+}
+
+func writeFileAtomically(path string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	tmpPath := f.Name()
+	defer func() {
+		_ = os.Remove(tmpPath)
+	}()
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	// the temp file is created with 0600, the values should be readable by the UDF containers running as other users.
+	if err := os.Chmod(tmpPath, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sideinputs
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateSideInputFile(t *testing.T) {
+	dir := t.TempDir()
+	version, err := GetSideInputVersion(dir, "si")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), version)
+
+	updated, err := UpdateSideInputFile(dir, "si", []byte("v2"), 2)
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	b, err := os.ReadFile(GetSideInputFilePath(dir, "si"))
+	assert.NoError(t, err)
+	assert.Equal(t, "v2", string(b))
+	version, err = GetSideInputVersion(dir, "si")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), version)
+
+	// an older or the same version is ignored
+	for _, v := range []uint64{1, 2} {
+		updated, err = UpdateSideInputFile(dir, "si", []byte("old"), v)
+		assert.NoError(t, err)
+		assert.False(t, updated)
+	}
+	b, err = os.ReadFile(GetSideInputFilePath(dir, "si"))
+	assert.NoError(t, err)
+	assert.Equal(t, "v2", string(b))
+
+	updated, err = UpdateSideInputFile(dir, "si", []byte("v3"), 3)
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	b, err = os.ReadFile(GetSideInputFilePath(dir, "si"))
+	assert.NoError(t, err)
+	assert.Equal(t, "v3", string(b))
+
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2) // no temp files left
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package synchronizer writes the values of the Side Inputs of a vertex from the side inputs store to the files in the
+// side inputs volume, which is mounted to the UDF container.
+package synchronizer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sideinputs"
+)
+
+// retryInterval is the interval to check the side inputs store again, when a Side Input is not available yet or
+// the store watcher is stopped unexpectedly.
+const retryInterval = time.Second
+
+type sideInputsSynchronizer struct {
+	kv         nats.KeyValue
+	sideInputs map[string]struct{}
+	mountPath  string
+}
+
+// NewSideInputsSynchronizer returns a synchronizer of the given Side Inputs, which writes the values in the kv store
+// to the files in the mount path.
+func NewSideInputsSynchronizer(kv nats.KeyValue, sideInputs []string, mountPath string) *sideInputsSynchronizer {
+	s := &sideInputsSynchronizer{
+		kv:         kv,
+		sideInputs: make(map[string]struct{}, len(sideInputs)),
+		mountPath:  mountPath,
+	}
+	for _, si := range sideInputs {
+		s.sideInputs[si] = struct{}{}
+	}
+	return s
+}
+
+// WaitForSideInputs blocks until the values of all the Side Inputs are available and written to the files, it's run
+// by the init container so that the vertex starts processing after all the Side Inputs are ready.
+func (s *sideInputsSynchronizer) WaitForSideInputs(ctx context.Context) error {
+	log := logging.FromContext(ctx)
+	for name := range s.sideInputs {
+		for {
+			entry, err := s.kv.Get(name)
+			if err == nil {
+				if _, err := sideinputs.UpdateSideInputFile(s.mountPath, name, entry.Value(), entry.Revision()); err != nil {
+					return err
+				}
+				log.Infow("Side input is ready", zap.String("sideInput", name), zap.Uint64("version", entry.Revision()))
+				break
+			}
+			if errors.Is(err, nats.ErrKeyNotFound) {
+				log.Infow("Side input is not available yet, waiting...", zap.String("sideInput", name))
+			} else {
+				log.Errorw("Failed to get the side input from the store", zap.String("sideInput", name), zap.Error(err))
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(retryInterval):
+			}
+		}
+	}
+	return nil
+}
+
+// Start watches the side inputs store and updates the files when the values of the Side Inputs change, until the
+// context is canceled. A value older than the one written is ignored.
+func (s *sideInputsSynchronizer) Start(ctx context.Context) error {
+	log := logging.FromContext(ctx)
+	for {
+		if err := s.watch(ctx); err != nil {
+			log.Errorw("Failed to watch the side inputs store", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			log.Info("Side inputs synchronizer is stopped")
+			return nil
+		case <-time.After(retryInterval):
+			log.Info("Restarting the side inputs store watcher")
+		}
+	}
+}
+
+// watch runs a watcher of the side inputs store until it's stopped.
+func (s *sideInputsSynchronizer) watch(ctx context.Context) error {
+	log := logging.FromContext(ctx)
+	watcher, err := s.kv.WatchAll(nats.Context(ctx))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = watcher.Stop()
+	}()
+	for entry := range watcher.Updates() {
+		if entry == nil { // all the existing values have been received
+			continue
+		}
+		if _, ok := s.sideInputs[entry.Key()]; !ok || entry.Operation() != nats.KeyValuePut {
+			continue
+		}
+		updated, err := sideinputs.UpdateSideInputFile(s.mountPath, entry.Key(), entry.Value(), entry.Revision())
+		if err != nil {
+			log.Errorw("Failed to update the side input file", zap.String("sideInput", entry.Key()), zap.Error(err))
+			continue
+		}
+		if updated {
+			log.Infow("Side input is updated", zap.String("sideInput", entry.Key()), zap.Uint64("version", entry.Revision()))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+
+	natstest "github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
+	"github.com/numaproj/numaflow/pkg/sideinputs"
+)
+
+func newTestKV(t *testing.T) nats.KeyValue {
+	t.Helper()
+	s := natstest.RunJetStreamServer(t)
+	t.Cleanup(func() { natstest.ShutdownJetStreamServer(t, s) })
+	conn, err := natstest.JetStreamClient(t, s).Connect(context.Background())
+	assert.NoError(t, err)
+	t.Cleanup(conn.Close)
+	js, err := conn.JetStream()
+	assert.NoError(t, err)
+	kv, err := js.CreateKeyValue(&nats.KeyValueConfig{Bucket: "test_SIDE_INPUTS", History: 1})
+	assert.NoError(t, err)
+	return kv
+}
+
+func readSideInput(t *testing.T, mountPath, name string) string {
+	t.Helper()
+	b, err := os.ReadFile(sideinputs.GetSideInputFilePath(mountPath, name))
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func TestSideInputsSynchronizer_WaitForSideInputs(t *testing.T) {
+	kv := newTestKV(t)
+	mountPath := t.TempDir()
+	s := NewSideInputsSynchronizer(kv, []string{"a", "b"}, mountPath)
+	_, err := kv.Put("a", []byte("value-a"))
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	done := make(chan error)
+	go func() {
+		done <- s.WaitForSideInputs(ctx)
+	}()
+	select {
+	case <-done:
+		t.Fatal("should wait for side input b")
+	case <-time.After(1500 * time.Millisecond):
+	}
+	_, err = kv.Put("b", []byte("value-b"))
+	assert.NoError(t, err)
+	assert.NoError(t, <-done)
+	assert.Equal(t, "value-a", readSideInput(t, mountPath, "a"))
+	assert.Equal(t, "value-b", readSideInput(t, mountPath, "b"))
+
+	// canceled before the side inputs are available
+	s = NewSideInputsSynchronizer(kv, []string{"c"}, mountPath)
+	cctx, ccancel := context.WithCancel(context.Background())
+	ccancel()
+	assert.ErrorIs(t, s.WaitForSideInputs(cctx), context.Canceled)
+}
+
+func TestSideInputsSynchronizer_Start(t *testing.T) {
+	kv := newTestKV(t)
+	mountPath := t.TempDir()
+	_, err := kv.Put("a", []byte("v1"))
+	assert.NoError(t, err)
+	_, err = kv.Put("other", []byte("x"))
+	assert.NoError(t, err)
+
+	s := NewSideInputsSynchronizer(kv, []string{"a"}, mountPath)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.NoError(t, s.Start(ctx))
+	}()
+	assert.Eventually(t, func() bool { return readSideInput(t, mountPath, "a") == "v1" }, 5*time.Second, 50*time.Millisecond)
+
+	rev, err := kv.Put("a", []byte("v2"))
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool { return readSideInput(t, mountPath, "a") == "v2" }, 5*time.Second, 50*time.Millisecond)
+	version, err := sideinputs.GetSideInputVersion(mountPath, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, rev, version)
+	assert.Equal(t, "", readSideInput(t, mountPath, "other"))
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("synchronizer is not stopped")
+	}
+}
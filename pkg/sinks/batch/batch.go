@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package batch accumulates the messages read by a sink into larger batches.
+//
+// The forwarder of a sink writes the messages of each read to the sink, and acknowledges them after the write
+// succeeds. Batching is done on the read side of the forwarder, so that the sink receives the accumulated batch in
+// a single write, and the messages are only acknowledged after the whole batch is written.
+package batch
+
+import (
+	"context"
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+const (
+	flushReasonSize    = "size"
+	flushReasonBytes   = "bytes"
+	flushReasonLatency = "latency"
+)
+
+// Batcher is an isb.BufferReader which keeps reading from the underlying reader until the batch reaches the max size,
+// the max bytes or the max latency.
+type Batcher struct {
+	isb.BufferReader
+	vertexName    string
+	pipelineName  string
+	maxBatchSize  int64
+	maxBatchBytes int64
+	maxLatency    time.Duration
+}
+
+// NewBatcher returns a Batcher reading from the reader, configured by the batching spec of the sink vertex.
+func NewBatcher(vertex *dfv1.Vertex, reader isb.BufferReader) *Batcher {
+	batching := dfv1.SinkBatching{}
+	if x := vertex.Spec.Sink; x != nil && x.Batching != nil {
+		batching = *x.Batching
+	}
+	return &Batcher{
+		BufferReader:  reader,
+		vertexName:    vertex.Spec.Name,
+		pipelineName:  vertex.Spec.PipelineName,
+		maxBatchSize:  batching.GetMaxBatchSize(),
+		maxBatchBytes: batching.GetMaxBatchBytes(),
+		maxLatency:    batching.GetMaxLatency(),
+	}
+}
+
+// Read reads a batch of messages. It returns as soon as the first read is empty, so that the forwarder can handle the
+// idling, otherwise it keeps reading at most count messages at a time until the batch is full or the max latency passes.
+func (b *Batcher) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	messages, err := b.BufferReader.Read(ctx, b.readCount(count, 0))
+	if err != nil || len(messages) == 0 {
+		return messages, err
+	}
+	bytes := payloadSize(messages)
+	// the reads are bounded by the max latency of the batch.
+	bctx, cancel := context.WithTimeout(ctx, b.maxLatency)
+	defer cancel()
+	var reason string
+	for {
+		if int64(len(messages)) >= b.maxBatchSize {
+			reason = flushReasonSize
+			break
+		}
+		if b.maxBatchBytes > 0 && bytes >= b.maxBatchBytes {
+			reason = flushReasonBytes
+			break
+		}
+		if bctx.Err() != nil {
+			reason = flushReasonLatency
+			break
+		}
+		more, err := b.BufferReader.Read(bctx, b.readCount(count, len(messages)))
+		messages = append(messages, more...)
+		bytes += payloadSize(more)
+		// the error caused by reaching the max latency is expected, the batch is flushed in the next iteration.
+		if err != nil && (ctx.Err() != nil || bctx.Err() == nil) {
+			return messages, err
+		}
+	}
+	batchFlushCount.With(map[string]string{metrics.LabelVertex: b.vertexName, metrics.LabelPipeline: b.pipelineName, labelReason: reason}).Inc()
+	batchMessageCount.With(map[string]string{metrics.LabelVertex: b.vertexName, metrics.LabelPipeline: b.pipelineName}).Observe(float64(len(messages)))
+	return messages, nil
+}
+
+// readCount returns the number of messages of the next read, which is at most count, and doesn't exceed the max batch size.
+func (b *Batcher) readCount(count int64, read int) int64 {
+	if remaining := b.maxBatchSize - int64(read); remaining < count {
+		return remaining
+	}
+	return count
+}
+
+func payloadSize(messages []*isb.ReadMessage) int64 {
+	var size int64
+	for _, m := range messages {
+		size += int64(len(m.Payload))
+	}
+	return size
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+)
+
+var testStartTime = time.Unix(1636470000, 0).UTC()
+
+func newTestBatcher(t *testing.T, batching *dfv1.SinkBatching, count int64) (*Batcher, *simplebuffer.InMemoryBuffer) {
+	buffer := simplebuffer.NewInMemoryBuffer("from", 100, 0, simplebuffer.WithReadTimeOut(100*time.Millisecond))
+	_, errs := buffer.Write(context.Background(), testutils.BuildTestWriteMessages(count, testStartTime))
+	assert.Equal(t, make([]error, count), errs)
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "sink",
+			Sink: &dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					Blackhole: &dfv1.Blackhole{},
+				},
+				Batching: batching,
+			},
+		},
+	}}
+	return NewBatcher(vertex, buffer), buffer
+}
+
+func TestBatcher_Read(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	t.Run("max batch size", func(t *testing.T) {
+		size := uint64(4)
+		b, _ := newTestBatcher(t, &dfv1.SinkBatching{MaxBatchSize: &size, MaxLatency: &metav1.Duration{Duration: time.Minute}}, 10)
+		// the forwarder reads 3 messages at a time
+		messages, err := b.Read(ctx, 3)
+		assert.NoError(t, err)
+		assert.Len(t, messages, 4)
+		assert.Equal(t, "3", messages[3].ID)
+		messages, err = b.Read(ctx, 3)
+		assert.NoError(t, err)
+		assert.Len(t, messages, 4)
+		assert.Equal(t, "4", messages[0].ID)
+	})
+
+	t.Run("max batch bytes", func(t *testing.T) {
+		b, _ := newTestBatcher(t, nil, 10)
+		first, err := b.BufferReader.Read(ctx, 1)
+		assert.NoError(t, err)
+		// two and a half payloads
+		b.maxBatchBytes = int64(len(first[0].Payload) * 5 / 2)
+		b.maxLatency = time.Minute
+		messages, err := b.Read(ctx, 1)
+		assert.NoError(t, err)
+		assert.Len(t, messages, 3)
+	})
+
+	t.Run("max latency", func(t *testing.T) {
+		b, _ := newTestBatcher(t, &dfv1.SinkBatching{MaxLatency: &metav1.Duration{Duration: 300 * time.Millisecond}}, 2)
+		start := time.Now()
+		messages, err := b.Read(ctx, 1)
+		assert.NoError(t, err)
+		assert.Len(t, messages, 2)
+		assert.GreaterOrEqual(t, time.Since(start), 300*time.Millisecond)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		b, _ := newTestBatcher(t, &dfv1.SinkBatching{MaxLatency: &metav1.Duration{Duration: time.Minute}}, 0)
+		messages, err := b.Read(ctx, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, messages)
+	})
+
+	t.Run("ack", func(t *testing.T) {
+		size := uint64(5)
+		b, buffer := newTestBatcher(t, &dfv1.SinkBatching{MaxBatchSize: &size}, 5)
+		messages, err := b.Read(ctx, 2)
+		assert.NoError(t, err)
+		assert.Len(t, messages, 5)
+		offsets := make([]isb.Offset, len(messages))
+		for i, m := range messages {
+			offsets[i] = m.ReadOffset
+		}
+		assert.Equal(t, make([]error, 5), b.Ack(ctx, offsets))
+		assert.True(t, buffer.IsEmpty())
+	})
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+const labelReason = "reason"
+
+// batchFlushCount is used to indicate the number of batches flushed to the sink, by the reason of the flush
+var batchFlushCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "sink_batching",
+	Name:      "flush_total",
+	Help:      "Total number of batches flushed to the sink, by the reason of size, bytes or latency",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, labelReason})
+
+// batchMessageCount is used to indicate the number of messages of the batches flushed to the sink
+var batchMessageCount = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Subsystem: "sink_batching",
+	Name:      "batch_messages",
+	Help:      "Number of messages of the batches flushed to the sink",
+	Buckets:   prometheus.ExponentialBuckets(1, 2, 14),
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
@@ -54,8 +54,32 @@ func NewBlackhole(vertex *dfv1.Vertex,
 	fetchWatermark fetch.Fetcher,
 	publishWatermark map[string]publish.Publisher,
 	whereToDecider forward.GoWhere,
+	forwardOpts []forward.Option,
 	opts ...Option) (*Blackhole, error) {
 
+	bh, err := NewBlackholeWriter(vertex, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultOpts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeSink), forward.WithLogger(bh.logger)}
+	if x := vertex.Spec.Limits; x != nil {
+		if x.ReadBatchSize != nil {
+			defaultOpts = append(defaultOpts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
+		}
+	}
+
+	isdf, err := forward.NewInterStepDataForward(vertex, fromBuffer, map[string][]isb.BufferWriter{vertex.Spec.Name: {bh}}, whereToDecider, applier.Terminal, fetchWatermark, publishWatermark, append(defaultOpts, forwardOpts...)...)
+	if err != nil {
+		return nil, err
+	}
+	bh.isdf = isdf
+
+	return bh, nil
+}
+
+// NewBlackholeWriter returns Blackhole type without a forwarder, which is only written to, e.g. as a fallback sink.
+func NewBlackholeWriter(vertex *dfv1.Vertex, opts ...Option) (*Blackhole, error) {
 	bh := new(Blackhole)
 	name := vertex.Spec.Name
 	bh.name = name
@@ -69,20 +93,6 @@ func NewBlackhole(vertex *dfv1.Vertex,
 	if bh.logger == nil {
 		bh.logger = logging.NewLogger()
 	}
-
-	forwardOpts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeSink), forward.WithLogger(bh.logger)}
-	if x := vertex.Spec.Limits; x != nil {
-		if x.ReadBatchSize != nil {
-			forwardOpts = append(forwardOpts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
-		}
-	}
-
-	isdf, err := forward.NewInterStepDataForward(vertex, fromBuffer, map[string][]isb.BufferWriter{vertex.Spec.Name: {bh}}, whereToDecider, applier.Terminal, fetchWatermark, publishWatermark, forwardOpts...)
-	if err != nil {
-		return nil, err
-	}
-	bh.isdf = isdf
-
 	return bh, nil
 }
 
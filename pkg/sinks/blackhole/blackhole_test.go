@@ -62,12 +62,14 @@ func TestBlackhole_Start(t *testing.T) {
 		AbstractVertex: dfv1.AbstractVertex{
 			Name: "sinks.blackhole",
 			Sink: &dfv1.Sink{
-				Blackhole: &dfv1.Blackhole{},
+				AbstractSink: dfv1.AbstractSink{
+					Blackhole: &dfv1.Blackhole{},
+				},
 			},
 		},
 	}}
 	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferList([]string{vertex.Spec.Name})
-	s, err := NewBlackhole(vertex, fromStep, fetchWatermark, publishWatermark, getSinkGoWhereDecider(vertex.Spec.Name))
+	s, err := NewBlackhole(vertex, fromStep, fetchWatermark, publishWatermark, getSinkGoWhereDecider(vertex.Spec.Name), nil)
 	assert.NoError(t, err)
 
 	stopped := s.Start()
@@ -99,7 +101,9 @@ func TestBlackhole_ForwardToTwoVertex(t *testing.T) {
 		AbstractVertex: dfv1.AbstractVertex{
 			Name: "sinks.blackhole1",
 			Sink: &dfv1.Sink{
-				Blackhole: &dfv1.Blackhole{},
+				AbstractSink: dfv1.AbstractSink{
+					Blackhole: &dfv1.Blackhole{},
+				},
 			},
 		},
 	}}
@@ -108,14 +112,16 @@ func TestBlackhole_ForwardToTwoVertex(t *testing.T) {
 		AbstractVertex: dfv1.AbstractVertex{
 			Name: "sinks.blackhole2",
 			Sink: &dfv1.Sink{
-				Blackhole: &dfv1.Blackhole{},
+				AbstractSink: dfv1.AbstractSink{
+					Blackhole: &dfv1.Blackhole{},
+				},
 			},
 		},
 	}}
 	fetchWatermark1, publishWatermark1 := generic.BuildNoOpWatermarkProgressorsFromBufferList([]string{vertex1.Spec.Name})
-	bh1, _ := NewBlackhole(vertex1, to1, fetchWatermark1, publishWatermark1, getSinkGoWhereDecider(vertex1.Spec.Name))
+	bh1, _ := NewBlackhole(vertex1, to1, fetchWatermark1, publishWatermark1, getSinkGoWhereDecider(vertex1.Spec.Name), nil)
 	fetchWatermark2, publishWatermark2 := generic.BuildNoOpWatermarkProgressorsFromBufferList([]string{vertex2.Spec.Name})
-	bh2, _ := NewBlackhole(vertex2, to2, fetchWatermark2, publishWatermark2, getSinkGoWhereDecider(vertex2.Spec.Name))
+	bh2, _ := NewBlackhole(vertex2, to2, fetchWatermark2, publishWatermark2, getSinkGoWhereDecider(vertex2.Spec.Name), nil)
 	bh1Stopped := bh1.Start()
 	bh2Stopped := bh2.Start()
 
@@ -0,0 +1,368 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forward"
+	"github.com/numaproj/numaflow/pkg/forward/applier"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/ndjson"
+	"github.com/numaproj/numaflow/pkg/watermark/fetch"
+	"github.com/numaproj/numaflow/pkg/watermark/publish"
+)
+
+// ToFile writes the output to the rolling files in a volume, partitioned by the event time.
+type ToFile struct {
+	name         string
+	pipelineName string
+	replica      int32
+	fileSink     *dfv1.FileSink
+	// directory where the partitions are created
+	dir       string
+	isdf      *forward.InterStepDataForward
+	watermark *fetch.Recorder
+	encoder   *ndjson.Encoder
+	// converts the files to Parquet, nil if the format is not Parquet
+	parquet       *parquetConverter
+	checkInterval time.Duration
+	lock          sync.Mutex
+	// partitions keyed by the start time in milliseconds
+	partitions map[int64]*partition
+	done       chan struct{}
+	wg         sync.WaitGroup
+	log        *zap.SugaredLogger
+}
+
+type Option func(*ToFile) error
+
+func WithLogger(log *zap.SugaredLogger) Option {
+	return func(t *ToFile) error {
+		t.log = log
+		return nil
+	}
+}
+
+// WithRootDir sets the directory where the volume is mounted, defaults to dfv1.PathFileSinkMount
+func WithRootDir(dir string) Option {
+	return func(t *ToFile) error {
+		t.dir = dir
+		return nil
+	}
+}
+
+// WithCheckInterval sets the interval to roll and finalize the files when there's no message, defaults to 1s.
+func WithCheckInterval(d time.Duration) Option {
+	return func(t *ToFile) error {
+		t.checkInterval = d
+		return nil
+	}
+}
+
+// NewToFile returns ToFile type.
+func NewToFile(vertexInstance *dfv1.VertexInstance,
+	fromBuffer isb.BufferReader,
+	fetchWatermark fetch.Fetcher,
+	publishWatermark map[string]publish.Publisher,
+	whereToDecider forward.GoWhere,
+	forwardOpts []forward.Option,
+	opts ...Option) (*ToFile, error) {
+
+	toFile, err := NewToFileWriter(vertexInstance, fetchWatermark, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	vertex := vertexInstance.Vertex
+	defaultOpts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeSink), forward.WithLogger(toFile.log)}
+	if x := vertex.Spec.Limits; x != nil {
+		if x.ReadBatchSize != nil {
+			defaultOpts = append(defaultOpts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
+		}
+	}
+	// the forwarder fetches the watermark of each batch through the recorder, which is used to finalize the files.
+	f, err := forward.NewInterStepDataForward(vertex, fromBuffer, map[string][]isb.BufferWriter{vertex.Spec.Name: {toFile}}, whereToDecider, applier.Terminal, toFile.watermark, publishWatermark, append(defaultOpts, forwardOpts...)...)
+	if err != nil {
+		_ = toFile.Close()
+		return nil, err
+	}
+	toFile.isdf = f
+	return toFile, nil
+}
+
+// NewToFileWriter returns ToFile type without a forwarder, e.g. for a fallback sink. The files are rolled and
+// finalized in the background until it's closed, with the watermark recorded by the forwarder using the same
+// fetch.Recorder.
+func NewToFileWriter(vertexInstance *dfv1.VertexInstance, fetchWatermark fetch.Fetcher, opts ...Option) (*ToFile, error) {
+	vertex := vertexInstance.Vertex
+	fileSink := vertex.Spec.Sink.File
+	toFile := &ToFile{
+		name:          vertex.Spec.Name,
+		pipelineName:  vertex.Spec.PipelineName,
+		replica:       vertexInstance.Replica,
+		fileSink:      fileSink,
+		dir:           dfv1.PathFileSinkMount,
+		checkInterval: time.Second,
+		partitions:    make(map[int64]*partition),
+		done:          make(chan struct{}),
+	}
+	for _, o := range opts {
+		if err := o(toFile); err != nil {
+			return nil, err
+		}
+	}
+	if toFile.log == nil {
+		toFile.log = logging.NewLogger()
+	}
+	toFile.log = toFile.log.With("sinkType", "file")
+	toFile.dir = filepath.Join(toFile.dir, fileSink.Path)
+	var err error
+	if fileSink.GetFormat() == dfv1.FileSinkFormatParquet {
+		if toFile.parquet, err = newParquetConverter(fileSink); err != nil {
+			return nil, err
+		}
+		// the messages are written to uncompressed NDJSON files, and compressed when converted to Parquet.
+		toFile.encoder, _ = ndjson.NewEncoder(dfv1.FileCompressionNone)
+	} else if toFile.encoder, err = ndjson.NewEncoder(fileSink.GetCompression()); err != nil {
+		return nil, err
+	}
+	if err := toFile.recover(); err != nil {
+		return nil, fmt.Errorf("failed to recover in-progress files, %w", err)
+	}
+	toFile.watermark = fetch.NewRecorder(fetchWatermark)
+
+	toFile.wg.Add(1)
+	go func() {
+		defer toFile.wg.Done()
+		ticker := time.NewTicker(toFile.checkInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-toFile.done:
+				return
+			case <-ticker.C:
+				toFile.lock.Lock()
+				toFile.finalizePartitions()
+				toFile.lock.Unlock()
+			}
+		}
+	}()
+	return toFile, nil
+}
+
+// recover picks up the in-progress files written by the replica before restarting, they are finalized when the
+// watermark passes the end of their partitions.
+func (tf *ToFile) recover() error {
+	return filepath.WalkDir(tf.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == tf.dir && errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		start, ok := parseFileName(d.Name(), tf.name, tf.replica)
+		if !ok {
+			return nil
+		}
+		p := tf.getPartition(start)
+		p.rolled = append(p.rolled, path)
+		tf.log.Infow("Recovered in-progress file", zap.String("path", path))
+		return nil
+	})
+}
+
+// getPartition returns the partition starting at the time, it's created if it doesn't exist.
+func (tf *ToFile) getPartition(start time.Time) *partition {
+	if p, ok := tf.partitions[start.UnixMilli()]; ok {
+		return p
+	}
+	p := &partition{
+		start: start,
+		end:   start.Add(tf.fileSink.GetPartitionDuration()),
+		dir:   filepath.Join(tf.dir, start.Format(tf.fileSink.GetPartitionFormat())),
+	}
+	tf.partitions[start.UnixMilli()] = p
+	return p
+}
+
+// GetName returns the name.
+func (tf *ToFile) GetName() string {
+	return tf.name
+}
+
+// GetPartitionIdx returns the partition index.
+// for sink it is always 0.
+func (tf *ToFile) GetPartitionIdx() int32 {
+	return 0
+}
+
+// Write writes the messages to the files of the partitions they belong to. The data is synced to the disk before
+// returning, so that the messages are not lost after they are acknowledged.
+func (tf *ToFile) Write(_ context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	errs := make([]error, len(messages))
+	// group the messages by partitions
+	var starts []time.Time
+	groups := make(map[time.Time][]int)
+	for i, msg := range messages {
+		start := msg.EventTime.UTC().Truncate(tf.fileSink.GetPartitionDuration())
+		if _, ok := groups[start]; !ok {
+			starts = append(starts, start)
+		}
+		groups[start] = append(groups[start], i)
+	}
+
+	tf.lock.Lock()
+	defer tf.lock.Unlock()
+	for _, start := range starts {
+		indexes := groups[start]
+		var buf bytes.Buffer
+		for _, idx := range indexes {
+			ndjson.WriteLine(&buf, messages[idx].Payload)
+			if tf.parquet != nil {
+				tf.parquet.sample(messages[idx].Payload)
+			}
+		}
+		err := tf.writePartition(tf.getPartition(start), buf.Bytes())
+		for _, idx := range indexes {
+			errs[idx] = err
+		}
+	}
+	labels := map[string]string{metrics.LabelVertex: tf.name, metrics.LabelPipeline: tf.pipelineName}
+	for _, err := range errs {
+		if err != nil {
+			fileSinkWriteErrors.With(labels).Inc()
+		} else {
+			fileSinkWriteCount.With(labels).Inc()
+		}
+	}
+	tf.finalizePartitions()
+	return nil, errs
+}
+
+func (tf *ToFile) writePartition(p *partition, data []byte) error {
+	if p.current == nil {
+		ext := tf.fileSink.GetFileExtension()
+		if tf.parquet != nil {
+			ext = stagingExtension
+		}
+		rf, err := openRollingFile(filepath.Join(p.dir, fileName(tf.name, tf.replica, p.start, ext)))
+		if err != nil {
+			return err
+		}
+		p.current = rf
+	}
+	encoded, err := tf.encoder.Encode(data)
+	if err != nil {
+		return fmt.Errorf("failed to compress data, %w", err)
+	}
+	if err := p.current.write(encoded); err != nil {
+		// the file might be partially written, roll it to avoid writing after the corrupted data
+		tf.roll(p)
+		return fmt.Errorf("failed to write to file, %w", err)
+	}
+	if p.current.size >= tf.fileSink.GetMaxFileSize() {
+		tf.roll(p)
+	}
+	return nil
+}
+
+// roll closes the current file of the partition, it's finalized together with the other files of the partition.
+func (tf *ToFile) roll(p *partition) {
+	if p.current == nil {
+		return
+	}
+	if err := p.current.close(); err != nil {
+		tf.log.Errorw("Failed to close file", zap.String("path", p.current.path), zap.Error(err))
+	}
+	p.rolled = append(p.rolled, p.current.path)
+	p.current = nil
+}
+
+// finalizePartitions finalizes the files of the partitions which end before the watermark, and rolls the files
+// which have been opened for longer than the roll interval.
+func (tf *ToFile) finalizePartitions() {
+	wm := tf.watermark.Get()
+	for key, p := range tf.partitions {
+		if p.current != nil && time.Since(p.current.opened) >= tf.fileSink.GetRollInterval() {
+			tf.roll(p)
+		}
+		if wm.Before(p.end) {
+			continue
+		}
+		tf.roll(p)
+		var failed []string
+		for _, path := range p.rolled {
+			if err := tf.finalizeFile(path); err != nil {
+				tf.log.Errorw("Failed to finalize file", zap.String("path", path), zap.Error(err))
+				failed = append(failed, path)
+				continue
+			}
+			fileSinkFinalizedCount.With(map[string]string{metrics.LabelVertex: tf.name, metrics.LabelPipeline: tf.pipelineName}).Inc()
+		}
+		if len(failed) > 0 {
+			p.rolled = failed
+			continue
+		}
+		delete(tf.partitions, key)
+	}
+}
+
+func (tf *ToFile) Close() error {
+	tf.log.Info("Closing file sink...")
+	close(tf.done)
+	tf.wg.Wait()
+	tf.lock.Lock()
+	defer tf.lock.Unlock()
+	// the files are left in progress, and recovered after restarting
+	for _, p := range tf.partitions {
+		tf.roll(p)
+	}
+	return nil
+}
+
+// Start starts sinking to the files.
+func (tf *ToFile) Start() <-chan struct{} {
+	return tf.isdf.Start()
+}
+
+// Stop stops sinking
+func (tf *ToFile) Stop() {
+	tf.isdf.Stop()
+	tf.log.Info("forwarder stopped successfully")
+}
+
+// ForceStop stops sinking
+func (tf *ToFile) ForceStop() {
+	tf.isdf.ForceStop()
+	tf.log.Info("forwarder force stopped successfully")
+}
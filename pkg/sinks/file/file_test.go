@@ -0,0 +1,310 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/sinks/testutils"
+	"github.com/numaproj/numaflow/pkg/watermark/fetch"
+	"github.com/numaproj/numaflow/pkg/watermark/generic"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+var testStart = time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+// listFiles returns the paths of the files relative to the dir.
+func listFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	var files []string
+	require.NoError(t, filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, _ := filepath.Rel(dir, path)
+		files = append(files, rel)
+		return nil
+	}))
+	sort.Strings(files)
+	return files
+}
+
+func readLines(t *testing.T, path string, compression dfv1.FileCompression) []string {
+	t.Helper()
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var r io.Reader = f
+	switch compression {
+	case dfv1.FileCompressionGzip:
+		gr, err := gzip.NewReader(f)
+		require.NoError(t, err)
+		r = gr
+	case dfv1.FileCompressionZstd:
+		zr, err := zstd.NewReader(f)
+		require.NoError(t, err)
+		defer zr.Close()
+		r = zr
+	}
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	require.NoError(t, scanner.Err())
+	return lines
+}
+
+func TestToFile_Write(t *testing.T) {
+	dir := t.TempDir()
+	toFile, err := NewToFileWriter(&dfv1.VertexInstance{Vertex: testutils.BuildTestVertex(dfv1.AbstractSink{File: &dfv1.FileSink{}}), Replica: 0}, &testFetcher{}, WithRootDir(dir))
+	require.NoError(t, err)
+	_, errs := toFile.Write(context.Background(), []isb.Message{
+		testutils.BuildTestMessage("0", testStart.Add(time.Minute), `{"a": 1}`),
+		testutils.BuildTestMessage("1", testStart.Add(time.Hour), `{"a": 2}`),
+		testutils.BuildTestMessage("2", testStart.Add(2*time.Minute), "hello"),
+	})
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	files := listFiles(t, dir)
+	require.Len(t, files, 2)
+	assert.True(t, strings.HasPrefix(files[0], "dt=2026-10-16/hr=09/testVertex-0-"))
+	assert.True(t, strings.HasSuffix(files[0], ".ndjson.inprogress"))
+	assert.True(t, strings.HasPrefix(files[1], "dt=2026-10-16/hr=10/testVertex-0-"))
+	assert.Equal(t, []string{`{"a":1}`, `"hello"`}, readLines(t, filepath.Join(dir, files[0]), dfv1.FileCompressionNone))
+
+	// the watermark passes the end of the first partition
+	advanceWatermark(toFile, testStart.Add(time.Hour))
+	toFile.lock.Lock()
+	toFile.finalizePartitions()
+	toFile.lock.Unlock()
+	files = listFiles(t, dir)
+	require.Len(t, files, 2)
+	assert.True(t, strings.HasSuffix(files[0], ".ndjson"))
+	assert.True(t, strings.HasSuffix(files[1], ".ndjson.inprogress"))
+
+	// late data is written to a new file, and finalized right away
+	_, errs = toFile.Write(context.Background(), []isb.Message{testutils.BuildTestMessage("3", testStart.Add(3*time.Minute), `{"a": 3}`)})
+	assert.NoError(t, errs[0])
+	files = listFiles(t, dir)
+	require.Len(t, files, 3)
+	assert.True(t, strings.HasPrefix(files[1], "dt=2026-10-16/hr=09/"))
+	assert.True(t, strings.HasSuffix(files[1], ".ndjson"))
+	assert.NoError(t, toFile.Close())
+}
+
+func TestToFile_Compression(t *testing.T) {
+	for _, compression := range []dfv1.FileCompression{dfv1.FileCompressionGzip, dfv1.FileCompressionZstd} {
+		t.Run(string(compression), func(t *testing.T) {
+			dir := t.TempDir()
+			toFile, err := NewToFileWriter(&dfv1.VertexInstance{Vertex: testutils.BuildTestVertex(dfv1.AbstractSink{File: &dfv1.FileSink{Compression: compression}}), Replica: 0}, &testFetcher{}, WithRootDir(dir))
+			require.NoError(t, err)
+			for i := 0; i < 3; i++ {
+				_, errs := toFile.Write(context.Background(), []isb.Message{testutils.BuildTestMessage("0", testStart, `{"a": 1}`), testutils.BuildTestMessage("1", testStart, `{"b": 2}`)})
+				assert.NoError(t, errs[0])
+			}
+			files := listFiles(t, dir)
+			require.Len(t, files, 1)
+			lines := readLines(t, filepath.Join(dir, files[0]), compression)
+			assert.Len(t, lines, 6)
+			assert.Equal(t, `{"b":2}`, lines[5])
+			assert.NoError(t, toFile.Close())
+		})
+	}
+}
+
+func TestToFile_Roll(t *testing.T) {
+	dir := t.TempDir()
+	size := apiresource.MustParse("10")
+	toFile, err := NewToFileWriter(&dfv1.VertexInstance{Vertex: testutils.BuildTestVertex(dfv1.AbstractSink{File: &dfv1.FileSink{MaxFileSize: &size, PartitionFormat: "2006/01/02"}}), Replica: 0}, &testFetcher{}, WithRootDir(dir))
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, errs := toFile.Write(context.Background(), []isb.Message{testutils.BuildTestMessage("0", testStart, `{"message": "more than 10 bytes"}`)})
+		assert.NoError(t, errs[0])
+	}
+	files := listFiles(t, dir)
+	require.Len(t, files, 3)
+	for _, f := range files {
+		assert.True(t, strings.HasPrefix(f, "2026/10/16/"))
+	}
+	p := toFile.partitions[testStart.UnixMilli()]
+	assert.Nil(t, p.current)
+	assert.Len(t, p.rolled, 3)
+	assert.NoError(t, toFile.Close())
+}
+
+func TestToFile_Recover(t *testing.T) {
+	dir := t.TempDir()
+	toFile, err := NewToFileWriter(&dfv1.VertexInstance{Vertex: testutils.BuildTestVertex(dfv1.AbstractSink{File: &dfv1.FileSink{}}), Replica: 0}, &testFetcher{}, WithRootDir(dir))
+	require.NoError(t, err)
+	_, errs := toFile.Write(context.Background(), []isb.Message{testutils.BuildTestMessage("0", testStart, `{"a": 1}`)})
+	assert.NoError(t, errs[0])
+	assert.NoError(t, toFile.Close())
+	other, err := NewToFileWriter(&dfv1.VertexInstance{Vertex: testutils.BuildTestVertex(dfv1.AbstractSink{File: &dfv1.FileSink{}}), Replica: 1}, &testFetcher{}, WithRootDir(dir))
+	require.NoError(t, err)
+	_, errs = other.Write(context.Background(), []isb.Message{testutils.BuildTestMessage("1", testStart, `{"a": 2}`)})
+	assert.NoError(t, errs[0])
+	assert.NoError(t, other.Close())
+
+	toFile, err = NewToFileWriter(&dfv1.VertexInstance{Vertex: testutils.BuildTestVertex(dfv1.AbstractSink{File: &dfv1.FileSink{}}), Replica: 0}, &testFetcher{}, WithRootDir(dir))
+	require.NoError(t, err)
+	require.Len(t, toFile.partitions, 1)
+	advanceWatermark(toFile, testStart.Add(time.Hour))
+	toFile.lock.Lock()
+	toFile.finalizePartitions()
+	toFile.lock.Unlock()
+	files := listFiles(t, dir)
+	require.Len(t, files, 2)
+	var finalized, inProgress int
+	for _, f := range files {
+		if strings.HasSuffix(f, inProgressSuffix) {
+			assert.Contains(t, f, "/testVertex-1-")
+			inProgress++
+		} else {
+			assert.Contains(t, f, "/testVertex-0-")
+			finalized++
+		}
+	}
+	assert.Equal(t, 1, finalized)
+	assert.Equal(t, 1, inProgress)
+	assert.NoError(t, toFile.Close())
+}
+
+func readParquet(t *testing.T, path string) ([]string, string) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	f, err := buffer.NewBufferFile(data)
+	require.NoError(t, err)
+	r, err := reader.NewParquetReader(f, nil, 1)
+	require.NoError(t, err)
+	defer r.ReadStop()
+	var columns []string
+	for _, info := range r.SchemaHandler.Infos[1:] {
+		columns = append(columns, info.ExName)
+	}
+	rows, err := r.ReadByNumber(int(r.GetNumRows()))
+	require.NoError(t, err)
+	b, err := json.Marshal(rows)
+	require.NoError(t, err)
+	return columns, string(b)
+}
+
+func TestToFile_Parquet(t *testing.T) {
+	t.Run("infer schema", func(t *testing.T) {
+		dir := t.TempDir()
+		toFile, err := NewToFileWriter(&dfv1.VertexInstance{Vertex: testutils.BuildTestVertex(dfv1.AbstractSink{File: &dfv1.FileSink{Format: dfv1.FileSinkFormatParquet, Compression: dfv1.FileCompressionGzip}}), Replica: 0}, &testFetcher{}, WithRootDir(dir))
+		require.NoError(t, err)
+		_, errs := toFile.Write(context.Background(), []isb.Message{
+			testutils.BuildTestMessage("0", testStart, `{"id": 1, "name": "a"}`),
+			testutils.BuildTestMessage("1", testStart, `not json`),
+			testutils.BuildTestMessage("2", testStart, `{"id": 2, "tags": ["x"]}`),
+		})
+		assert.NoError(t, errs[0])
+		files := listFiles(t, dir)
+		require.Len(t, files, 1)
+		assert.True(t, strings.HasSuffix(files[0], ".ndjson.inprogress"))
+
+		advanceWatermark(toFile, testStart.Add(time.Hour))
+		toFile.lock.Lock()
+		toFile.finalizePartitions()
+		toFile.lock.Unlock()
+		files = listFiles(t, dir)
+		require.Len(t, files, 1)
+		assert.True(t, strings.HasSuffix(files[0], ".parquet"))
+		columns, rows := readParquet(t, filepath.Join(dir, files[0]))
+		assert.Equal(t, []string{"id", "name", "tags"}, columns)
+		assert.JSONEq(t, `[{"Id": 1, "Name": "a", "Tags": null}, {"Id": 2, "Name": null, "Tags": "[\"x\"]"}]`, rows)
+		assert.NoError(t, toFile.Close())
+	})
+
+	t.Run("json schema", func(t *testing.T) {
+		dir := t.TempDir()
+		toFile, err := NewToFileWriter(&dfv1.VertexInstance{Vertex: testutils.BuildTestVertex(dfv1.AbstractSink{File: &dfv1.FileSink{
+			Format:  dfv1.FileSinkFormatParquet,
+			Parquet: &dfv1.ParquetOptions{Schema: `{"type": "object", "properties": {"id": {"type": "integer"}, "score": {"type": "number"}}}`},
+		}}), Replica: 0}, &testFetcher{}, WithRootDir(dir))
+		require.NoError(t, err)
+		_, errs := toFile.Write(context.Background(), []isb.Message{testutils.BuildTestMessage("3", testStart, `{"id": 1, "score": 2, "name": "a"}`)})
+		assert.NoError(t, errs[0])
+		assert.NoError(t, toFile.Close())
+
+		// the in-progress file is converted after restarting
+		toFile, err = NewToFileWriter(&dfv1.VertexInstance{Vertex: testutils.BuildTestVertex(dfv1.AbstractSink{File: &dfv1.FileSink{
+			Format:  dfv1.FileSinkFormatParquet,
+			Parquet: &dfv1.ParquetOptions{Schema: `{"type": "object", "properties": {"id": {"type": "integer"}, "score": {"type": "number"}}}`},
+		}}), Replica: 0}, &testFetcher{}, WithRootDir(dir))
+		require.NoError(t, err)
+		advanceWatermark(toFile, testStart.Add(time.Hour))
+		toFile.lock.Lock()
+		toFile.finalizePartitions()
+		toFile.lock.Unlock()
+		files := listFiles(t, dir)
+		require.Len(t, files, 1)
+		columns, rows := readParquet(t, filepath.Join(dir, files[0]))
+		assert.Equal(t, []string{"id", "score"}, columns)
+		assert.JSONEq(t, `[{"Id": 1, "Score": 2}]`, rows)
+		assert.NoError(t, toFile.Close())
+	})
+}
+
+func TestParseFileName(t *testing.T) {
+	start, ok := parseFileName(fileName("out", 2, testStart, ".ndjson.gz"), "out", 2)
+	assert.True(t, ok)
+	assert.Equal(t, testStart, start)
+	_, ok = parseFileName(fileName("out", 2, testStart, ".ndjson.gz"), "out", 1)
+	assert.False(t, ok)
+	_, ok = parseFileName("out-2-abc-1.ndjson.inprogress", "out", 2)
+	assert.False(t, ok)
+	_, ok = parseFileName("out-2-1-1.ndjson", "out", 2)
+	assert.False(t, ok)
+}
+
+type testFetcher struct {
+	generic.NoOpWMProgressor
+	watermark wmb.Watermark
+}
+
+func (f *testFetcher) GetWatermark(isb.Offset, int32) wmb.Watermark {
+	return f.watermark
+}
+
+// advanceWatermark records the watermark in the same way as the forwarder fetches the watermark of a batch.
+func advanceWatermark(toFile *ToFile, t time.Time) {
+	toFile.watermark = fetch.NewRecorder(&testFetcher{watermark: wmb.Watermark(t)})
+	toFile.watermark.GetWatermark(nil, 0)
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+// fileSinkWriteCount is used to indicate the number of messages written to the files
+var fileSinkWriteCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "file_sink",
+	Name:      "write_total",
+	Help:      "Total number of messages written to the files",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// fileSinkWriteErrors is used to indicate the number of errors while writing to the files
+var fileSinkWriteErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "file_sink",
+	Name:      "write_error_total",
+	Help:      "Total number of write errors",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// fileSinkFinalizedCount is used to indicate the number of files finalized
+var fileSinkFinalizedCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "file_sink",
+	Name:      "finalized_files_total",
+	Help:      "Total number of files finalized",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// fileSinkSkippedCount is used to indicate the number of messages skipped when converting to Parquet
+var fileSinkSkippedCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "file_sink",
+	Name:      "parquet_skipped_total",
+	Help:      "Total number of messages skipped when converting to Parquet because they are not JSON objects",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
@@ -0,0 +1,180 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/parquet"
+)
+
+// parquetConverter converts the in-progress NDJSON files to Parquet files when they are finalized. The messages are
+// written to the NDJSON files first, so that they are durable before being acknowledged, while a Parquet file can
+// only be read after its footer is written.
+type parquetConverter struct {
+	options     *dfv1.ParquetOptions
+	compression dfv1.FileCompression
+	// the columns, derived from the JSON schema, or inferred from the first messages
+	schema parquet.Schema
+	// the first messages used to infer the columns
+	samples [][]byte
+}
+
+func newParquetConverter(fileSink *dfv1.FileSink) (*parquetConverter, error) {
+	c := &parquetConverter{options: fileSink.Parquet, compression: fileSink.GetCompression()}
+	if o := fileSink.Parquet; o != nil && o.Schema != "" {
+		s, err := parquet.SchemaFromJSONSchema([]byte(o.Schema))
+		if err != nil {
+			return nil, err
+		}
+		c.schema = s
+	}
+	return c, nil
+}
+
+// sample keeps the payload if more messages are needed to infer the columns.
+func (c *parquetConverter) sample(payload []byte) {
+	if c.schema == nil && len(c.samples) < c.options.GetInferSchemaSampleSize() {
+		c.samples = append(c.samples, append([]byte(nil), payload...))
+	}
+}
+
+// getSchema returns the columns, they are inferred from the sampled messages, or the first lines of the file if
+// there isn't any, e.g. the file is recovered after restarting.
+func (c *parquetConverter) getSchema(path string) (parquet.Schema, error) {
+	if c.schema != nil {
+		return c.schema, nil
+	}
+	samples := c.samples
+	if len(samples) == 0 {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r := bufio.NewReader(f)
+		for len(samples) < c.options.GetInferSchemaSampleSize() {
+			line, err := readLine(r)
+			if len(line) > 0 {
+				samples = append(samples, line)
+			}
+			if err != nil {
+				break
+			}
+		}
+	}
+	s := parquet.InferSchema(samples)
+	if len(s) == 0 {
+		return nil, fmt.Errorf("no columns can be inferred from %d messages, the messages should be JSON objects", len(samples))
+	}
+	c.schema, c.samples = s, nil
+	return s, nil
+}
+
+// convert writes the records in an NDJSON file to a Parquet file, and returns the number of records skipped because
+// they are not JSON objects. The Parquet file is written to a temporary file first, and renamed after it's complete.
+func (c *parquetConverter) convert(src, dst string) (int, error) {
+	schema, err := c.getSchema(src)
+	if err != nil {
+		return 0, err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return 0, err
+	}
+	defer in.Close()
+	tmp := filepath.Join(filepath.Dir(dst), "."+filepath.Base(dst)+".tmp")
+	out, err := os.Create(tmp)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = out.Close()
+		_ = os.Remove(tmp)
+	}()
+	bw := bufio.NewWriter(out)
+	w, err := parquet.NewWriter(bw, schema, parquet.WithRowGroupSize(c.options.GetRowGroupSize()), parquet.WithCompression(string(c.compression)))
+	if err != nil {
+		return 0, err
+	}
+	skipped := 0
+	r := bufio.NewReader(in)
+	for {
+		line, readErr := readLine(r)
+		if len(line) > 0 {
+			if err := w.Write(line); err != nil {
+				skipped++
+			}
+		}
+		if readErr != nil {
+			if !errors.Is(readErr, io.EOF) {
+				return 0, readErr
+			}
+			break
+		}
+	}
+	if err := w.Close(); err != nil {
+		return 0, fmt.Errorf("failed to write parquet file, %w", err)
+	}
+	if err := bw.Flush(); err != nil {
+		return 0, err
+	}
+	if err := out.Sync(); err != nil {
+		return 0, err
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		return 0, err
+	}
+	return skipped, nil
+}
+
+func readLine(r *bufio.Reader) ([]byte, error) {
+	line, err := r.ReadBytes('\n')
+	return bytes.TrimSpace(line), err
+}
+
+// finalizeFile finalizes an in-progress file, it's converted to a Parquet file if the format is Parquet, otherwise
+// it's renamed by removing the suffix.
+func (tf *ToFile) finalizeFile(path string) error {
+	if tf.parquet == nil {
+		return finalize(path)
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil
+	}
+	dst := strings.TrimSuffix(strings.TrimSuffix(path, inProgressSuffix), stagingExtension) + tf.fileSink.GetFileExtension()
+	skipped, err := tf.parquet.convert(path, dst)
+	if err != nil {
+		return fmt.Errorf("failed to convert to parquet, %w", err)
+	}
+	if skipped > 0 {
+		tf.log.Warnw("Skipped the messages which are not JSON objects", zap.String("path", dst), zap.Int("skipped", skipped))
+		fileSinkSkippedCount.With(map[string]string{metrics.LabelVertex: tf.name, metrics.LabelPipeline: tf.pipelineName}).Add(float64(skipped))
+	}
+	return os.Remove(path)
+}
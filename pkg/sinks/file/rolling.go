@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// inProgressSuffix is the suffix of the files which are not finalized yet.
+	inProgressSuffix = ".inprogress"
+	// stagingExtension is the extension of the in-progress files which are converted to Parquet when finalized.
+	stagingExtension = ".ndjson"
+)
+
+// partition is an event time range of the messages which are written to the same directory.
+type partition struct {
+	start time.Time
+	end   time.Time
+	dir   string
+	// the file being written, nil if there isn't one
+	current *rollingFile
+	// the paths of the rolled files which are not finalized yet
+	rolled []string
+}
+
+// rollingFile is a file being written.
+type rollingFile struct {
+	path   string
+	file   *os.File
+	size   int64
+	opened time.Time
+}
+
+// fileName returns the name of a new in-progress file of a partition. The replica and the partition start time are
+// encoded in the name, so that the in-progress files can be recovered after restarts.
+func fileName(vertexName string, replica int32, start time.Time, ext string) string {
+	return fmt.Sprintf("%s-%d-%d-%d%s%s", vertexName, replica, start.UnixMilli(), time.Now().UnixNano(), ext, inProgressSuffix)
+}
+
+// parseFileName returns the partition start time of an in-progress file written by the replica, and false if the
+// file is not an in-progress file of the replica.
+func parseFileName(name, vertexName string, replica int32) (time.Time, bool) {
+	prefix := fmt.Sprintf("%s-%d-", vertexName, replica)
+	if !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, inProgressSuffix) {
+		return time.Time{}, false
+	}
+	parts := strings.SplitN(strings.TrimPrefix(name, prefix), "-", 2)
+	if len(parts) != 2 {
+		return time.Time{}, false
+	}
+	ms, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(ms).UTC(), true
+}
+
+func openRollingFile(path string) (*rollingFile, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directory, %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file, %w", err)
+	}
+	return &rollingFile{path: path, file: f, opened: time.Now()}, nil
+}
+
+// write writes the data, and syncs it to the disk before the messages are acknowledged.
+func (rf *rollingFile) write(data []byte) error {
+	n, err := rf.file.Write(data)
+	rf.size += int64(n)
+	if err != nil {
+		return err
+	}
+	return rf.file.Sync()
+}
+
+func (rf *rollingFile) close() error {
+	return rf.file.Close()
+}
+
+// finalize renames an in-progress file by removing the suffix.
+func finalize(path string) error {
+	if err := os.Rename(path, strings.TrimSuffix(path, inProgressSuffix)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,365 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/Masterminds/sprig/v3"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forward"
+	"github.com/numaproj/numaflow/pkg/forward/applier"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+	"github.com/numaproj/numaflow/pkg/watermark/fetch"
+	"github.com/numaproj/numaflow/pkg/watermark/publish"
+)
+
+// ToHTTP sends the output to an HTTP endpoint.
+type ToHTTP struct {
+	name         string
+	pipelineName string
+	isdf         *forward.InterStepDataForward
+	httpSink     *dfv1.HTTPSink
+	client       *http.Client
+	urlTemplate  *template.Template
+	// header templates keyed by the header names
+	headerTemplates map[string]*template.Template
+	// value of the Authorization header, empty if not configured
+	authorization string
+	user          string
+	password      string
+	log           *zap.SugaredLogger
+}
+
+type Option func(*ToHTTP) error
+
+func WithLogger(log *zap.SugaredLogger) Option {
+	return func(t *ToHTTP) error {
+		t.log = log
+		return nil
+	}
+}
+
+// templateData is the data used to render the URL and header templates.
+type templateData struct {
+	Keys      []string
+	ID        string
+	EventTime time.Time
+	Payload   string
+}
+
+// request is an HTTP request to be sent, with the indexes of the messages in it.
+type request struct {
+	url     string
+	headers map[string]string
+	indexes []int
+}
+
+// NewToHTTP returns ToHTTP type.
+func NewToHTTP(vertex *dfv1.Vertex,
+	fromBuffer isb.BufferReader,
+	fetchWatermark fetch.Fetcher,
+	publishWatermark map[string]publish.Publisher,
+	whereToDecider forward.GoWhere,
+	forwardOpts []forward.Option,
+	opts ...Option) (*ToHTTP, error) {
+
+	toHTTP, err := NewToHTTPWriter(vertex, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultOpts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeSink), forward.WithLogger(toHTTP.log)}
+	if x := vertex.Spec.Limits; x != nil {
+		if x.ReadBatchSize != nil {
+			defaultOpts = append(defaultOpts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
+		}
+	}
+	f, err := forward.NewInterStepDataForward(vertex, fromBuffer, map[string][]isb.BufferWriter{vertex.Spec.Name: {toHTTP}}, whereToDecider, applier.Terminal, fetchWatermark, publishWatermark, append(defaultOpts, forwardOpts...)...)
+	if err != nil {
+		return nil, err
+	}
+	toHTTP.isdf = f
+	return toHTTP, nil
+}
+
+// NewToHTTPWriter returns ToHTTP type with the HTTP client configured, but no forwarder. It's used when the sink is
+// only written to by the forwarder of another sink, i.e. as a fallback sink.
+func NewToHTTPWriter(vertex *dfv1.Vertex, opts ...Option) (*ToHTTP, error) {
+	httpSink := vertex.Spec.Sink.HTTP
+	toHTTP := new(ToHTTP)
+	for _, o := range opts {
+		if err := o(toHTTP); err != nil {
+			return nil, err
+		}
+	}
+	if toHTTP.log == nil {
+		toHTTP.log = logging.NewLogger()
+	}
+	toHTTP.log = toHTTP.log.With("sinkType", "http")
+	toHTTP.name = vertex.Spec.Name
+	toHTTP.pipelineName = vertex.Spec.PipelineName
+	if err := toHTTP.configure(httpSink); err != nil {
+		return nil, err
+	}
+	return toHTTP, nil
+}
+
+// configure parses the templates, reads the credentials and creates the HTTP client.
+func (th *ToHTTP) configure(httpSink *dfv1.HTTPSink) error {
+	th.httpSink = httpSink
+	var err error
+	if th.urlTemplate, err = parseTemplate("url", httpSink.URL); err != nil {
+		return err
+	}
+	th.headerTemplates = make(map[string]*template.Template, len(httpSink.Headers))
+	for k, v := range httpSink.Headers {
+		if th.headerTemplates[k], err = parseTemplate(k, v); err != nil {
+			return err
+		}
+	}
+	if a := httpSink.Auth; a != nil && a.Token != nil {
+		token, err := sharedutil.GetSecretFromVolume(a.Token)
+		if err != nil {
+			return fmt.Errorf("failed to get http sink auth token, %w", err)
+		}
+		th.authorization = "Bearer " + token
+	}
+	if a := httpSink.BasicAuth; a != nil {
+		if a.User != nil {
+			if th.user, err = sharedutil.GetSecretFromVolume(a.User); err != nil {
+				return fmt.Errorf("failed to get http sink basic auth user, %w", err)
+			}
+		}
+		if a.Password != nil {
+			if th.password, err = sharedutil.GetSecretFromVolume(a.Password); err != nil {
+				return fmt.Errorf("failed to get http sink basic auth password, %w", err)
+			}
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if httpSink.TLS != nil {
+		c, err := sharedutil.GetTLSConfig(httpSink.TLS)
+		if err != nil {
+			return fmt.Errorf("failed to get http sink tls config, %w", err)
+		}
+		transport.TLSClientConfig = c
+	}
+	th.client = &http.Client{Transport: transport, Timeout: httpSink.GetTimeout()}
+	return nil
+}
+
+func parseTemplate(name, text string) (*template.Template, error) {
+	t, err := template.New(name).Funcs(sprig.TxtFuncMap()).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template %q, %w", name, err)
+	}
+	return t, nil
+}
+
+// GetName returns the name.
+func (th *ToHTTP) GetName() string {
+	return th.name
+}
+
+// GetPartitionIdx returns the partition index.
+// for sink it is always 0.
+func (th *ToHTTP) GetPartitionIdx() int32 {
+	return 0
+}
+
+// Write sends the messages to the HTTP endpoint. The messages failed with the non-retryable status codes are
+// returned with isb.NoRetryableBufferWriteErr, so that the forwarder drops them instead of retrying forever.
+func (th *ToHTTP) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	errs := make([]error, len(messages))
+	requests := th.buildRequests(messages, errs)
+	for _, r := range requests {
+		var body []byte
+		if th.httpSink.Batch {
+			body = batchBody(messages, r.indexes)
+		} else {
+			body = messages[r.indexes[0]].Payload
+		}
+		err := th.send(ctx, r, body)
+		for _, idx := range r.indexes {
+			errs[idx] = err
+		}
+	}
+	labels := map[string]string{metrics.LabelVertex: th.name, metrics.LabelPipeline: th.pipelineName}
+	for _, err := range errs {
+		if err == nil {
+			httpSinkWriteCount.With(labels).Inc()
+			continue
+		}
+		httpSinkWriteErrors.With(labels).Inc()
+		if _, ok := err.(isb.NoRetryableBufferWriteErr); ok {
+			httpSinkDropped.With(labels).Inc()
+		}
+	}
+	return nil, errs
+}
+
+// buildRequests renders the templates of the messages, and returns the requests to be sent. In batch mode, the
+// messages with the same URL and headers are sent in one request. The errors of the messages failed to be rendered
+// are set in errs.
+func (th *ToHTTP) buildRequests(messages []isb.Message, errs []error) []*request {
+	var requests []*request
+	byTarget := make(map[string]*request)
+	for i, msg := range messages {
+		data := templateData{Keys: msg.Keys, ID: msg.ID, EventTime: msg.EventTime, Payload: string(msg.Payload)}
+		url, err := render(th.urlTemplate, data)
+		if err != nil {
+			errs[i] = isb.NoRetryableBufferWriteErr{Name: th.name, Message: fmt.Sprintf("failed to render url, %v", err)}
+			continue
+		}
+		headers := make(map[string]string, len(th.headerTemplates))
+		for k, t := range th.headerTemplates {
+			if headers[k], err = render(t, data); err != nil {
+				break
+			}
+		}
+		if err != nil {
+			errs[i] = isb.NoRetryableBufferWriteErr{Name: th.name, Message: fmt.Sprintf("failed to render headers, %v", err)}
+			continue
+		}
+		if !th.httpSink.Batch {
+			requests = append(requests, &request{url: url, headers: headers, indexes: []int{i}})
+			continue
+		}
+		target := targetKey(url, headers)
+		if r, ok := byTarget[target]; ok {
+			r.indexes = append(r.indexes, i)
+			continue
+		}
+		r := &request{url: url, headers: headers, indexes: []int{i}}
+		byTarget[target] = r
+		requests = append(requests, r)
+	}
+	return requests
+}
+
+func render(t *template.Template, data templateData) (string, error) {
+	var b strings.Builder
+	if err := t.Execute(&b, data); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// targetKey returns a key identifying the URL and headers of a request.
+func targetKey(url string, headers map[string]string) string {
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	b.WriteString(url)
+	for _, k := range names {
+		b.WriteString("\n" + k + ":" + headers[k])
+	}
+	return b.String()
+}
+
+// batchBody returns a JSON array of the payloads, the payloads which are valid JSON are embedded as they are,
+// and the others are embedded as JSON strings.
+func batchBody(messages []isb.Message, indexes []int) []byte {
+	items := make([]json.RawMessage, 0, len(indexes))
+	for _, idx := range indexes {
+		payload := messages[idx].Payload
+		if json.Valid(payload) {
+			items = append(items, payload)
+			continue
+		}
+		s, _ := json.Marshal(string(payload))
+		items = append(items, s)
+	}
+	body, _ := json.Marshal(items)
+	return body
+}
+
+// send sends the request, and returns a non-retryable error if the response status code is not retryable.
+func (th *ToHTTP) send(ctx context.Context, r *request, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, th.httpSink.GetMethod(), r.url, bytes.NewReader(body))
+	if err != nil {
+		return isb.NoRetryableBufferWriteErr{Name: th.name, Message: fmt.Sprintf("failed to create http request, %v", err)}
+	}
+	if th.httpSink.Batch {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range r.headers {
+		req.Header.Set(k, v)
+	}
+	if th.authorization != "" {
+		req.Header.Set("Authorization", th.authorization)
+	}
+	if th.httpSink.BasicAuth != nil {
+		req.SetBasicAuth(th.user, th.password)
+	}
+	resp, err := th.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send http request, %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	msg := fmt.Sprintf("http request failed with status code %d, %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	if th.httpSink.IsRetryableStatusCode(resp.StatusCode) {
+		return errors.New(msg)
+	}
+	th.log.Warnw("Dropping messages with non-retryable status code", zap.Int("statusCode", resp.StatusCode), zap.Int("messages", len(r.indexes)))
+	return isb.NoRetryableBufferWriteErr{Name: th.name, Message: msg}
+}
+
+func (th *ToHTTP) Close() error {
+	th.client.CloseIdleConnections()
+	return nil
+}
+
+// Start starts sinking to the HTTP endpoint.
+func (th *ToHTTP) Start() <-chan struct{} {
+	return th.isdf.Start()
+}
+
+// Stop stops sinking
+func (th *ToHTTP) Stop() {
+	th.isdf.Stop()
+	th.log.Info("forwarder stopped successfully")
+}
+
+// ForceStop stops sinking
+func (th *ToHTTP) ForceStop() {
+	th.isdf.ForceStop()
+	th.log.Info("forwarder force stopped successfully")
+}
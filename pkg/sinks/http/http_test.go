@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/sinks/testutils"
+)
+
+type receivedRequest struct {
+	method  string
+	path    string
+	headers http.Header
+	body    string
+}
+
+func newTestServer(t *testing.T, status func(path string) int) (*httptest.Server, *[]receivedRequest) {
+	t.Helper()
+	var lock sync.Mutex
+	var received []receivedRequest
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		lock.Lock()
+		received = append(received, receivedRequest{method: r.Method, path: r.URL.Path, headers: r.Header, body: string(body)})
+		lock.Unlock()
+		w.WriteHeader(status(r.URL.Path))
+	}))
+	t.Cleanup(s.Close)
+	return s, &received
+}
+
+var testMessages = []isb.Message{
+	testutils.BuildTestMessage("0", time.Time{}, `{"n":0}`, "a"),
+	testutils.BuildTestMessage("1", time.Time{}, "hello", "b"),
+	testutils.BuildTestMessage("2", time.Time{}, `{"n":2}`, "a"),
+}
+
+func TestToHTTP_WritePerMessage(t *testing.T) {
+	s, received := newTestServer(t, func(string) int { return http.StatusOK })
+	toHTTP, err := NewToHTTPWriter(testutils.BuildTestVertex(dfv1.AbstractSink{HTTP: &dfv1.HTTPSink{
+		URL:     s.URL + "/{{index .Keys 0}}",
+		Method:  http.MethodPut,
+		Headers: map[string]string{"X-Message-ID": "{{.ID}}"},
+	}}))
+	require.NoError(t, err)
+	_, errs := toHTTP.Write(context.Background(), testMessages)
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	require.Len(t, *received, 3)
+	r := (*received)[1]
+	assert.Equal(t, http.MethodPut, r.method)
+	assert.Equal(t, "/b", r.path)
+	assert.Equal(t, "1", r.headers.Get("X-Message-ID"))
+	assert.Equal(t, "hello", r.body)
+}
+
+func TestToHTTP_WriteBatch(t *testing.T) {
+	s, received := newTestServer(t, func(string) int { return http.StatusAccepted })
+	toHTTP, err := NewToHTTPWriter(testutils.BuildTestVertex(dfv1.AbstractSink{HTTP: &dfv1.HTTPSink{URL: s.URL + "/{{index .Keys 0}}", Batch: true}}))
+	require.NoError(t, err)
+	_, errs := toHTTP.Write(context.Background(), testMessages)
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	require.Len(t, *received, 2)
+	r := (*received)[0]
+	assert.Equal(t, http.MethodPost, r.method)
+	assert.Equal(t, "/a", r.path)
+	assert.Equal(t, "application/json", r.headers.Get("Content-Type"))
+	var items []json.RawMessage
+	require.NoError(t, json.Unmarshal([]byte(r.body), &items))
+	assert.Equal(t, []json.RawMessage{json.RawMessage(`{"n":0}`), json.RawMessage(`{"n":2}`)}, items)
+	assert.Equal(t, `["hello"]`, (*received)[1].body)
+}
+
+func TestToHTTP_WriteStatusCodes(t *testing.T) {
+	s, _ := newTestServer(t, func(path string) int {
+		switch path {
+		case "/a":
+			return http.StatusServiceUnavailable
+		default:
+			return http.StatusBadRequest
+		}
+	})
+	toHTTP, err := NewToHTTPWriter(testutils.BuildTestVertex(dfv1.AbstractSink{HTTP: &dfv1.HTTPSink{URL: s.URL + "/{{index .Keys 0}}"}}))
+	require.NoError(t, err)
+	_, errs := toHTTP.Write(context.Background(), testMessages)
+	require.Len(t, errs, 3)
+	assert.Error(t, errs[0])
+	_, ok := errs[0].(isb.NoRetryableBufferWriteErr)
+	assert.False(t, ok)
+	_, ok = errs[1].(isb.NoRetryableBufferWriteErr)
+	assert.True(t, ok)
+
+	// custom retryable status codes
+	toHTTP, err = NewToHTTPWriter(testutils.BuildTestVertex(dfv1.AbstractSink{HTTP: &dfv1.HTTPSink{URL: s.URL + "/{{index .Keys 0}}", RetryableStatusCodes: []int32{http.StatusBadRequest}}}))
+	require.NoError(t, err)
+	_, errs = toHTTP.Write(context.Background(), testMessages)
+	_, ok = errs[0].(isb.NoRetryableBufferWriteErr)
+	assert.True(t, ok)
+	_, ok = errs[1].(isb.NoRetryableBufferWriteErr)
+	assert.False(t, ok)
+}
+
+func TestToHTTP_WriteTemplateError(t *testing.T) {
+	s, received := newTestServer(t, func(string) int { return http.StatusOK })
+	toHTTP, err := NewToHTTPWriter(testutils.BuildTestVertex(dfv1.AbstractSink{HTTP: &dfv1.HTTPSink{URL: s.URL + "/{{index .Keys 1}}"}}))
+	require.NoError(t, err)
+	_, errs := toHTTP.Write(context.Background(), testMessages[:1])
+	_, ok := errs[0].(isb.NoRetryableBufferWriteErr)
+	assert.True(t, ok)
+	assert.Empty(t, *received)
+}
+
+func TestToHTTP_WriteConnectionError(t *testing.T) {
+	s, _ := newTestServer(t, func(string) int { return http.StatusOK })
+	toHTTP, err := NewToHTTPWriter(testutils.BuildTestVertex(dfv1.AbstractSink{HTTP: &dfv1.HTTPSink{URL: s.URL}}))
+	require.NoError(t, err)
+	s.Close()
+	_, errs := toHTTP.Write(context.Background(), testMessages[:1])
+	assert.Error(t, errs[0])
+	_, ok := errs[0].(isb.NoRetryableBufferWriteErr)
+	assert.False(t, ok)
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/schemaregistry"
 	"github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/publish"
@@ -45,6 +46,8 @@ type ToKafka struct {
 	isdf         *forward.InterStepDataForward
 	kafkaSink    *dfv1.KafkaSink
 	log          *zap.SugaredLogger
+	// schema registry client used to encode the payloads, nil if not configured
+	schemaRegistry *schemaregistry.Client
 }
 
 type Option func(*ToKafka) error
@@ -62,8 +65,33 @@ func NewToKafka(vertex *dfv1.Vertex,
 	fetchWatermark fetch.Fetcher,
 	publishWatermark map[string]publish.Publisher,
 	whereToDecider forward.GoWhere,
+	forwardOpts []forward.Option,
 	opts ...Option) (*ToKafka, error) {
 
+	toKafka, err := NewToKafkaWriter(vertex, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultOpts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeSink), forward.WithLogger(toKafka.log)}
+	if x := vertex.Spec.Limits; x != nil {
+		if x.ReadBatchSize != nil {
+			defaultOpts = append(defaultOpts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
+		}
+	}
+
+	f, err := forward.NewInterStepDataForward(vertex, fromBuffer, map[string][]isb.BufferWriter{vertex.Spec.Name: {toKafka}}, whereToDecider, applier.Terminal, fetchWatermark, publishWatermark, append(defaultOpts, forwardOpts...)...)
+	if err != nil {
+		_ = toKafka.Close()
+		return nil, err
+	}
+	toKafka.isdf = f
+	return toKafka, nil
+}
+
+// NewToKafkaWriter returns ToKafka type connected to the brokers, without a forwarder. It's used as the writer of a
+// fallback sink, whose producer is closed with the forwarder of the primary sink.
+func NewToKafkaWriter(vertex *dfv1.Vertex, opts ...Option) (*ToKafka, error) {
 	kafkaSink := vertex.Spec.Sink.Kafka
 	toKafka := new(ToKafka)
 	// apply options for kafka sink
@@ -82,19 +110,13 @@ func NewToKafka(vertex *dfv1.Vertex,
 	toKafka.pipelineName = vertex.Spec.PipelineName
 	toKafka.topic = kafkaSink.Topic
 	toKafka.kafkaSink = kafkaSink
-
-	forwardOpts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeSink), forward.WithLogger(toKafka.log)}
-	if x := vertex.Spec.Limits; x != nil {
-		if x.ReadBatchSize != nil {
-			forwardOpts = append(forwardOpts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
+	if sr := kafkaSink.SchemaRegistry; sr != nil {
+		client, err := schemaregistry.NewClientFromSpec(sr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create schema registry client, %w", err)
 		}
+		toKafka.schemaRegistry = client
 	}
-
-	f, err := forward.NewInterStepDataForward(vertex, fromBuffer, map[string][]isb.BufferWriter{vertex.Spec.Name: {toKafka}}, whereToDecider, applier.Terminal, fetchWatermark, publishWatermark, forwardOpts...)
-	if err != nil {
-		return nil, err
-	}
-	toKafka.isdf = f
 	producer, err := connect(kafkaSink)
 	if err != nil {
 		return nil, err
@@ -145,11 +167,12 @@ func (tk *ToKafka) GetPartitionIdx() int32 {
 }
 
 // Write writes to the kafka topic.
-func (tk *ToKafka) Write(_ context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+func (tk *ToKafka) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
 	errs := make([]error, len(messages))
 	for i := 0; i < len(errs); i++ {
 		errs[i] = fmt.Errorf("unknown error")
 	}
+	values, encodeErrs := tk.encode(ctx, messages)
 	if !tk.connected {
 		producer, err := connect(tk.kafkaSink)
 		if err != nil {
@@ -166,7 +189,7 @@ func (tk *ToKafka) Write(_ context.Context, messages []isb.Message) ([]isb.Offse
 	go func() {
 		sent := 0
 		for {
-			if sent == len(messages) {
+			if sent == len(values) {
 				close(done)
 				return
 			}
@@ -190,15 +213,18 @@ func (tk *ToKafka) Write(_ context.Context, messages []isb.Message) ([]isb.Offse
 			}
 		}
 	}()
-	for index, msg := range messages {
+	for index, value := range values {
 		message := &sarama.ProducerMessage{
 			Topic:    tk.topic,
-			Value:    sarama.ByteEncoder(msg.Payload),
+			Value:    sarama.ByteEncoder(value),
 			Metadata: index, // Use metadata to identify if it succeeds or fails in the async return.
 		}
 		tk.producer.Input() <- message
 	}
 	<-done
+	for index, err := range encodeErrs {
+		errs[index] = err
+	}
 	for _, err := range errs {
 		if err != nil {
 			kafkaSinkWriteErrors.With(map[string]string{metrics.LabelVertex: tk.name, metrics.LabelPipeline: tk.pipelineName}).Inc()
@@ -209,6 +235,40 @@ func (tk *ToKafka) Write(_ context.Context, messages []isb.Message) ([]isb.Offse
 	return nil, errs
 }
 
+// encode returns the values to be produced keyed by the index of the messages, and the errors of the messages
+// failed to be encoded with the schema registry.
+func (tk *ToKafka) encode(ctx context.Context, messages []isb.Message) (map[int][]byte, map[int]error) {
+	values := make(map[int][]byte, len(messages))
+	encodeErrs := make(map[int]error)
+	for index, msg := range messages {
+		if tk.schemaRegistry == nil {
+			values[index] = msg.Payload
+			continue
+		}
+		value, err := tk.schemaRegistry.Encode(ctx, tk.subject(), msg.Payload)
+		if err != nil {
+			kafkaSinkEncodeErrors.With(map[string]string{metrics.LabelVertex: tk.name, metrics.LabelPipeline: tk.pipelineName}).Inc()
+			if tk.kafkaSink.SchemaRegistry.GetOnFailure() == dfv1.SchemaRegistryFailurePolicyDrop {
+				// the forwarder drops the messages with no retryable errors.
+				encodeErrs[index] = isb.NoRetryableBufferWriteErr{Name: tk.name, Message: fmt.Sprintf("failed to encode the message with schema registry, %v", err)}
+			} else {
+				encodeErrs[index] = fmt.Errorf("failed to encode the message with schema registry, %w", err)
+			}
+			continue
+		}
+		values[index] = value
+	}
+	return values, encodeErrs
+}
+
+// subject returns the schema registry subject used to encode the messages.
+func (tk *ToKafka) subject() string {
+	if s := tk.kafkaSink.SchemaRegistry.Subject; s != "" {
+		return s
+	}
+	return tk.topic + "-value"
+}
+
 func (tk *ToKafka) Close() error {
 	tk.log.Info("Closing kafka producer...")
 	return tk.producer.Close()
@@ -17,8 +17,12 @@ limitations under the License.
 package kafka
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
@@ -27,6 +31,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/schemaregistry"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 
 	mock "github.com/Shopify/sarama/mocks"
@@ -42,7 +47,9 @@ func TestWriteSuccessToKafka(t *testing.T) {
 		AbstractVertex: dfv1.AbstractVertex{
 			Name: "testVertex",
 			Sink: &dfv1.Sink{
-				Kafka: &dfv1.KafkaSink{},
+				AbstractSink: dfv1.AbstractSink{
+					Kafka: &dfv1.KafkaSink{},
+				},
 			},
 		},
 	}}
@@ -95,7 +102,9 @@ func TestWriteFailureToKafka(t *testing.T) {
 		AbstractVertex: dfv1.AbstractVertex{
 			Name: "testVertex",
 			Sink: &dfv1.Sink{
-				Kafka: &dfv1.KafkaSink{},
+				AbstractSink: dfv1.AbstractSink{
+					Kafka: &dfv1.KafkaSink{},
+				},
 			},
 		},
 	}}
@@ -152,3 +161,47 @@ func getSinkGoWhereDecider(vertexName string) forward.GoWhere {
 	})
 	return fsd
 }
+
+func TestWriteToKafkaWithSchemaRegistry(t *testing.T) {
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/subjects/topic-1-value/versions/latest", r.URL.Path)
+		_, _ = w.Write([]byte(`{"id":7,"schema":"{\"type\":\"record\",\"name\":\"T\",\"fields\":[{\"name\":\"a\",\"type\":\"int\"}]}"}`))
+	}))
+	defer registry.Close()
+
+	tests := []struct {
+		onFailure   dfv1.SchemaRegistryFailurePolicy
+		noRetryable bool
+	}{
+		{onFailure: dfv1.SchemaRegistryFailurePolicyFail, noRetryable: false},
+		{onFailure: dfv1.SchemaRegistryFailurePolicyDrop, noRetryable: true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.onFailure), func(t *testing.T) {
+			kafkaSink := &dfv1.KafkaSink{SchemaRegistry: &dfv1.SchemaRegistry{URL: registry.URL, OnFailure: tt.onFailure}}
+			toKafka := &ToKafka{name: "Test", topic: "topic-1", kafkaSink: kafkaSink, log: logging.NewLogger(), connected: true}
+			toKafka.schemaRegistry, _ = schemaregistry.NewClientFromSpec(kafkaSink.SchemaRegistry)
+			conf := mock.NewTestConfig()
+			conf.Producer.Return.Successes = true
+			conf.Producer.Return.Errors = true
+			producer := mock.NewAsyncProducer(t, conf)
+			producer.ExpectInputWithCheckerFunctionAndSucceed(func(val []byte) error {
+				// magic byte, schema id 7, avro encoded int 1.
+				if !bytes.Equal(val, []byte{0, 0, 0, 0, 7, 2}) {
+					return fmt.Errorf("unexpected value %v", val)
+				}
+				return nil
+			})
+			toKafka.producer = producer
+			msgs := []isb.Message{
+				{Body: isb.Body{Payload: []byte(`{"a":1}`)}},
+				{Body: isb.Body{Payload: []byte(`{"b":1}`)}},
+			}
+			_, errs := toKafka.Write(context.Background(), msgs)
+			assert.NoError(t, errs[0])
+			assert.Error(t, errs[1])
+			assert.Equal(t, tt.noRetryable, errors.As(errs[1], &isb.NoRetryableBufferWriteErr{}))
+			assert.NoError(t, producer.Close())
+		})
+	}
+}
@@ -42,3 +42,10 @@ var kafkaSinkWriteTimeouts = promauto.NewCounterVec(prometheus.CounterOpts{
 	Name:      "write_timeout_total",
 	Help:      "Total number of write timeouts on NewToKafka",
 }, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// kafkaSinkEncodeErrors is used to indicate the number of messages failed to be encoded with the schema registry
+var kafkaSinkEncodeErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "kafka_sink",
+	Name:      "encode_error_total",
+	Help:      "Total number of messages failed to be encoded with the schema registry",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
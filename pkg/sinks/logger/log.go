@@ -18,6 +18,8 @@ package logger
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
 
 	"go.uber.org/zap"
@@ -38,6 +40,15 @@ type ToLog struct {
 	pipelineName string
 	isdf         *forward.InterStepDataForward
 	logger       *zap.SugaredLogger
+	format       dfv1.LogFormat
+	fields       []dfv1.LogField
+	samplingRate float64
+	// accumulates the sampling rate of the messages, a message is printed when it reaches 1
+	sampled          float64
+	maxPayloadLength int
+	// records the watermark of the batch being written
+	watermark *fetch.Recorder
+	printer   *log.Logger
 }
 
 type Option func(*ToLog) error
@@ -55,30 +66,23 @@ func NewToLog(vertex *dfv1.Vertex,
 	fetchWatermark fetch.Fetcher,
 	publishWatermark map[string]publish.Publisher,
 	whereToDecider forward.GoWhere,
+	forwardOpts []forward.Option,
 	opts ...Option) (*ToLog, error) {
 
-	toLog := new(ToLog)
-	name := vertex.Spec.Name
-	toLog.name = name
-	toLog.pipelineName = vertex.Spec.PipelineName
-	// use opts in future for specifying logger format etc
-	for _, o := range opts {
-		if err := o(toLog); err != nil {
-			return nil, err
-		}
-	}
-	if toLog.logger == nil {
-		toLog.logger = logging.NewLogger()
+	toLog, err := NewToLogWriter(vertex, fetchWatermark, opts...)
+	if err != nil {
+		return nil, err
 	}
 
-	forwardOpts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeSink), forward.WithLogger(toLog.logger)}
+	defaultOpts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeSink), forward.WithLogger(toLog.logger)}
 	if x := vertex.Spec.Limits; x != nil {
 		if x.ReadBatchSize != nil {
-			forwardOpts = append(forwardOpts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
+			defaultOpts = append(defaultOpts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
 		}
 	}
 
-	isdf, err := forward.NewInterStepDataForward(vertex, fromBuffer, map[string][]isb.BufferWriter{vertex.Spec.Name: {toLog}}, whereToDecider, applier.Terminal, fetchWatermark, publishWatermark, forwardOpts...)
+	// the forwarder fetches the watermark of each batch through the recorder, which is printed with the messages.
+	isdf, err := forward.NewInterStepDataForward(vertex, fromBuffer, map[string][]isb.BufferWriter{vertex.Spec.Name: {toLog}}, whereToDecider, applier.Terminal, toLog.watermark, publishWatermark, append(defaultOpts, forwardOpts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -87,6 +91,43 @@ func NewToLog(vertex *dfv1.Vertex,
 	return toLog, nil
 }
 
+// NewToLogWriter returns ToLog type without a forwarder, e.g. for a fallback sink, which can only be written to.
+// The watermark printed is the one recorded by the forwarder using the same fetch.Recorder.
+func NewToLogWriter(vertex *dfv1.Vertex, fetchWatermark fetch.Fetcher, opts ...Option) (*ToLog, error) {
+	toLog := new(ToLog)
+	name := vertex.Spec.Name
+	toLog.name = name
+	toLog.pipelineName = vertex.Spec.PipelineName
+	logSink := dfv1.Log{}
+	if vertex.Spec.Sink != nil && vertex.Spec.Sink.Log != nil {
+		logSink = *vertex.Spec.Sink.Log
+	}
+	samplingRate, err := logSink.GetSamplingRate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid sampling rate %q, %w", logSink.SamplingRate, err)
+	}
+	toLog.format = logSink.GetFormat()
+	toLog.fields = logSink.GetFields()
+	toLog.samplingRate = samplingRate
+	toLog.maxPayloadLength = logSink.GetMaxPayloadLength()
+	if toLog.format == dfv1.LogFormatJSON {
+		// no timestamp prefix, so that each line is a valid JSON object
+		toLog.printer = log.New(log.Writer(), "", 0)
+	} else {
+		toLog.printer = log.Default()
+	}
+	for _, o := range opts {
+		if err := o(toLog); err != nil {
+			return nil, err
+		}
+	}
+	if toLog.logger == nil {
+		toLog.logger = logging.NewLogger()
+	}
+	toLog.watermark = fetch.NewRecorder(fetchWatermark)
+	return toLog, nil
+}
+
 // GetName returns the name.
 func (t *ToLog) GetName() string {
 	return t.name
@@ -106,14 +147,82 @@ func (t *ToLog) IsFull() bool {
 
 // Write writes to the log.
 func (t *ToLog) Write(_ context.Context, messages []isb.Message) ([]isb.Offset, []error) {
-	prefix := "(" + t.GetName() + ")"
 	for _, message := range messages {
 		logSinkWriteCount.With(map[string]string{metrics.LabelVertex: t.name, metrics.LabelPipeline: t.pipelineName}).Inc()
-		log.Println(prefix, " Payload - ", string(message.Payload), " Keys - ", message.Keys, " EventTime - ", message.EventTime.UnixMilli())
+		if !t.sample() {
+			continue
+		}
+		if t.format == dfv1.LogFormatJSON {
+			t.printJSON(message)
+		} else {
+			t.printHuman(message)
+		}
 	}
 	return nil, make([]error, len(messages))
 }
 
+// sample returns whether the next message should be printed. The messages are sampled evenly, e.g. with the rate 0.25,
+// every 4th message is printed.
+func (t *ToLog) sample() bool {
+	t.sampled += t.samplingRate
+	if t.sampled < 1 {
+		return false
+	}
+	t.sampled--
+	return true
+}
+
+// payload returns the payload truncated to the max payload length.
+func (t *ToLog) payload(message isb.Message) string {
+	if t.maxPayloadLength > 0 && len(message.Payload) > t.maxPayloadLength {
+		return string(message.Payload[:t.maxPayloadLength]) + "..."
+	}
+	return string(message.Payload)
+}
+
+func (t *ToLog) printHuman(message isb.Message) {
+	line := []interface{}{"(" + t.GetName() + ")", " Payload - ", t.payload(message)}
+	for _, f := range t.fields {
+		switch f {
+		case dfv1.LogFieldKeys:
+			line = append(line, " Keys - ", message.Keys)
+		case dfv1.LogFieldEventTime:
+			line = append(line, " EventTime - ", message.EventTime.UnixMilli())
+		case dfv1.LogFieldID:
+			line = append(line, " ID - ", message.ID)
+		case dfv1.LogFieldWatermark:
+			line = append(line, " Watermark - ", t.watermark.Get().UnixMilli())
+		case dfv1.LogFieldIsLate:
+			line = append(line, " IsLate - ", message.IsLate)
+		}
+	}
+	t.printer.Println(line...)
+}
+
+func (t *ToLog) printJSON(message isb.Message) {
+	line := map[string]interface{}{"vertex": t.GetName(), "payload": t.payload(message)}
+	for _, f := range t.fields {
+		switch f {
+		case dfv1.LogFieldKeys:
+			line[string(f)] = message.Keys
+		case dfv1.LogFieldEventTime:
+			line[string(f)] = message.EventTime.UnixMilli()
+		case dfv1.LogFieldID:
+			line[string(f)] = message.ID
+		case dfv1.LogFieldWatermark:
+			line[string(f)] = t.watermark.Get().UnixMilli()
+		case dfv1.LogFieldIsLate:
+			line[string(f)] = message.IsLate
+		}
+	}
+	b, err := json.Marshal(line)
+	if err != nil {
+		t.logger.Errorw("Failed to marshal the message to JSON", zap.Error(err))
+		return
+	}
+	t.printer.Println(string(b))
+}
+
 func (t *ToLog) Close() error {
 	return nil
 }
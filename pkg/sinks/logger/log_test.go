@@ -17,7 +17,10 @@ limitations under the License.
 package logger
 
 import (
+	"bytes"
 	"context"
+	"log"
+	"strings"
 	"testing"
 	"time"
 
@@ -27,9 +30,12 @@ import (
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	sinktestutils "github.com/numaproj/numaflow/pkg/sinks/testutils"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -63,12 +69,14 @@ func TestToLog_Start(t *testing.T) {
 		AbstractVertex: dfv1.AbstractVertex{
 			Name: "sinks.logger",
 			Sink: &dfv1.Sink{
-				Log: &dfv1.Log{},
+				AbstractSink: dfv1.AbstractSink{
+					Log: &dfv1.Log{},
+				},
 			},
 		},
 	}}
 	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferList([]string{vertex.Spec.Name})
-	s, err := NewToLog(vertex, fromStep, fetchWatermark, publishWatermark, getSinkGoWhereDecider(vertex.Spec.Name))
+	s, err := NewToLog(vertex, fromStep, fetchWatermark, publishWatermark, getSinkGoWhereDecider(vertex.Spec.Name), nil)
 	assert.NoError(t, err)
 
 	stopped := s.Start()
@@ -119,7 +127,9 @@ func TestToLog_ForwardToTwoVertex(t *testing.T) {
 				AbstractVertex: dfv1.AbstractVertex{
 					Name: "sinks.logger1",
 					Sink: &dfv1.Sink{
-						Log: &dfv1.Log{},
+						AbstractSink: dfv1.AbstractSink{
+							Log: &dfv1.Log{},
+						},
 					},
 				},
 			}}
@@ -128,14 +138,16 @@ func TestToLog_ForwardToTwoVertex(t *testing.T) {
 				AbstractVertex: dfv1.AbstractVertex{
 					Name: "sinks.logger2",
 					Sink: &dfv1.Sink{
-						Log: &dfv1.Log{},
+						AbstractSink: dfv1.AbstractSink{
+							Log: &dfv1.Log{},
+						},
 					},
 				},
 			}}
 			fetchWatermark1, publishWatermark1 := generic.BuildNoOpWatermarkProgressorsFromBufferList([]string{vertex1.Spec.Name})
-			logger1, _ := NewToLog(vertex1, to1, fetchWatermark1, publishWatermark1, getSinkGoWhereDecider(vertex1.Spec.Name))
+			logger1, _ := NewToLog(vertex1, to1, fetchWatermark1, publishWatermark1, getSinkGoWhereDecider(vertex1.Spec.Name), nil)
 			fetchWatermark2, publishWatermark2 := generic.BuildNoOpWatermarkProgressorsFromBufferList([]string{vertex2.Spec.Name})
-			logger2, _ := NewToLog(vertex2, to2, fetchWatermark2, publishWatermark2, getSinkGoWhereDecider(vertex2.Spec.Name))
+			logger2, _ := NewToLog(vertex2, to2, fetchWatermark2, publishWatermark2, getSinkGoWhereDecider(vertex2.Spec.Name), nil)
 			logger1Stopped := logger1.Start()
 			logger2Stopped := logger2.Start()
 
@@ -182,3 +194,95 @@ func getSinkGoWhereDecider(vertexName string) forward.GoWhere {
 	})
 	return fsd
 }
+
+type testFetcher struct {
+	generic.NoOpWMProgressor
+}
+
+func (f *testFetcher) GetWatermark(isb.Offset, int32) wmb.Watermark {
+	return wmb.Watermark(testStartTime)
+}
+
+// capturePrints records the watermark of the batch as the forwarder does before writing,
+// and redirects what the sink prints to the returned buffer.
+func capturePrints(s *ToLog) *bytes.Buffer {
+	s.watermark.GetWatermark(nil, 0)
+	buf := &bytes.Buffer{}
+	s.printer = log.New(buf, "", 0)
+	return buf
+}
+
+func TestToLog_Write(t *testing.T) {
+	messages := testutils.BuildTestWriteMessages(2, testStartTime)
+	messages[0].Keys = []string{"k1"}
+	messages[1].IsLate = true
+
+	t.Run("default", func(t *testing.T) {
+		s, err := NewToLogWriter(sinktestutils.BuildTestVertex(dfv1.AbstractSink{Log: &dfv1.Log{}}), &testFetcher{})
+		require.NoError(t, err)
+		buf := capturePrints(s)
+		_, errs := s.Write(context.Background(), messages)
+		assert.Equal(t, make([]error, 2), errs)
+		assert.Equal(t, `(testVertex)  Payload -  {"Key":"paydload_0","Value":0}  Keys -  [k1]  EventTime -  1636470000000
+(testVertex)  Payload -  {"Key":"paydload_1","Value":1}  Keys -  []  EventTime -  1636470060000
+`, buf.String())
+	})
+
+	t.Run("human", func(t *testing.T) {
+		s, err := NewToLogWriter(sinktestutils.BuildTestVertex(dfv1.AbstractSink{Log: &dfv1.Log{Fields: []dfv1.LogField{dfv1.LogFieldID, dfv1.LogFieldWatermark, dfv1.LogFieldIsLate}}}), &testFetcher{})
+		require.NoError(t, err)
+		buf := capturePrints(s)
+		s.Write(context.Background(), messages)
+		assert.Equal(t, `(testVertex)  Payload -  {"Key":"paydload_0","Value":0}  ID -  0  Watermark -  1636470000000  IsLate -  false
+(testVertex)  Payload -  {"Key":"paydload_1","Value":1}  ID -  1  Watermark -  1636470000000  IsLate -  true
+`, buf.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		s, err := NewToLogWriter(sinktestutils.BuildTestVertex(dfv1.AbstractSink{Log: &dfv1.Log{
+			Format: dfv1.LogFormatJSON,
+			Fields: []dfv1.LogField{dfv1.LogFieldKeys, dfv1.LogFieldEventTime, dfv1.LogFieldID, dfv1.LogFieldWatermark, dfv1.LogFieldIsLate},
+		}}), &testFetcher{})
+		require.NoError(t, err)
+		buf := capturePrints(s)
+		s.Write(context.Background(), messages[:1])
+		assert.Equal(t, `{"eventTime":1636470000000,"id":"0","isLate":false,"keys":["k1"],"payload":"{\"Key\":\"paydload_0\",\"Value\":0}","vertex":"testVertex","watermark":1636470000000}
+`, buf.String())
+	})
+
+	t.Run("truncation", func(t *testing.T) {
+		maxPayloadLength := uint32(7)
+		s, err := NewToLogWriter(sinktestutils.BuildTestVertex(dfv1.AbstractSink{Log: &dfv1.Log{Format: dfv1.LogFormatJSON, Fields: []dfv1.LogField{dfv1.LogFieldID}, MaxPayloadLength: &maxPayloadLength}}), &testFetcher{})
+		require.NoError(t, err)
+		buf := capturePrints(s)
+		s.Write(context.Background(), messages[:1])
+		assert.Equal(t, `{"id":"0","payload":"{\"Key\":...","vertex":"testVertex"}
+`, buf.String())
+	})
+
+	t.Run("sampling", func(t *testing.T) {
+		s, err := NewToLogWriter(sinktestutils.BuildTestVertex(dfv1.AbstractSink{Log: &dfv1.Log{Format: dfv1.LogFormatJSON, Fields: []dfv1.LogField{dfv1.LogFieldID}, SamplingRate: "0.25"}}), &testFetcher{})
+		require.NoError(t, err)
+		buf := capturePrints(s)
+		s.Write(context.Background(), testutils.BuildTestWriteMessages(10, testStartTime))
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		assert.Len(t, lines, 2)
+		assert.Contains(t, lines[0], `"id":"3"`)
+		assert.Contains(t, lines[1], `"id":"7"`)
+
+		s, err = NewToLogWriter(sinktestutils.BuildTestVertex(dfv1.AbstractSink{Log: &dfv1.Log{SamplingRate: "0"}}), &testFetcher{})
+		require.NoError(t, err)
+		buf = capturePrints(s)
+		s.Write(context.Background(), messages)
+		assert.Empty(t, buf.String())
+	})
+
+	t.Run("invalid sampling rate", func(t *testing.T) {
+		vertex := sinktestutils.BuildTestVertex(dfv1.AbstractSink{Log: &dfv1.Log{SamplingRate: "half"}})
+		fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferList([]string{vertex.Spec.Name})
+		_, err := NewToLog(vertex, simplebuffer.NewInMemoryBuffer("from", 10, 0), fetchWatermark, publishWatermark, getSinkGoWhereDecider(vertex.Spec.Name), nil)
+		assert.Error(t, err)
+		_, err = NewToLogWriter(vertex, fetchWatermark)
+		assert.Error(t, err)
+	})
+}
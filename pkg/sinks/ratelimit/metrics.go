@@ -0,0 +1,38 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+// throttledSeconds is used to indicate the time spent waiting for the rate limits
+var throttledSeconds = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "sink_rate_limit",
+	Name:      "throttled_seconds_total",
+	Help:      "Total time in seconds spent waiting for the rate limits",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// replicasGauge is used to indicate the number of replicas the rate limits are shared by
+var replicasGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "sink_rate_limit",
+	Name:      "replicas",
+	Help:      "Number of replicas the rate limits are shared by",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
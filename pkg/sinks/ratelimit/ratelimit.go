@@ -0,0 +1,198 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ratelimit limits the rate of the messages written to a sink.
+//
+// The limits of a vertex are shared by its replicas, each replica is limited to an even share of them. The number of
+// replicas of the vertex status is kept in an annotation of the pods by the vertex controller, since the pods can't read
+// the vertex object, and read from the downward API volume.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// Limiter is a forward.RateLimiter limiting the messages per second and the bytes per second.
+type Limiter struct {
+	vertexName   string
+	pipelineName string
+	rateLimit    dfv1.SinkRateLimit
+	// nil if there's no limit
+	messages *rate.Limiter
+	bytes    *rate.Limiter
+	// file of the number of replicas of the vertex
+	replicasFile    string
+	refreshInterval time.Duration
+	lock            sync.Mutex
+	replicas        int
+	log             *zap.SugaredLogger
+}
+
+type Option func(*Limiter)
+
+func WithLogger(log *zap.SugaredLogger) Option {
+	return func(l *Limiter) {
+		l.log = log
+	}
+}
+
+// WithReplicasFile sets the file of the number of replicas, defaults to the file in the pod information volume.
+func WithReplicasFile(path string) Option {
+	return func(l *Limiter) {
+		l.replicasFile = path
+	}
+}
+
+// WithRefreshInterval sets the interval to read the number of replicas, defaults to 10s.
+func WithRefreshInterval(d time.Duration) Option {
+	return func(l *Limiter) {
+		l.refreshInterval = d
+	}
+}
+
+// NewLimiter returns a Limiter of the rate limit of the sink vertex. The limits are not shared before Start is called.
+func NewLimiter(vertex *dfv1.Vertex, opts ...Option) *Limiter {
+	l := &Limiter{
+		vertexName:      vertex.Spec.Name,
+		pipelineName:    vertex.Spec.PipelineName,
+		replicasFile:    filepath.Join(dfv1.PathPodInfoMount, dfv1.PodInfoReplicasFile),
+		refreshInterval: 10 * time.Second,
+	}
+	if x := vertex.Spec.Sink; x != nil && x.RateLimit != nil {
+		l.rateLimit = *x.RateLimit
+	}
+	for _, o := range opts {
+		o(l)
+	}
+	if l.log == nil {
+		l.log = logging.NewLogger()
+	}
+	if l.rateLimit.GetMessagesPerSecond() > 0 {
+		l.messages = rate.NewLimiter(0, 0)
+	}
+	if l.rateLimit.GetBytesPerSecond() > 0 {
+		l.bytes = rate.NewLimiter(0, 0)
+	}
+	l.setReplicas(1)
+	return l
+}
+
+// Start reads the number of replicas, and keeps reading it periodically until the context is done.
+func (l *Limiter) Start(ctx context.Context) {
+	l.refreshReplicas()
+	go func() {
+		ticker := time.NewTicker(l.refreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				l.refreshReplicas()
+			}
+		}
+	}()
+}
+
+// Wait blocks until the messages of the total bytes are allowed to be written, or the context is done.
+func (l *Limiter) Wait(ctx context.Context, messages int, bytes int) error {
+	start := time.Now()
+	defer func() {
+		throttledSeconds.With(map[string]string{metrics.LabelVertex: l.vertexName, metrics.LabelPipeline: l.pipelineName}).Add(time.Since(start).Seconds())
+	}()
+	if err := waitN(ctx, l.messages, messages); err != nil {
+		return err
+	}
+	return waitN(ctx, l.bytes, bytes)
+}
+
+// waitN waits for n tokens, in chunks of the burst if n is greater than the burst.
+func waitN(ctx context.Context, limiter *rate.Limiter, n int) error {
+	if limiter == nil {
+		return nil
+	}
+	for n > 0 {
+		c := n
+		if burst := limiter.Burst(); c > burst {
+			c = burst
+		}
+		if err := limiter.WaitN(ctx, c); err != nil {
+			return err
+		}
+		n -= c
+	}
+	return nil
+}
+
+// refreshReplicas reads the number of replicas, and keeps the current one if it's not available.
+func (l *Limiter) refreshReplicas() {
+	data, err := os.ReadFile(l.replicasFile)
+	if err != nil {
+		l.log.Warnw("Failed to read the number of replicas", zap.String("file", l.replicasFile), zap.Error(err))
+		return
+	}
+	replicas, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil || replicas < 1 {
+		l.log.Warnw("Invalid number of replicas", zap.String("replicas", string(data)))
+		return
+	}
+	l.setReplicas(replicas)
+}
+
+// setReplicas divides the limits by the number of replicas.
+func (l *Limiter) setReplicas(replicas int) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if replicas == l.replicas {
+		return
+	}
+	if l.messages != nil {
+		l.messages.SetLimit(rate.Limit(float64(l.rateLimit.GetMessagesPerSecond()) / float64(replicas)))
+		l.messages.SetBurst(share(l.rateLimit.GetMessageBurst(), replicas))
+	}
+	if l.bytes != nil {
+		l.bytes.SetLimit(rate.Limit(float64(l.rateLimit.GetBytesPerSecond()) / float64(replicas)))
+		l.bytes.SetBurst(share(l.rateLimit.GetByteBurst(), replicas))
+	}
+	if l.replicas != 0 {
+		l.log.Infow("Number of replicas changed, updated the rate limits", zap.Int("from", l.replicas), zap.Int("to", replicas))
+	}
+	l.replicas = replicas
+	replicasGauge.With(map[string]string{metrics.LabelVertex: l.vertexName, metrics.LabelPipeline: l.pipelineName}).Set(float64(replicas))
+}
+
+// share returns the share of the burst of each replica, which is at least 1.
+func share(burst int64, replicas int) int {
+	s := int(math.Ceil(float64(burst) / float64(replicas)))
+	if s < 1 {
+		return 1
+	}
+	return s
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func newTestVertex(rateLimit *dfv1.SinkRateLimit) *dfv1.Vertex {
+	return &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "sink",
+			Sink: &dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					Log: &dfv1.Log{},
+				},
+				RateLimit: rateLimit,
+			},
+		},
+	}}
+}
+
+func TestLimiter_Replicas(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	messages := uint32(100)
+	burst := uint32(10)
+	bytes := apiresource.MustParse("1000")
+	replicasFile := filepath.Join(t.TempDir(), "replicas")
+
+	l := NewLimiter(newTestVertex(&dfv1.SinkRateLimit{MessagesPerSecond: &messages, MessageBurst: &burst, BytesPerSecond: &bytes}),
+		WithReplicasFile(replicasFile), WithRefreshInterval(10*time.Millisecond))
+	// the file doesn't exist, the limits are not shared.
+	l.Start(ctx)
+	assert.Equal(t, 100.0, float64(l.messages.Limit()))
+	assert.Equal(t, 10, l.messages.Burst())
+	assert.Equal(t, 1000.0, float64(l.bytes.Limit()))
+	assert.Equal(t, 1000, l.bytes.Burst())
+
+	assert.NoError(t, os.WriteFile(replicasFile, []byte("4"), 0644))
+	assert.Eventually(t, func() bool {
+		return l.messages.Limit() == 25
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 3, l.messages.Burst())
+	assert.Equal(t, 250.0, float64(l.bytes.Limit()))
+	assert.Equal(t, 250, l.bytes.Burst())
+
+	// invalid numbers of replicas are ignored.
+	assert.NoError(t, os.WriteFile(replicasFile, []byte(""), 0644))
+	l.refreshReplicas()
+	assert.Equal(t, 25.0, float64(l.messages.Limit()))
+}
+
+func TestLimiter_Wait(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	t.Run("no limit", func(t *testing.T) {
+		l := NewLimiter(newTestVertex(&dfv1.SinkRateLimit{}))
+		assert.Nil(t, l.messages)
+		assert.Nil(t, l.bytes)
+		assert.NoError(t, l.Wait(ctx, 1000, 1000000))
+	})
+
+	t.Run("messages", func(t *testing.T) {
+		messages := uint32(100)
+		burst := uint32(10)
+		l := NewLimiter(newTestVertex(&dfv1.SinkRateLimit{MessagesPerSecond: &messages, MessageBurst: &burst}))
+		start := time.Now()
+		// the burst is consumed at once, the following 20 messages take 200ms.
+		assert.NoError(t, l.Wait(ctx, 30, 0))
+		assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+	})
+
+	t.Run("bytes", func(t *testing.T) {
+		bytes := apiresource.MustParse("1000")
+		l := NewLimiter(newTestVertex(&dfv1.SinkRateLimit{BytesPerSecond: &bytes}))
+		start := time.Now()
+		assert.NoError(t, l.Wait(ctx, 1, 1200))
+		assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		messages := uint32(1)
+		l := NewLimiter(newTestVertex(&dfv1.SinkRateLimit{MessagesPerSecond: &messages}))
+		cctx, ccancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer ccancel()
+		assert.Error(t, l.Wait(cctx, 10, 0))
+	})
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+// s3SinkWriteCount is used to indicate the number of messages uploaded
+var s3SinkWriteCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "s3_sink",
+	Name:      "write_total",
+	Help:      "Total number of messages uploaded",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// s3SinkWriteErrors is used to indicate the number of messages failed to be uploaded
+var s3SinkWriteErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "s3_sink",
+	Name:      "write_error_total",
+	Help:      "Total number of messages failed to be uploaded",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// s3SinkUploadCount is used to indicate the number of objects uploaded
+var s3SinkUploadCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "s3_sink",
+	Name:      "upload_total",
+	Help:      "Total number of objects uploaded",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// s3SinkUploadBytes is used to indicate the size of the objects uploaded
+var s3SinkUploadBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "s3_sink",
+	Name:      "upload_bytes_total",
+	Help:      "Total size of objects uploaded in bytes",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
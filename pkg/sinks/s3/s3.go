@@ -0,0 +1,293 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forward"
+	"github.com/numaproj/numaflow/pkg/forward/applier"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/ndjson"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+	"github.com/numaproj/numaflow/pkg/watermark/fetch"
+	"github.com/numaproj/numaflow/pkg/watermark/publish"
+)
+
+// ToS3 uploads the output to an S3 compatible object storage.
+type ToS3 struct {
+	name         string
+	pipelineName string
+	replica      int32
+	s3Sink       *dfv1.S3Sink
+	isdf         *forward.InterStepDataForward
+	uploader     *s3manager.Uploader
+	keyTemplate  *template.Template
+	encoder      *ndjson.Encoder
+	log          *zap.SugaredLogger
+}
+
+type Option func(*ToS3) error
+
+func WithLogger(log *zap.SugaredLogger) Option {
+	return func(t *ToS3) error {
+		t.log = log
+		return nil
+	}
+}
+
+// keyData is the data used to render the object keys.
+type keyData struct {
+	EventTime time.Time
+	Keys      []string
+	Pipeline  string
+	Vertex    string
+	Replica   int32
+	BatchID   string
+	Extension string
+}
+
+// NewToS3 returns ToS3 type.
+func NewToS3(vertexInstance *dfv1.VertexInstance,
+	fromBuffer isb.BufferReader,
+	fetchWatermark fetch.Fetcher,
+	publishWatermark map[string]publish.Publisher,
+	whereToDecider forward.GoWhere,
+	forwardOpts []forward.Option,
+	opts ...Option) (*ToS3, error) {
+
+	toS3, err := NewToS3Writer(vertexInstance, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	vertex := vertexInstance.Vertex
+	defaultOpts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeSink), forward.WithLogger(toS3.log)}
+	if x := vertex.Spec.Limits; x != nil {
+		if x.ReadBatchSize != nil {
+			defaultOpts = append(defaultOpts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
+		}
+	}
+	f, err := forward.NewInterStepDataForward(vertex, fromBuffer, map[string][]isb.BufferWriter{vertex.Spec.Name: {toS3}}, whereToDecider, applier.Terminal, fetchWatermark, publishWatermark, append(defaultOpts, forwardOpts...)...)
+	if err != nil {
+		return nil, err
+	}
+	toS3.isdf = f
+	return toS3, nil
+}
+
+// NewToS3Writer returns ToS3 type without a forwarder, the objects are uploaded when it's written to by the forwarder
+// of another sink, i.e. as a fallback sink.
+func NewToS3Writer(vertexInstance *dfv1.VertexInstance, opts ...Option) (*ToS3, error) {
+	vertex := vertexInstance.Vertex
+	s3Sink := vertex.Spec.Sink.S3
+	toS3 := &ToS3{
+		name:         vertex.Spec.Name,
+		pipelineName: vertex.Spec.PipelineName,
+		replica:      vertexInstance.Replica,
+	}
+	for _, o := range opts {
+		if err := o(toS3); err != nil {
+			return nil, err
+		}
+	}
+	if toS3.log == nil {
+		toS3.log = logging.NewLogger()
+	}
+	toS3.log = toS3.log.With("sinkType", "s3").With("bucket", s3Sink.Bucket)
+	if err := toS3.configure(s3Sink); err != nil {
+		return nil, err
+	}
+	return toS3, nil
+}
+
+// configure parses the key template, and creates the uploader.
+func (ts *ToS3) configure(s3Sink *dfv1.S3Sink) error {
+	ts.s3Sink = s3Sink
+	var err error
+	if ts.keyTemplate, err = template.New("key").Funcs(sprig.TxtFuncMap()).Option("missingkey=error").Parse(s3Sink.GetKey()); err != nil {
+		return fmt.Errorf("failed to parse key template, %w", err)
+	}
+	if ts.encoder, err = ndjson.NewEncoder(s3Sink.GetCompression()); err != nil {
+		return err
+	}
+	config := aws.NewConfig().WithRegion(s3Sink.GetRegion()).WithS3ForcePathStyle(s3Sink.ForcePathStyle)
+	if s3Sink.Endpoint != "" {
+		config = config.WithEndpoint(s3Sink.Endpoint)
+	}
+	if s3Sink.AccessKey != nil && s3Sink.SecretKey != nil {
+		accessKey, err := sharedutil.GetSecretFromVolume(s3Sink.AccessKey)
+		if err != nil {
+			return fmt.Errorf("failed to get s3 access key, %w", err)
+		}
+		secretKey, err := sharedutil.GetSecretFromVolume(s3Sink.SecretKey)
+		if err != nil {
+			return fmt.Errorf("failed to get s3 secret key, %w", err)
+		}
+		config = config.WithCredentials(credentials.NewStaticCredentials(accessKey, secretKey, ""))
+	}
+	if s3Sink.TLS != nil {
+		c, err := sharedutil.GetTLSConfig(s3Sink.TLS)
+		if err != nil {
+			return fmt.Errorf("failed to get s3 tls config, %w", err)
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = c
+		config = config.WithHTTPClient(&http.Client{Transport: transport})
+	}
+	sess, err := session.NewSession(config)
+	if err != nil {
+		return fmt.Errorf("failed to create s3 session, %w", err)
+	}
+	ts.uploader = s3manager.NewUploader(sess, func(u *s3manager.Uploader) {
+		u.PartSize = s3Sink.GetPartSize()
+	})
+	return nil
+}
+
+// GetName returns the name.
+func (ts *ToS3) GetName() string {
+	return ts.name
+}
+
+// GetPartitionIdx returns the partition index.
+// for sink it is always 0.
+func (ts *ToS3) GetPartitionIdx() int32 {
+	return 0
+}
+
+// Write uploads the messages as objects, the messages with the same key are uploaded as one object. It returns after
+// the uploads complete, so that the messages are acknowledged only if they are uploaded.
+func (ts *ToS3) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	errs := make([]error, len(messages))
+	batchID := fmt.Sprintf("%d-%s", time.Now().UnixNano(), strings.Split(uuid.New().String(), "-")[0])
+	var keys []string
+	groups := make(map[string][]int)
+	for i, msg := range messages {
+		key, err := ts.renderKey(msg, batchID)
+		if err != nil {
+			errs[i] = isb.NoRetryableBufferWriteErr{Name: ts.name, Message: fmt.Sprintf("failed to render object key, %v", err)}
+			continue
+		}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], i)
+	}
+	labels := map[string]string{metrics.LabelVertex: ts.name, metrics.LabelPipeline: ts.pipelineName}
+	for _, key := range keys {
+		indexes := groups[key]
+		var buf bytes.Buffer
+		for _, idx := range indexes {
+			ndjson.WriteLine(&buf, messages[idx].Payload)
+		}
+		err := ts.upload(ctx, key, buf.Bytes())
+		if err != nil {
+			ts.log.Errorw("Failed to upload object", zap.String("key", key), zap.Error(err))
+		}
+		for _, idx := range indexes {
+			errs[idx] = err
+		}
+	}
+	for _, err := range errs {
+		if err != nil {
+			s3SinkWriteErrors.With(labels).Inc()
+		} else {
+			s3SinkWriteCount.With(labels).Inc()
+		}
+	}
+	return nil, errs
+}
+
+func (ts *ToS3) renderKey(msg isb.Message, batchID string) (string, error) {
+	var b strings.Builder
+	data := keyData{
+		EventTime: msg.EventTime,
+		Keys:      msg.Keys,
+		Pipeline:  ts.pipelineName,
+		Vertex:    ts.name,
+		Replica:   ts.replica,
+		BatchID:   batchID,
+		Extension: ts.s3Sink.GetExtension(),
+	}
+	if err := ts.keyTemplate.Execute(&b, data); err != nil {
+		return "", err
+	}
+	key := strings.TrimPrefix(b.String(), "/")
+	if key == "" {
+		return "", fmt.Errorf("empty object key")
+	}
+	return key, nil
+}
+
+// upload uploads the data as an object, it's uploaded in multiple parts if it's larger than the part size.
+func (ts *ToS3) upload(ctx context.Context, key string, data []byte) error {
+	body, err := ts.encoder.Encode(data)
+	if err != nil {
+		return fmt.Errorf("failed to compress data, %w", err)
+	}
+	if _, err := ts.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket:      aws.String(ts.s3Sink.Bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(body),
+		ContentType: aws.String("application/x-ndjson"),
+	}); err != nil {
+		return fmt.Errorf("failed to upload object %q, %w", key, err)
+	}
+	labels := map[string]string{metrics.LabelVertex: ts.name, metrics.LabelPipeline: ts.pipelineName}
+	s3SinkUploadCount.With(labels).Inc()
+	s3SinkUploadBytes.With(labels).Add(float64(len(body)))
+	return nil
+}
+
+func (ts *ToS3) Close() error {
+	return nil
+}
+
+// Start starts sinking to S3.
+func (ts *ToS3) Start() <-chan struct{} {
+	return ts.isdf.Start()
+}
+
+// Stop stops sinking
+func (ts *ToS3) Stop() {
+	ts.isdf.Stop()
+	ts.log.Info("forwarder stopped successfully")
+}
+
+// ForceStop stops sinking
+func (ts *ToS3) ForceStop() {
+	ts.isdf.ForceStop()
+	ts.log.Info("forwarder force stopped successfully")
+}
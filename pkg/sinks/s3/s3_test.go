@@ -0,0 +1,201 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/sinks/testutils"
+)
+
+// fakeS3 is a minimal S3 compatible server, which supports the path style PutObject and multipart upload APIs.
+type fakeS3 struct {
+	lock       sync.Mutex
+	objects    map[string][]byte
+	uploads    map[string]map[int][]byte
+	multiparts int
+	fail       bool
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{objects: make(map[string][]byte), uploads: make(map[string]map[int][]byte)}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.fail {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	body, _ := io.ReadAll(r.Body)
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	query := r.URL.Query()
+	uploadID := query.Get("uploadId")
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		uploadID = strconv.Itoa(len(f.uploads) + 1)
+		f.uploads[uploadID] = make(map[int][]byte)
+		_, _ = fmt.Fprintf(w, `<InitiateMultipartUploadResult><UploadId>%s</UploadId></InitiateMultipartUploadResult>`, uploadID)
+	case r.Method == http.MethodPut && uploadID != "":
+		n, _ := strconv.Atoi(query.Get("partNumber"))
+		f.uploads[uploadID][n] = body
+		w.Header().Set("ETag", fmt.Sprintf(`"%d"`, n))
+	case r.Method == http.MethodPost && uploadID != "":
+		parts := f.uploads[uploadID]
+		var numbers []int
+		for n := range parts {
+			numbers = append(numbers, n)
+		}
+		sort.Ints(numbers)
+		var data []byte
+		for _, n := range numbers {
+			data = append(data, parts[n]...)
+		}
+		f.objects[path] = data
+		f.multiparts++
+		delete(f.uploads, uploadID)
+		_, _ = fmt.Fprintf(w, `<CompleteMultipartUploadResult><Key>%s</Key><ETag>"x"</ETag></CompleteMultipartUploadResult>`, path)
+	case r.Method == http.MethodDelete && uploadID != "":
+		delete(f.uploads, uploadID)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPut:
+		f.objects[path] = body
+		w.Header().Set("ETag", `"x"`)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *fakeS3) get(key string) []byte {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.objects[key]
+}
+
+func (f *fakeS3) keys() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	var keys []string
+	for k := range f.objects {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func gunzip(t *testing.T, data []byte) string {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func TestToS3_Write(t *testing.T) {
+	server := newFakeS3()
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+	toS3, err := NewToS3Writer(&dfv1.VertexInstance{Vertex: testutils.BuildTestVertex(dfv1.AbstractSink{S3: &dfv1.S3Sink{
+		Endpoint:       ts.URL,
+		Bucket:         "test-bucket",
+		Key:            `{{ .EventTime.UTC.Format "2006/01/02" }}/{{ index .Keys 0 }}-{{ .Replica }}{{ .Extension }}`,
+		ForcePathStyle: true,
+	}}), Replica: 1})
+	require.NoError(t, err)
+	eventTime := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	msgs := []isb.Message{
+		testutils.BuildTestMessage("0", eventTime, `{"v":1}`, "a"),
+		testutils.BuildTestMessage("1", eventTime, `{"v":2}`, "b"),
+		testutils.BuildTestMessage("2", eventTime, "{\"v\":3}\n", "a"),
+		testutils.BuildTestMessage("3", eventTime, `{"v":4}`),
+	}
+	_, errs := toS3.Write(context.Background(), msgs)
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	assert.NoError(t, errs[2])
+	// no keys to render the key.
+	assert.True(t, errors.As(errs[3], &isb.NoRetryableBufferWriteErr{}))
+	assert.Equal(t, []string{"test-bucket/2026/10/16/a-1.ndjson.gz", "test-bucket/2026/10/16/b-1.ndjson.gz"}, server.keys())
+	assert.Equal(t, "{\"v\":1}\n{\"v\":3}\n", gunzip(t, server.get("test-bucket/2026/10/16/a-1.ndjson.gz")))
+	assert.Equal(t, "{\"v\":2}\n", gunzip(t, server.get("test-bucket/2026/10/16/b-1.ndjson.gz")))
+
+	// the messages are not acknowledged if the uploads fail.
+	server.lock.Lock()
+	server.fail = true
+	server.lock.Unlock()
+	_, errs = toS3.Write(context.Background(), msgs[:1])
+	assert.Error(t, errs[0])
+	assert.False(t, errors.As(errs[0], &isb.NoRetryableBufferWriteErr{}))
+}
+
+func TestToS3_WriteMultipart(t *testing.T) {
+	server := newFakeS3()
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	partSize := apiresource.MustParse("5Mi")
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+	toS3, err := NewToS3Writer(&dfv1.VertexInstance{Vertex: testutils.BuildTestVertex(dfv1.AbstractSink{S3: &dfv1.S3Sink{
+		Endpoint:       ts.URL,
+		Bucket:         "test-bucket",
+		Key:            "{{ .Vertex }}-{{ .BatchID }}{{ .Extension }}",
+		ForcePathStyle: true,
+		Compression:    dfv1.FileCompressionNone,
+		PartSize:       &partSize,
+	}}), Replica: 1})
+	require.NoError(t, err)
+	payload := `"` + strings.Repeat("x", 1024) + `"`
+	var msgs []isb.Message
+	var expected strings.Builder
+	for i := 0; i < 12*1024; i++ {
+		msgs = append(msgs, testutils.BuildTestMessage(strconv.Itoa(i), time.Now(), payload))
+		expected.WriteString(payload)
+		expected.WriteString("\n")
+	}
+	_, errs := toS3.Write(context.Background(), msgs)
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	keys := server.keys()
+	require.Len(t, keys, 1)
+	assert.True(t, strings.HasPrefix(keys[0], "test-bucket/testVertex-"))
+	assert.True(t, strings.HasSuffix(keys[0], ".ndjson"))
+	assert.Equal(t, 1, server.multiparts)
+	assert.Equal(t, expected.String(), string(server.get(keys[0])))
+}
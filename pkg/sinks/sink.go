@@ -34,9 +34,15 @@ import (
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sinks/batch"
 	"github.com/numaproj/numaflow/pkg/sinks/blackhole"
+	filesink "github.com/numaproj/numaflow/pkg/sinks/file"
+	httpsink "github.com/numaproj/numaflow/pkg/sinks/http"
 	kafkasink "github.com/numaproj/numaflow/pkg/sinks/kafka"
 	logsink "github.com/numaproj/numaflow/pkg/sinks/logger"
+	"github.com/numaproj/numaflow/pkg/sinks/ratelimit"
+	s3sink "github.com/numaproj/numaflow/pkg/sinks/s3"
+	sqlsink "github.com/numaproj/numaflow/pkg/sinks/sql"
 	"github.com/numaproj/numaflow/pkg/sinks/udsink"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
@@ -101,8 +107,13 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 	}
 
 	var sinkHandler *udsink.UDSgRPCBasedUDSink = nil
-	if udSink := u.VertexInstance.Vertex.Spec.Sink.UDSink; udSink != nil {
-		sinkHandler, err = udsink.NewUDSgRPCBasedUDSink()
+	// the user defined sink can be either the primary sink or the fallback sink
+	if udSink := u.VertexInstance.Vertex.Spec.Sink.GetUDSink(); udSink != nil {
+		enableStatus, err := u.VertexInstance.Vertex.UDSinkStatusEnabled()
+		if err != nil {
+			return fmt.Errorf("failed to parse UDSink status metadata, %w", err)
+		}
+		sinkHandler, err = udsink.NewUDSgRPCBasedUDSink(enableStatus)
 		if err != nil {
 			return fmt.Errorf("failed to create gRPC client, %w", err)
 		}
@@ -119,10 +130,18 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 		}()
 	}
 
+	var forwardOpts []forward.Option
+	if u.VertexInstance.Vertex.Spec.Sink.RateLimit != nil {
+		// the rate limiter is shared by the sinkers of all the partitions.
+		limiter := ratelimit.NewLimiter(u.VertexInstance.Vertex, ratelimit.WithLogger(log))
+		limiter.Start(ctx)
+		forwardOpts = append(forwardOpts, forward.WithRateLimiter(limiter))
+	}
+
 	var finalWg sync.WaitGroup
 	for index := range u.VertexInstance.Vertex.OwnedBuffers() {
 		finalWg.Add(1)
-		sinker, err := u.getSinker(readers[index], log, fetchWatermark, publishWatermark, sinkHandler)
+		sinker, err := u.getSinker(readers[index], log, fetchWatermark, publishWatermark, sinkHandler, forwardOpts...)
 		if err != nil {
 			return fmt.Errorf("failed to find a sink, errpr: %w", err)
 		}
@@ -164,17 +183,71 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 }
 
 // getSinker takes in the logger from the parent context
-func (u *SinkProcessor) getSinker(reader isb.BufferReader, logger *zap.SugaredLogger, fetchWM fetch.Fetcher, publishWM map[string]publish.Publisher, sinkHandler *udsink.UDSgRPCBasedUDSink) (Sinker, error) {
+func (u *SinkProcessor) getSinker(reader isb.BufferReader, logger *zap.SugaredLogger, fetchWM fetch.Fetcher, publishWM map[string]publish.Publisher, sinkHandler *udsink.UDSgRPCBasedUDSink, forwardOpts ...forward.Option) (Sinker, error) {
 	sink := u.VertexInstance.Vertex.Spec.Sink
+	if sink.Fallback != nil {
+		// the recorder is shared by the primary and the fallback sinks, so that the fallback sink sees the watermark
+		// fetched by the forwarder of the primary sink.
+		fetchWM = fetch.NewRecorder(fetchWM)
+		fallback, err := u.createFallbackWriter(sink.Fallback, logger.With("fallback", true), fetchWM, sinkHandler)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the fallback sink, %w", err)
+		}
+		forwardOpts = append(forwardOpts, forward.WithFallbackWriter(fallback))
+		if sink.MaxRetries != nil {
+			forwardOpts = append(forwardOpts, forward.WithMaxRetries(*sink.MaxRetries))
+		}
+	}
+	if sink.Batching != nil {
+		// the forwarder acknowledges the messages of the accumulated batch after the sink writes them.
+		reader = batch.NewBatcher(u.VertexInstance.Vertex, reader)
+	}
+	vertex := u.VertexInstance.Vertex
 	if x := sink.Log; x != nil {
-		return logsink.NewToLog(u.VertexInstance.Vertex, reader, fetchWM, publishWM, u.getSinkGoWhereDecider(), logsink.WithLogger(logger))
+		return logsink.NewToLog(vertex, reader, fetchWM, publishWM, u.getSinkGoWhereDecider(), forwardOpts, logsink.WithLogger(logger))
 	} else if x := sink.Kafka; x != nil {
-		return kafkasink.NewToKafka(u.VertexInstance.Vertex, reader, fetchWM, publishWM, u.getSinkGoWhereDecider(), kafkasink.WithLogger(logger))
+		return kafkasink.NewToKafka(vertex, reader, fetchWM, publishWM, u.getSinkGoWhereDecider(), forwardOpts, kafkasink.WithLogger(logger))
 	} else if x := sink.Blackhole; x != nil {
-		return blackhole.NewBlackhole(u.VertexInstance.Vertex, reader, fetchWM, publishWM, u.getSinkGoWhereDecider(), blackhole.WithLogger(logger))
+		return blackhole.NewBlackhole(vertex, reader, fetchWM, publishWM, u.getSinkGoWhereDecider(), forwardOpts, blackhole.WithLogger(logger))
+	} else if x := sink.HTTP; x != nil {
+		return httpsink.NewToHTTP(vertex, reader, fetchWM, publishWM, u.getSinkGoWhereDecider(), forwardOpts, httpsink.WithLogger(logger))
+	} else if x := sink.File; x != nil {
+		return filesink.NewToFile(u.VertexInstance, reader, fetchWM, publishWM, u.getSinkGoWhereDecider(), forwardOpts, filesink.WithLogger(logger))
+	} else if x := sink.S3; x != nil {
+		return s3sink.NewToS3(u.VertexInstance, reader, fetchWM, publishWM, u.getSinkGoWhereDecider(), forwardOpts, s3sink.WithLogger(logger))
+	} else if x := sink.SQL; x != nil {
+		return sqlsink.NewToSQL(vertex, reader, fetchWM, publishWM, u.getSinkGoWhereDecider(), forwardOpts, sqlsink.WithLogger(logger))
 	} else if x := sink.UDSink; x != nil {
 		// if the sink is a user defined sink, then we need to pass the sinkHandler to it which will be used to invoke the user defined sink
-		return udsink.NewUserDefinedSink(u.VertexInstance.Vertex, reader, fetchWM, publishWM, u.getSinkGoWhereDecider(), sinkHandler, udsink.WithLogger(logger))
+		return udsink.NewUserDefinedSink(vertex, reader, fetchWM, publishWM, u.getSinkGoWhereDecider(), sinkHandler, forwardOpts, udsink.WithLogger(logger))
+	}
+	return nil, fmt.Errorf("invalid sink spec")
+}
+
+// createFallbackWriter creates the writer of the fallback sink. It has no forwarder, the messages are written to it by
+// the forwarder of the primary sink, which closes it on shutdown.
+func (u *SinkProcessor) createFallbackWriter(fallback *dfv1.AbstractSink, logger *zap.SugaredLogger, fetchWM fetch.Fetcher, sinkHandler *udsink.UDSgRPCBasedUDSink) (isb.BufferWriter, error) {
+	fallbackInstance := *u.VertexInstance
+	fallbackInstance.Vertex = u.VertexInstance.Vertex.DeepCopy()
+	fallbackInstance.Vertex.Spec.Sink = &dfv1.Sink{AbstractSink: *fallback}
+	vertex := fallbackInstance.Vertex
+	if x := fallback.Log; x != nil {
+		return logsink.NewToLogWriter(vertex, fetchWM, logsink.WithLogger(logger))
+	} else if x := fallback.Kafka; x != nil {
+		return kafkasink.NewToKafkaWriter(vertex, kafkasink.WithLogger(logger))
+	} else if x := fallback.Blackhole; x != nil {
+		return blackhole.NewBlackholeWriter(vertex, blackhole.WithLogger(logger))
+	} else if x := fallback.HTTP; x != nil {
+		return httpsink.NewToHTTPWriter(vertex, httpsink.WithLogger(logger))
+	} else if x := fallback.File; x != nil {
+		// the volume of the fallback file sink is mounted to a different path
+		return filesink.NewToFileWriter(&fallbackInstance, fetchWM, filesink.WithLogger(logger), filesink.WithRootDir(dfv1.PathFallbackFileSinkMount))
+	} else if x := fallback.S3; x != nil {
+		return s3sink.NewToS3Writer(&fallbackInstance, s3sink.WithLogger(logger))
+	} else if x := fallback.SQL; x != nil {
+		return sqlsink.NewToSQLWriter(vertex, sqlsink.WithLogger(logger))
+	} else if x := fallback.UDSink; x != nil {
+		return udsink.NewUserDefinedSinkWriter(vertex, sinkHandler, udsink.WithLogger(logger))
 	}
 	return nil, fmt.Errorf("invalid sink spec")
 }
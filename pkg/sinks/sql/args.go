@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// buildArgs maps the message to the arguments of the statement.
+func buildArgs(specs []dfv1.SQLArg, msg isb.Message) ([]interface{}, error) {
+	var payload interface{}
+	parsed := false
+	args := make([]interface{}, len(specs))
+	for i, spec := range specs {
+		switch spec.Metadata {
+		case dfv1.SQLArgMetadataEventTime:
+			args[i] = msg.EventTime.UTC()
+			continue
+		case dfv1.SQLArgMetadataID:
+			args[i] = msg.ID
+			continue
+		case dfv1.SQLArgMetadataKey:
+			if len(msg.Keys) > 0 {
+				args[i] = msg.Keys[0]
+			}
+			continue
+		case dfv1.SQLArgMetadataKeys:
+			keys := msg.Keys
+			if keys == nil {
+				keys = []string{}
+			}
+			b, _ := json.Marshal(keys)
+			args[i] = string(b)
+			continue
+		}
+		if !parsed {
+			d := json.NewDecoder(bytes.NewReader(msg.Payload))
+			d.UseNumber()
+			if err := d.Decode(&payload); err != nil {
+				return nil, fmt.Errorf("invalid json payload, %w", err)
+			}
+			parsed = true
+		}
+		v, err := toArg(lookup(payload, spec.Field))
+		if err != nil {
+			return nil, fmt.Errorf("invalid field %q, %w", spec.Field, err)
+		}
+		args[i] = v
+	}
+	return args, nil
+}
+
+// lookup returns the value of the field path, or nil if it doesn't exist.
+func lookup(v interface{}, path string) interface{} {
+	for _, p := range strings.Split(path, ".") {
+		switch x := v.(type) {
+		case map[string]interface{}:
+			v = x[p]
+		case []interface{}:
+			idx, err := strconv.Atoi(p)
+			if err != nil || idx < 0 || idx >= len(x) {
+				return nil
+			}
+			v = x[idx]
+		default:
+			return nil
+		}
+	}
+	return v
+}
+
+// toArg converts a JSON value to a statement argument.
+func toArg(v interface{}) (interface{}, error) {
+	switch x := v.(type) {
+	case json.Number:
+		if i, err := x.Int64(); err == nil {
+			return i, nil
+		}
+		return x.Float64()
+	case map[string]interface{}, []interface{}:
+		b, err := json.Marshal(x)
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	default:
+		// string, bool or nil.
+		return x, nil
+	}
+}
+
+// mysqlNonRetryableErrors are the MySQL error numbers caused by the data, which fail again if retried.
+var mysqlNonRetryableErrors = map[uint16]bool{
+	1048: true, // column cannot be null
+	1062: true, // duplicate entry
+	1216: true, // foreign key constraint fails on insert
+	1217: true, // foreign key constraint fails on delete
+	1264: true, // out of range value
+	1292: true, // incorrect value, e.g. datetime
+	1364: true, // field doesn't have a default value
+	1366: true, // incorrect value
+	1406: true, // data too long
+	1451: true, // foreign key constraint fails on delete or update
+	1452: true, // foreign key constraint fails on insert or update
+	3819: true, // check constraint violated
+}
+
+// isNonRetryable returns true if the error is caused by the data, e.g. a constraint violation.
+func isNonRetryable(err error) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		// class 22 is data exception, and class 23 is integrity constraint violation.
+		class := pqErr.Code.Class()
+		return class == "22" || class == "23"
+	}
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		return mysqlNonRetryableErrors[mysqlErr.Number]
+	}
+	var coded interface{ Code() int }
+	if errors.As(err, &coded) {
+		// sqlite, the primary result codes of SQLITE_CONSTRAINT and SQLITE_MISMATCH.
+		code := coded.Code() & 0xff
+		return code == 19 || code == 20
+	}
+	return false
+}
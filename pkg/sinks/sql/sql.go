@@ -0,0 +1,226 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forward"
+	"github.com/numaproj/numaflow/pkg/forward/applier"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+	"github.com/numaproj/numaflow/pkg/watermark/fetch"
+	"github.com/numaproj/numaflow/pkg/watermark/publish"
+)
+
+// savepoint is the name of the savepoint created before executing the statement for each message, which is
+// used to roll back the failed message without aborting the transaction.
+const savepoint = "numaflow_sink_row"
+
+// ToSQL writes the messages to a SQL database.
+type ToSQL struct {
+	name         string
+	pipelineName string
+	sqlSink      *dfv1.SQLSink
+	isdf         *forward.InterStepDataForward
+	db           *sql.DB
+	log          *zap.SugaredLogger
+}
+
+type Option func(*ToSQL) error
+
+func WithLogger(log *zap.SugaredLogger) Option {
+	return func(t *ToSQL) error {
+		t.log = log
+		return nil
+	}
+}
+
+// NewToSQL returns ToSQL type.
+func NewToSQL(vertex *dfv1.Vertex,
+	fromBuffer isb.BufferReader,
+	fetchWatermark fetch.Fetcher,
+	publishWatermark map[string]publish.Publisher,
+	whereToDecider forward.GoWhere,
+	forwardOpts []forward.Option,
+	opts ...Option) (*ToSQL, error) {
+
+	toSQL, err := NewToSQLWriter(vertex, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultOpts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeSink), forward.WithLogger(toSQL.log)}
+	if x := vertex.Spec.Limits; x != nil {
+		if x.ReadBatchSize != nil {
+			defaultOpts = append(defaultOpts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
+		}
+	}
+	f, err := forward.NewInterStepDataForward(vertex, fromBuffer, map[string][]isb.BufferWriter{vertex.Spec.Name: {toSQL}}, whereToDecider, applier.Terminal, fetchWatermark, publishWatermark, append(defaultOpts, forwardOpts...)...)
+	if err != nil {
+		_ = toSQL.Close()
+		return nil, err
+	}
+	toSQL.isdf = f
+	return toSQL, nil
+}
+
+// NewToSQLWriter returns ToSQL type with the database opened, but no forwarder. It's used as the writer of a fallback
+// sink, the database is closed with the forwarder of the primary sink.
+func NewToSQLWriter(vertex *dfv1.Vertex, opts ...Option) (*ToSQL, error) {
+	sqlSink := vertex.Spec.Sink.SQL
+	toSQL := &ToSQL{
+		name:         vertex.Spec.Name,
+		pipelineName: vertex.Spec.PipelineName,
+		sqlSink:      sqlSink,
+	}
+	for _, o := range opts {
+		if err := o(toSQL); err != nil {
+			return nil, err
+		}
+	}
+	if toSQL.log == nil {
+		toSQL.log = logging.NewLogger()
+	}
+	toSQL.log = toSQL.log.With("sinkType", "sql").With("driver", sqlSink.Driver)
+	dsn, err := sharedutil.GetSecretFromVolume(sqlSink.DSN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the dsn, %w", err)
+	}
+	if err := toSQL.connect(string(sqlSink.Driver), dsn); err != nil {
+		return nil, err
+	}
+	return toSQL, nil
+}
+
+// connect opens the database, the connections are established lazily.
+func (ts *ToSQL) connect(driver, dsn string) error {
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return fmt.Errorf("failed to open the database, %w", err)
+	}
+	db.SetMaxOpenConns(ts.sqlSink.GetMaxOpenConns())
+	db.SetMaxIdleConns(ts.sqlSink.GetMaxOpenConns())
+	ts.db = db
+	return nil
+}
+
+// GetName returns the name.
+func (ts *ToSQL) GetName() string {
+	return ts.name
+}
+
+// GetPartitionIdx returns the partition index.
+// for sink it is always 0.
+func (ts *ToSQL) GetPartitionIdx() int32 {
+	return 0
+}
+
+// Write executes the statement for each message in one transaction. The messages which fail with non-retryable
+// errors, e.g. invalid payloads or constraint violations, are rolled back to their savepoints and dropped, while the
+// other messages are committed. If any message fails with a retryable error, the whole transaction is rolled back,
+// and all the messages are retried.
+func (ts *ToSQL) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	errs := make([]error, len(messages))
+	labels := map[string]string{metrics.LabelVertex: ts.name, metrics.LabelPipeline: ts.pipelineName}
+	if err := ts.write(ctx, messages, errs); err != nil {
+		ts.log.Errorw("Failed to write to the database", zap.Error(err))
+		for i := range errs {
+			errs[i] = err
+		}
+	}
+	for _, err := range errs {
+		if err != nil {
+			sqlSinkWriteErrors.With(labels).Inc()
+		} else {
+			sqlSinkWriteCount.With(labels).Inc()
+		}
+	}
+	return nil, errs
+}
+
+// write writes the messages in a transaction, it sets the non-retryable errors to errs, and returns a retryable
+// error if the transaction fails.
+func (ts *ToSQL) write(ctx context.Context, messages []isb.Message, errs []error) error {
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin the transaction, %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	stmt, err := tx.PrepareContext(ctx, ts.sqlSink.Statement)
+	if err != nil {
+		return fmt.Errorf("failed to prepare the statement, %w", err)
+	}
+	defer func() { _ = stmt.Close() }()
+	for i, msg := range messages {
+		args, err := buildArgs(ts.sqlSink.Args, msg)
+		if err != nil {
+			errs[i] = isb.NoRetryableBufferWriteErr{Name: ts.name, Message: fmt.Sprintf("failed to map the message to the statement arguments, %v", err)}
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT "+savepoint); err != nil {
+			return fmt.Errorf("failed to create the savepoint, %w", err)
+		}
+		if _, err := stmt.ExecContext(ctx, args...); err != nil {
+			if !isNonRetryable(err) {
+				return fmt.Errorf("failed to execute the statement, %w", err)
+			}
+			errs[i] = isb.NoRetryableBufferWriteErr{Name: ts.name, Message: fmt.Sprintf("failed to execute the statement, %v", err)}
+			if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+savepoint); err != nil {
+				return fmt.Errorf("failed to roll back to the savepoint, %w", err)
+			}
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT "+savepoint); err != nil {
+			return fmt.Errorf("failed to release the savepoint, %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit the transaction, %w", err)
+	}
+	return nil
+}
+
+func (ts *ToSQL) Close() error {
+	return ts.db.Close()
+}
+
+// Start starts sinking to the database.
+func (ts *ToSQL) Start() <-chan struct{} {
+	return ts.isdf.Start()
+}
+
+// Stop stops sinking
+func (ts *ToSQL) Stop() {
+	ts.isdf.Stop()
+	ts.log.Info("forwarder stopped successfully")
+}
+
+// ForceStop stops sinking
+func (ts *ToSQL) ForceStop() {
+	ts.isdf.ForceStop()
+	ts.log.Info("forwarder force stopped successfully")
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sinks/testutils"
+)
+
+var testEventTime = time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+// connectTestDB connects the sink to a new SQLite database with the "totals" table.
+func connectTestDB(t *testing.T, toSQL *ToSQL) {
+	require.NoError(t, toSQL.connect("sqlite", filepath.Join(t.TempDir(), "test.db")))
+	t.Cleanup(func() { _ = toSQL.Close() })
+	_, err := toSQL.db.Exec(`CREATE TABLE totals (id TEXT PRIMARY KEY, total INTEGER NOT NULL CHECK (total >= 0), tags TEXT, updated_at TIMESTAMP)`)
+	require.NoError(t, err)
+}
+
+func TestToSQL_Write(t *testing.T) {
+	toSQL := &ToSQL{name: testutils.TestVertexName, pipelineName: testutils.TestPipelineName, log: logging.NewLogger(), sqlSink: &dfv1.SQLSink{
+		Statement: `INSERT INTO totals (id, total, tags, updated_at) VALUES (?, ?, ?, ?) ON CONFLICT (id) DO UPDATE SET total = excluded.total, tags = excluded.tags, updated_at = excluded.updated_at`,
+		Args: []dfv1.SQLArg{
+			{Metadata: dfv1.SQLArgMetadataKey},
+			{Field: "stats.total"},
+			{Field: "tags"},
+			{Metadata: dfv1.SQLArgMetadataEventTime},
+		},
+	}}
+	connectTestDB(t, toSQL)
+	msgs := []isb.Message{
+		testutils.BuildTestMessage("0", testEventTime, `{"stats": {"total": 1}, "tags": ["x"]}`, "a"),
+		testutils.BuildTestMessage("1", testEventTime, `{"stats": {"total": 2}}`, "b"),
+		// upsert
+		testutils.BuildTestMessage("2", testEventTime, `{"stats": {"total": 3}}`, "a"),
+		// check constraint
+		testutils.BuildTestMessage("3", testEventTime, `{"stats": {"total": -1}}`, "c"),
+		// not null constraint
+		testutils.BuildTestMessage("4", testEventTime, `{"stats": {}}`, "d"),
+		// invalid json
+		testutils.BuildTestMessage("5", testEventTime, `{`, "e"),
+	}
+	_, errs := toSQL.Write(context.Background(), msgs)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, errs[i])
+	}
+	for i := 3; i < 6; i++ {
+		assert.True(t, errors.As(errs[i], &isb.NoRetryableBufferWriteErr{}), "message %d", i)
+	}
+
+	rows, err := toSQL.db.Query(`SELECT id, total, tags FROM totals ORDER BY id`)
+	require.NoError(t, err)
+	defer func() { _ = rows.Close() }()
+	type row struct {
+		id    string
+		total int64
+		tags  *string
+	}
+	var result []row
+	for rows.Next() {
+		var r row
+		require.NoError(t, rows.Scan(&r.id, &r.total, &r.tags))
+		result = append(result, r)
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, []row{{id: "a", total: 3}, {id: "b", total: 2}}, result)
+}
+
+func TestToSQL_WriteRetryable(t *testing.T) {
+	toSQL := &ToSQL{name: testutils.TestVertexName, pipelineName: testutils.TestPipelineName, log: logging.NewLogger(), sqlSink: &dfv1.SQLSink{
+		Statement: `INSERT INTO missing_table (id) VALUES (?)`,
+		Args:      []dfv1.SQLArg{{Metadata: dfv1.SQLArgMetadataID}},
+	}}
+	connectTestDB(t, toSQL)
+	_, errs := toSQL.Write(context.Background(), []isb.Message{
+		testutils.BuildTestMessage("0", testEventTime, `{}`),
+		testutils.BuildTestMessage("1", testEventTime, `{}`),
+	})
+	for _, err := range errs {
+		assert.Error(t, err)
+		assert.False(t, errors.As(err, &isb.NoRetryableBufferWriteErr{}))
+	}
+}
+
+func TestBuildArgs(t *testing.T) {
+	args, err := buildArgs([]dfv1.SQLArg{
+		{Field: "a"},
+		{Field: "b.0"},
+		{Field: "b.5"},
+		{Field: "c"},
+		{Field: "d"},
+		{Field: "e"},
+		{Metadata: dfv1.SQLArgMetadataID},
+		{Metadata: dfv1.SQLArgMetadataKeys},
+		{Metadata: dfv1.SQLArgMetadataKey},
+	}, testutils.BuildTestMessage("id-0", testEventTime, `{"a": 1.5, "b": [10], "c": {"x": true}, "d": "s", "e": false}`, "k1", "k2"))
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{1.5, int64(10), nil, `{"x":true}`, "s", false, "id-0", `["k1","k2"]`, "k1"}, args)
+
+	// the payload is not parsed if only metadata is used.
+	args, err = buildArgs([]dfv1.SQLArg{{Metadata: dfv1.SQLArgMetadataKey}}, testutils.BuildTestMessage("id-1", testEventTime, `not json`))
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{nil}, args)
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package testutils provides the helpers shared by the tests of the sinks.
+package testutils
+
+import (
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+const (
+	// TestPipelineName is the name of the pipeline of the test vertices.
+	TestPipelineName = "testPipeline"
+	// TestVertexName is the name of the test vertices.
+	TestVertexName = "testVertex"
+)
+
+// BuildTestVertex builds a sink vertex of the given sink, which can be used for testing.
+func BuildTestVertex(sink dfv1.AbstractSink) *dfv1.Vertex {
+	return &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: TestPipelineName,
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: TestVertexName,
+			Sink: &dfv1.Sink{AbstractSink: sink},
+		},
+	}}
+}
+
+// BuildTestMessage builds a test isb.Message of the payload, which can be used for testing.
+func BuildTestMessage(id string, eventTime time.Time, payload string, keys ...string) isb.Message {
+	return isb.Message{
+		Header: isb.Header{MessageInfo: isb.MessageInfo{EventTime: eventTime}, ID: id, Keys: keys},
+		Body:   isb.Body{Payload: []byte(payload)},
+	}
+}
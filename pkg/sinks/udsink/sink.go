@@ -18,6 +18,7 @@ package udsink
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"go.uber.org/zap"
@@ -28,11 +29,19 @@ import (
 	"github.com/numaproj/numaflow/pkg/forward"
 	"github.com/numaproj/numaflow/pkg/forward/applier"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/publish"
 )
 
+const (
+	// the retry interval of the failed messages starts from retryInitialInterval, and doubles after each retry until
+	// it reaches retryMaxInterval, unless the user defined sink responds a longer backoff.
+	retryInitialInterval = 100 * time.Millisecond
+	retryMaxInterval     = 30 * time.Second
+)
+
 type UserDefinedSink struct {
 	name         string
 	pipelineName string
@@ -57,8 +66,31 @@ func NewUserDefinedSink(vertex *dfv1.Vertex,
 	publishWatermark map[string]publish.Publisher,
 	whereToDecider forward.GoWhere,
 	udsink *UDSgRPCBasedUDSink,
+	forwardOpts []forward.Option,
 	opts ...Option) (*UserDefinedSink, error) {
 
+	s, err := NewUserDefinedSinkWriter(vertex, udsink, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultOpts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeSink), forward.WithLogger(s.logger), forward.WithRetryBackoff(retryInitialInterval, retryMaxInterval)}
+	if x := vertex.Spec.Limits; x != nil {
+		if x.ReadBatchSize != nil {
+			defaultOpts = append(defaultOpts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
+		}
+	}
+
+	isdf, err := forward.NewInterStepDataForward(vertex, fromBuffer, map[string][]isb.BufferWriter{vertex.Spec.Name: {s}}, whereToDecider, applier.Terminal, fetchWatermark, publishWatermark, append(defaultOpts, forwardOpts...)...)
+	if err != nil {
+		return nil, err
+	}
+	s.isdf = isdf
+	return s, nil
+}
+
+// NewUserDefinedSinkWriter returns a user defined sink without a forwarder, which is used as a fallback sink.
+func NewUserDefinedSinkWriter(vertex *dfv1.Vertex, udsink *UDSgRPCBasedUDSink, opts ...Option) (*UserDefinedSink, error) {
 	s := new(UserDefinedSink)
 	name := vertex.Spec.Name
 	s.name = name
@@ -71,20 +103,7 @@ func NewUserDefinedSink(vertex *dfv1.Vertex,
 	if s.logger == nil {
 		s.logger = logging.NewLogger()
 	}
-
-	forwardOpts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeSink), forward.WithLogger(s.logger)}
-	if x := vertex.Spec.Limits; x != nil {
-		if x.ReadBatchSize != nil {
-			forwardOpts = append(forwardOpts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
-		}
-	}
 	s.udsink = udsink
-
-	isdf, err := forward.NewInterStepDataForward(vertex, fromBuffer, map[string][]isb.BufferWriter{vertex.Spec.Name: {s}}, whereToDecider, applier.Terminal, fetchWatermark, publishWatermark, forwardOpts...)
-	if err != nil {
-		return nil, err
-	}
-	s.isdf = isdf
 	return s, nil
 }
 
@@ -115,7 +134,18 @@ func (s *UserDefinedSink) Write(ctx context.Context, messages []isb.Message) ([]
 			Watermark: &sinkpb.Watermark{Watermark: timestamppb.New(time.Time{})}, // TODO: insert the correct watermark
 		}
 	}
-	return nil, s.udsink.Apply(ctx, msgs)
+	errs := s.udsink.Apply(ctx, msgs)
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		failureType := failureTypeRetryable
+		if errors.As(err, &isb.NoRetryableBufferWriteErr{}) {
+			failureType = failureTypePermanent
+		}
+		udsinkWriteFailureCount.With(map[string]string{metrics.LabelVertex: s.name, metrics.LabelPipeline: s.pipelineName, labelFailureType: failureType}).Inc()
+	}
+	return nil, errs
 }
 
 func (s *UserDefinedSink) Close() error {
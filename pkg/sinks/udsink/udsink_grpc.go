@@ -19,25 +19,31 @@ package udsink
 import (
 	"context"
 	"fmt"
-	sinkclient "github.com/numaproj/numaflow/pkg/sdkclient/sink/client"
 	"time"
 
 	sinkpb "github.com/numaproj/numaflow-go/pkg/apis/proto/sink/v1"
 	"google.golang.org/protobuf/types/known/emptypb"
+
+	udsinkpb "github.com/numaproj/numaflow/pkg/apis/proto/udsink"
+	"github.com/numaproj/numaflow/pkg/isb"
+	sinkclient "github.com/numaproj/numaflow/pkg/sdkclient/sink/client"
 )
 
 // UDSgRPCBasedUDSink applies user defined sink over gRPC (over Unix Domain Socket) client/server where server is the UDSink.
 type UDSgRPCBasedUDSink struct {
 	client sinkclient.Client
+	// enableStatus indicates the UDSink responds a typed status of each message with the udsink protocol, which tells
+	// a permanent failure and the backoff of a retryable failure. Otherwise, all the failures are retryable.
+	enableStatus bool
 }
 
 // NewUDSgRPCBasedUDSink returns UDSgRPCBasedUDSink
-func NewUDSgRPCBasedUDSink() (*UDSgRPCBasedUDSink, error) {
+func NewUDSgRPCBasedUDSink(enableStatus bool) (*UDSgRPCBasedUDSink, error) {
 	c, err := sinkclient.New()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create a new gRPC client: %w", err)
 	}
-	return &UDSgRPCBasedUDSink{c}, nil
+	return &UDSgRPCBasedUDSink{client: c, enableStatus: enableStatus}, nil
 }
 
 // CloseConn closes the gRPC client connection.
@@ -66,19 +72,15 @@ func (u *UDSgRPCBasedUDSink) WaitUntilReady(ctx context.Context) error {
 }
 
 func (u *UDSgRPCBasedUDSink) Apply(ctx context.Context, dList []*sinkpb.DatumRequest) []error {
+	if u.enableStatus {
+		return u.applyWithStatus(ctx, dList)
+	}
 	errs := make([]error, len(dList))
 
 	responseList, err := u.client.SinkFn(ctx, dList)
 	if err != nil {
 		for i := range dList {
-			errs[i] = ApplyUDSinkErr{
-				UserUDSinkErr: false,
-				Message:       fmt.Sprintf("gRPC client.SinkFn failed, %s", err),
-				InternalErr: InternalErr{
-					Flag:        true,
-					MainCarDown: false,
-				},
-			}
+			errs[i] = sinkFnErr(err)
 		}
 		return errs
 	}
@@ -102,3 +104,67 @@ func (u *UDSgRPCBasedUDSink) Apply(ctx context.Context, dList []*sinkpb.DatumReq
 	}
 	return errs
 }
+
+// applyWithStatus writes the messages with the udsink protocol, and maps the typed status of each message to an error.
+// A permanent failure is not retried, so that it's written to the fallback sink, and a retryable failure with a backoff
+// is not retried within the backoff.
+func (u *UDSgRPCBasedUDSink) applyWithStatus(ctx context.Context, dList []*sinkpb.DatumRequest) []error {
+	errs := make([]error, len(dList))
+	datums := make([]*udsinkpb.DatumRequest, len(dList))
+	for i, d := range dList {
+		datums[i] = &udsinkpb.DatumRequest{
+			Id:        d.GetId(),
+			Keys:      d.GetKeys(),
+			Value:     d.GetValue(),
+			EventTime: d.GetEventTime().GetEventTime().AsTime().UnixMilli(),
+			Watermark: d.GetWatermark().GetWatermark().AsTime().UnixMilli(),
+		}
+	}
+
+	responseList, err := u.client.SinkStatusFn(ctx, datums)
+	if err != nil {
+		for i := range dList {
+			errs[i] = sinkFnErr(err)
+		}
+		return errs
+	}
+	resMap := make(map[string]*udsinkpb.Response)
+	for _, res := range responseList {
+		resMap[res.GetId()] = res
+	}
+	for i, m := range dList {
+		r, existing := resMap[m.GetId()]
+		if !existing {
+			errs[i] = fmt.Errorf("not found in responseList")
+			continue
+		}
+		errMsg := r.GetErrMsg()
+		if errMsg == "" {
+			errMsg = "unsuccessful due to unknown reason"
+		}
+		switch r.GetStatus() {
+		case udsinkpb.Status_SUCCESS:
+		case udsinkpb.Status_PERMANENT:
+			errs[i] = isb.NoRetryableBufferWriteErr{Name: "udsink", Message: errMsg}
+		default:
+			if r.GetBackoffMillis() > 0 {
+				errs[i] = isb.RetryableBufferWriteErr{Name: "udsink", Message: errMsg, Backoff: time.Duration(r.GetBackoffMillis()) * time.Millisecond}
+			} else {
+				errs[i] = fmt.Errorf(errMsg)
+			}
+		}
+	}
+	return errs
+}
+
+// sinkFnErr returns the error of a failed gRPC client.SinkFn call.
+func sinkFnErr(err error) error {
+	return ApplyUDSinkErr{
+		UserUDSinkErr: false,
+		Message:       fmt.Sprintf("gRPC client.SinkFn failed, %s", err),
+		InternalErr: InternalErr{
+			Flag:        true,
+			MainCarDown: false,
+		},
+	}
+}
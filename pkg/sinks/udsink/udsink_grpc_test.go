@@ -19,6 +19,7 @@ package udsink
 import (
 	"context"
 	"fmt"
+	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/sdkclient/sink/clienttest"
 	"testing"
 	"time"
@@ -27,12 +28,39 @@ import (
 	sinkpb "github.com/numaproj/numaflow-go/pkg/apis/proto/sink/v1"
 	"github.com/numaproj/numaflow-go/pkg/apis/proto/sink/v1/sinkmock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
+
+	udsinkpb "github.com/numaproj/numaflow/pkg/apis/proto/udsink"
 )
 
 func NewMockUDSgRPCBasedUDSink(mockClient *sinkmock.MockUserDefinedSinkClient) *UDSgRPCBasedUDSink {
 	c, _ := clienttest.New(mockClient)
-	return &UDSgRPCBasedUDSink{c}
+	return &UDSgRPCBasedUDSink{client: c}
+}
+
+// fakeStatusClient responds to the datums sent on the stream with the respond function once the stream is closed.
+type fakeStatusClient struct {
+	respond func(datums []*udsinkpb.DatumRequest) []*udsinkpb.Response
+}
+
+func (f *fakeStatusClient) SinkFn(_ context.Context, _ ...grpc.CallOption) (udsinkpb.UDSink_SinkFnClient, error) {
+	return &fakeStatusStream{respond: f.respond}, nil
+}
+
+type fakeStatusStream struct {
+	grpc.ClientStream
+	respond func(datums []*udsinkpb.DatumRequest) []*udsinkpb.Response
+	datums  []*udsinkpb.DatumRequest
+}
+
+func (f *fakeStatusStream) Send(datum *udsinkpb.DatumRequest) error {
+	f.datums = append(f.datums, datum)
+	return nil
+}
+
+func (f *fakeStatusStream) CloseAndRecv() (*udsinkpb.ResponseList, error) {
+	return &udsinkpb.ResponseList{Responses: f.respond(f.datums)}, nil
 }
 
 func Test_gRPCBasedUDSink_WaitUntilReadyWithMockClient(t *testing.T) {
@@ -173,3 +201,38 @@ func Test_gRPCBasedUDSink_ApplyWithMockClient(t *testing.T) {
 		assert.Equal(t, expectedErrList, gotErrList)
 	})
 }
+
+func Test_gRPCBasedUDSink_ApplyWithStatus(t *testing.T) {
+	var testDatumList []*sinkpb.DatumRequest
+	for i := 0; i < 5; i++ {
+		testDatumList = append(testDatumList, &sinkpb.DatumRequest{
+			Id:        fmt.Sprintf("test_id_%d", i),
+			Value:     []byte(`sink_message`),
+			EventTime: &sinkpb.EventTime{EventTime: timestamppb.New(time.Unix(1661169660, 0))},
+			Watermark: &sinkpb.Watermark{Watermark: timestamppb.New(time.Time{})},
+		})
+	}
+	statusClient := &fakeStatusClient{respond: func(datums []*udsinkpb.DatumRequest) []*udsinkpb.Response {
+		assert.Len(t, datums, 5)
+		assert.Equal(t, int64(1661169660000), datums[0].EventTime)
+		// respond in the reverse order, and skip the last message.
+		return []*udsinkpb.Response{
+			{Id: "test_id_3", Status: udsinkpb.Status_RETRYABLE, ErrMsg: "rate limited", BackoffMillis: 5000},
+			{Id: "test_id_2", Status: udsinkpb.Status_PERMANENT, ErrMsg: "invalid message"},
+			{Id: "test_id_1", Status: udsinkpb.Status_RETRYABLE, ErrMsg: "mock sink message error"},
+			{Id: "test_id_0", Status: udsinkpb.Status_SUCCESS},
+		}
+	}}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c, _ := clienttest.NewWithStatusClient(sinkmock.NewMockUserDefinedSinkClient(ctrl), statusClient)
+	u := &UDSgRPCBasedUDSink{client: c, enableStatus: true}
+
+	gotErrList := u.Apply(context.Background(), testDatumList)
+	assert.Equal(t, 5, len(gotErrList))
+	assert.NoError(t, gotErrList[0])
+	assert.Equal(t, fmt.Errorf("mock sink message error"), gotErrList[1])
+	assert.Equal(t, isb.NoRetryableBufferWriteErr{Name: "udsink", Message: "invalid message"}, gotErrList[2])
+	assert.Equal(t, isb.RetryableBufferWriteErr{Name: "udsink", Message: "rate limited", Backoff: 5 * time.Second}, gotErrList[3])
+	assert.Equal(t, fmt.Errorf("not found in responseList"), gotErrList[4])
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// checkpointEntry is the acknowledged offset of a file.
+type checkpointEntry struct {
+	// Path of the file when the offset is acknowledged, only for troubleshooting.
+	Path string `json:"path"`
+	// Offset is the offset in the file of the next byte to read.
+	Offset int64 `json:"offset"`
+	// Truncations is the number of times the file is found truncated in place, the offsets read before a truncation
+	// are not acknowledged after it.
+	Truncations int64 `json:"truncations,omitempty"`
+}
+
+// checkpoint keeps the acknowledged offsets of the files, keyed by the file IDs, which survive renaming.
+type checkpoint map[string]checkpointEntry
+
+// loadCheckpoint loads the checkpoint from the path, an empty checkpoint is returned if the file does not exist.
+func loadCheckpoint(path string) (checkpoint, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return checkpoint{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read the checkpoint file %q, %w", path, err)
+	}
+	cp := checkpoint{}
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the checkpoint file %q, %w", path, err)
+	}
+	return cp, nil
+}
+
+// save persists the checkpoint, it's written to a temporary file and renamed so that a crash does not leave a partial file.
+func (cp checkpoint) save(path string) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,495 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package file implements a source which reads the files matching a glob pattern from a volume, it follows the
+// appended data, detects rotations, and checkpoints the acknowledged offsets so that the reading resumes after restarts.
+package file
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/araddon/dateparse"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forward"
+	"github.com/numaproj/numaflow/pkg/forward/applier"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/expr"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/watermark/fetch"
+	"github.com/numaproj/numaflow/pkg/watermark/processor"
+	"github.com/numaproj/numaflow/pkg/watermark/publish"
+	"github.com/numaproj/numaflow/pkg/watermark/store"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+type fileSource struct {
+	// name of the source vertex
+	name string
+	// name of the pipeline
+	pipelineName string
+	// root directory where the volume is mounted
+	root string
+	// glob pattern of the files, relative to the root
+	pattern string
+	format  dfv1.FileFormat
+	follow  bool
+	// interval to look for new, rotated or appended files
+	pollInterval time.Duration
+	// path of the checkpoint file, relative to the root
+	checkpointPath string
+	// event time extraction, the modification time of the file is used if nil
+	eventTime *dfv1.FileEventTime
+	// read timeout for the reader
+	readTimeout time.Duration
+	// files being read, keyed by the file IDs
+	files map[string]*trackedFile
+	// IDs of the files in the order they are found
+	fileOrder []string
+	// last time the files were scanned
+	lastScanTime time.Time
+	// acknowledged offsets
+	checkpoint checkpoint
+	lock       sync.Mutex
+	// forwarder that writes the consumed data to destination
+	forwarder *forward.InterStepDataForward
+	// source watermark publisher
+	sourcePublishWM publish.Publisher
+	cancel          context.CancelFunc
+	logger          *zap.SugaredLogger
+}
+
+type Option func(*fileSource) error
+
+// WithLogger is used to return logger information
+func WithLogger(l *zap.SugaredLogger) Option {
+	return func(o *fileSource) error {
+		o.logger = l
+		return nil
+	}
+}
+
+// WithReadTimeout sets the read timeout
+func WithReadTimeout(timeout time.Duration) Option {
+	return func(o *fileSource) error {
+		o.readTimeout = timeout
+		return nil
+	}
+}
+
+// WithRootDir sets the directory where the volume is mounted, defaults to dfv1.PathFileSourceMount
+func WithRootDir(dir string) Option {
+	return func(o *fileSource) error {
+		o.root = dir
+		return nil
+	}
+}
+
+// New returns a file source.
+func New(
+	vertexInstance *dfv1.VertexInstance,
+	writers map[string][]isb.BufferWriter,
+	fsd forward.ToWhichStepDecider,
+	mapApplier applier.MapApplier,
+	fetchWM fetch.Fetcher,
+	publishWM map[string]publish.Publisher,
+	publishWMStores store.WatermarkStorer,
+	opts ...Option) (*fileSource, error) {
+
+	vertexSpec := vertexInstance.Vertex.Spec
+	fileSpec := vertexSpec.Source.File
+	fs := &fileSource{
+		name:           vertexSpec.Name,
+		pipelineName:   vertexSpec.PipelineName,
+		root:           dfv1.PathFileSourceMount,
+		pattern:        fileSpec.Path,
+		format:         fileSpec.GetFormat(),
+		follow:         fileSpec.IsFollowing(),
+		pollInterval:   fileSpec.GetPollInterval(),
+		checkpointPath: filepath.Clean(fileSpec.GetCheckpointPath(vertexSpec.PipelineName, vertexSpec.Name)),
+		eventTime:      fileSpec.EventTime,
+		readTimeout:    time.Second,
+		files:          make(map[string]*trackedFile),
+	}
+	for _, o := range opts {
+		if err := o(fs); err != nil {
+			return nil, err
+		}
+	}
+	if fs.logger == nil {
+		fs.logger = logging.NewLogger()
+	}
+	if _, err := filepath.Match(fs.pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid file path pattern %q, %w", fs.pattern, err)
+	}
+	cp, err := loadCheckpoint(filepath.Join(fs.root, fs.checkpointPath))
+	if err != nil {
+		return nil, err
+	}
+	fs.checkpoint = cp
+
+	forwardOpts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeSource), forward.WithLogger(fs.logger), forward.WithSourceWatermarkPublisher(fs)}
+	if x := vertexSpec.Limits; x != nil {
+		if x.ReadBatchSize != nil {
+			forwardOpts = append(forwardOpts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
+		}
+	}
+	forwarder, err := forward.NewInterStepDataForward(vertexInstance.Vertex, fs, writers, fsd, mapApplier, fetchWM, publishWM, forwardOpts...)
+	if err != nil {
+		return nil, err
+	}
+	fs.forwarder = forwarder
+
+	ctx, cancel := context.WithCancel(context.Background())
+	fs.cancel = cancel
+	entityName := fmt.Sprintf("%s-%d", vertexInstance.Vertex.Name, vertexInstance.Replica)
+	processorEntity := processor.NewProcessorEntity(entityName)
+	// toVertexPartitionCount is 1 because we publish watermarks within source itself.
+	fs.sourcePublishWM = publish.NewPublish(ctx, processorEntity, publishWMStores, 1, publish.IsSource(), publish.WithDelay(vertexSpec.Watermark.GetMaxDelay()))
+	return fs, nil
+}
+
+func (fs *fileSource) GetName() string {
+	return fs.name
+}
+
+// GetPartitionIdx returns the partition number for the source vertex buffer
+// Source is like a buffer with only one partition. So, we always return 0
+func (fs *fileSource) GetPartitionIdx() int32 {
+	return 0
+}
+
+// Read reads the records from the files. It returns as soon as there are records available, or waits for the new data
+// until the read timeout.
+func (fs *fileSource) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	deadline := time.Now().Add(fs.readTimeout)
+	for {
+		if time.Since(fs.lastScanTime) >= fs.pollInterval {
+			fs.scan()
+		}
+		msgs := fs.readRecords(count)
+		if len(msgs) > 0 {
+			fileSourceReadCount.With(map[string]string{metrics.LabelVertex: fs.name, metrics.LabelPipeline: fs.pipelineName}).Add(float64(len(msgs)))
+			return msgs, nil
+		}
+		wait := time.Until(deadline)
+		if wait <= 0 {
+			return msgs, nil
+		}
+		if next := time.Until(fs.lastScanTime.Add(fs.pollInterval)); next < wait {
+			wait = next
+		}
+		select {
+		case <-ctx.Done():
+			return msgs, nil
+		case <-time.After(wait):
+		}
+	}
+}
+
+// scan looks for the files matching the pattern, new files are opened from the checkpointed offsets.
+func (fs *fileSource) scan() {
+	fs.lastScanTime = time.Now()
+	paths, err := filepath.Glob(filepath.Join(fs.root, fs.pattern))
+	if err != nil {
+		fs.logger.Errorw("Failed to list the files", zap.String("pattern", fs.pattern), zap.Error(err))
+		return
+	}
+	seen := make(map[string]bool)
+	for _, p := range paths {
+		rel, err := filepath.Rel(fs.root, p)
+		if err != nil || rel == fs.checkpointPath || rel == fs.checkpointPath+".tmp" {
+			continue
+		}
+		fi, err := os.Stat(p)
+		if err != nil || !fi.Mode().IsRegular() {
+			continue
+		}
+		id := fileID(fi)
+		seen[id] = true
+		if tf, ok := fs.files[id]; ok {
+			if tf.path != rel {
+				fs.logger.Infow("File is renamed", zap.String("from", tf.path), zap.String("to", rel))
+				tf.path = rel
+			}
+			tf.matched = true
+			continue
+		}
+		fs.lock.Lock()
+		entry := fs.checkpoint[id]
+		fs.lock.Unlock()
+		tf, err := openTrackedFile(id, fs.root, rel, entry)
+		if err != nil {
+			fileSourceReadErrors.With(map[string]string{metrics.LabelVertex: fs.name, metrics.LabelPipeline: fs.pipelineName}).Inc()
+			fs.logger.Errorw("Failed to open the file", zap.String("path", rel), zap.Error(err))
+			continue
+		}
+		if tf.truncations != entry.Truncations {
+			fs.resetCheckpoint(tf)
+		}
+		fs.logger.Infow("Start reading the file", zap.String("path", rel), zap.Int64("offset", tf.offset))
+		fs.files[id] = tf
+		fs.fileOrder = append(fs.fileOrder, id)
+	}
+	for id, tf := range fs.files {
+		if !seen[id] {
+			// rotated away or deleted, it's still read to the end.
+			tf.matched = false
+		}
+	}
+	// forget the offsets of the files which no longer exist.
+	fs.lock.Lock()
+	for id := range fs.checkpoint {
+		if _, ok := fs.files[id]; !ok && !seen[id] {
+			delete(fs.checkpoint, id)
+		}
+	}
+	fs.lock.Unlock()
+}
+
+// readRecords reads up to count records from the files.
+func (fs *fileSource) readRecords(count int64) []*isb.ReadMessage {
+	msgs := make([]*isb.ReadMessage, 0, count)
+	for _, id := range fs.fileOrder {
+		tf := fs.files[id]
+		if tf.done {
+			continue
+		}
+		var modTime time.Time
+		for int64(len(msgs)) < count {
+			// no more data is going to be appended if not following, or the file is rotated away.
+			final := tf.eof && (!fs.follow || !tf.matched)
+			r, err := tf.next(fs.format, final)
+			if err != nil {
+				fileSourceReadErrors.With(map[string]string{metrics.LabelVertex: fs.name, metrics.LabelPipeline: fs.pipelineName}).Inc()
+				fs.logger.Warnw("Skipped invalid data", zap.String("path", tf.path), zap.Error(err))
+				continue
+			}
+			if r != nil {
+				if modTime.IsZero() {
+					if fi, err := tf.file.Stat(); err == nil {
+						modTime = fi.ModTime()
+					}
+				}
+				msgs = append(msgs, fs.newReadMessage(tf, r, modTime))
+				continue
+			}
+			if final {
+				fs.logger.Infow("Finished reading the file", zap.String("path", tf.path))
+				tf.close()
+				break
+			}
+			if tf.eof && len(msgs) > 0 {
+				// check for appended data in the next read
+				tf.eof = false
+				break
+			}
+			truncations := tf.truncations
+			n, err := tf.fill()
+			if tf.truncations != truncations {
+				fs.logger.Infow("File is truncated, reading from the beginning", zap.String("path", tf.path))
+				fs.resetCheckpoint(tf)
+			}
+			if err != nil {
+				fileSourceReadErrors.With(map[string]string{metrics.LabelVertex: fs.name, metrics.LabelPipeline: fs.pipelineName}).Inc()
+				fs.logger.Errorw("Failed to read the file", zap.String("path", tf.path), zap.Error(err))
+				break
+			}
+			if n == 0 && fs.follow && tf.matched {
+				// nothing new
+				break
+			}
+		}
+		if int64(len(msgs)) >= count {
+			break
+		}
+	}
+	fs.removeFinishedFiles()
+	return msgs
+}
+
+// removeFinishedFiles stops tracking the files which are rotated away and completely read. The files completely read
+// without following are kept, so that they are not read again.
+func (fs *fileSource) removeFinishedFiles() {
+	order := fs.fileOrder[:0]
+	for _, id := range fs.fileOrder {
+		if tf := fs.files[id]; tf.done && !tf.matched {
+			delete(fs.files, id)
+			continue
+		}
+		order = append(order, id)
+	}
+	fs.fileOrder = order
+}
+
+func (fs *fileSource) newReadMessage(tf *trackedFile, r *record, modTime time.Time) *isb.ReadMessage {
+	offset := toOffset(tf.id, r.truncations, r.end)
+	eventTime := modTime
+	if fs.eventTime != nil {
+		if t, err := fs.extractEventTime(r.data); err != nil {
+			fs.logger.Warnw("Failed to extract the event time, using the modification time of the file", zap.String("path", tf.path), zap.Error(err))
+		} else {
+			eventTime = t
+		}
+	}
+	return &isb.ReadMessage{
+		ReadOffset: isb.SimpleStringOffset(func() string { return offset }),
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: eventTime},
+				ID:          offset,
+				Keys:        []string{tf.path},
+			},
+			Body: isb.Body{Payload: r.data},
+		},
+	}
+}
+
+func (fs *fileSource) extractEventTime(payload []byte) (time.Time, error) {
+	timeStr, err := expr.EvalStr(fs.eventTime.Expression, payload)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if fs.eventTime.Format != "" {
+		return time.Parse(fs.eventTime.Format, timeStr)
+	}
+	return dateparse.ParseStrict(timeStr)
+}
+
+func (fs *fileSource) PublishSourceWatermarks(msgs []*isb.ReadMessage) {
+	var oldest time.Time
+	for _, m := range msgs {
+		if oldest.IsZero() || m.EventTime.Before(oldest) {
+			oldest = m.EventTime
+		}
+	}
+	if len(msgs) > 0 && !oldest.IsZero() {
+		// toVertexPartitionIdx is 0 because we publish watermarks within source itself.
+		fs.sourcePublishWM.PublishWatermark(wmb.Watermark(oldest), nil, 0) // Source publisher does not care about the offset
+	}
+}
+
+// Ack acknowledges the offsets, and persists the checkpoint.
+func (fs *fileSource) Ack(_ context.Context, offsets []isb.Offset) []error {
+	errs := make([]error, len(offsets))
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+	for i, o := range offsets {
+		id, truncations, end, err := offsetFrom(o.String())
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		entry := fs.checkpoint[id]
+		switch {
+		case truncations < entry.Truncations:
+			// read before the file was truncated, the offset no longer points to the same data.
+			continue
+		case truncations > entry.Truncations:
+			entry.Truncations, entry.Offset = truncations, end
+		case end > entry.Offset:
+			entry.Offset = end
+		}
+		if tf, ok := fs.files[id]; ok {
+			entry.Path = tf.path
+		}
+		fs.checkpoint[id] = entry
+	}
+	fs.saveCheckpoint()
+	fileSourceAckCount.With(map[string]string{metrics.LabelVertex: fs.name, metrics.LabelPipeline: fs.pipelineName}).Add(float64(len(offsets)))
+	return errs
+}
+
+// resetCheckpoint resets the acknowledged offset of a file truncated in place to the offset it's read from again.
+func (fs *fileSource) resetCheckpoint(tf *trackedFile) {
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+	fs.checkpoint[tf.id] = checkpointEntry{Path: tf.path, Offset: tf.offset, Truncations: tf.truncations}
+	fs.saveCheckpoint()
+}
+
+// saveCheckpoint persists the checkpoint, the lock must be held by the caller.
+func (fs *fileSource) saveCheckpoint() {
+	// the acknowledgement is not failed if the checkpoint can not be persisted, the messages are re-read after restarts.
+	if err := fs.checkpoint.save(filepath.Join(fs.root, fs.checkpointPath)); err != nil {
+		fileSourceCheckpointErrors.With(map[string]string{metrics.LabelVertex: fs.name, metrics.LabelPipeline: fs.pipelineName}).Inc()
+		fs.logger.Errorw("Failed to persist the checkpoint", zap.String("path", fs.checkpointPath), zap.Error(err))
+	}
+}
+
+func (fs *fileSource) NoAck(_ context.Context, _ []isb.Offset) {}
+
+func (fs *fileSource) Pending(_ context.Context) (int64, error) {
+	return isb.PendingNotAvailable, nil
+}
+
+func (fs *fileSource) Close() error {
+	fs.logger.Info("Closing file source...")
+	fs.cancel()
+	for _, tf := range fs.files {
+		tf.close()
+	}
+	if err := fs.sourcePublishWM.Close(); err != nil {
+		fs.logger.Errorw("Failed to close source vertex watermark publisher", zap.Error(err))
+	}
+	fs.logger.Info("File source closed")
+	return nil
+}
+
+func (fs *fileSource) Stop() {
+	fs.logger.Info("Stopping file source reader...")
+	fs.forwarder.Stop()
+}
+
+func (fs *fileSource) ForceStop() {
+	fs.Stop()
+	fs.forwarder.ForceStop()
+}
+
+func (fs *fileSource) Start() <-chan struct{} {
+	return fs.forwarder.Start()
+}
+
+// toOffset returns the offset of a record, which is formatted
+// <fileID>:<number of times the file was truncated>:<offset in the file after the record>
+func toOffset(id string, truncations, end int64) string {
+	return id + ":" + strconv.FormatInt(truncations, 10) + ":" + strconv.FormatInt(end, 10)
+}
+
+func offsetFrom(offset string) (string, int64, int64, error) {
+	parts := strings.Split(offset, ":")
+	if len(parts) != 3 {
+		return "", 0, 0, fmt.Errorf("malformed offset %q", offset)
+	}
+	truncations, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("malformed offset %q, %w", offset, err)
+	}
+	end, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("malformed offset %q, %w", offset, err)
+	}
+	return parts[0], truncations, end, nil
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forward"
+	"github.com/numaproj/numaflow/pkg/forward/applier"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/watermark/generic"
+	"github.com/numaproj/numaflow/pkg/watermark/store"
+	"github.com/numaproj/numaflow/pkg/watermark/store/noop"
+)
+
+type forwardToAll struct{}
+
+func (f forwardToAll) WhereTo(_ []string, _ []string) ([]forward.VertexBuffer, error) {
+	return []forward.VertexBuffer{{ToVertexName: "out"}}, nil
+}
+
+func newTestSource(t *testing.T, root string, spec *dfv1.FileSource) *fileSource {
+	t.Helper()
+	spec.VolumeName = "data"
+	if spec.PollInterval == nil {
+		spec.PollInterval = &metav1.Duration{Duration: 10 * time.Millisecond}
+	}
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "testPipeline",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name:   "in",
+			Source: &dfv1.Source{File: spec},
+		},
+	}}
+	toBuffers := map[string][]isb.BufferWriter{"out": {simplebuffer.NewInMemoryBuffer("out", 100, 0)}}
+	publishWMStore := store.BuildWatermarkStore(noop.NewKVNoOpStore(), noop.NewKVNoOpStore())
+	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(map[string][]isb.BufferWriter{})
+	src, err := New(&dfv1.VertexInstance{Vertex: vertex}, toBuffers, forwardToAll{}, applier.Terminal, fetchWatermark, publishWatermark, publishWMStore, WithRootDir(root), WithReadTimeout(100*time.Millisecond))
+	require.NoError(t, err)
+	return src
+}
+
+func writeFile(t *testing.T, path string, data string, flag int) {
+	t.Helper()
+	f, err := os.OpenFile(path, flag|os.O_WRONLY|os.O_CREATE, 0644)
+	require.NoError(t, err)
+	_, err = f.WriteString(data)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+func readPayloads(t *testing.T, src *fileSource, ack bool) []string {
+	t.Helper()
+	msgs, err := src.Read(context.Background(), 100)
+	require.NoError(t, err)
+	payloads := make([]string, 0, len(msgs))
+	offsets := make([]isb.Offset, 0, len(msgs))
+	for _, m := range msgs {
+		payloads = append(payloads, string(m.Payload))
+		offsets = append(offsets, m.ReadOffset)
+	}
+	if ack {
+		for _, err := range src.Ack(context.Background(), offsets) {
+			assert.NoError(t, err)
+		}
+	}
+	return payloads
+}
+
+func TestFileSource_Lines(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "a.log"), "a1\n\na2\n", os.O_TRUNC)
+	writeFile(t, filepath.Join(root, "b.txt"), "b1\n", os.O_TRUNC)
+	src := newTestSource(t, root, &dfv1.FileSource{Path: "*.log"})
+	defer func() { _ = src.Close() }()
+
+	msgs, err := src.Read(context.Background(), 100)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	assert.Equal(t, "a1", string(msgs[0].Payload))
+	assert.Equal(t, []string{"a.log"}, msgs[0].Keys)
+	assert.Equal(t, "a2", string(msgs[1].Payload))
+
+	// a partial line is not read until it's completed.
+	writeFile(t, filepath.Join(root, "a.log"), "a3", os.O_APPEND)
+	assert.Empty(t, readPayloads(t, src, true))
+	writeFile(t, filepath.Join(root, "a.log"), "\n", os.O_APPEND)
+	assert.Equal(t, []string{"a3"}, readPayloads(t, src, true))
+
+	// new files are picked up.
+	writeFile(t, filepath.Join(root, "c.log"), "c1\n", os.O_TRUNC)
+	assert.Equal(t, []string{"c1"}, readPayloads(t, src, true))
+
+	pending, err := src.Pending(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, isb.PendingNotAvailable, pending)
+}
+
+func TestFileSource_JSON(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "a.json"), "{\"a\": 1}\n{\"b\":\n 2}\nnot json\n{\"c\": 3}", os.O_TRUNC)
+	src := newTestSource(t, root, &dfv1.FileSource{Path: "*.json", Format: dfv1.FileFormatJSON})
+	defer func() { _ = src.Close() }()
+	assert.Equal(t, []string{`{"a": 1}`, "{\"b\":\n 2}", `{"c": 3}`}, readPayloads(t, src, true))
+}
+
+func TestFileSource_Rotation(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "app.log"), "1\n", os.O_TRUNC)
+	src := newTestSource(t, root, &dfv1.FileSource{Path: "app.log"})
+	defer func() { _ = src.Close() }()
+	assert.Equal(t, []string{"1"}, readPayloads(t, src, true))
+
+	// data written before the rotation is still read from the rotated file.
+	writeFile(t, filepath.Join(root, "app.log"), "2\n3", os.O_APPEND)
+	require.NoError(t, os.Rename(filepath.Join(root, "app.log"), filepath.Join(root, "app.log.1")))
+	writeFile(t, filepath.Join(root, "app.log"), "4444\n", os.O_TRUNC)
+	var payloads []string
+	for i := 0; i < 5 && len(payloads) < 3; i++ {
+		payloads = append(payloads, readPayloads(t, src, true)...)
+	}
+	assert.ElementsMatch(t, []string{"2", "3", "4444"}, payloads)
+	assert.Len(t, src.files, 1)
+
+	// in-place truncation starts from the beginning.
+	writeFile(t, filepath.Join(root, "app.log"), "5\n", os.O_TRUNC)
+	assert.Equal(t, []string{"5"}, readPayloads(t, src, true))
+}
+
+func TestFileSource_Checkpoint(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "a.log"), "1\n2\n", os.O_TRUNC)
+	src := newTestSource(t, root, &dfv1.FileSource{Path: "*"})
+	assert.Equal(t, []string{"1", "2"}, readPayloads(t, src, true))
+	writeFile(t, filepath.Join(root, "a.log"), "3\n", os.O_APPEND)
+	// read without acknowledging.
+	assert.Equal(t, []string{"3"}, readPayloads(t, src, false))
+	require.NoError(t, src.Close())
+	_, err := os.Stat(filepath.Join(root, ".numaflow-testPipeline-in.checkpoint"))
+	require.NoError(t, err)
+
+	// the checkpoint file itself is not read, and the unacknowledged data is read again.
+	src = newTestSource(t, root, &dfv1.FileSource{Path: "*"})
+	defer func() { _ = src.Close() }()
+	assert.Equal(t, []string{"3"}, readPayloads(t, src, true))
+}
+
+func TestFileSource_TruncateAndRestart(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "a.log"), "1\n2\n3\n", os.O_TRUNC)
+	src := newTestSource(t, root, &dfv1.FileSource{Path: "a.log"})
+	msgs, err := src.Read(context.Background(), 100)
+	require.NoError(t, err)
+	require.Len(t, msgs, 3)
+	for _, err := range src.Ack(context.Background(), []isb.Offset{msgs[0].ReadOffset, msgs[1].ReadOffset}) {
+		assert.NoError(t, err)
+	}
+
+	// truncated in place, e.g. with "copytruncate".
+	writeFile(t, filepath.Join(root, "a.log"), "4\n", os.O_TRUNC)
+	assert.Equal(t, []string{"4"}, readPayloads(t, src, true))
+	// the offset read before the truncation does not move the checkpoint.
+	for _, err := range src.Ack(context.Background(), []isb.Offset{msgs[2].ReadOffset}) {
+		assert.NoError(t, err)
+	}
+	writeFile(t, filepath.Join(root, "a.log"), "5\n6\n7\n8\n", os.O_APPEND)
+	assert.Equal(t, []string{"5", "6", "7", "8"}, readPayloads(t, src, false))
+	require.NoError(t, src.Close())
+
+	// the unacknowledged data after the truncation is read again, and nothing before it.
+	src = newTestSource(t, root, &dfv1.FileSource{Path: "a.log"})
+	defer func() { _ = src.Close() }()
+	assert.Equal(t, []string{"5", "6", "7", "8"}, readPayloads(t, src, true))
+
+	// truncated while not running.
+	require.NoError(t, src.Close())
+	writeFile(t, filepath.Join(root, "a.log"), "9\n", os.O_TRUNC)
+	src = newTestSource(t, root, &dfv1.FileSource{Path: "a.log"})
+	assert.Equal(t, []string{"9"}, readPayloads(t, src, true))
+}
+
+func TestFileSource_NoFollow(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "a.log"), "1\n2", os.O_TRUNC)
+	follow := false
+	src := newTestSource(t, root, &dfv1.FileSource{Path: "a.log", Follow: &follow})
+	defer func() { _ = src.Close() }()
+	// the last line without the new line is read.
+	assert.Equal(t, []string{"1", "2"}, readPayloads(t, src, true))
+	writeFile(t, filepath.Join(root, "a.log"), "3\n", os.O_APPEND)
+	assert.Empty(t, readPayloads(t, src, true))
+}
+
+func TestFileSource_EventTime(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "a.json"), "{\"ts\": \"2023-05-01T10:00:00Z\"}\n{\"ts\": \"bad\"}\n", os.O_TRUNC)
+	src := newTestSource(t, root, &dfv1.FileSource{
+		Path:      "a.json",
+		Format:    dfv1.FileFormatJSON,
+		EventTime: &dfv1.FileEventTime{Expression: "json(payload).ts", Format: time.RFC3339},
+	})
+	defer func() { _ = src.Close() }()
+	msgs, err := src.Read(context.Background(), 100)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	assert.True(t, time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC).Equal(msgs[0].EventTime))
+	// falls back to the modification time of the file.
+	fi, err := os.Stat(filepath.Join(root, "a.json"))
+	require.NoError(t, err)
+	assert.True(t, fi.ModTime().Equal(msgs[1].EventTime))
+}
+
+func TestOffsetFrom(t *testing.T) {
+	id, truncations, end, err := offsetFrom(toOffset("1-2", 3, 10))
+	assert.NoError(t, err)
+	assert.Equal(t, "1-2", id)
+	assert.Equal(t, int64(3), truncations)
+	assert.Equal(t, int64(10), end)
+	_, _, _, err = offsetFrom("10")
+	assert.Error(t, err)
+	_, _, _, err = offsetFrom("1-2:10")
+	assert.Error(t, err)
+}
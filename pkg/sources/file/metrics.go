@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+// fileSourceReadCount is used to indicate the number of messages read
+var fileSourceReadCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "file_source",
+	Name:      "read_total",
+	Help:      "Total number of messages read from the files",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// fileSourceAckCount is used to indicate the number of messages acknowledged
+var fileSourceAckCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "file_source",
+	Name:      "ack_total",
+	Help:      "Total number of messages acknowledged",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// fileSourceReadErrors is used to indicate the number of errors while reading the files, including the invalid records skipped
+var fileSourceReadErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "file_source",
+	Name:      "read_error_total",
+	Help:      "Total number of errors while reading the files",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// fileSourceCheckpointErrors is used to indicate the number of errors while persisting the checkpoint
+var fileSourceCheckpointErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "file_source",
+	Name:      "checkpoint_error_total",
+	Help:      "Total number of errors while persisting the read offset checkpoint",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
@@ -0,0 +1,205 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"syscall"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// readChunkSize is the number of bytes read from a file at once.
+const readChunkSize = 64 * 1024
+
+// fileID returns an ID of the file, which does not change when the file is renamed, e.g. rotated.
+func fileID(fi os.FileInfo) string {
+	if st, ok := fi.Sys().(*syscall.Stat_t); ok {
+		return fmt.Sprintf("%d-%d", st.Dev, st.Ino)
+	}
+	return fi.Name()
+}
+
+// record is a message read from a file.
+type record struct {
+	data []byte
+	// end is the offset in the file right after the record.
+	end int64
+	// truncations is the number of times the file was truncated when the record is read.
+	truncations int64
+}
+
+// trackedFile is a file being read.
+type trackedFile struct {
+	id string
+	// latest path of the file, relative to the root
+	path string
+	file *os.File
+	// offset in the file of the first byte in buf
+	offset int64
+	// number of times the file is found truncated in place
+	truncations int64
+	// bytes read but not yet turned into records
+	buf []byte
+	// if the end of the file was reached in the last read
+	eof bool
+	// if the file is still matched by the pattern, false after it's rotated away or deleted
+	matched bool
+	// if the file is completely read and closed
+	done bool
+}
+
+func openTrackedFile(id, root, path string, cp checkpointEntry) (*trackedFile, error) {
+	f, err := os.Open(root + string(os.PathSeparator) + path)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	offset, truncations := cp.Offset, cp.Truncations
+	if offset > fi.Size() {
+		// truncated since the offset was checkpointed
+		offset = 0
+		truncations++
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return &trackedFile{id: id, path: path, file: f, offset: offset, truncations: truncations, matched: true}, nil
+}
+
+// fill reads more data from the file into the buffer, and returns the number of bytes read.
+func (tf *trackedFile) fill() (int, error) {
+	fi, err := tf.file.Stat()
+	if err != nil {
+		return 0, err
+	}
+	if end := tf.offset + int64(len(tf.buf)); fi.Size() < end {
+		// truncated in place, e.g. rotated with "copytruncate", read from the beginning again
+		if _, err := tf.file.Seek(0, io.SeekStart); err != nil {
+			return 0, err
+		}
+		tf.offset, tf.buf = 0, nil
+		tf.truncations++
+	}
+	chunk := make([]byte, readChunkSize)
+	n, err := tf.file.Read(chunk)
+	tf.buf = append(tf.buf, chunk[:n]...)
+	tf.eof = errors.Is(err, io.EOF) || n < readChunkSize
+	if err != nil && !errors.Is(err, io.EOF) {
+		return n, err
+	}
+	return n, nil
+}
+
+// next returns the next complete record in the buffer, or nil if there isn't one. final means no more data is going to
+// be appended to the file, so that the remaining bytes are returned as a record. The error is returned when invalid
+// data is skipped.
+func (tf *trackedFile) next(format dfv1.FileFormat, final bool) (*record, error) {
+	for len(tf.buf) > 0 {
+		var data []byte
+		var consumed int
+		var skipErr error
+		switch format {
+		case dfv1.FileFormatJSON:
+			data, consumed, skipErr = nextJSON(tf.buf, final)
+		default:
+			data, consumed = nextLine(tf.buf, final)
+		}
+		if consumed == 0 {
+			return nil, nil
+		}
+		tf.buf = tf.buf[consumed:]
+		tf.offset += int64(consumed)
+		if skipErr != nil {
+			return nil, skipErr
+		}
+		if len(data) == 0 {
+			// empty lines or whitespaces
+			continue
+		}
+		return &record{data: data, end: tf.offset, truncations: tf.truncations}, nil
+	}
+	return nil, nil
+}
+
+// nextLine returns the next line without the line ending, and the number of bytes consumed.
+func nextLine(buf []byte, final bool) ([]byte, int) {
+	idx := bytes.IndexByte(buf, '\n')
+	if idx < 0 {
+		if !final {
+			return nil, 0
+		}
+		idx = len(buf)
+	}
+	consumed := idx + 1
+	if consumed > len(buf) {
+		consumed = len(buf)
+	}
+	line := bytes.TrimSuffix(buf[:idx], []byte{'\r'})
+	return append([]byte(nil), line...), consumed
+}
+
+// nextJSON returns the next JSON value, and the number of bytes consumed. Invalid data is skipped up to the next line.
+func nextJSON(buf []byte, final bool) ([]byte, int, error) {
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	var raw json.RawMessage
+	err := dec.Decode(&raw)
+	switch {
+	case err == nil:
+		return append([]byte(nil), raw...), int(dec.InputOffset()), nil
+	case errors.Is(err, io.EOF):
+		// only whitespaces
+		return nil, len(buf), nil
+	case errors.Is(err, io.ErrUnexpectedEOF) && !final:
+		// wait for the rest of the value
+		return nil, 0, nil
+	}
+	// skip the invalid data up to the next line
+	idx := bytes.IndexByte(buf, '\n')
+	if idx < 0 {
+		if !final {
+			return nil, 0, nil
+		}
+		idx = len(buf) - 1
+	}
+	return nil, idx + 1, fmt.Errorf("invalid json data %q, %w", truncate(buf[:idx+1], 64), err)
+}
+
+func truncate(b []byte, size int) string {
+	if len(b) > size {
+		return string(b[:size]) + "..."
+	}
+	return string(b)
+}
+
+func (tf *trackedFile) close() {
+	if tf.file != nil {
+		_ = tf.file.Close()
+	}
+	tf.done = true
+	tf.buf = nil
+}
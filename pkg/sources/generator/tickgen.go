@@ -19,13 +19,17 @@ limitations under the License.
 package generator
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
+	mathrand "math/rand"
 	"strconv"
+	"text/template"
 	"time"
 
+	"github.com/Masterminds/sprig/v3"
 	"go.uber.org/zap"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
@@ -63,6 +67,19 @@ type record struct {
 	data   []byte
 	offset int64
 	key    string
+	// event time in nanoseconds
+	eventTime int64
+	// if the record is generated as late data
+	late bool
+}
+
+// templateData is the data available to the payload template
+type templateData struct {
+	Key       string
+	Seq       int64
+	EventTime time.Time
+	Value     uint64
+	Replica   int32
 }
 
 var recordGenerator = func(size int32, value *uint64, createdTS int64) []byte {
@@ -109,6 +126,22 @@ type memgen struct {
 	timeunit time.Duration
 	// genfn function that generates a payload as a byte array
 	genfn func(int32, *uint64, int64) []byte
+	// template to generate the payloads, genfn is used if it's nil
+	template *template.Template
+	// keyDistribution is how the keys are picked for the messages
+	keyDistribution dfv1.KeyDistributionType
+	// zipf generates the key indexes for the zipf distribution
+	zipf *mathrand.Zipf
+	// rand is the random source of the worker
+	rand *mathrand.Rand
+	// jitter is the max duration randomly subtracted from the event times
+	jitter time.Duration
+	// latePercent is the percentage of the messages generated as late data
+	latePercent uint32
+	// lateBy is how much older the event times of the late messages are
+	lateBy time.Duration
+	// seq is the sequence number of the next message
+	seq int64
 	// name is the name of the source node
 	name string
 	// pipelineName is the name of the pipeline
@@ -179,19 +212,49 @@ func NewMemGen(vertexInstance *dfv1.VertexInstance,
 	if vertexInstance.Vertex.Spec.Source.Generator.Value != nil {
 		value = vertexInstance.Vertex.Spec.Source.Generator.Value
 	}
+	genSpec := vertexInstance.Vertex.Spec.Source.Generator
+	var tmpl *template.Template
+	if genSpec.Template != nil {
+		t, err := template.New("payload").Funcs(sprig.TxtFuncMap()).Parse(*genSpec.Template)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the payload template, %w", err)
+		}
+		tmpl = t
+	}
+	rnd := mathrand.New(mathrand.NewSource(time.Now().UnixNano() + int64(vertexInstance.Replica)))
+	var zipf *mathrand.Zipf
+	if genSpec.GetKeyDistributionType() == dfv1.KeyDistributionZipf {
+		s, err := genSpec.GetZipfExponent()
+		if err != nil {
+			return nil, fmt.Errorf("invalid zipf exponent, %w", err)
+		}
+		if keyCount < 1 {
+			return nil, fmt.Errorf("invalid zipf distribution, keyCount %d should be greater than 0", keyCount)
+		}
+		if zipf = mathrand.NewZipf(rnd, s, 1, uint64(keyCount-1)); zipf == nil {
+			return nil, fmt.Errorf("invalid zipf distribution, exponent %v should be greater than 1", s)
+		}
+	}
 
 	gensrc := &memgen{
-		rpu:            rpu,
-		keyCount:       keyCount,
-		value:          value,
-		msgSize:        msgSize,
-		timeunit:       timeunit,
-		name:           vertexInstance.Vertex.Spec.Name,
-		pipelineName:   vertexInstance.Vertex.Spec.PipelineName,
-		genfn:          recordGenerator,
-		vertexInstance: vertexInstance,
-		srcchan:        make(chan record, rpu*int(keyCount)*5),
-		readTimeout:    3 * time.Second, // default timeout
+		rpu:             rpu,
+		keyCount:        keyCount,
+		value:           value,
+		msgSize:         msgSize,
+		timeunit:        timeunit,
+		name:            vertexInstance.Vertex.Spec.Name,
+		pipelineName:    vertexInstance.Vertex.Spec.PipelineName,
+		genfn:           recordGenerator,
+		template:        tmpl,
+		keyDistribution: genSpec.GetKeyDistributionType(),
+		zipf:            zipf,
+		rand:            rnd,
+		jitter:          genSpec.GetJitter(),
+		latePercent:     genSpec.GetLatePercent(),
+		lateBy:          genSpec.GetLateBy(),
+		vertexInstance:  vertexInstance,
+		srcchan:         make(chan record, rpu*int(keyCount)*5),
+		readTimeout:     3 * time.Second, // default timeout
 	}
 
 	for _, o := range opts {
@@ -262,7 +325,7 @@ loop:
 		select {
 		case r := <-mg.srcchan:
 			tickgenSourceReadCount.With(map[string]string{metrics.LabelVertex: mg.name, metrics.LabelPipeline: mg.pipelineName}).Inc()
-			msgs = append(msgs, mg.newReadMessage(r.key, r.data, r.offset))
+			msgs = append(msgs, mg.newReadMessage(r))
 		case <-timeout:
 			mg.logger.Debugw("Timed out waiting for messages to read.", zap.Duration("waited", mg.readTimeout))
 			break loop
@@ -272,12 +335,22 @@ loop:
 }
 
 func (mg *memgen) PublishSourceWatermarks(msgs []*isb.ReadMessage) {
-	if len(msgs) <= 0 {
+	// use the oldest event time of the messages as watermark to make it conservative, the late messages are
+	// excluded so that they stay behind the watermark.
+	var oldest time.Time
+	for _, m := range msgs {
+		if m.IsLate {
+			continue
+		}
+		if oldest.IsZero() || m.EventTime.Before(oldest) {
+			oldest = m.EventTime
+		}
+	}
+	if oldest.IsZero() {
 		return
 	}
-	// use the first event time of the message as watermark to make it conservative
 	// toVertexPartitionCount is 1 because we publish watermarks within source itself.
-	mg.sourcePublishWM.PublishWatermark(wmb.Watermark(msgs[0].EventTime), nil, 0) // Source publisher does not care about the offset
+	mg.sourcePublishWM.PublishWatermark(wmb.Watermark(oldest), nil, 0) // Source publisher does not care about the offset
 }
 
 // Ack acknowledges an array of offset.
@@ -328,21 +401,23 @@ func (mg *memgen) NewWorker(ctx context.Context, rate int) func(chan time.Time,
 				return
 			case ts := <-tickChan:
 				tickgenSourceCount.With(map[string]string{metrics.LabelVertex: mg.name, metrics.LabelPipeline: mg.pipelineName})
-				// we would generate all the keys in a round robin fashion
+				// by default, we would generate all the keys in a round robin fashion
 				// even if there are multiple pods, all the pods will generate same keys in the same order.
 				// TODO: alternatively, we could also think about generating a subset of keys per pod.
-				t := ts.UnixNano()
-				for i := 0; i < rate; i++ {
-					for k := int32(0); k < mg.keyCount; k++ {
-						key := fmt.Sprintf("key-%d-%d", mg.vertexInstance.Replica, k)
-						payload := mg.genfn(mg.msgSize, mg.value, t)
-						r := record{data: payload, offset: time.Now().UTC().UnixNano(), key: key}
-						select {
-						case <-ctx.Done():
-							log.Info("Context.Done is called. returning from the inner function")
-							return
-						case mg.srcchan <- r:
-						}
+				for i := 0; i < rate*int(mg.keyCount); i++ {
+					k := int32(i) % mg.keyCount
+					if mg.keyDistribution != dfv1.KeyDistributionRoundRobin {
+						k = mg.nextKeyIndex()
+					}
+					r, ok := mg.newRecord(k, ts)
+					if !ok {
+						continue
+					}
+					select {
+					case <-ctx.Done():
+						log.Info("Context.Done is called. returning from the inner function")
+						return
+					case mg.srcchan <- r:
 					}
 				}
 			}
@@ -350,6 +425,46 @@ func (mg *memgen) NewWorker(ctx context.Context, rate int) func(chan time.Time,
 	}
 }
 
+// nextKeyIndex picks the index of the key following the key distribution
+func (mg *memgen) nextKeyIndex() int32 {
+	if mg.zipf != nil {
+		return int32(mg.zipf.Uint64())
+	}
+	return mg.rand.Int31n(mg.keyCount)
+}
+
+// newRecord generates a record of the key at the tick, it returns false if the payload can not be generated.
+func (mg *memgen) newRecord(k int32, ts time.Time) (record, bool) {
+	key := fmt.Sprintf("key-%d-%d", mg.vertexInstance.Replica, k)
+	eventTime := ts
+	if mg.jitter > 0 {
+		eventTime = eventTime.Add(-time.Duration(mg.rand.Int63n(int64(mg.jitter))))
+	}
+	late := mg.latePercent > 0 && uint32(mg.rand.Intn(100)) < mg.latePercent
+	if late {
+		// older than any of the messages generated on time at the tick
+		eventTime = eventTime.Add(-mg.jitter - mg.lateBy)
+	}
+	seq := mg.seq
+	mg.seq++
+	var payload []byte
+	if mg.template != nil {
+		value := uint64(eventTime.UnixNano())
+		if mg.value != nil {
+			value = *mg.value
+		}
+		var buf bytes.Buffer
+		if err := mg.template.Execute(&buf, templateData{Key: key, Seq: seq, EventTime: eventTime, Value: value, Replica: mg.vertexInstance.Replica}); err != nil {
+			mg.logger.Errorw("Failed to generate the payload with the template", zap.Error(err))
+			return record{}, false
+		}
+		payload = buf.Bytes()
+	} else {
+		payload = mg.genfn(mg.msgSize, mg.value, eventTime.UnixNano())
+	}
+	return record{data: payload, offset: time.Now().UTC().UnixNano(), key: key, eventTime: eventTime.UnixNano(), late: late}, true
+}
+
 // generator fires once per time unit and generates records and writes them to the channel
 func (mg *memgen) generator(ctx context.Context, rate int, timeunit time.Duration) {
 	go func() {
@@ -389,17 +504,17 @@ func (mg *memgen) generator(ctx context.Context, rate int, timeunit time.Duratio
 	}()
 }
 
-func (mg *memgen) newReadMessage(key string, payload []byte, offset int64) *isb.ReadMessage {
+func (mg *memgen) newReadMessage(r record) *isb.ReadMessage {
 	msg := isb.Message{
 		Header: isb.Header{
-			// TODO: insert the right time based on the generator
-			MessageInfo: isb.MessageInfo{EventTime: timeFromNanos(parseTime(payload))},
-			ID:          strconv.FormatInt(offset, 10) + "-" + strconv.FormatInt(int64(mg.vertexInstance.Replica), 10),
-			Keys:        []string{key},
+			MessageInfo: isb.MessageInfo{EventTime: timeFromNanos(r.eventTime), IsLate: r.late},
+			ID:          strconv.FormatInt(r.offset, 10) + "-" + strconv.FormatInt(int64(mg.vertexInstance.Replica), 10),
+			Keys:        []string{r.key},
 		},
-		Body: isb.Body{Payload: payload},
+		Body: isb.Body{Payload: r.data},
 	}
 
+	offset := r.offset
 	return &isb.ReadMessage{
 		ReadOffset: isb.SimpleIntOffset(func() int64 { return offset }),
 		Message:    msg,
@@ -18,6 +18,7 @@ package generator
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -268,3 +269,112 @@ func TestWatermark(t *testing.T) {
 		msgsread += 1
 	}
 }
+
+func newTestMemGen(t *testing.T, spec *dfv1.GeneratorSource) *memgen {
+	t.Helper()
+	vertex := &dfv1.Vertex{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "memgen",
+		},
+		Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name:   "testVertex",
+				Source: &dfv1.Source{Generator: spec},
+			},
+		},
+	}
+	m := &dfv1.VertexInstance{
+		Vertex:   vertex,
+		Hostname: "TestRead",
+		Replica:  0,
+	}
+	toBuffers := map[string][]isb.BufferWriter{
+		"writer": {simplebuffer.NewInMemoryBuffer("writer", 100, 0)},
+	}
+	publishWMStore := store.BuildWatermarkStore(noop.NewKVNoOpStore(), noop.NewKVNoOpStore())
+	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toBuffers)
+	mgen, err := NewMemGen(m, toBuffers, myForwardToAllTest{}, applier.Terminal, fetchWatermark, publishWatermark, publishWMStore)
+	assert.NoError(t, err)
+	return mgen
+}
+
+func TestTemplatePayload(t *testing.T) {
+	tmpl := `{"key": "{{.Key}}", "seq": {{.Seq}}, "ts": "{{.EventTime.UTC.Format "2006-01-02"}}", "n": {{randInt 5 6}}}`
+	mgen := newTestMemGen(t, &dfv1.GeneratorSource{Template: &tmpl})
+	ts := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	for i := 0; i < 2; i++ {
+		r, ok := mgen.newRecord(1, ts)
+		assert.True(t, ok)
+		assert.Equal(t, fmt.Sprintf(`{"key": "key-0-1", "seq": %d, "ts": "2023-05-01", "n": 5}`, i), string(r.data))
+		msg := mgen.newReadMessage(r)
+		assert.Equal(t, []string{"key-0-1"}, msg.Keys)
+		assert.True(t, ts.Equal(msg.EventTime))
+	}
+
+	bad := "{{.Unknown"
+	mgen.vertexInstance.Vertex.Spec.Source.Generator.Template = &bad
+	_, err := NewMemGen(mgen.vertexInstance, nil, nil, nil, nil, nil, nil)
+	assert.Error(t, err)
+}
+
+func TestKeyDistribution(t *testing.T) {
+	keyCount := int32(10)
+	mgen := newTestMemGen(t, &dfv1.GeneratorSource{
+		KeyCount:        &keyCount,
+		KeyDistribution: &dfv1.KeyDistribution{Type: dfv1.KeyDistributionZipf, ZipfExponent: "2"},
+	})
+	counts := make([]int, keyCount)
+	for i := 0; i < 10000; i++ {
+		k := mgen.nextKeyIndex()
+		assert.True(t, k >= 0 && k < keyCount)
+		counts[k]++
+	}
+	// the first key is the hottest one
+	for k := 1; k < int(keyCount); k++ {
+		assert.Greater(t, counts[0], counts[k])
+	}
+
+	mgen = newTestMemGen(t, &dfv1.GeneratorSource{
+		KeyCount:        &keyCount,
+		KeyDistribution: &dfv1.KeyDistribution{Type: dfv1.KeyDistributionUniform},
+	})
+	counts = make([]int, keyCount)
+	for i := 0; i < 10000; i++ {
+		counts[mgen.nextKeyIndex()]++
+	}
+	for k := 0; k < int(keyCount); k++ {
+		assert.Greater(t, counts[k], 500)
+	}
+}
+
+func TestEventTimeJitterAndLateData(t *testing.T) {
+	latePercent := uint32(20)
+	mgen := newTestMemGen(t, &dfv1.GeneratorSource{
+		Jitter:      &v1.Duration{Duration: time.Second},
+		LatePercent: &latePercent,
+		LateBy:      &v1.Duration{Duration: time.Minute},
+	})
+	ts := time.Now()
+	late := 0
+	outOfOrder := false
+	var last time.Time
+	for i := 0; i < 1000; i++ {
+		r, ok := mgen.newRecord(0, ts)
+		assert.True(t, ok)
+		msg := mgen.newReadMessage(r)
+		if msg.IsLate {
+			late++
+			assert.True(t, msg.EventTime.Before(ts.Add(-time.Minute-time.Second)))
+			continue
+		}
+		assert.False(t, msg.EventTime.After(ts))
+		assert.True(t, msg.EventTime.After(ts.Add(-time.Second)))
+		if msg.EventTime.Before(last) {
+			outOfOrder = true
+		}
+		last = msg.EventTime
+	}
+	assert.True(t, outOfOrder)
+	assert.InDelta(t, 200, late, 80)
+}
@@ -0,0 +1,544 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package grpc implements a source which receives messages from the clients on bidirectional gRPC streams, and
+// sends the acknowledgements back once the messages are forwarded to the next vertices.
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/ingest"
+	"github.com/numaproj/numaflow/pkg/forward"
+	"github.com/numaproj/numaflow/pkg/forward/applier"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedtls "github.com/numaproj/numaflow/pkg/shared/tls"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+	"github.com/numaproj/numaflow/pkg/watermark/fetch"
+	"github.com/numaproj/numaflow/pkg/watermark/processor"
+	"github.com/numaproj/numaflow/pkg/watermark/publish"
+	"github.com/numaproj/numaflow/pkg/watermark/store"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+// fullnessChecker is implemented by the buffer writers which know whether the buffers are full.
+type fullnessChecker interface {
+	IsFull() bool
+}
+
+type grpcSource struct {
+	name         string
+	pipelineName string
+	ready        *atomic.Bool
+	readTimeout  time.Duration
+	bufferSize   int
+	// max number of the in-flight messages of each stream
+	window int
+	// bearer token of the clients, no auth if it's empty
+	authToken string
+	messages  chan *isb.ReadMessage
+	// buffer writers of the next vertices, used to check if they are full
+	writers []isb.BufferWriter
+	// in-flight messages keyed by the offsets
+	inflight map[string]inflightMessage
+	lock     sync.Mutex
+	// sequence number of the streams
+	streamSeq *atomic.Int64
+	listener  net.Listener
+	server    *grpc.Server
+	logger    *zap.SugaredLogger
+	forwarder *forward.InterStepDataForward
+	// source watermark publisher
+	sourcePublishWM publish.Publisher
+	// context cancel function
+	cancelFunc context.CancelFunc
+}
+
+type inflightMessage struct {
+	stream *stream
+	id     string
+}
+
+// stream is a Publish stream from a client.
+type stream struct {
+	id  int64
+	srv ingest.Ingest_PublishServer
+	// number of the in-flight messages
+	inflight *atomic.Int64
+	// notified when messages are acknowledged
+	notify   chan struct{}
+	sendLock sync.Mutex
+	closed   bool
+}
+
+type Option func(*grpcSource) error
+
+// WithLogger is used to return logger information
+func WithLogger(l *zap.SugaredLogger) Option {
+	return func(o *grpcSource) error {
+		o.logger = l
+		return nil
+	}
+}
+
+// WithReadTimeout is used to set the read timeout for the from buffer
+func WithReadTimeout(t time.Duration) Option {
+	return func(o *grpcSource) error {
+		o.readTimeout = t
+		return nil
+	}
+}
+
+func WithBufferSize(s int) Option {
+	return func(o *grpcSource) error {
+		o.bufferSize = s
+		return nil
+	}
+}
+
+// WithListener sets the listener of the server, defaults to listening on dfv1.VertexGRPCPort
+func WithListener(l net.Listener) Option {
+	return func(o *grpcSource) error {
+		o.listener = l
+		return nil
+	}
+}
+
+func New(
+	vertexInstance *dfv1.VertexInstance,
+	writers map[string][]isb.BufferWriter,
+	fsd forward.ToWhichStepDecider,
+	mapApplier applier.MapApplier,
+	fetchWM fetch.Fetcher,
+	publishWM map[string]publish.Publisher,
+	publishWMStores store.WatermarkStorer,
+	opts ...Option) (*grpcSource, error) {
+
+	spec := vertexInstance.Vertex.Spec.Source.GRPC
+	g := &grpcSource{
+		name:         vertexInstance.Vertex.Spec.Name,
+		pipelineName: vertexInstance.Vertex.Spec.PipelineName,
+		ready:        atomic.NewBool(false),
+		bufferSize:   1000,            // default size
+		readTimeout:  1 * time.Second, // default timeout
+		window:       spec.GetWindow(),
+		inflight:     make(map[string]inflightMessage),
+		streamSeq:    atomic.NewInt64(0),
+	}
+	for _, o := range opts {
+		if err := o(g); err != nil {
+			return nil, err
+		}
+	}
+	if g.logger == nil {
+		g.logger = logging.NewLogger()
+	}
+	g.messages = make(chan *isb.ReadMessage, g.bufferSize)
+	for _, ws := range writers {
+		g.writers = append(g.writers, ws...)
+	}
+
+	if x := spec.Auth; x != nil && x.Token != nil {
+		if s, err := sharedutil.GetSecretFromVolume(x.Token); err != nil {
+			return nil, fmt.Errorf("failed to get auth token, %w", err)
+		} else {
+			g.authToken = s
+		}
+	}
+	tlsConfig, err := getServerTLSConfig(spec.TLS)
+	if err != nil {
+		return nil, err
+	}
+	if g.listener == nil {
+		if g.listener, err = net.Listen("tcp", fmt.Sprintf(":%d", dfv1.VertexGRPCPort)); err != nil {
+			return nil, fmt.Errorf("failed to listen on port %d, %w", dfv1.VertexGRPCPort, err)
+		}
+	}
+	g.server = grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)), grpc.MaxRecvMsgSize(sharedutil.LookupEnvIntOr(dfv1.EnvGRPCMaxMessageSize, dfv1.DefaultGRPCMaxMessageSize)))
+	ingest.RegisterIngestServer(g.server, g)
+	go func() {
+		g.logger.Info("Starting gRPC source server")
+		if err := g.server.Serve(g.listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			g.logger.Fatalw("Failed to serve on gRPC source server", zap.Error(err))
+		}
+		g.logger.Info("Shutdown gRPC source server")
+	}()
+
+	forwardOpts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeSource), forward.WithLogger(g.logger), forward.WithSourceWatermarkPublisher(g)}
+	if x := vertexInstance.Vertex.Spec.Limits; x != nil {
+		if x.ReadBatchSize != nil {
+			forwardOpts = append(forwardOpts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
+		}
+	}
+	g.forwarder, err = forward.NewInterStepDataForward(vertexInstance.Vertex, g, writers, fsd, mapApplier, fetchWM, publishWM, forwardOpts...)
+	if err != nil {
+		g.server.Stop()
+		g.logger.Errorw("Error instantiating the forwarder", zap.Error(err))
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	g.cancelFunc = cancel
+	entityName := fmt.Sprintf("%s-%d", vertexInstance.Vertex.Name, vertexInstance.Replica)
+	processorEntity := processor.NewProcessorEntity(entityName)
+	// source publisher toVertexPartitionCount will be 1, because we publish watermarks within source itself.
+	g.sourcePublishWM = publish.NewPublish(ctx, processorEntity, publishWMStores, 1, publish.IsSource(), publish.WithDelay(vertexInstance.Vertex.Spec.Watermark.GetMaxDelay()))
+	return g, nil
+}
+
+// getServerTLSConfig returns the TLS config of the server, a self-signed certificate is used if the certificate is
+// not specified, and the client certificates are verified if the CA certificate is specified.
+func getServerTLSConfig(t *dfv1.TLS) (*tls.Config, error) {
+	c := &tls.Config{MinVersion: tls.VersionTLS12}
+	if t != nil && t.CertSecret != nil && t.KeySecret != nil {
+		cert, err := sharedutil.GetSecretFromVolume(t.CertSecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the server certificate, %w", err)
+		}
+		key, err := sharedutil.GetSecretFromVolume(t.KeySecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the server private key, %w", err)
+		}
+		pair, err := tls.X509KeyPair([]byte(cert), []byte(key))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the server certificate, %w", err)
+		}
+		c.Certificates = []tls.Certificate{pair}
+	} else {
+		cer, err := sharedtls.GenerateX509KeyPair()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate cert: %w", err)
+		}
+		c.Certificates = []tls.Certificate{*cer}
+	}
+	if t != nil && t.CACertSecret != nil {
+		caCert, err := sharedutil.GetSecretFromVolume(t.CACertSecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the CA certificate, %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caCert)) {
+			return nil, fmt.Errorf("failed to parse the CA certificate")
+		}
+		c.ClientCAs = pool
+		c.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return c, nil
+}
+
+// Publish receives the messages from a client, and sends the acknowledgements back on the stream.
+func (g *grpcSource) Publish(srv ingest.Ingest_PublishServer) error {
+	if err := g.authorize(srv.Context()); err != nil {
+		return err
+	}
+	if !g.ready.Load() {
+		return status.Error(codes.Unavailable, "grpc source not ready")
+	}
+	ctx := srv.Context()
+	st := &stream{id: g.streamSeq.Inc(), srv: srv, inflight: atomic.NewInt64(0), notify: make(chan struct{}, 1)}
+	labels := map[string]string{metrics.LabelVertex: g.name, metrics.LabelPipeline: g.pipelineName}
+	grpcSourceStreams.With(labels).Inc()
+	defer func() {
+		grpcSourceStreams.With(labels).Dec()
+		g.closeStream(st)
+	}()
+	if err := g.send(st, &ingest.PublishResponse{Window: g.availableWindow(st)}); err != nil {
+		return err
+	}
+	for {
+		if err := g.waitForWindow(ctx, st); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		req, err := srv.Recv()
+		if errors.Is(err, io.EOF) {
+			// the client is done with sending, wait for the acknowledgements of the in-flight messages.
+			return g.waitForAcks(ctx, st)
+		} else if err != nil {
+			return err
+		}
+		m := g.newReadMessage(st, req)
+		if m == nil {
+			// the ID is in-flight, reject the message rather than tracking the same offset twice.
+			if err := g.send(st, &ingest.PublishResponse{FailedIds: []string{req.GetId()}, Window: g.availableWindow(st)}); err != nil {
+				return err
+			}
+			continue
+		}
+		select {
+		case g.messages <- m:
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+func (g *grpcSource) authorize(ctx context.Context) error {
+	if g.authToken == "" {
+		return nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get("authorization") {
+		if strings.TrimSpace(v) == "Bearer "+g.authToken {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "request not authorized")
+}
+
+// waitForWindow waits until the stream has a window for more messages, and the buffers of the next vertices are not full.
+func (g *grpcSource) waitForWindow(ctx context.Context, st *stream) error {
+	blocked := false
+	for {
+		if st.inflight.Load() < int64(g.window) && !g.isBufferFull() {
+			return nil
+		}
+		if !blocked {
+			blocked = true
+			grpcSourceWindowBlocked.With(map[string]string{metrics.LabelVertex: g.name, metrics.LabelPipeline: g.pipelineName}).Inc()
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-st.notify:
+		case <-time.After(100 * time.Millisecond):
+			// re-check if the buffers are still full
+		}
+	}
+}
+
+func (g *grpcSource) waitForAcks(ctx context.Context, st *stream) error {
+	for st.inflight.Load() > 0 {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-st.notify:
+		}
+	}
+	return nil
+}
+
+func (g *grpcSource) isBufferFull() bool {
+	for _, w := range g.writers {
+		if c, ok := w.(fullnessChecker); ok && c.IsFull() {
+			return true
+		}
+	}
+	return false
+}
+
+// availableWindow returns the number of the messages the client can publish without waiting for the acknowledgements.
+func (g *grpcSource) availableWindow(st *stream) int32 {
+	if g.isBufferFull() {
+		return 0
+	}
+	if w := int64(g.window) - st.inflight.Load(); w > 0 {
+		return int32(w)
+	}
+	return 0
+}
+
+// newReadMessage returns the read message of the request, or nil if the ID of the request is in-flight in the stream.
+func (g *grpcSource) newReadMessage(st *stream, req *ingest.PublishRequest) *isb.ReadMessage {
+	id := req.GetId()
+	if id == "" {
+		id = uuid.New().String()
+	}
+	eventTime := time.Now()
+	if req.GetEventTime() > 0 {
+		eventTime = time.UnixMilli(req.GetEventTime())
+	}
+	offset := fmt.Sprintf("%d:%s", st.id, id)
+	g.lock.Lock()
+	if _, ok := g.inflight[offset]; ok {
+		g.lock.Unlock()
+		return nil
+	}
+	g.inflight[offset] = inflightMessage{stream: st, id: id}
+	g.lock.Unlock()
+	st.inflight.Inc()
+	return &isb.ReadMessage{
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: eventTime},
+				ID:          id,
+				Keys:        req.GetKeys(),
+			},
+			Body: isb.Body{
+				Payload: req.GetPayload(),
+			},
+		},
+		ReadOffset: isb.SimpleStringOffset(func() string { return offset }),
+	}
+}
+
+// send sends a response on the stream if it's not closed.
+func (g *grpcSource) send(st *stream, resp *ingest.PublishResponse) error {
+	st.sendLock.Lock()
+	defer st.sendLock.Unlock()
+	if st.closed {
+		return nil
+	}
+	return st.srv.Send(resp)
+}
+
+// closeStream closes the stream, the in-flight messages of the stream are still forwarded, but not acknowledged to the client.
+func (g *grpcSource) closeStream(st *stream) {
+	st.sendLock.Lock()
+	st.closed = true
+	st.sendLock.Unlock()
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	for offset, m := range g.inflight {
+		if m.stream == st {
+			delete(g.inflight, offset)
+		}
+	}
+}
+
+// respond sends the acknowledged or failed message IDs back to the streams they are from.
+func (g *grpcSource) respond(offsets []isb.Offset, success bool) {
+	ids := make(map[*stream][]string)
+	var streams []*stream
+	g.lock.Lock()
+	for _, o := range offsets {
+		m, ok := g.inflight[o.String()]
+		if !ok {
+			// the stream is closed.
+			continue
+		}
+		delete(g.inflight, o.String())
+		m.stream.inflight.Dec()
+		if _, ok := ids[m.stream]; !ok {
+			streams = append(streams, m.stream)
+		}
+		ids[m.stream] = append(ids[m.stream], m.id)
+	}
+	g.lock.Unlock()
+	for _, st := range streams {
+		resp := &ingest.PublishResponse{Window: g.availableWindow(st)}
+		if success {
+			resp.AckedIds = ids[st]
+		} else {
+			resp.FailedIds = ids[st]
+		}
+		if err := g.send(st, resp); err != nil {
+			g.logger.Warnw("Failed to send the response to the stream", zap.Int64("stream", st.id), zap.Error(err))
+		}
+		select {
+		case st.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (g *grpcSource) GetName() string {
+	return g.name
+}
+
+// GetPartitionIdx returns the partition number for the source vertex buffer
+// Source is like a buffer with only one partition. So, we always return 0
+func (g *grpcSource) GetPartitionIdx() int32 {
+	return 0
+}
+
+func (g *grpcSource) Read(_ context.Context, count int64) ([]*isb.ReadMessage, error) {
+	var msgs []*isb.ReadMessage
+	timeout := time.After(g.readTimeout)
+loop:
+	for i := int64(0); i < count; i++ {
+		select {
+		case m := <-g.messages:
+			grpcSourceReadCount.With(map[string]string{metrics.LabelVertex: g.name, metrics.LabelPipeline: g.pipelineName}).Inc()
+			msgs = append(msgs, m)
+		case <-timeout:
+			g.logger.Debugw("Timed out waiting for messages to read.", zap.Duration("waited", g.readTimeout), zap.Int("read", len(msgs)))
+			break loop
+		}
+	}
+	return msgs, nil
+}
+
+func (g *grpcSource) PublishSourceWatermarks(msgs []*isb.ReadMessage) {
+	var oldest time.Time
+	for _, m := range msgs {
+		if oldest.IsZero() || m.EventTime.Before(oldest) {
+			oldest = m.EventTime
+		}
+	}
+	if len(msgs) > 0 && !oldest.IsZero() {
+		// toVertexPartitionIdx is 0, because we publish watermarks within source itself.
+		g.sourcePublishWM.PublishWatermark(wmb.Watermark(oldest), nil, 0) // Source publisher does not care about the offset
+	}
+}
+
+// Ack sends the acknowledgements back to the clients, since the messages have been forwarded.
+func (g *grpcSource) Ack(_ context.Context, offsets []isb.Offset) []error {
+	g.respond(offsets, true)
+	grpcSourceAckCount.With(map[string]string{metrics.LabelVertex: g.name, metrics.LabelPipeline: g.pipelineName}).Add(float64(len(offsets)))
+	return make([]error, len(offsets))
+}
+
+// NoAck tells the clients the messages failed to be forwarded, so that they can publish them again.
+func (g *grpcSource) NoAck(_ context.Context, offsets []isb.Offset) {
+	g.respond(offsets, false)
+}
+
+func (g *grpcSource) Close() error {
+	g.logger.Info("Shutting down gRPC source server...")
+	g.cancelFunc()
+	g.server.Stop()
+	if err := g.sourcePublishWM.Close(); err != nil {
+		g.logger.Errorw("Failed to close source vertex watermark publisher", zap.Error(err))
+	}
+	g.logger.Info("gRPC source server shutdown")
+	return nil
+}
+
+func (g *grpcSource) Stop() {
+	g.logger.Info("Stopping gRPC reader...")
+	g.ready.Store(false)
+	g.forwarder.Stop()
+}
+
+func (g *grpcSource) ForceStop() {
+	g.Stop()
+}
+
+func (g *grpcSource) Start() <-chan struct{} {
+	defer func() { g.ready.Store(true) }()
+	return g.forwarder.Start()
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/ingest"
+	"github.com/numaproj/numaflow/pkg/forward"
+	"github.com/numaproj/numaflow/pkg/forward/applier"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/watermark/generic"
+	"github.com/numaproj/numaflow/pkg/watermark/store"
+	"github.com/numaproj/numaflow/pkg/watermark/store/noop"
+)
+
+type forwardToAll struct{}
+
+func (f forwardToAll) WhereTo(_ []string, _ []string) ([]forward.VertexBuffer, error) {
+	return []forward.VertexBuffer{{ToVertexName: "out"}}, nil
+}
+
+func newTestSource(t *testing.T, spec *dfv1.GRPCSource, dest *simplebuffer.InMemoryBuffer) (*grpcSource, ingest.IngestClient) {
+	t.Helper()
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "testPipeline",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name:   "in",
+			Source: &dfv1.Source{GRPC: spec},
+		},
+	}}
+	toBuffers := map[string][]isb.BufferWriter{"out": {dest}}
+	publishWMStore := store.BuildWatermarkStore(noop.NewKVNoOpStore(), noop.NewKVNoOpStore())
+	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(map[string][]isb.BufferWriter{})
+	lis := bufconn.Listen(1024 * 1024)
+	src, err := New(&dfv1.VertexInstance{Vertex: vertex}, toBuffers, forwardToAll{}, applier.Terminal, fetchWatermark, publishWatermark, publishWMStore, WithListener(lis), WithReadTimeout(100*time.Millisecond))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = src.Close() })
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return src, ingest.NewIngestClient(conn)
+}
+
+func TestGRPCSource_NotReady(t *testing.T) {
+	_, client := newTestSource(t, &dfv1.GRPCSource{}, simplebuffer.NewInMemoryBuffer("out", 10, 0))
+	stream, err := client.Publish(context.Background())
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestGRPCSource_Auth(t *testing.T) {
+	src, client := newTestSource(t, &dfv1.GRPCSource{}, simplebuffer.NewInMemoryBuffer("out", 10, 0))
+	src.authToken = "secret"
+	src.ready.Store(true)
+	stream, err := client.Publish(context.Background())
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer secret")
+	stream, err = client.Publish(ctx)
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, int32(1000), resp.Window)
+}
+
+func TestGRPCSource_FlowControl(t *testing.T) {
+	window := uint32(2)
+	dest := simplebuffer.NewInMemoryBuffer("out", 2, 0)
+	src, client := newTestSource(t, &dfv1.GRPCSource{Window: &window}, dest)
+	src.ready.Store(true)
+	ctx := context.Background()
+	stream, err := client.Publish(ctx)
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), resp.Window)
+
+	for _, id := range []string{"a", "b", "c"} {
+		require.NoError(t, stream.Send(&ingest.PublishRequest{Id: id, Keys: []string{"k"}, Payload: []byte(id), EventTime: 1000}))
+	}
+	msgs, err := src.Read(ctx, 10)
+	require.NoError(t, err)
+	// the third message is not received until the window is available.
+	require.Len(t, msgs, 2)
+	assert.Equal(t, "a", msgs[0].ID)
+	assert.Equal(t, []string{"k"}, msgs[0].Keys)
+	assert.Equal(t, "a", string(msgs[0].Payload))
+	assert.Equal(t, time.UnixMilli(1000), msgs[0].EventTime)
+
+	src.NoAck(ctx, []isb.Offset{msgs[1].ReadOffset})
+	resp, err = stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b"}, resp.FailedIds)
+	assert.Equal(t, int32(1), resp.Window)
+	msgs, err = src.Read(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "c", msgs[0].ID)
+
+	// the window is 0 when the buffer is full.
+	_, errs := dest.Write(ctx, []isb.Message{{}, {}})
+	assert.NoError(t, errs[0])
+	assert.True(t, src.isBufferFull())
+	src.Ack(ctx, []isb.Offset{msgs[0].ReadOffset})
+	resp, err = stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c"}, resp.AckedIds)
+	assert.Equal(t, int32(0), resp.Window)
+}
+
+func TestGRPCSource_DuplicateID(t *testing.T) {
+	dest := simplebuffer.NewInMemoryBuffer("out", 10, 0)
+	src, client := newTestSource(t, &dfv1.GRPCSource{}, dest)
+	src.ready.Store(true)
+	ctx := context.Background()
+	stream, err := client.Publish(ctx)
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, int32(1000), resp.Window)
+
+	require.NoError(t, stream.Send(&ingest.PublishRequest{Id: "a", Payload: []byte("first")}))
+	require.NoError(t, stream.Send(&ingest.PublishRequest{Id: "a", Payload: []byte("second")}))
+	// the second message is failed right away since "a" is in-flight.
+	resp, err = stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, resp.FailedIds)
+	assert.Equal(t, int32(999), resp.Window)
+	msgs, err := src.Read(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "first", string(msgs[0].Payload))
+
+	// the stream is done once the in-flight message is acknowledged.
+	require.NoError(t, stream.CloseSend())
+	src.Ack(ctx, []isb.Offset{msgs[0].ReadOffset})
+	resp, err = stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, resp.AckedIds)
+	_, err = stream.Recv()
+	assert.ErrorIs(t, err, io.EOF)
+
+	// the ID can be published again after it's acknowledged.
+	stream, err = client.Publish(ctx)
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&ingest.PublishRequest{Id: "a", Payload: []byte("third")}))
+	msgs, err = src.Read(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "third", string(msgs[0].Payload))
+}
+
+func TestGRPCSource_Forward(t *testing.T) {
+	dest := simplebuffer.NewInMemoryBuffer("out", 10, 0)
+	src, client := newTestSource(t, &dfv1.GRPCSource{}, dest)
+	stop := src.Start()
+	defer func() {
+		src.Stop()
+		<-stop
+	}()
+	ctx := context.Background()
+	stream, err := client.Publish(ctx)
+	require.NoError(t, err)
+	var resp *ingest.PublishResponse
+	// retry until the source is ready.
+	for i := 0; i < 10; i++ {
+		if resp, err = stream.Recv(); err == nil {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+		stream, err = client.Publish(ctx)
+		require.NoError(t, err)
+	}
+	require.NoError(t, err)
+	assert.Equal(t, int32(1000), resp.Window)
+	require.NoError(t, stream.Send(&ingest.PublishRequest{Id: "1", Payload: []byte("hello")}))
+	require.NoError(t, stream.Send(&ingest.PublishRequest{Payload: []byte("world")}))
+	require.NoError(t, stream.CloseSend())
+	var acked []string
+	for len(acked) < 2 {
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		acked = append(acked, resp.AckedIds...)
+	}
+	assert.Equal(t, "1", acked[0])
+	assert.NotEmpty(t, acked[1])
+	msgs, err := dest.Read(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	assert.Equal(t, "hello", string(msgs[0].Payload))
+	assert.Equal(t, "world", string(msgs[1].Payload))
+}
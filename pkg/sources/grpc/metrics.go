@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+// grpcSourceReadCount is used to indicate the number of messages read by the gRPC source vertex
+var grpcSourceReadCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "grpc_source",
+	Name:      "read_total",
+	Help:      "Total number of messages Read",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// grpcSourceAckCount is used to indicate the number of messages acknowledged to the clients
+var grpcSourceAckCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "grpc_source",
+	Name:      "ack_total",
+	Help:      "Total number of messages acknowledged",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// grpcSourceStreams is used to indicate the number of the open streams
+var grpcSourceStreams = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "grpc_source",
+	Name:      "streams",
+	Help:      "Number of the open streams",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// grpcSourceWindowBlocked is used to indicate the number of times receiving from a stream is paused by the flow control
+var grpcSourceWindowBlocked = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "grpc_source",
+	Name:      "window_blocked_total",
+	Help:      "Total number of times receiving from a stream is paused because the window is exhausted or the buffers are full",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
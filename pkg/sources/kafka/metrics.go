@@ -43,3 +43,10 @@ var kafkaSourceOffsetAckErrors = promauto.NewCounterVec(prometheus.CounterOpts{
 	Name:      "ack_error_total",
 	Help:      "Total number of Kafka ID Errors",
 }, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// kafkaSourceDecodeErrors is used to indicate the number of messages failed to be decoded with the schema registry
+var kafkaSourceDecodeErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "kafka_source",
+	Name:      "decode_error_total",
+	Help:      "Total number of messages failed to be decoded with the schema registry",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
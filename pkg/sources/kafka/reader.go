@@ -34,6 +34,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/schemaregistry"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/processor"
@@ -82,6 +83,14 @@ type KafkaSource struct {
 	// source watermark publisher stores
 	srcPublishWMStores store.WatermarkStorer
 	lock               *sync.RWMutex
+	// schema registry client used to decode the payloads, nil if not configured
+	schemaRegistry *schemaregistry.Client
+	// what to do if a payload can not be decoded
+	schemaRegistryOnFailure dfv1.SchemaRegistryFailurePolicy
+	// messages dropped because of decoding failures, which are yet to be marked
+	droppedMessages []*sarama.ConsumerMessage
+	// the message failed to be decoded with the "fail" policy, which is retried before reading any new messages
+	undecodableMessage *sarama.ConsumerMessage
 }
 
 type Option func(*KafkaSource) error
@@ -128,24 +137,68 @@ func (r *KafkaSource) GetPartitionIdx() int32 {
 	return 0
 }
 
-func (r *KafkaSource) Read(_ context.Context, count int64) ([]*isb.ReadMessage, error) {
+func (r *KafkaSource) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
 	msgs := make([]*isb.ReadMessage, 0, count)
 	timeout := time.After(r.readTimeout)
 loop:
 	for i := int64(0); i < count; i++ {
-		select {
-		case m := <-r.handler.messages:
-			kafkaSourceReadCount.With(map[string]string{metrics.LabelVertex: r.name, metrics.LabelPipeline: r.pipelineName}).Inc()
-			msgs = append(msgs, toReadMessage(m))
-		case <-timeout:
-			// log that timeout has happened and don't return an error
-			r.logger.Debugw("Timed out waiting for messages to read.", zap.Duration("waited", r.readTimeout))
-			break loop
+		var m *sarama.ConsumerMessage
+		if r.undecodableMessage != nil {
+			// retry the message failed to be decoded in the previous read before consuming any new ones.
+			m, r.undecodableMessage = r.undecodableMessage, nil
+		} else {
+			select {
+			case m = <-r.handler.messages:
+				kafkaSourceReadCount.With(map[string]string{metrics.LabelVertex: r.name, metrics.LabelPipeline: r.pipelineName}).Inc()
+			case <-timeout:
+				// log that timeout has happened and don't return an error
+				r.logger.Debugw("Timed out waiting for messages to read.", zap.Duration("waited", r.readTimeout))
+				break loop
+			}
+		}
+		readMessage := toReadMessage(m)
+		if r.schemaRegistry != nil {
+			payload, err := r.schemaRegistry.Decode(ctx, m.Value)
+			if err != nil {
+				kafkaSourceDecodeErrors.With(map[string]string{metrics.LabelVertex: r.name, metrics.LabelPipeline: r.pipelineName}).Inc()
+				if r.schemaRegistryOnFailure != dfv1.SchemaRegistryFailurePolicyDrop {
+					// Hold the message and stop reading, it's decoded again in the next read, so that the source
+					// is blocked on it until it can be decoded (e.g. the schema registry is back) or the policy is changed.
+					r.undecodableMessage = m
+					if len(msgs) == 0 {
+						// back off before the next attempt.
+						select {
+						case <-timeout:
+						case <-ctx.Done():
+						}
+					}
+					return msgs, fmt.Errorf("failed to decode the message at offset %s with schema registry, %w", readMessage.ID, err)
+				}
+				r.logger.Warnw("Failed to decode the message with schema registry, dropping it", zap.String("offset", readMessage.ID), zap.Error(err))
+				r.droppedMessages = append(r.droppedMessages, m)
+				continue
+			}
+			readMessage.Payload = payload
 		}
+		msgs = append(msgs, readMessage)
+	}
+	if len(msgs) == 0 {
+		// nothing is going to be acked, mark the dropped messages now.
+		r.markDroppedMessages()
 	}
 	return msgs, nil
 }
 
+// markDroppedMessages marks the offsets of the messages dropped because of decoding failures. It's invoked
+// after acking the messages read in the same batch, so that the offsets are not marked beyond the unacked ones.
+func (r *KafkaSource) markDroppedMessages() {
+	for _, m := range r.droppedMessages {
+		r.handler.sess.MarkMessage(m, "")
+		kafkaSourceAckCount.With(map[string]string{metrics.LabelVertex: r.name, metrics.LabelPipeline: r.pipelineName}).Inc()
+	}
+	r.droppedMessages = nil
+}
+
 func (r *KafkaSource) PublishSourceWatermarks(msgs []*isb.ReadMessage) {
 	// oldestTimestamps stores the latest timestamps for different partitions
 	oldestTimestamps := make(map[int32]time.Time)
@@ -197,6 +250,7 @@ func (r *KafkaSource) Ack(_ context.Context, offsets []isb.Offset) []error {
 		kafkaSourceAckCount.With(map[string]string{metrics.LabelVertex: r.name, metrics.LabelPipeline: r.pipelineName}).Inc()
 
 	}
+	r.markDroppedMessages()
 	// How come it does not return errors at all?
 	return make([]error, len(offsets))
 }
@@ -350,6 +404,15 @@ func NewKafkaSource(
 	}
 	kafkasource.config = config
 
+	if sr := source.SchemaRegistry; sr != nil {
+		client, err := schemaregistry.NewClientFromSpec(sr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create schema registry client, %w", err)
+		}
+		kafkasource.schemaRegistry = client
+		kafkasource.schemaRegistryOnFailure = sr.GetOnFailure()
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	kafkasource.cancelfn = cancel
 	kafkasource.lifecyclectx = ctx
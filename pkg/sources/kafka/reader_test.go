@@ -17,10 +17,14 @@ limitations under the License.
 package kafka
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/Shopify/sarama"
 	"github.com/stretchr/testify/assert"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
@@ -28,6 +32,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/schemaregistry"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 	"github.com/numaproj/numaflow/pkg/watermark/store/noop"
@@ -181,3 +186,102 @@ func TestOffset(t *testing.T) {
 	expected := fmt.Sprintf("%s:%v:%v", topic, partition, offset)
 	assert.Equal(t, expected, formattedoffset)
 }
+
+type fakeSession struct {
+	sarama.ConsumerGroupSession
+	marked []int64
+}
+
+func (s *fakeSession) MarkOffset(_ string, _ int32, offset int64, _ string) {
+	s.marked = append(s.marked, offset)
+}
+
+func (s *fakeSession) MarkMessage(m *sarama.ConsumerMessage, _ string) {
+	s.marked = append(s.marked, m.Offset+1)
+}
+
+func TestReadWithSchemaRegistry(t *testing.T) {
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/schemas/ids/7", r.URL.Path)
+		_, _ = w.Write([]byte(`{"schema":"{\"type\":\"record\",\"name\":\"T\",\"fields\":[{\"name\":\"a\",\"type\":\"int\"}]}"}`))
+	}))
+	defer registry.Close()
+
+	client, err := schemaregistry.NewClientFromSpec(&dfv1.SchemaRegistry{URL: registry.URL})
+	assert.NoError(t, err)
+	sess := &fakeSession{}
+	ks := &KafkaSource{
+		name:                    "testVertex",
+		readTimeout:             100 * time.Millisecond,
+		handler:                 newConsumerHandler(10),
+		logger:                  logging.NewLogger(),
+		schemaRegistry:          client,
+		schemaRegistryOnFailure: dfv1.SchemaRegistryFailurePolicyDrop,
+	}
+	ks.handler.sess = sess
+	ks.handler.messages <- &sarama.ConsumerMessage{Topic: "t", Offset: 1, Value: []byte{0, 0, 0, 0, 7, 2}}
+	ks.handler.messages <- &sarama.ConsumerMessage{Topic: "t", Offset: 2, Value: []byte(`{"a":1}`)}
+
+	msgs, err := ks.Read(context.Background(), 2)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 1)
+	assert.JSONEq(t, `{"a":1}`, string(msgs[0].Payload))
+	// the dropped message is marked after acking the messages read before it.
+	assert.Empty(t, sess.marked)
+	ks.Ack(context.Background(), []isb.Offset{msgs[0].ReadOffset})
+	assert.Equal(t, []int64{2, 3}, sess.marked)
+
+	// marked right away if nothing is read.
+	ks.handler.messages <- &sarama.ConsumerMessage{Topic: "t", Offset: 3, Value: []byte{0, 0, 0, 0, 7}}
+	msgs, err = ks.Read(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Empty(t, msgs)
+	assert.Equal(t, []int64{2, 3, 4}, sess.marked)
+}
+
+func TestReadWithSchemaRegistryFailurePolicyFail(t *testing.T) {
+	available := false
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/schemas/ids/8" && !available {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"schema":"{\"type\":\"record\",\"name\":\"T\",\"fields\":[{\"name\":\"a\",\"type\":\"int\"}]}"}`))
+	}))
+	defer registry.Close()
+
+	client, err := schemaregistry.NewClientFromSpec(&dfv1.SchemaRegistry{URL: registry.URL})
+	assert.NoError(t, err)
+	ks := &KafkaSource{
+		name:                    "testVertex",
+		readTimeout:             10 * time.Millisecond,
+		handler:                 newConsumerHandler(10),
+		logger:                  logging.NewLogger(),
+		schemaRegistry:          client,
+		schemaRegistryOnFailure: dfv1.SchemaRegistryFailurePolicyFail,
+	}
+	ks.handler.sess = &fakeSession{}
+	ks.handler.messages <- &sarama.ConsumerMessage{Topic: "t", Offset: 1, Value: []byte{0, 0, 0, 0, 7, 2}}
+	ks.handler.messages <- &sarama.ConsumerMessage{Topic: "t", Offset: 2, Value: []byte{0, 0, 0, 0, 8, 4}}
+	ks.handler.messages <- &sarama.ConsumerMessage{Topic: "t", Offset: 3, Value: []byte{0, 0, 0, 0, 7, 6}}
+
+	// the messages read before the failed one are returned with the error.
+	msgs, err := ks.Read(context.Background(), 3)
+	assert.Error(t, err)
+	assert.Len(t, msgs, 1)
+	assert.Equal(t, "t:0:1", msgs[0].ID)
+
+	// blocked on the failed message.
+	msgs, err = ks.Read(context.Background(), 3)
+	assert.Error(t, err)
+	assert.Empty(t, msgs)
+	assert.Len(t, ks.handler.messages, 1)
+
+	// resumed once it can be decoded.
+	available = true
+	msgs, err = ks.Read(context.Background(), 3)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 2)
+	assert.JSONEq(t, `{"a":2}`, string(msgs[0].Payload))
+	assert.Equal(t, "t:0:3", msgs[1].ID)
+}
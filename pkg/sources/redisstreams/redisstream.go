@@ -43,16 +43,29 @@ import (
 )
 
 type redisStreamsSource struct {
-	// shared code to read from Redis Streams
-	*redisclient.RedisStreamsRead
+	// name of the source vertex
+	name string
 	// name of the pipeline
 	pipelineName string
+	// consumer group to read with
+	group string
+	// readers to read from the streams, one for each stream
+	readers []*redisclient.RedisStreamsRead
+	// index of the reader to start with in the next Read, to avoid starving the streams after the first one
+	nextReaderIdx int
+	// read timeout of all the streams
+	readTimeout time.Duration
+	// pending entries of other consumers idle longer than this are claimed, 0 to disable
+	claimIdleTime time.Duration
+	// last time the idle pending entries were claimed
+	lastClaimTime time.Time
 	// forwarder that writes the consumed data to destination
 	forwarder *forward.InterStepDataForward
 	// context cancel function
 	cancelfn context.CancelFunc
 	// source watermark publisher
 	sourcePublishWM publish.Publisher
+	log             *zap.SugaredLogger
 }
 
 type Option func(*redisStreamsSource) error
@@ -60,7 +73,7 @@ type Option func(*redisStreamsSource) error
 // WithLogger is used to return logger information
 func WithLogger(l *zap.SugaredLogger) Option {
 	return func(o *redisStreamsSource) error {
-		o.Log = l
+		o.log = l
 		return nil
 	}
 }
@@ -68,7 +81,7 @@ func WithLogger(l *zap.SugaredLogger) Option {
 // WithReadTimeOut sets the read timeout
 func WithReadTimeOut(t time.Duration) Option {
 	return func(o *redisStreamsSource) error {
-		o.Options.ReadTimeOut = t
+		o.readTimeout = t
 		return nil
 	}
 }
@@ -83,47 +96,20 @@ func New(
 	publishWMStores store.WatermarkStorer,
 	opts ...Option) (*redisStreamsSource, error) {
 
-	// create RedisClient to connect to Redis
 	vertexSpec := vertexInstance.Vertex.Spec
 	redisSpec := vertexSpec.Source.RedisStreams
-	redisClient, err := newRedisClient(redisSpec)
-	if err != nil {
-		return nil, err
-	}
-
-	readerOpts := &redisclient.Options{
-		InfoRefreshInterval: time.Second,
-		ReadTimeOut:         time.Second,
-		CheckBackLog:        true,
-	}
-
-	// RedisStreamsReader handles reading from Redis Streams
-	redisStreamsReader := &redisclient.RedisStreamsRead{
-		Name:        vertexSpec.Name,
-		Stream:      redisSpec.Stream,
-		Group:       redisSpec.ConsumerGroup,
-		Consumer:    fmt.Sprintf("%s-%v", vertexSpec.Name, vertexInstance.Replica),
-		RedisClient: redisClient,
-		Options:     *readerOpts,
-		Metrics: redisclient.Metrics{
-			ReadErrorsInc: func() {
-				redisStreamsSourceReadErrors.With(map[string]string{metrics.LabelVertex: vertexSpec.Name, metrics.LabelPipeline: vertexSpec.PipelineName}).Inc()
-			},
-			ReadsAdd: func(count int) {
-				redisStreamsSourceReadCount.With(map[string]string{metrics.LabelVertex: vertexSpec.Name, metrics.LabelPipeline: vertexSpec.PipelineName}).Add(float64(count))
-			},
-			AcksAdd: func(count int) {
-				redisStreamsSourceAckCount.With(map[string]string{metrics.LabelVertex: vertexSpec.Name, metrics.LabelPipeline: vertexSpec.PipelineName}).Add(float64(count))
-			},
-			AckErrorsAdd: func(count int) {
-				redisStreamsSourceAckErrors.With(map[string]string{metrics.LabelVertex: vertexSpec.Name, metrics.LabelPipeline: vertexSpec.PipelineName}).Add(float64(count))
-			},
-		},
+	streams := redisSpec.GetStreams()
+	if len(streams) == 0 {
+		return nil, fmt.Errorf("no redis stream specified")
 	}
 
 	redisStreamsSource := &redisStreamsSource{
-		RedisStreamsRead: redisStreamsReader,
-		pipelineName:     vertexSpec.PipelineName,
+		name:          vertexSpec.Name,
+		pipelineName:  vertexSpec.PipelineName,
+		group:         redisSpec.ConsumerGroup,
+		readTimeout:   time.Second,
+		claimIdleTime: redisSpec.GetClaimIdleTime(),
+		lastClaimTime: time.Now(),
 	}
 
 	for _, o := range opts {
@@ -132,12 +118,73 @@ func New(
 			return nil, operr
 		}
 	}
-	if redisStreamsReader.Log == nil {
-		redisStreamsReader.Log = logging.NewLogger()
+	if redisStreamsSource.log == nil {
+		redisStreamsSource.log = logging.NewLogger()
+	}
+
+	// create RedisClient to connect to Redis
+	redisClient, err := newRedisClient(redisSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	// the read timeout is shared by the streams, because they are read one after another.
+	readTimeout := redisStreamsSource.readTimeout / time.Duration(len(streams))
+	if readTimeout < time.Millisecond {
+		readTimeout = time.Millisecond
+	}
+	for _, stream := range streams {
+		// RedisStreamsReader handles reading from Redis Streams
+		redisStreamsReader := &redisclient.RedisStreamsRead{
+			Name:        vertexSpec.Name,
+			Stream:      stream,
+			Group:       redisSpec.ConsumerGroup,
+			Consumer:    fmt.Sprintf("%s-%v", vertexSpec.Name, vertexInstance.Replica),
+			RedisClient: redisClient,
+			Options: redisclient.Options{
+				InfoRefreshInterval: time.Second,
+				ReadTimeOut:         readTimeout,
+				CheckBackLog:        true,
+			},
+			Log: redisStreamsSource.log.With("stream", stream),
+			Metrics: redisclient.Metrics{
+				ReadErrorsInc: func() {
+					redisStreamsSourceReadErrors.With(map[string]string{metrics.LabelVertex: vertexSpec.Name, metrics.LabelPipeline: vertexSpec.PipelineName}).Inc()
+				},
+				ReadsAdd: func(count int) {
+					redisStreamsSourceReadCount.With(map[string]string{metrics.LabelVertex: vertexSpec.Name, metrics.LabelPipeline: vertexSpec.PipelineName}).Add(float64(count))
+				},
+				AcksAdd: func(count int) {
+					redisStreamsSourceAckCount.With(map[string]string{metrics.LabelVertex: vertexSpec.Name, metrics.LabelPipeline: vertexSpec.PipelineName}).Add(float64(count))
+				},
+				AckErrorsAdd: func(count int) {
+					redisStreamsSourceAckErrors.With(map[string]string{metrics.LabelVertex: vertexSpec.Name, metrics.LabelPipeline: vertexSpec.PipelineName}).Add(float64(count))
+				},
+			},
+		}
+		// function which takes the messages that have been read from the Stream and turns them into ReadMessages
+		redisStreamsReader.XStreamToMessages = func(xstreams []redis.XStream, messages []*isb.ReadMessage, labels map[string]string) ([]*isb.ReadMessage, error) {
+			for _, xstream := range xstreams {
+				for _, message := range xstream.Messages {
+					if len(message.Values) == 0 {
+						// don't think there should be a message with no values, but if there is...
+						redisStreamsSource.log.Warnf("unexpected: RedisStreams message has no values? message=%+v", message)
+						continue
+					}
+					outMsg, err := produceMsg(xstream.Stream, message)
+					if err != nil {
+						return nil, err
+					}
+					messages = append(messages, outMsg)
+				}
+			}
+			return messages, nil
+		}
+		redisStreamsSource.readers = append(redisStreamsSource.readers, redisStreamsReader)
 	}
 
 	// Create InterStepDataForward
-	forwardOpts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeSource), forward.WithLogger(redisStreamsSource.Log), forward.WithSourceWatermarkPublisher(redisStreamsSource)}
+	forwardOpts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeSource), forward.WithLogger(redisStreamsSource.log), forward.WithSourceWatermarkPublisher(redisStreamsSource)}
 	if x := vertexInstance.Vertex.Spec.Limits; x != nil {
 		if x.ReadBatchSize != nil {
 			forwardOpts = append(forwardOpts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
@@ -145,7 +192,7 @@ func New(
 	}
 	forwarder, err := forward.NewInterStepDataForward(vertexInstance.Vertex, redisStreamsSource, writers, fsd, mapApplier, fetchWM, publishWM, forwardOpts...)
 	if err != nil {
-		redisStreamsSource.Log.Errorw("Error instantiating the forwarder", zap.Error(err))
+		redisStreamsSource.log.Errorw("Error instantiating the forwarder", zap.Error(err))
 		return nil, err
 	}
 	redisStreamsSource.forwarder = forwarder
@@ -158,37 +205,11 @@ func New(
 	// toVertexPartitionCount is 1 because we publish watermarks within source itself.
 	redisStreamsSource.sourcePublishWM = publish.NewPublish(ctx, processorEntity, publishWMStores, 1, publish.IsSource(), publish.WithDelay(vertexInstance.Vertex.Spec.Watermark.GetMaxDelay()))
 
-	// create the ConsumerGroup here if not already created
-	err = redisStreamsSource.createConsumerGroup(ctx, redisSpec)
-	if err != nil {
-		return nil, err
-	}
-
-	// function which takes the messages that have been read from the Stream and turns them into ReadMessages
-	redisStreamsSource.XStreamToMessages = func(xstreams []redis.XStream, messages []*isb.ReadMessage, labels map[string]string) ([]*isb.ReadMessage, error) {
-		if len(xstreams) > 1 {
-			redisStreamsSource.Log.Warnf("redisStreamsSource shouldn't have more than one Stream; xstreams=%+v", xstreams)
-		} else if len(xstreams) == 1 {
-			xstream := xstreams[0]
-
-			for _, message := range xstream.Messages {
-				var outMsg *isb.ReadMessage
-				var err error
-				if len(message.Values) >= 1 {
-					outMsg, err = produceMsg(message)
-					if err != nil {
-						return nil, err
-					}
-				} else {
-					// don't think there should be a message with no values, but if there is...
-					redisStreamsSource.Log.Warnf("unexpected: RedisStreams message has no values? message=%+v", message)
-					continue
-				}
-				messages = append(messages, outMsg)
-			}
+	// create the ConsumerGroups here if not already created
+	for _, r := range redisStreamsSource.readers {
+		if err := createConsumerGroup(ctx, r, redisSpec); err != nil {
+			return nil, err
 		}
-
-		return messages, nil
 	}
 
 	return redisStreamsSource, nil
@@ -220,8 +241,8 @@ func newRedisClient(sourceSpec *dfv1.RedisStreamsSource) (*redisclient.RedisClie
 	return redisclient.NewRedisClient(opts), nil
 }
 
-func produceMsg(inMsg redis.XMessage) (*isb.ReadMessage, error) {
-	var readOffset = inMsg.ID
+func produceMsg(stream string, inMsg redis.XMessage) (*isb.ReadMessage, error) {
+	var readOffset = toOffset(stream, inMsg.ID)
 
 	jsonSerialized, err := json.Marshal(inMsg.Values)
 	if err != nil {
@@ -268,24 +289,138 @@ func msgIdToTime(id string) (time.Time, error) {
 
 }
 
-func (rsSource *redisStreamsSource) createConsumerGroup(ctx context.Context, sourceSpec *dfv1.RedisStreamsSource) error {
+// toOffset returns the offset of a message, which is formatted <stream>:<messageID>
+func toOffset(stream string, id string) string {
+	return fmt.Sprintf("%s:%s", stream, id)
+}
+
+// offsetFrom returns the stream and the message ID of an offset.
+func offsetFrom(offset string) (string, string, error) {
+	// the stream name might contain ':', but the message ID doesn't.
+	idx := strings.LastIndex(offset, ":")
+	if idx < 0 {
+		return "", "", fmt.Errorf("malformed offset %q", offset)
+	}
+	return offset[:idx], offset[idx+1:], nil
+}
+
+func createConsumerGroup(ctx context.Context, r *redisclient.RedisStreamsRead, sourceSpec *dfv1.RedisStreamsSource) error {
 	// user can configure to read stream either from the beginning or from the most recent messages
 	readFrom := redisclient.ReadFromLatest
 	if sourceSpec.ReadFromBeginning {
 		readFrom = redisclient.ReadFromEarliest
 	}
-	rsSource.Log.Infof("Creating Redis Stream group %q on Stream %q (readFrom=%v)", rsSource.Group, rsSource.Stream, readFrom)
-	err := rsSource.RedisClient.CreateStreamGroup(ctx, rsSource.Stream, rsSource.Group, readFrom)
+	r.Log.Infof("Creating Redis Stream group %q on Stream %q (readFrom=%v)", r.Group, r.Stream, readFrom)
+	err := r.RedisClient.CreateStreamGroup(ctx, r.Stream, r.Group, readFrom)
 	if err != nil {
 		if redisclient.IsAlreadyExistError(err) {
-			rsSource.Log.Infow("Consumer Group on Stream already exists.", zap.String("group", rsSource.Group), zap.String("stream", rsSource.Stream))
+			r.Log.Infow("Consumer Group on Stream already exists.", zap.String("group", r.Group), zap.String("stream", r.Stream))
 		} else {
-			return fmt.Errorf("failed to create consumer group %q on redis stream %q: err=%v", rsSource.Group, rsSource.Stream, err)
+			return fmt.Errorf("failed to create consumer group %q on redis stream %q: err=%v", r.Group, r.Stream, err)
 		}
 	}
 	return nil
 }
 
+func (rsSource *redisStreamsSource) GetName() string {
+	return rsSource.name
+}
+
+// GetPartitionIdx returns the partition number for the source vertex buffer
+// Source is like a buffer with only one partition. So, we always return 0
+func (rsSource *redisStreamsSource) GetPartitionIdx() int32 {
+	return 0
+}
+
+// Read reads from the streams one after another, starting with a different stream each time.
+func (rsSource *redisStreamsSource) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	rsSource.claimIdlePending(ctx, count)
+	msgs := make([]*isb.ReadMessage, 0, count)
+	var readErr error
+	for i := 0; i < len(rsSource.readers) && int64(len(msgs)) < count; i++ {
+		r := rsSource.readers[(rsSource.nextReaderIdx+i)%len(rsSource.readers)]
+		// messages read before an error are still returned
+		m, err := r.Read(ctx, count-int64(len(msgs)))
+		msgs = append(msgs, m...)
+		if err != nil {
+			if readErr != nil {
+				rsSource.log.Errorw("Failed to read from stream", zap.String("stream", r.Stream), zap.Error(err))
+			} else {
+				readErr = fmt.Errorf("failed to read from stream %q, %w", r.Stream, err)
+			}
+		}
+	}
+	rsSource.nextReaderIdx = (rsSource.nextReaderIdx + 1) % len(rsSource.readers)
+	return msgs, readErr
+}
+
+// claimIdlePending claims the idle pending entries of the other consumers periodically.
+func (rsSource *redisStreamsSource) claimIdlePending(ctx context.Context, count int64) {
+	if rsSource.claimIdleTime <= 0 || time.Since(rsSource.lastClaimTime) < rsSource.claimIdleTime {
+		return
+	}
+	rsSource.lastClaimTime = time.Now()
+	for _, r := range rsSource.readers {
+		claimed, err := r.ClaimIdlePending(ctx, rsSource.claimIdleTime, count)
+		if err != nil {
+			rsSource.log.Errorw("Failed to claim idle pending entries", zap.String("stream", r.Stream), zap.Error(err))
+			continue
+		}
+		if claimed > 0 {
+			rsSource.log.Infow("Claimed idle pending entries", zap.String("stream", r.Stream), zap.Int("count", claimed))
+			redisStreamsSourceClaimCount.With(map[string]string{metrics.LabelVertex: rsSource.name, metrics.LabelPipeline: rsSource.pipelineName}).Add(float64(claimed))
+		}
+	}
+}
+
+// Ack acknowledges the offsets to the streams they are read from.
+func (rsSource *redisStreamsSource) Ack(ctx context.Context, offsets []isb.Offset) []error {
+	errs := make([]error, len(offsets))
+	// indexes of the offsets and the message ID offsets, grouped by stream
+	indexes := make(map[string][]int)
+	idOffsets := make(map[string][]isb.Offset)
+	for i, o := range offsets {
+		stream, id, err := offsetFrom(o.String())
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		indexes[stream] = append(indexes[stream], i)
+		idOffsets[stream] = append(idOffsets[stream], isb.SimpleStringOffset(func() string { return id }))
+	}
+	for _, r := range rsSource.readers {
+		if len(idOffsets[r.Stream]) == 0 {
+			continue
+		}
+		for i, err := range r.Ack(ctx, idOffsets[r.Stream]) {
+			errs[indexes[r.Stream][i]] = err
+		}
+		delete(idOffsets, r.Stream)
+	}
+	for stream := range idOffsets {
+		for _, i := range indexes[stream] {
+			errs[i] = fmt.Errorf("unknown stream %q in offset %q", stream, offsets[i].String())
+		}
+	}
+	return errs
+}
+
+func (rsSource *redisStreamsSource) NoAck(_ context.Context, _ []isb.Offset) {}
+
+// Pending returns the sum of the lag of the consumer group in all the streams.
+// For Redis Server < v7.0, the lag is always 0; therefore it's recommended to use >= v7.0
+func (rsSource *redisStreamsSource) Pending(ctx context.Context) (int64, error) {
+	total := int64(0)
+	for _, r := range rsSource.readers {
+		pending, err := r.Pending(ctx)
+		if err != nil {
+			return isb.PendingNotAvailable, fmt.Errorf("failed to get the pending of stream %q, %w", r.Stream, err)
+		}
+		total += pending
+	}
+	return total, nil
+}
+
 func (rsSource *redisStreamsSource) PublishSourceWatermarks(msgs []*isb.ReadMessage) {
 	var oldest time.Time
 	for _, m := range msgs {
@@ -300,17 +435,17 @@ func (rsSource *redisStreamsSource) PublishSourceWatermarks(msgs []*isb.ReadMess
 }
 
 func (rsSource *redisStreamsSource) Close() error {
-	rsSource.Log.Info("Shutting down redis source server...")
+	rsSource.log.Info("Shutting down redis source server...")
 	rsSource.cancelfn()
 	if err := rsSource.sourcePublishWM.Close(); err != nil {
-		rsSource.Log.Errorw("Failed to close source vertex watermark publisher", zap.Error(err))
+		rsSource.log.Errorw("Failed to close source vertex watermark publisher", zap.Error(err))
 	}
-	rsSource.Log.Info("Redis source server shutdown")
+	rsSource.log.Info("Redis source server shutdown")
 	return nil
 }
 
 func (rsSource *redisStreamsSource) Stop() {
-	rsSource.Log.Info("Stopping redis streams source reader...")
+	rsSource.log.Info("Stopping redis streams source reader...")
 	rsSource.forwarder.Stop()
 }
 
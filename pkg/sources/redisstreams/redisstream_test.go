@@ -17,11 +17,24 @@ limitations under the License.
 package redisstreams
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forward"
+	"github.com/numaproj/numaflow/pkg/forward/applier"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/watermark/generic"
+	"github.com/numaproj/numaflow/pkg/watermark/store"
+	"github.com/numaproj/numaflow/pkg/watermark/store/noop"
 )
 
 func TestProduceMsg(t *testing.T) {
@@ -61,9 +74,10 @@ func TestProduceMsg(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.testCase, func(t *testing.T) {
-			outMsg, err := produceMsg(tt.inMsg)
+			outMsg, err := produceMsg("my:stream", tt.inMsg)
 			assert.NotNil(t, outMsg)
 			assert.Nil(t, err)
+			assert.Equal(t, "my:stream:"+tt.inMsg.ID, outMsg.ReadOffset.String())
 			assert.Equal(t, tt.expectedBody, string(outMsg.Payload))
 			assert.Equal(t, len(tt.expectedKeys), len(outMsg.Keys))
 			for key := range tt.expectedKeys {
@@ -109,3 +123,115 @@ func TestMsgIdToTime(t *testing.T) {
 		})
 	}
 }
+
+func TestOffsetFrom(t *testing.T) {
+	stream, id, err := offsetFrom(toOffset("my:stream", "1518951480106-0"))
+	assert.NoError(t, err)
+	assert.Equal(t, "my:stream", stream)
+	assert.Equal(t, "1518951480106-0", id)
+	_, _, err = offsetFrom("1518951480106-0")
+	assert.Error(t, err)
+}
+
+type forwardToAll struct{}
+
+func (f forwardToAll) WhereTo(_ []string, _ []string) ([]forward.VertexBuffer, error) {
+	return []forward.VertexBuffer{{ToVertexName: "out"}}, nil
+}
+
+func newTestSource(t *testing.T, m *miniredis.Miniredis, replica int32) *redisStreamsSource {
+	t.Helper()
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "testPipeline",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "in",
+			Source: &dfv1.Source{
+				RedisStreams: &dfv1.RedisStreamsSource{
+					RedisConfig:       dfv1.RedisConfig{URL: m.Addr()},
+					Stream:            "s1",
+					Streams:           []string{"s2"},
+					ConsumerGroup:     "group",
+					ReadFromBeginning: true,
+					ClaimIdleTime:     &metav1.Duration{Duration: time.Minute},
+				},
+			},
+		},
+	}}
+	toBuffers := map[string][]isb.BufferWriter{"out": {simplebuffer.NewInMemoryBuffer("out", 100, 0)}}
+	publishWMStore := store.BuildWatermarkStore(noop.NewKVNoOpStore(), noop.NewKVNoOpStore())
+	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(map[string][]isb.BufferWriter{})
+	src, err := New(&dfv1.VertexInstance{Vertex: vertex, Replica: replica}, toBuffers, forwardToAll{}, applier.Terminal, fetchWatermark, publishWatermark, publishWMStore, WithReadTimeOut(100*time.Millisecond))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = src.Close() })
+	return src
+}
+
+func TestRedisStreamsSource_MultipleStreams(t *testing.T) {
+	m := miniredis.RunT(t)
+	ctx := context.Background()
+	_, _ = m.XAdd("s1", "*", []string{"k", "v1"})
+	_, _ = m.XAdd("s2", "*", []string{"k", "v2"})
+	_, _ = m.XAdd("s2", "*", []string{"k", "v3"})
+	src := newTestSource(t, m, 0)
+
+	pending, err := src.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), pending)
+
+	msgs, err := src.Read(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 3)
+	offsets := make([]isb.Offset, 0, len(msgs))
+	for _, msg := range msgs {
+		offsets = append(offsets, msg.ReadOffset)
+	}
+	for _, err := range src.Ack(ctx, offsets) {
+		assert.NoError(t, err)
+	}
+	for _, stream := range []string{"s1", "s2"} {
+		p, err := src.readers[0].Client.XPending(ctx, stream, "group").Result()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), p.Count)
+	}
+	errs := src.Ack(ctx, []isb.Offset{isb.SimpleStringOffset(func() string { return "s3:1-0" })})
+	assert.Error(t, errs[0])
+}
+
+func TestRedisStreamsSource_ClaimIdlePending(t *testing.T) {
+	m := miniredis.RunT(t)
+	ctx := context.Background()
+	now := time.Now()
+	m.SetTime(now)
+	_, _ = m.XAdd("s1", "*", []string{"k", "v1"})
+	_, _ = m.XAdd("s2", "*", []string{"k", "v2"})
+
+	// replica 1 reads the messages and dies without acknowledging them.
+	dead := newTestSource(t, m, 1)
+	msgs, err := dead.Read(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+
+	src := newTestSource(t, m, 0)
+	msgs, err = src.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, msgs)
+
+	// not idle for long enough yet.
+	src.lastClaimTime = time.Time{}
+	msgs, err = src.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, msgs)
+
+	m.SetTime(now.Add(2 * time.Minute))
+	src.lastClaimTime = time.Time{}
+	msgs, err = src.Read(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	offsets := []isb.Offset{msgs[0].ReadOffset, msgs[1].ReadOffset}
+	for _, err := range src.Ack(ctx, offsets) {
+		assert.NoError(t, err)
+	}
+	p, err := src.readers[0].Client.XPending(ctx, "s1", "group").Result()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), p.Count)
+}
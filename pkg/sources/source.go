@@ -36,12 +36,15 @@ import (
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/shuffle"
+	"github.com/numaproj/numaflow/pkg/sources/file"
 	"github.com/numaproj/numaflow/pkg/sources/generator"
+	"github.com/numaproj/numaflow/pkg/sources/grpc"
 	"github.com/numaproj/numaflow/pkg/sources/http"
 	"github.com/numaproj/numaflow/pkg/sources/kafka"
 	"github.com/numaproj/numaflow/pkg/sources/nats"
 	"github.com/numaproj/numaflow/pkg/sources/redisstreams"
 	"github.com/numaproj/numaflow/pkg/sources/transformer"
+	"github.com/numaproj/numaflow/pkg/udf/wasm"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 	"github.com/numaproj/numaflow/pkg/watermark/generic/jetstream"
@@ -142,7 +145,21 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 		}
 		toVertexPartitionMap[edge.To] = edge.GetToVertexPartitionCount()
 	}
-	if sp.VertexInstance.Vertex.HasUDTransformer() {
+	if sp.VertexInstance.Vertex.HasUDTransformer() && sp.VertexInstance.Vertex.Spec.Source.UDTransformer.Wasm != nil {
+		var module *wasm.Module
+		x := sp.VertexInstance.Vertex.Spec.Source.UDTransformer.Wasm
+		if module, err = wasm.NewModule(ctx, x.GetModulePath(), wasm.WithMemoryLimitPages(x.GetMemoryLimitPages())); err != nil {
+			return fmt.Errorf("failed to load the wasm module, %w", err)
+		}
+		defer func() {
+			if err := module.Close(context.Background()); err != nil {
+				log.Warnw("Failed to close the wasm module", zap.Error(err))
+			}
+		}()
+		t := wasm.NewTransformerApplier(module)
+		readyChecker = t
+		sourcer, err = sp.getSourcer(writersMap, sp.getTransformerGoWhereDecider(shuffleFuncMap), t, fetchWatermark, publishWatermark, sourcePublisherStores, log)
+	} else if sp.VertexInstance.Vertex.HasUDTransformer() {
 		t, err := transformer.NewGRPCBasedTransformer()
 		if err != nil {
 			return fmt.Errorf("failed to create gRPC client, %w", err)
@@ -239,6 +256,22 @@ func (sp *SourceProcessor) getSourcer(writers map[string][]isb.BufferWriter,
 			readOptions = append(readOptions, redisstreams.WithReadTimeOut(l.ReadTimeout.Duration))
 		}
 		return redisstreams.New(sp.VertexInstance, writers, fsd, mapApplier, fetchWM, publishWM, publishWMStores, readOptions...)
+	} else if x := src.File; x != nil {
+		readOptions := []file.Option{
+			file.WithLogger(logger),
+		}
+		if l := sp.VertexInstance.Vertex.Spec.Limits; l != nil && l.ReadTimeout != nil {
+			readOptions = append(readOptions, file.WithReadTimeout(l.ReadTimeout.Duration))
+		}
+		return file.New(sp.VertexInstance, writers, fsd, mapApplier, fetchWM, publishWM, publishWMStores, readOptions...)
+	} else if x := src.GRPC; x != nil {
+		readOptions := []grpc.Option{
+			grpc.WithLogger(logger),
+		}
+		if l := sp.VertexInstance.Vertex.Spec.Limits; l != nil && l.ReadTimeout != nil {
+			readOptions = append(readOptions, grpc.WithReadTimeout(l.ReadTimeout.Duration))
+		}
+		return grpc.New(sp.VertexInstance, writers, fsd, mapApplier, fetchWM, publishWM, publishWMStores, readOptions...)
 	}
 	return nil, fmt.Errorf("invalid source spec")
 }
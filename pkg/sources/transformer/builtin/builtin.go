@@ -27,6 +27,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	eventtime "github.com/numaproj/numaflow/pkg/sources/transformer/builtin/event_time"
 	"github.com/numaproj/numaflow/pkg/sources/transformer/builtin/filter"
+	"github.com/numaproj/numaflow/pkg/sources/transformer/builtin/keyby"
 	timeextractionfilter "github.com/numaproj/numaflow/pkg/sources/transformer/builtin/time_extraction_filter"
 )
 
@@ -57,6 +58,8 @@ func (b *Builtin) executor() (functionsdk.MapTFunc, error) {
 		return eventtime.New(b.KWArgs)
 	case "timeExtractionFilter":
 		return timeextractionfilter.New(b.KWArgs)
+	case "keyBy":
+		return keyby.New(b.KWArgs)
 	default:
 		return nil, fmt.Errorf("unrecognized transformer %q", b.Name)
 	}
@@ -0,0 +1,65 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyby
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	functionsdk "github.com/numaproj/numaflow-go/pkg/function"
+
+	"github.com/numaproj/numaflow/pkg/shared/expr"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+type keyBy struct {
+	program *expr.Program
+}
+
+// New returns a transformer which sets the keys of the messages. The argument "expression" is evaluated to a string
+// or a list of strings as the keys, e.g. `[json(payload).region, json(payload).user]`.
+func New(args map[string]string) (functionsdk.MapTFunc, error) {
+	expression, existing := args["expression"]
+	if !existing {
+		return nil, fmt.Errorf(`missing "expression"`)
+	}
+	p, err := expr.Compile(expression)
+	if err != nil {
+		return nil, fmt.Errorf(`invalid "expression", %w`, err)
+	}
+	k := keyBy{
+		program: p,
+	}
+
+	return func(ctx context.Context, keys []string, datum functionsdk.Datum) functionsdk.MessageTs {
+		log := logging.FromContext(ctx)
+		resultMsg, err := k.apply(datum.EventTime(), datum.Value())
+		if err != nil {
+			log.Errorf("KeyBy transformer apply got an error: %v", err)
+		}
+		return functionsdk.MessageTsBuilder().Append(resultMsg)
+	}, nil
+}
+
+func (k keyBy) apply(et time.Time, msg []byte) (functionsdk.MessageT, error) {
+	keys, err := k.program.EvalStrings(msg)
+	if err != nil {
+		return functionsdk.MessageTToDrop(), err
+	}
+	return functionsdk.NewMessageT(msg, et).WithKeys(keys), nil
+}
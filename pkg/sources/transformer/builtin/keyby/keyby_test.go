@@ -0,0 +1,71 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyby
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	functionsdk "github.com/numaproj/numaflow-go/pkg/function"
+	"github.com/stretchr/testify/assert"
+)
+
+type testDatum struct {
+	value     []byte
+	eventTime time.Time
+}
+
+func (h *testDatum) Metadata() functionsdk.DatumMetadata {
+	return nil
+}
+
+func (h *testDatum) Value() []byte {
+	return h.value
+}
+
+func (h *testDatum) EventTime() time.Time {
+	return h.eventTime
+}
+
+func (h *testDatum) Watermark() time.Time {
+	return time.Time{}
+}
+
+func TestKeyBy(t *testing.T) {
+	t.Run("missing expression", func(t *testing.T) {
+		_, err := New(map[string]string{})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "missing")
+	})
+
+	t.Run("keys with event time kept", func(t *testing.T) {
+		handle, err := New(map[string]string{"expression": `json(payload).region`})
+		assert.NoError(t, err)
+		msg := []byte(`{"region": "us"}`)
+		eventTime := time.Unix(1661169600, 0)
+		result := handle(context.Background(), []string{"k1"}, &testDatum{value: msg, eventTime: eventTime})
+		assert.Equal(t, []functionsdk.MessageT{functionsdk.NewMessageT(msg, eventTime).WithKeys([]string{"us"})}, result.Items())
+	})
+
+	t.Run("expression error", func(t *testing.T) {
+		handle, err := New(map[string]string{"expression": `json(payload).region`})
+		assert.NoError(t, err)
+		result := handle(context.Background(), []string{"k1"}, &testDatum{value: []byte("not json")})
+		assert.Equal(t, []functionsdk.MessageT{functionsdk.MessageTToDrop()}, result.Items())
+	})
+}
@@ -26,7 +26,12 @@ import (
 
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/udf/builtin/cat"
+	"github.com/numaproj/numaflow/pkg/udf/builtin/enrich"
 	"github.com/numaproj/numaflow/pkg/udf/builtin/filter"
+	"github.com/numaproj/numaflow/pkg/udf/builtin/flatten"
+	"github.com/numaproj/numaflow/pkg/udf/builtin/jsontransform"
+	"github.com/numaproj/numaflow/pkg/udf/builtin/keyby"
+	"github.com/numaproj/numaflow/pkg/udf/builtin/schemavalidate"
 )
 
 type Builtin struct {
@@ -39,7 +44,7 @@ func (b *Builtin) Start(ctx context.Context) error {
 	log := logging.FromContext(ctx)
 	log.Infow("Start a builtin function", zap.Any("name", b.Name), zap.Strings("args", b.Args), zap.Any("kwargs", b.KWArgs))
 
-	executor, err := b.executor()
+	executor, err := b.executor(ctx)
 	if err != nil {
 		return err
 	}
@@ -47,13 +52,23 @@ func (b *Builtin) Start(ctx context.Context) error {
 	return nil
 }
 
-func (b *Builtin) executor() (functionsdk.MapFunc, error) {
+func (b *Builtin) executor(ctx context.Context) (functionsdk.MapFunc, error) {
 	// TODO: deal with args later
 	switch b.Name {
 	case "cat":
 		return cat.New(), nil
 	case "filter":
 		return filter.New(b.KWArgs)
+	case "jsonTransform":
+		return jsontransform.New(b.KWArgs)
+	case "flatten":
+		return flatten.New(b.KWArgs)
+	case "keyBy":
+		return keyby.New(b.KWArgs)
+	case "schemaValidate":
+		return schemavalidate.New(b.KWArgs)
+	case "enrich":
+		return enrich.New(ctx, b.KWArgs)
 	default:
 		return nil, fmt.Errorf("unrecognized function %q", b.Name)
 	}
@@ -17,6 +17,9 @@ limitations under the License.
 package builtin
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -24,6 +27,10 @@ import (
 
 func TestGetExecutors(t *testing.T) {
 	t.Run("test good", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		dataPath := filepath.Join(t.TempDir(), "data.json")
+		assert.NoError(t, os.WriteFile(dataPath, []byte(`{"a": {"b": "c"}}`), 0644))
 		builtins := []Builtin{
 			{
 				Name: "cat",
@@ -32,9 +39,29 @@ func TestGetExecutors(t *testing.T) {
 				Name:   "filter",
 				KWArgs: map[string]string{"expression": `json(payload).a=="b"`},
 			},
+			{
+				Name:   "jsonTransform",
+				KWArgs: map[string]string{"select": "a", "set.c": `json(payload).a`},
+			},
+			{
+				Name:   "flatten",
+				KWArgs: map[string]string{"path": "a.b"},
+			},
+			{
+				Name:   "keyBy",
+				KWArgs: map[string]string{"expression": `json(payload).a`},
+			},
+			{
+				Name:   "schemaValidate",
+				KWArgs: map[string]string{"schema": `{"type": "object"}`},
+			},
+			{
+				Name:   "enrich",
+				KWArgs: map[string]string{"dataPath": dataPath, "lookup": `json(payload).a`},
+			},
 		}
 		for _, b := range builtins {
-			e, err := b.executor()
+			e, err := b.executor(ctx)
 			assert.NoError(t, err)
 			assert.NotNil(t, e)
 		}
@@ -44,7 +71,7 @@ func TestGetExecutors(t *testing.T) {
 		b := &Builtin{
 			Name: "catt",
 		}
-		_, err := b.executor()
+		_, err := b.executor(context.Background())
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "unrecognized function")
 	})
@@ -0,0 +1,347 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enrich
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	functionsdk "github.com/numaproj/numaflow-go/pkg/function"
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/shared/expr"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+const (
+	formatCSV  = "csv"
+	formatJSON = "json"
+
+	defaultMissTag = "miss"
+)
+
+var arguments = map[string]bool{
+	"dataPath": true,
+	"format":   true,
+	"keyField": true,
+	"lookup":   true,
+	"target":   true,
+	"hitTag":   true,
+	"missTag":  true,
+}
+
+type record map[string]interface{}
+
+type enrich struct {
+	path      string
+	format    string
+	keyField  string
+	lookup    *expr.Program
+	target    string
+	hitTag    string
+	missTag   string
+	lock      sync.RWMutex
+	dataset   map[string]record
+	watcher   *fsnotify.Watcher
+	closeOnce sync.Once
+	logger    *zap.SugaredLogger
+}
+
+// New returns a map function enriching the JSON objects with the records of a keyed dataset, which is loaded into
+// memory, and reloaded when the file changes. The arguments are:
+//
+//   - "dataPath": path of the dataset file, e.g. a mounted ConfigMap.
+//   - "format": "csv" or "json", defaults to "csv" if the file name ends with ".csv", otherwise "json". A CSV file
+//     has a header row with the field names, a JSON file is an array of objects, or an object of the records by keys.
+//   - "keyField": the field of the records used as the keys, required by the CSV files and the JSON arrays.
+//   - "lookup": an expression evaluated on the message to the key to look up.
+//   - "target": the field to put the matched record in, the fields of the record are merged into the message if not
+//     specified.
+//   - "hitTag": tag of the enriched messages, no tag by default.
+//   - "missTag": tag of the messages not enriched, defaults to "miss".
+//
+// The file is watched until the context is done.
+func New(ctx context.Context, args map[string]string) (functionsdk.MapFunc, error) {
+	e, err := newEnrich(args)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.watch(ctx); err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, keys []string, datum functionsdk.Datum) functionsdk.Messages {
+		log := logging.FromContext(ctx)
+		resultMsg, err := e.apply(keys, datum.Value())
+		if err != nil {
+			log.Errorf("Enrich map function apply got an error: %v", err)
+		}
+		return functionsdk.MessagesBuilder().Append(resultMsg)
+	}, nil
+}
+
+func newEnrich(args map[string]string) (*enrich, error) {
+	for k := range args {
+		if !arguments[k] {
+			return nil, fmt.Errorf("unrecognized argument %q", k)
+		}
+	}
+	e := &enrich{
+		path:     args["dataPath"],
+		format:   args["format"],
+		keyField: args["keyField"],
+		target:   args["target"],
+		hitTag:   args["hitTag"],
+		missTag:  defaultMissTag,
+		logger:   logging.NewLogger().Named("enrich"),
+	}
+	if e.path == "" {
+		return nil, fmt.Errorf(`missing "dataPath"`)
+	}
+	e.path = filepath.Clean(e.path)
+	if e.format == "" {
+		if strings.HasSuffix(strings.ToLower(e.path), ".csv") {
+			e.format = formatCSV
+		} else {
+			e.format = formatJSON
+		}
+	}
+	if e.format != formatCSV && e.format != formatJSON {
+		return nil, fmt.Errorf(`invalid "format" %q, it should be either "csv" or "json"`, e.format)
+	}
+	lookup, existing := args["lookup"]
+	if !existing {
+		return nil, fmt.Errorf(`missing "lookup"`)
+	}
+	p, err := expr.Compile(lookup)
+	if err != nil {
+		return nil, fmt.Errorf(`invalid "lookup", %w`, err)
+	}
+	e.lookup = p
+	if v, existing := args["missTag"]; existing {
+		e.missTag = v
+	}
+	if err := e.load(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// load loads the dataset from the file.
+func (e *enrich) load() error {
+	data, err := os.ReadFile(e.path)
+	if err != nil {
+		return fmt.Errorf("failed to read the dataset %q, %w", e.path, err)
+	}
+	var dataset map[string]record
+	if e.format == formatCSV {
+		dataset, err = parseCSV(data, e.keyField)
+	} else {
+		dataset, err = parseJSON(data, e.keyField)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to parse the dataset %q, %w", e.path, err)
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.dataset = dataset
+	return nil
+}
+
+// watch reloads the dataset when the file changes, until the context is done. The directory of the file is watched,
+// because a mounted ConfigMap is updated by replacing a symbolic link in the directory.
+func (e *enrich) watch(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create a file watcher, %w", err)
+	}
+	if err := watcher.Add(filepath.Dir(e.path)); err != nil {
+		_ = watcher.Close()
+		return fmt.Errorf("failed to watch the dataset %q, %w", e.path, err)
+	}
+	e.watcher = watcher
+	realPath, _ := filepath.EvalSymlinks(e.path)
+	go func() {
+		defer e.close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				var changed bool
+				if changed, realPath = e.changed(event, realPath); !changed {
+					continue
+				}
+				if err := e.load(); err != nil {
+					// keep using the previous dataset, the file might be in the middle of an update.
+					e.logger.Warnw("Failed to reload the dataset", zap.Error(err))
+				} else {
+					e.logger.Infow("Reloaded the dataset", zap.String("path", e.path))
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				e.logger.Errorw("Failed to watch the dataset", zap.Error(err))
+			}
+		}
+	}()
+	return nil
+}
+
+// changed returns whether the event changes the dataset file, and the resolved path of the file. The other files in
+// the directory are ignored, except the symbolic links of a mounted ConfigMap, which change the resolved path.
+func (e *enrich) changed(event fsnotify.Event, realPath string) (bool, string) {
+	if event.Op == fsnotify.Chmod {
+		return false, realPath
+	}
+	current, _ := filepath.EvalSymlinks(e.path)
+	if filepath.Clean(event.Name) == e.path || current != realPath {
+		return true, current
+	}
+	return false, realPath
+}
+
+// close stops watching the file.
+func (e *enrich) close() {
+	e.closeOnce.Do(func() {
+		if e.watcher != nil {
+			_ = e.watcher.Close()
+		}
+	})
+}
+
+func (e *enrich) get(key string) (record, bool) {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	r, ok := e.dataset[key]
+	return r, ok
+}
+
+func (e *enrich) apply(keys []string, msg []byte) (functionsdk.Message, error) {
+	miss := functionsdk.NewMessage(msg).WithKeys(keys).WithTags([]string{e.missTag})
+	key, err := e.lookup.Eval(msg)
+	if err != nil {
+		return miss, err
+	}
+	r, ok := e.get(fmt.Sprintf("%v", key))
+	if !ok {
+		return miss, nil
+	}
+	payload := make(map[string]interface{})
+	d := json.NewDecoder(bytes.NewReader(msg))
+	d.UseNumber()
+	if err := d.Decode(&payload); err != nil {
+		return miss, fmt.Errorf("failed to parse the message as a JSON object, %w", err)
+	}
+	if e.target != "" {
+		payload[e.target] = r
+	} else {
+		for k, v := range r {
+			payload[k] = v
+		}
+	}
+	value, err := json.Marshal(payload)
+	if err != nil {
+		return miss, fmt.Errorf("failed to marshal the enriched message, %w", err)
+	}
+	result := functionsdk.NewMessage(value).WithKeys(keys)
+	if e.hitTag != "" {
+		result = result.WithTags([]string{e.hitTag})
+	}
+	return result, nil
+}
+
+func parseCSV(data []byte, keyField string) (map[string]record, error) {
+	if keyField == "" {
+		return nil, fmt.Errorf(`missing "keyField"`)
+	}
+	rows, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("missing the header row")
+	}
+	header := rows[0]
+	keyIdx := -1
+	for i, f := range header {
+		if f == keyField {
+			keyIdx = i
+		}
+	}
+	if keyIdx < 0 {
+		return nil, fmt.Errorf("key field %q is not found in the header row", keyField)
+	}
+	dataset := make(map[string]record, len(rows)-1)
+	for _, row := range rows[1:] {
+		r := make(record, len(header))
+		for i, f := range header {
+			r[f] = row[i]
+		}
+		dataset[row[keyIdx]] = r
+	}
+	return dataset, nil
+}
+
+func parseJSON(data []byte, keyField string) (map[string]record, error) {
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	dataset := make(map[string]record)
+	switch w := v.(type) {
+	case map[string]interface{}:
+		for k, x := range w {
+			r, ok := x.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("record %q is not an object", k)
+			}
+			dataset[k] = r
+		}
+	case []interface{}:
+		if keyField == "" {
+			return nil, fmt.Errorf(`missing "keyField"`)
+		}
+		for i, x := range w {
+			r, ok := x.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("record %d is not an object", i)
+			}
+			k, ok := r[keyField]
+			if !ok {
+				return nil, fmt.Errorf("key field %q is not found in record %d", keyField, i)
+			}
+			dataset[fmt.Sprintf("%v", k)] = r
+		}
+	default:
+		return nil, fmt.Errorf("it should be an array or an object")
+	}
+	return dataset, nil
+}
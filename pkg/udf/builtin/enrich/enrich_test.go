@@ -0,0 +1,214 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enrich
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	functionsdk "github.com/numaproj/numaflow-go/pkg/function"
+	"github.com/stretchr/testify/assert"
+)
+
+type testDatum struct {
+	value []byte
+}
+
+func (h *testDatum) Metadata() functionsdk.DatumMetadata {
+	return nil
+}
+
+func (h *testDatum) Value() []byte {
+	return h.value
+}
+
+func (h *testDatum) EventTime() time.Time {
+	return time.Time{}
+}
+
+func (h *testDatum) Watermark() time.Time {
+	return time.Time{}
+}
+
+const (
+	testCSV = `id,country,tier
+1,us,gold
+2,fr,silver
+`
+	testJSONArray  = `[{"id": 1, "country": "us"}, {"id": 2, "country": "fr"}]`
+	testJSONObject = `{"1": {"country": "us"}, "2": {"country": "fr"}}`
+)
+
+func writeDataset(t *testing.T, name, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	assert.NoError(t, os.WriteFile(path, []byte(data), 0644))
+	return path
+}
+
+func TestNew(t *testing.T) {
+	csvPath := writeDataset(t, "users.csv", testCSV)
+
+	t.Run("missing arguments", func(t *testing.T) {
+		_, err := New(context.Background(), map[string]string{"lookup": "json(payload).id"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `missing "dataPath"`)
+		_, err = New(context.Background(), map[string]string{"dataPath": csvPath, "keyField": "id"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `missing "lookup"`)
+		_, err = New(context.Background(), map[string]string{"dataPath": csvPath, "lookup": "json(payload).id"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `missing "keyField"`)
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		_, err := New(context.Background(), map[string]string{"dataPath": csvPath, "format": "xml", "keyField": "id", "lookup": "json(payload).id"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "format"`)
+	})
+
+	t.Run("key field not found", func(t *testing.T) {
+		_, err := New(context.Background(), map[string]string{"dataPath": csvPath, "keyField": "name", "lookup": "json(payload).id"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `key field "name" is not found`)
+	})
+
+	t.Run("unrecognized argument", func(t *testing.T) {
+		_, err := New(context.Background(), map[string]string{"dataPath": csvPath, "keyField": "id", "lookup": "json(payload).id", "key": "id"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `unrecognized argument "key"`)
+	})
+
+	t.Run("dataset not found", func(t *testing.T) {
+		_, err := New(context.Background(), map[string]string{"dataPath": filepath.Join(t.TempDir(), "users.json"), "lookup": "json(payload).id"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to read the dataset")
+	})
+}
+
+func TestEnrich(t *testing.T) {
+	t.Run("csv merged", func(t *testing.T) {
+		e, err := newEnrich(map[string]string{"dataPath": writeDataset(t, "users.csv", testCSV), "keyField": "id", "lookup": "json(payload).userId", "hitTag": "hit"})
+		assert.NoError(t, err)
+		result, err := e.apply([]string{"k1"}, []byte(`{"userId": 1, "amount": 12345678901234567}`))
+		assert.NoError(t, err)
+		assert.Equal(t, functionsdk.NewMessage([]byte(`{"amount":12345678901234567,"country":"us","id":"1","tier":"gold","userId":1}`)).WithKeys([]string{"k1"}).WithTags([]string{"hit"}), result)
+	})
+
+	t.Run("json array with target", func(t *testing.T) {
+		e, err := newEnrich(map[string]string{"dataPath": writeDataset(t, "users.json", testJSONArray), "keyField": "id", "lookup": "json(payload).userId", "target": "user"})
+		assert.NoError(t, err)
+		result, err := e.apply(nil, []byte(`{"userId": 2}`))
+		assert.NoError(t, err)
+		assert.Equal(t, functionsdk.NewMessage([]byte(`{"user":{"country":"fr","id":2},"userId":2}`)), result)
+	})
+
+	t.Run("json object with miss", func(t *testing.T) {
+		e, err := newEnrich(map[string]string{"dataPath": writeDataset(t, "users.json", testJSONObject), "lookup": "string(json(payload).userId)", "missTag": "unknown-user"})
+		assert.NoError(t, err)
+		msg := []byte(`{"userId": 3}`)
+		result, err := e.apply(nil, msg)
+		assert.NoError(t, err)
+		assert.Equal(t, functionsdk.NewMessage(msg).WithTags([]string{"unknown-user"}), result)
+		// a message failing the lookup is also a miss.
+		msg = []byte(`not json`)
+		result, err = e.apply(nil, msg)
+		assert.Error(t, err)
+		assert.Equal(t, functionsdk.NewMessage(msg).WithTags([]string{"unknown-user"}), result)
+	})
+
+	t.Run("hot reload", func(t *testing.T) {
+		path := writeDataset(t, "users.json", testJSONObject)
+		e, err := newEnrich(map[string]string{"dataPath": path, "lookup": "string(json(payload).userId)", "target": "user"})
+		assert.NoError(t, err)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		assert.NoError(t, e.watch(ctx))
+		// an invalid dataset is ignored.
+		assert.NoError(t, os.WriteFile(path, []byte(`{"3": `), 0644))
+		time.Sleep(100 * time.Millisecond)
+		_, ok := e.get("1")
+		assert.True(t, ok)
+		// replace the file like a ConfigMap update.
+		tmp := filepath.Join(filepath.Dir(path), "..tmp")
+		assert.NoError(t, os.WriteFile(tmp, []byte(`{"3": {"country": "de"}}`), 0644))
+		assert.NoError(t, os.Rename(tmp, path))
+		assert.Eventually(t, func() bool {
+			_, ok := e.get("3")
+			return ok
+		}, 5*time.Second, 10*time.Millisecond)
+		result, err := e.apply(nil, []byte(`{"userId": 3}`))
+		assert.NoError(t, err)
+		assert.Equal(t, functionsdk.NewMessage([]byte(`{"user":{"country":"de"},"userId":3}`)), result)
+	})
+
+	t.Run("changed", func(t *testing.T) {
+		dir := t.TempDir()
+		data := filepath.Join(dir, "..2023_10_01")
+		assert.NoError(t, os.Mkdir(data, 0755))
+		assert.NoError(t, os.WriteFile(filepath.Join(data, "users.json"), []byte(testJSONObject), 0644))
+		assert.NoError(t, os.Symlink(data, filepath.Join(dir, "..data")))
+		path := filepath.Join(dir, "users.json")
+		assert.NoError(t, os.Symlink(filepath.Join("..data", "users.json"), path))
+		e, err := newEnrich(map[string]string{"dataPath": path, "lookup": "string(json(payload).userId)"})
+		assert.NoError(t, err)
+		realPath, err := filepath.EvalSymlinks(path)
+		assert.NoError(t, err)
+
+		changed, _ := e.changed(fsnotify.Event{Name: path, Op: fsnotify.Write}, realPath)
+		assert.True(t, changed)
+		changed, _ = e.changed(fsnotify.Event{Name: path, Op: fsnotify.Chmod}, realPath)
+		assert.False(t, changed)
+		changed, _ = e.changed(fsnotify.Event{Name: filepath.Join(dir, "other.json"), Op: fsnotify.Create}, realPath)
+		assert.False(t, changed)
+
+		// a ConfigMap update replaces the "..data" link.
+		newData := filepath.Join(dir, "..2023_10_02")
+		assert.NoError(t, os.Mkdir(newData, 0755))
+		assert.NoError(t, os.WriteFile(filepath.Join(newData, "users.json"), []byte(testJSONObject), 0644))
+		assert.NoError(t, os.Symlink(newData, filepath.Join(dir, "..data_tmp")))
+		assert.NoError(t, os.Rename(filepath.Join(dir, "..data_tmp"), filepath.Join(dir, "..data")))
+		changed, newRealPath := e.changed(fsnotify.Event{Name: filepath.Join(dir, "..data"), Op: fsnotify.Create}, realPath)
+		assert.True(t, changed)
+		assert.Equal(t, filepath.Join(newData, "users.json"), newRealPath)
+		changed, _ = e.changed(fsnotify.Event{Name: filepath.Join(dir, "..2023_10_01"), Op: fsnotify.Remove}, newRealPath)
+		assert.False(t, changed)
+	})
+
+	t.Run("watcher closed", func(t *testing.T) {
+		path := writeDataset(t, "users.json", testJSONObject)
+		e, err := newEnrich(map[string]string{"dataPath": path, "lookup": "string(json(payload).userId)"})
+		assert.NoError(t, err)
+		ctx, cancel := context.WithCancel(context.Background())
+		assert.NoError(t, e.watch(ctx))
+		cancel()
+		assert.Eventually(t, func() bool {
+			return e.watcher.Add(filepath.Dir(path)) != nil
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("map function", func(t *testing.T) {
+		handle, err := New(context.Background(), map[string]string{"dataPath": writeDataset(t, "users.csv", testCSV), "keyField": "id", "lookup": "json(payload).userId", "target": "user"})
+		assert.NoError(t, err)
+		result := handle(context.Background(), nil, &testDatum{value: []byte(`{"userId": 2}`)})
+		assert.Equal(t, []functionsdk.Message{functionsdk.NewMessage([]byte(`{"user":{"country":"fr","id":"2","tier":"silver"},"userId":2}`))}, result.Items())
+	})
+}
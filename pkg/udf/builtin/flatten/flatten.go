@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flatten
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	functionsdk "github.com/numaproj/numaflow-go/pkg/function"
+
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+type flatten struct {
+	// path of the array field in the JSON object, split by ".".
+	path []string
+}
+
+// New returns a map function which splits an array field of the JSON object into messages, one message per element.
+// The argument "path" is the dot separated path of the array field, e.g. "order.items".
+func New(args map[string]string) (functionsdk.MapFunc, error) {
+	path, existing := args["path"]
+	if !existing || strings.TrimSpace(path) == "" {
+		return nil, fmt.Errorf(`missing "path"`)
+	}
+	f := flatten{
+		path: strings.Split(strings.TrimSpace(path), "."),
+	}
+
+	return func(ctx context.Context, keys []string, datum functionsdk.Datum) functionsdk.Messages {
+		log := logging.FromContext(ctx)
+		resultMsgs, err := f.apply(keys, datum.Value())
+		if err != nil {
+			log.Errorf("Flatten map function apply got an error: %v", err)
+		}
+		return resultMsgs
+	}, nil
+}
+
+func (f flatten) apply(keys []string, msg []byte) (functionsdk.Messages, error) {
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(msg))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return functionsdk.MessagesBuilder().Append(functionsdk.MessageToDrop()), fmt.Errorf("failed to parse the message as JSON, %w", err)
+	}
+	for _, p := range f.path {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return functionsdk.MessagesBuilder().Append(functionsdk.MessageToDrop()), fmt.Errorf("field %q is not found", strings.Join(f.path, "."))
+		}
+		if v, ok = obj[p]; !ok {
+			return functionsdk.MessagesBuilder().Append(functionsdk.MessageToDrop()), fmt.Errorf("field %q is not found", strings.Join(f.path, "."))
+		}
+	}
+	elements, ok := v.([]interface{})
+	if !ok {
+		return functionsdk.MessagesBuilder().Append(functionsdk.MessageToDrop()), fmt.Errorf("field %q is not an array", strings.Join(f.path, "."))
+	}
+	results := functionsdk.MessagesBuilder()
+	if len(elements) == 0 {
+		return results.Append(functionsdk.MessageToDrop()), nil
+	}
+	for _, e := range elements {
+		// the strings are output as they are, other elements are output in JSON format.
+		if s, ok := e.(string); ok {
+			results = results.Append(functionsdk.NewMessage([]byte(s)).WithKeys(keys))
+			continue
+		}
+		value, err := json.Marshal(e)
+		if err != nil {
+			return functionsdk.MessagesBuilder().Append(functionsdk.MessageToDrop()), fmt.Errorf("failed to marshal the element, %w", err)
+		}
+		results = results.Append(functionsdk.NewMessage(value).WithKeys(keys))
+	}
+	return results, nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flatten
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	functionsdk "github.com/numaproj/numaflow-go/pkg/function"
+	"github.com/stretchr/testify/assert"
+)
+
+type testDatum struct {
+	value     []byte
+	eventTime time.Time
+}
+
+func (h *testDatum) Metadata() functionsdk.DatumMetadata {
+	return nil
+}
+
+func (h *testDatum) Value() []byte {
+	return h.value
+}
+
+func (h *testDatum) EventTime() time.Time {
+	return h.eventTime
+}
+
+func (h *testDatum) Watermark() time.Time {
+	return time.Time{}
+}
+
+func TestFlatten(t *testing.T) {
+	t.Run("missing path", func(t *testing.T) {
+		_, err := New(map[string]string{})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "missing")
+	})
+
+	t.Run("flatten nested array", func(t *testing.T) {
+		handle, err := New(map[string]string{"path": "order.items"})
+		assert.NoError(t, err)
+		result := handle(context.Background(), []string{"k1"}, &testDatum{value: []byte(`{"order": {"items": [{"id": 12345678901234567}, "abc", 1.5]}}`)})
+		assert.Equal(t, []functionsdk.Message{
+			functionsdk.NewMessage([]byte(`{"id":12345678901234567}`)).WithKeys([]string{"k1"}),
+			functionsdk.NewMessage([]byte("abc")).WithKeys([]string{"k1"}),
+			functionsdk.NewMessage([]byte("1.5")).WithKeys([]string{"k1"}),
+		}, result.Items())
+	})
+
+	t.Run("empty array", func(t *testing.T) {
+		handle, err := New(map[string]string{"path": "items"})
+		assert.NoError(t, err)
+		result := handle(context.Background(), nil, &testDatum{value: []byte(`{"items": []}`)})
+		assert.Equal(t, []functionsdk.Message{functionsdk.MessageToDrop()}, result.Items())
+	})
+
+	t.Run("invalid messages", func(t *testing.T) {
+		handle, err := New(map[string]string{"path": "order.items"})
+		assert.NoError(t, err)
+		for _, msg := range []string{`not json`, `{"order": []}`, `{"order": {"id": 1}}`, `{"order": {"items": "abc"}}`} {
+			result := handle(context.Background(), nil, &testDatum{value: []byte(msg)})
+			assert.Equal(t, []functionsdk.Message{functionsdk.MessageToDrop()}, result.Items())
+		}
+	})
+}
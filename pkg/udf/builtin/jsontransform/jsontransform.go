@@ -0,0 +1,209 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jsontransform
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	functionsdk "github.com/numaproj/numaflow-go/pkg/function"
+
+	"github.com/numaproj/numaflow/pkg/shared/expr"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+const (
+	// setPrefix is the prefix of the arguments computing the output fields, e.g. "set.total".
+	setPrefix = "set."
+	// selectAll selects all the fields of the input.
+	selectAll = "*"
+)
+
+type field struct {
+	name    string
+	program *expr.Program
+}
+
+type renaming struct {
+	from string
+	to   string
+}
+
+type jsonTransform struct {
+	// selected fields of the input, nil means all the fields.
+	selected []string
+	dropped  []string
+	// renamed fields, in the order of the "rename" argument.
+	renamed []renaming
+	// computed fields, sorted by the names.
+	computed []field
+	keys     *expr.Program
+	tags     *expr.Program
+}
+
+// New returns a map function transforming JSON objects. The arguments are:
+//
+//   - "select": comma separated fields of the input object to keep, defaults to "*", which keeps all the fields.
+//   - "drop": comma separated fields of the input object to drop.
+//   - "rename": comma separated "old:new" pairs of the fields to rename, they are renamed simultaneously, e.g. "a:b,b:a"
+//     swaps the fields.
+//   - "set.<field>": an expression computing the output field, evaluated on the input message.
+//   - "keys": an expression evaluated to a string or a list of strings as the output keys, the input keys are kept
+//     if not specified.
+//   - "tags": an expression evaluated to a string or a list of strings as the output tags.
+//
+// They are applied in the order of select, drop, rename and set.
+func New(args map[string]string) (functionsdk.MapFunc, error) {
+	t, err := newJSONTransform(args)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, keys []string, datum functionsdk.Datum) functionsdk.Messages {
+		log := logging.FromContext(ctx)
+		resultMsg, err := t.apply(keys, datum.Value())
+		if err != nil {
+			log.Errorf("JSON transform map function apply got an error: %v", err)
+		}
+		return functionsdk.MessagesBuilder().Append(resultMsg)
+	}, nil
+}
+
+func newJSONTransform(args map[string]string) (*jsonTransform, error) {
+	t := &jsonTransform{}
+	for k, v := range args {
+		switch {
+		case k == "select":
+			if strings.TrimSpace(v) != selectAll {
+				t.selected = splitFields(v)
+			}
+		case k == "drop":
+			t.dropped = splitFields(v)
+		case k == "rename":
+			for _, pair := range splitFields(v) {
+				from, to, ok := strings.Cut(pair, ":")
+				from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+				if !ok || from == "" || to == "" {
+					return nil, fmt.Errorf(`invalid "rename" %q, it should be comma separated "old:new" pairs`, v)
+				}
+				t.renamed = append(t.renamed, renaming{from: from, to: to})
+			}
+		case k == "keys" || k == "tags":
+			p, err := expr.Compile(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %q, %w", k, err)
+			}
+			if k == "keys" {
+				t.keys = p
+			} else {
+				t.tags = p
+			}
+		case strings.HasPrefix(k, setPrefix) && len(k) > len(setPrefix):
+			p, err := expr.Compile(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %q, %w", k, err)
+			}
+			t.computed = append(t.computed, field{name: strings.TrimPrefix(k, setPrefix), program: p})
+		default:
+			return nil, fmt.Errorf("unrecognized argument %q", k)
+		}
+	}
+	// the fields are computed in a deterministic order.
+	sort.Slice(t.computed, func(i, j int) bool { return t.computed[i].name < t.computed[j].name })
+	return t, nil
+}
+
+func (t *jsonTransform) apply(keys []string, msg []byte) (functionsdk.Message, error) {
+	input := make(map[string]interface{})
+	// keep the numbers as they are, e.g. the large integers.
+	d := json.NewDecoder(bytes.NewReader(msg))
+	d.UseNumber()
+	if err := d.Decode(&input); err != nil {
+		return functionsdk.MessageToDrop(), fmt.Errorf("failed to parse the message as a JSON object, %w", err)
+	}
+	output := make(map[string]interface{})
+	if t.selected == nil {
+		for k, v := range input {
+			output[k] = v
+		}
+	} else {
+		for _, k := range t.selected {
+			if v, ok := input[k]; ok {
+				output[k] = v
+			}
+		}
+	}
+	for _, k := range t.dropped {
+		delete(output, k)
+	}
+	// read all the renamed fields before writing any of them, so that they are renamed simultaneously.
+	values := make([]interface{}, len(t.renamed))
+	found := make([]bool, len(t.renamed))
+	for i, r := range t.renamed {
+		values[i], found[i] = output[r.from]
+	}
+	for i, r := range t.renamed {
+		if found[i] {
+			delete(output, r.from)
+		}
+	}
+	for i, r := range t.renamed {
+		if found[i] {
+			output[r.to] = values[i]
+		}
+	}
+	for _, f := range t.computed {
+		v, err := f.program.Eval(msg)
+		if err != nil {
+			return functionsdk.MessageToDrop(), err
+		}
+		output[f.name] = v
+	}
+	value, err := json.Marshal(output)
+	if err != nil {
+		return functionsdk.MessageToDrop(), fmt.Errorf("failed to marshal the output, %w", err)
+	}
+	result := functionsdk.NewMessage(value).WithKeys(keys)
+	if t.keys != nil {
+		outputKeys, err := t.keys.EvalStrings(msg)
+		if err != nil {
+			return functionsdk.MessageToDrop(), err
+		}
+		result = result.WithKeys(outputKeys)
+	}
+	if t.tags != nil {
+		tags, err := t.tags.EvalStrings(msg)
+		if err != nil {
+			return functionsdk.MessageToDrop(), err
+		}
+		result = result.WithTags(tags)
+	}
+	return result, nil
+}
+
+func splitFields(s string) []string {
+	var fields []string
+	for _, f := range strings.Split(s, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jsontransform
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	functionsdk "github.com/numaproj/numaflow-go/pkg/function"
+	"github.com/stretchr/testify/assert"
+)
+
+type testDatum struct {
+	value []byte
+}
+
+func (h *testDatum) Metadata() functionsdk.DatumMetadata {
+	return nil
+}
+
+func (h *testDatum) Value() []byte {
+	return h.value
+}
+
+func (h *testDatum) EventTime() time.Time {
+	return time.Time{}
+}
+
+func (h *testDatum) Watermark() time.Time {
+	return time.Time{}
+}
+
+const testMsg = `{"id": 12345678901234567, "name": "numa", "amount": 10, "currency": "usd", "internal": true}`
+
+func TestNew(t *testing.T) {
+	t.Run("unrecognized argument", func(t *testing.T) {
+		_, err := New(map[string]string{"expression": "true"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `unrecognized argument "expression"`)
+	})
+
+	t.Run("invalid rename", func(t *testing.T) {
+		_, err := New(map[string]string{"rename": "a:b,c"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "rename"`)
+	})
+
+	t.Run("invalid expression", func(t *testing.T) {
+		_, err := New(map[string]string{"set.a": `ab\na`})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "set.a"`)
+	})
+}
+
+func TestJSONTransform(t *testing.T) {
+	t.Run("select rename and set", func(t *testing.T) {
+		handle, err := New(map[string]string{
+			"select":     "id, name, amount",
+			"rename":     "name:user",
+			"set.amount": `int(json(payload).amount) * 100`,
+			"set.label":  `sprig.upper(json(payload).currency)`,
+		})
+		assert.NoError(t, err)
+		result := handle(context.Background(), []string{"k1"}, &testDatum{value: []byte(testMsg)})
+		assert.Equal(t, 1, len(result.Items()))
+		assert.JSONEq(t, `{"id": 12345678901234567, "user": "numa", "amount": 1000, "label": "USD"}`, string(result.Items()[0].Value()))
+		assert.Equal(t, []string{"k1"}, result.Items()[0].Keys())
+	})
+
+	t.Run("swap", func(t *testing.T) {
+		handle, err := New(map[string]string{
+			"select": "name, currency",
+			"rename": "name:currency,currency:name",
+		})
+		assert.NoError(t, err)
+		result := handle(context.Background(), nil, &testDatum{value: []byte(testMsg)})
+		assert.Equal(t, 1, len(result.Items()))
+		assert.JSONEq(t, `{"name": "usd", "currency": "numa"}`, string(result.Items()[0].Value()))
+	})
+
+	t.Run("drop with keys and tags", func(t *testing.T) {
+		handle, err := New(map[string]string{
+			"drop": "internal,currency",
+			"keys": `[json(payload).name, json(payload).currency]`,
+			"tags": `json(payload).amount > 5 ? "large" : "small"`,
+		})
+		assert.NoError(t, err)
+		result := handle(context.Background(), []string{"k1"}, &testDatum{value: []byte(testMsg)})
+		assert.Equal(t, 1, len(result.Items()))
+		assert.JSONEq(t, `{"id": 12345678901234567, "name": "numa", "amount": 10}`, string(result.Items()[0].Value()))
+		assert.Equal(t, functionsdk.NewMessage([]byte(`{"amount":10,"id":12345678901234567,"name":"numa"}`)).WithKeys([]string{"numa", "usd"}).WithTags([]string{"large"}), result.Items()[0])
+	})
+
+	t.Run("not a JSON object", func(t *testing.T) {
+		handle, err := New(map[string]string{"select": "*"})
+		assert.NoError(t, err)
+		result := handle(context.Background(), nil, &testDatum{value: []byte(`[1, 2]`)})
+		assert.Equal(t, 1, len(result.Items()))
+		assert.Equal(t, functionsdk.MessageToDrop(), result.Items()[0])
+	})
+
+	t.Run("expression error", func(t *testing.T) {
+		handle, err := New(map[string]string{"set.a": `int(json(payload).name)`})
+		assert.NoError(t, err)
+		result := handle(context.Background(), nil, &testDatum{value: []byte(testMsg)})
+		assert.Equal(t, 1, len(result.Items()))
+		assert.Equal(t, functionsdk.MessageToDrop(), result.Items()[0])
+	})
+}
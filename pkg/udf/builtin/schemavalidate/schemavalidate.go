@@ -0,0 +1,130 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemavalidate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	functionsdk "github.com/numaproj/numaflow-go/pkg/function"
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+const (
+	defaultValidTag   = "valid"
+	defaultInvalidTag = "invalid"
+)
+
+type schemaValidate struct {
+	schema     *gojsonschema.Schema
+	validTag   string
+	invalidTag string
+	// attachErrors wraps the invalid messages with the validation errors.
+	attachErrors bool
+}
+
+// invalidMessage is the output of an invalid message when the validation errors are attached.
+type invalidMessage struct {
+	Errors []string `json:"errors"`
+	// Payload is the original message, it's a string if the message is not valid JSON.
+	Payload interface{} `json:"payload"`
+}
+
+// New returns a map function validating the messages against a JSON schema. The arguments are:
+//
+//   - "schemaPath": path of the file containing the JSON schema, e.g. a mounted ConfigMap.
+//   - "schema": the JSON schema, if "schemaPath" is not specified.
+//   - "validTag": tag of the valid messages, defaults to "valid".
+//   - "invalidTag": tag of the invalid messages, defaults to "invalid".
+//   - "attachErrors": whether to wrap the invalid messages as {"errors": [...], "payload": ...}, defaults to "true".
+func New(args map[string]string) (functionsdk.MapFunc, error) {
+	var schema []byte
+	if path, existing := args["schemaPath"]; existing {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the schema file %q, %w", path, err)
+		}
+		schema = b
+	} else if s, existing := args["schema"]; existing {
+		schema = []byte(s)
+	} else {
+		return nil, fmt.Errorf(`missing "schemaPath" or "schema"`)
+	}
+	compiled, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(schema))
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema, %w", err)
+	}
+	s := schemaValidate{
+		schema:       compiled,
+		validTag:     defaultValidTag,
+		invalidTag:   defaultInvalidTag,
+		attachErrors: true,
+	}
+	if v, existing := args["validTag"]; existing {
+		s.validTag = v
+	}
+	if v, existing := args["invalidTag"]; existing {
+		s.invalidTag = v
+	}
+	if v, existing := args["attachErrors"]; existing {
+		if s.attachErrors, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf(`invalid "attachErrors" %q, %w`, v, err)
+		}
+	}
+
+	return func(ctx context.Context, keys []string, datum functionsdk.Datum) functionsdk.Messages {
+		log := logging.FromContext(ctx)
+		resultMsg, err := s.apply(keys, datum.Value())
+		if err != nil {
+			log.Errorf("Schema validate map function apply got an error: %v", err)
+		}
+		return functionsdk.MessagesBuilder().Append(resultMsg)
+	}, nil
+}
+
+func (s schemaValidate) apply(keys []string, msg []byte) (functionsdk.Message, error) {
+	var errs []string
+	result, err := s.schema.Validate(gojsonschema.NewBytesLoader(msg))
+	if err != nil {
+		errs = []string{fmt.Sprintf("invalid JSON: %v", err)}
+	} else if !result.Valid() {
+		for _, e := range result.Errors() {
+			errs = append(errs, e.String())
+		}
+	}
+	if len(errs) == 0 {
+		return functionsdk.NewMessage(msg).WithKeys(keys).WithTags([]string{s.validTag}), nil
+	}
+	if !s.attachErrors {
+		return functionsdk.NewMessage(msg).WithKeys(keys).WithTags([]string{s.invalidTag}), nil
+	}
+	output := invalidMessage{Errors: errs, Payload: string(msg)}
+	if json.Valid(msg) {
+		output.Payload = json.RawMessage(msg)
+	}
+	value, err := json.Marshal(output)
+	if err != nil {
+		// the original message is still sent as invalid, without the errors.
+		return functionsdk.NewMessage(msg).WithKeys(keys).WithTags([]string{s.invalidTag}), fmt.Errorf("failed to attach the validation errors, %w", err)
+	}
+	return functionsdk.NewMessage(value).WithKeys(keys).WithTags([]string{s.invalidTag}), nil
+}
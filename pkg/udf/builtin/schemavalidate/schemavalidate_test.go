@@ -0,0 +1,123 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemavalidate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	functionsdk "github.com/numaproj/numaflow-go/pkg/function"
+	"github.com/stretchr/testify/assert"
+)
+
+type testDatum struct {
+	value []byte
+}
+
+func (h *testDatum) Metadata() functionsdk.DatumMetadata {
+	return nil
+}
+
+func (h *testDatum) Value() []byte {
+	return h.value
+}
+
+func (h *testDatum) EventTime() time.Time {
+	return time.Time{}
+}
+
+func (h *testDatum) Watermark() time.Time {
+	return time.Time{}
+}
+
+const testSchema = `{
+  "type": "object",
+  "properties": {
+    "id": {"type": "integer"},
+    "name": {"type": "string"}
+  },
+  "required": ["id"]
+}`
+
+func TestNew(t *testing.T) {
+	t.Run("missing schema", func(t *testing.T) {
+		_, err := New(map[string]string{})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "missing")
+	})
+
+	t.Run("invalid schema", func(t *testing.T) {
+		_, err := New(map[string]string{"schema": `{"type": 1}`})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid schema")
+	})
+
+	t.Run("schema file not found", func(t *testing.T) {
+		_, err := New(map[string]string{"schemaPath": filepath.Join(t.TempDir(), "schema.json")})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to read the schema file")
+	})
+
+	t.Run("invalid attachErrors", func(t *testing.T) {
+		_, err := New(map[string]string{"schema": testSchema, "attachErrors": "yes please"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "attachErrors"`)
+	})
+}
+
+func TestSchemaValidate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "schema.json")
+	assert.NoError(t, os.WriteFile(path, []byte(testSchema), 0644))
+
+	t.Run("valid message", func(t *testing.T) {
+		handle, err := New(map[string]string{"schemaPath": path})
+		assert.NoError(t, err)
+		msg := []byte(`{"id": 1, "name": "numa"}`)
+		result := handle(context.Background(), []string{"k1"}, &testDatum{value: msg})
+		assert.Equal(t, []functionsdk.Message{functionsdk.NewMessage(msg).WithKeys([]string{"k1"}).WithTags([]string{"valid"})}, result.Items())
+	})
+
+	t.Run("invalid message with errors attached", func(t *testing.T) {
+		handle, err := New(map[string]string{"schemaPath": path})
+		assert.NoError(t, err)
+		msg := []byte(`{"name": 1}`)
+		result := handle(context.Background(), []string{"k1"}, &testDatum{value: msg})
+		expected := `{"errors":["(root): id is required","name: Invalid type. Expected: string, given: integer"],"payload":{"name":1}}`
+		assert.Equal(t, []functionsdk.Message{functionsdk.NewMessage([]byte(expected)).WithKeys([]string{"k1"}).WithTags([]string{"invalid"})}, result.Items())
+	})
+
+	t.Run("invalid JSON with custom tags", func(t *testing.T) {
+		handle, err := New(map[string]string{"schema": testSchema, "validTag": "ok", "invalidTag": "quarantine"})
+		assert.NoError(t, err)
+		result := handle(context.Background(), nil, &testDatum{value: []byte(`not json`)})
+		assert.Equal(t, 1, len(result.Items()))
+		assert.Contains(t, string(result.Items()[0].Value()), `"payload":"not json"`)
+		assert.Contains(t, string(result.Items()[0].Value()), `invalid JSON`)
+		assert.Equal(t, functionsdk.NewMessage(result.Items()[0].Value()).WithTags([]string{"quarantine"}), result.Items()[0])
+	})
+
+	t.Run("invalid message without errors attached", func(t *testing.T) {
+		handle, err := New(map[string]string{"schema": testSchema, "attachErrors": "false"})
+		assert.NoError(t, err)
+		msg := []byte(`{"id": "1"}`)
+		result := handle(context.Background(), nil, &testDatum{value: msg})
+		assert.Equal(t, []functionsdk.Message{functionsdk.NewMessage(msg).WithTags([]string{"invalid"})}, result.Items())
+	})
+}
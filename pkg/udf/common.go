@@ -26,10 +26,56 @@ import (
 	jetstreamisb "github.com/numaproj/numaflow/pkg/isb/stores/jetstream"
 	redisisb "github.com/numaproj/numaflow/pkg/isb/stores/redis"
 	"github.com/numaproj/numaflow/pkg/isbsvc"
+	clientsdk "github.com/numaproj/numaflow/pkg/sdkclient/udf/client"
+	"github.com/numaproj/numaflow/pkg/shared/circuitbreaker"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 )
 
+// newUDFClient returns a gRPC client of the remote UDF server if it's specified, otherwise of the UDF container, and
+// the circuit breaker of the calls.
+func newUDFClient(vertex *dfv1.Vertex) (clientsdk.Client, *circuitbreaker.CircuitBreaker, error) {
+	maxMessageSize := sharedutil.LookupEnvIntOr(dfv1.EnvGRPCMaxMessageSize, dfv1.DefaultGRPCMaxMessageSize)
+	r := vertex.Spec.UDF.Remote
+	limits := vertex.Spec.Limits
+	if r == nil {
+		cbConfig := limits.GetUDFCircuitBreaker()
+		cb := circuitbreaker.New(cbConfig.GetFailureThreshold(), cbConfig.GetOpenDuration())
+		c, err := clientsdk.New(
+			clientsdk.WithMaxMessageSize(maxMessageSize),
+			clientsdk.WithCallTimeout(limits.GetUDFTimeout()),
+			clientsdk.WithCircuitBreaker(cb))
+		if err != nil {
+			return nil, nil, err
+		}
+		return c, cb, nil
+	}
+	tlsConfig, err := sharedutil.GetTLSConfig(r.TLS)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the tls config of the remote udf, %w", err)
+	}
+	// the settings of the remote udf take precedence over the ones in the vertex limits.
+	timeout := r.GetTimeout()
+	if r.Timeout == nil && limits.GetUDFTimeout() > 0 {
+		timeout = limits.GetUDFTimeout()
+	}
+	cbConfig := limits.GetUDFCircuitBreaker()
+	if r.CircuitBreaker != nil {
+		cbConfig = *r.CircuitBreaker
+	}
+	cb := circuitbreaker.New(cbConfig.GetFailureThreshold(), cbConfig.GetOpenDuration())
+	c, err := clientsdk.NewRemote(r.Address,
+		clientsdk.WithMaxMessageSize(maxMessageSize),
+		clientsdk.WithTLSConfig(tlsConfig),
+		clientsdk.WithCallTimeout(timeout),
+		clientsdk.WithCircuitBreaker(cb))
+	if err != nil {
+		return nil, nil, err
+	}
+	return c, cb, nil
+}
+
 func buildRedisBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInstance) ([]isb.BufferReader, map[string][]isb.BufferWriter, error) {
 	var readers []isb.BufferReader
 	redisClient := redisclient.NewInClusterRedisClient()
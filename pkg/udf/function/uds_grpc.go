@@ -31,6 +31,7 @@ import (
 
 	functionpb "github.com/numaproj/numaflow-go/pkg/apis/proto/function/v1"
 	functionsdk "github.com/numaproj/numaflow-go/pkg/function"
+	"github.com/numaproj/numaflow/pkg/apis/proto/mapbatch"
 	map_applier "github.com/numaproj/numaflow/pkg/forward/applier"
 	"github.com/numaproj/numaflow/pkg/isb"
 	reduce_applier "github.com/numaproj/numaflow/pkg/reduce/applier"
@@ -45,6 +46,7 @@ type UDSgRPCBasedUDF struct {
 }
 
 var _ map_applier.MapApplier = (*UDSgRPCBasedUDF)(nil)
+var _ map_applier.MapBatchApplier = (*UDSgRPCBasedUDF)(nil)
 var _ reduce_applier.ReduceApplier = (*UDSgRPCBasedUDF)(nil)
 
 // NewUDSgRPCBasedUDF returns a new UDSgRPCBasedUDF object.
@@ -178,6 +180,116 @@ func (u *UDSgRPCBasedUDF) ApplyMap(ctx context.Context, readMessage *isb.ReadMes
 	return writeMessages, nil
 }
 
+// ApplyMapBatch sends all the read messages to the UDF in one streaming call, and maps the results back to the read
+// messages by the message IDs.
+func (u *UDSgRPCBasedUDF) ApplyMapBatch(ctx context.Context, readMessages []*isb.ReadMessage) ([][]*isb.WriteMessage, error) {
+	datums := make([]*mapbatch.DatumRequest, len(readMessages))
+	// indexes of the read messages by the message IDs
+	indexes := make(map[string]int, len(readMessages))
+	for i, readMessage := range readMessages {
+		id := readMessage.Message.ID
+		if _, ok := indexes[id]; ok {
+			return nil, ApplyUDFErr{
+				UserUDFErr: false,
+				Message:    fmt.Sprintf("duplicate message ID %q in the batch", id),
+				InternalErr: InternalErr{
+					Flag:        true,
+					MainCarDown: false,
+				},
+			}
+		}
+		indexes[id] = i
+		datums[i] = &mapbatch.DatumRequest{
+			Id:           id,
+			Keys:         readMessage.Keys,
+			Value:        readMessage.Body.Payload,
+			EventTime:    readMessage.EventTime.UnixMilli(),
+			Watermark:    readMessage.Watermark.UnixMilli(),
+			NumDelivered: readMessage.Metadata.NumDelivered,
+		}
+	}
+
+	responses, err := u.client.MapBatchFn(ctx, datums)
+	if err != nil {
+		udfErr, _ := sdkerr.FromError(err)
+		if udfErr.ErrorKind() == sdkerr.Retryable {
+			var success bool
+			_ = wait.ExponentialBackoffWithContext(ctx, wait.Backoff{
+				// retry every "duration * factor + [0, jitter]" interval for 5 times
+				Duration: 1 * time.Second,
+				Factor:   1,
+				Jitter:   0.1,
+				Steps:    5,
+			}, func() (done bool, err error) {
+				responses, err = u.client.MapBatchFn(ctx, datums)
+				if err != nil {
+					udfErr, _ = sdkerr.FromError(err)
+					return udfErr.ErrorKind() != sdkerr.Retryable, nil
+				}
+				success = true
+				return true, nil
+			})
+			if success {
+				err = nil
+			}
+		}
+		if err != nil {
+			return nil, ApplyUDFErr{
+				UserUDFErr: false,
+				Message:    fmt.Sprintf("gRPC client.MapBatchFn failed, %s", err),
+				InternalErr: InternalErr{
+					Flag:        true,
+					MainCarDown: false,
+				},
+			}
+		}
+	}
+
+	results := make([][]*isb.WriteMessage, len(readMessages))
+	for _, response := range responses {
+		i, ok := indexes[response.GetId()]
+		if !ok || results[i] != nil {
+			return nil, ApplyUDFErr{
+				UserUDFErr: false,
+				Message:    fmt.Sprintf("gRPC client.MapBatchFn failed, unexpected response of message ID %q", response.GetId()),
+				InternalErr: InternalErr{
+					Flag:        true,
+					MainCarDown: false,
+				},
+			}
+		}
+		writeMessages := make([]*isb.WriteMessage, 0, len(response.GetResults()))
+		for _, result := range response.GetResults() {
+			writeMessages = append(writeMessages, &isb.WriteMessage{
+				Message: isb.Message{
+					Header: isb.Header{
+						MessageInfo: readMessages[i].MessageInfo,
+						Keys:        result.Keys,
+					},
+					Body: isb.Body{
+						Payload: result.Value,
+					},
+				},
+				Tags: result.Tags,
+			})
+		}
+		results[i] = writeMessages
+	}
+	for i, writeMessages := range results {
+		if writeMessages == nil {
+			return nil, ApplyUDFErr{
+				UserUDFErr: false,
+				Message:    fmt.Sprintf("gRPC client.MapBatchFn failed, no response of message ID %q", readMessages[i].Message.ID),
+				InternalErr: InternalErr{
+					Flag:        true,
+					MainCarDown: false,
+				},
+			}
+		}
+	}
+	return results, nil
+}
+
 func (u *UDSgRPCBasedUDF) ApplyMapStream(ctx context.Context, message *isb.ReadMessage, writeMessageCh chan<- isb.WriteMessage) error {
 	defer close(writeMessageCh)
 
@@ -27,6 +27,7 @@ import (
 
 	functionpb "github.com/numaproj/numaflow-go/pkg/apis/proto/function/v1"
 	"github.com/numaproj/numaflow-go/pkg/apis/proto/function/v1/funcmock"
+	"github.com/numaproj/numaflow/pkg/apis/proto/mapbatch"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
 	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
@@ -447,6 +448,101 @@ func TestHGRPCBasedUDF_ApplyWithMockClient(t *testing.T) {
 	assert.Equal(t, expectedKeys, resultKeys)
 }
 
+// fakeMapBatchClient responds to the datums sent on the stream with the respond function once the stream is closed.
+type fakeMapBatchClient struct {
+	respond func(datums []*mapbatch.DatumRequest) []*mapbatch.DatumResponse
+}
+
+func (f *fakeMapBatchClient) MapBatchFn(_ context.Context, _ ...grpc.CallOption) (mapbatch.MapBatch_MapBatchFnClient, error) {
+	return &fakeMapBatchStream{respond: f.respond, closed: make(chan struct{})}, nil
+}
+
+type fakeMapBatchStream struct {
+	grpc.ClientStream
+	respond   func(datums []*mapbatch.DatumRequest) []*mapbatch.DatumResponse
+	datums    []*mapbatch.DatumRequest
+	responses []*mapbatch.DatumResponse
+	closed    chan struct{}
+}
+
+func (f *fakeMapBatchStream) Send(datum *mapbatch.DatumRequest) error {
+	f.datums = append(f.datums, datum)
+	return nil
+}
+
+func (f *fakeMapBatchStream) CloseSend() error {
+	f.responses = f.respond(f.datums)
+	close(f.closed)
+	return nil
+}
+
+func (f *fakeMapBatchStream) Recv() (*mapbatch.DatumResponse, error) {
+	<-f.closed
+	if len(f.responses) == 0 {
+		return nil, io.EOF
+	}
+	resp := f.responses[0]
+	f.responses = f.responses[1:]
+	return resp, nil
+}
+
+func TestGRPCBasedUDF_ApplyBatchWithMockClient(t *testing.T) {
+	var readMessages []*isb.ReadMessage
+	for _, m := range testutils.BuildTestReadMessages(3, time.Unix(1661169600, 0)) {
+		m := m
+		readMessages = append(readMessages, &m)
+	}
+	newUDF := func(respond func(datums []*mapbatch.DatumRequest) []*mapbatch.DatumResponse) *UDSgRPCBasedUDF {
+		ctrl := gomock.NewController(t)
+		c, _ := clienttest.NewWithMapBatchClient(funcmock.NewMockUserDefinedFunctionClient(ctrl), &fakeMapBatchClient{respond: respond})
+		return NewUDSgRPCBasedUDFWithClient(c)
+	}
+
+	t.Run("test success", func(t *testing.T) {
+		u := newUDF(func(datums []*mapbatch.DatumRequest) []*mapbatch.DatumResponse {
+			// respond in the reverse order, and filter out the first message.
+			var responses []*mapbatch.DatumResponse
+			for i := len(datums) - 1; i >= 0; i-- {
+				response := &mapbatch.DatumResponse{Id: datums[i].Id}
+				if i > 0 {
+					response.Results = []*mapbatch.Result{{Keys: datums[i].Keys, Value: datums[i].Value, Tags: []string{"tag"}}}
+				}
+				responses = append(responses, response)
+			}
+			return responses
+		})
+		results, err := u.ApplyMapBatch(context.Background(), readMessages)
+		assert.NoError(t, err)
+		assert.Len(t, results, 3)
+		assert.Empty(t, results[0])
+		for i := 1; i < 3; i++ {
+			assert.Len(t, results[i], 1)
+			assert.Equal(t, readMessages[i].Payload, results[i][0].Payload)
+			assert.Equal(t, readMessages[i].Keys, results[i][0].Keys)
+			assert.Equal(t, readMessages[i].EventTime, results[i][0].EventTime)
+			assert.Equal(t, []string{"tag"}, results[i][0].Tags)
+		}
+	})
+
+	t.Run("test missing response", func(t *testing.T) {
+		u := newUDF(func(datums []*mapbatch.DatumRequest) []*mapbatch.DatumResponse {
+			return []*mapbatch.DatumResponse{{Id: datums[0].Id}, {Id: datums[1].Id}}
+		})
+		_, err := u.ApplyMapBatch(context.Background(), readMessages)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "no response of message ID")
+	})
+
+	t.Run("test unexpected response", func(t *testing.T) {
+		u := newUDF(func(datums []*mapbatch.DatumRequest) []*mapbatch.DatumResponse {
+			return []*mapbatch.DatumResponse{{Id: datums[0].Id}, {Id: datums[0].Id}, {Id: datums[1].Id}}
+		})
+		_, err := u.ApplyMapBatch(context.Background(), readMessages)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unexpected response of message ID")
+	})
+}
+
 func TestGRPCBasedUDF_BasicApplyStreamWithMockClient(t *testing.T) {
 	t.Run("test success", func(t *testing.T) {
 
@@ -21,22 +21,30 @@ import (
 	"fmt"
 	"sync"
 
-	clientsdk "github.com/numaproj/numaflow/pkg/sdkclient/udf/client"
-
 	"go.uber.org/zap"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/forward"
+	"github.com/numaproj/numaflow/pkg/forward/applier"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/metrics"
+	clientsdk "github.com/numaproj/numaflow/pkg/sdkclient/udf/client"
+	"github.com/numaproj/numaflow/pkg/shared/circuitbreaker"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/shuffle"
 	"github.com/numaproj/numaflow/pkg/udf/function"
+	"github.com/numaproj/numaflow/pkg/udf/wasm"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 	"github.com/numaproj/numaflow/pkg/watermark/generic/jetstream"
 )
 
+// mapUDFHandler applies the map UDF, and reports the health of it.
+type mapUDFHandler interface {
+	applier.MapApplier
+	metrics.HealthChecker
+}
+
 type MapUDFProcessor struct {
 	ISBSvcType     dfv1.ISBSvcType
 	VertexInstance *dfv1.VertexInstance
@@ -49,26 +57,49 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 	fromBuffer := u.VertexInstance.Vertex.OwnedBuffers()
 	finalWg := sync.WaitGroup{}
 
-	log = log.With("protocol", "uds-grpc-map-udf")
-	maxMessageSize := sharedutil.LookupEnvIntOr(dfv1.EnvGRPCMaxMessageSize, dfv1.DefaultGRPCMaxMessageSize)
-	c, err := clientsdk.New(clientsdk.WithMaxMessageSize(maxMessageSize))
-	if err != nil {
-		return fmt.Errorf("failed to create a new gRPC client: %w", err)
-	}
-	udfHandler, err := function.NewUDSgRPCBasedUDF(c)
-	if err != nil {
-		return fmt.Errorf("failed to create gRPC client, %w", err)
-	}
-	// Readiness check
-	if err := udfHandler.WaitUntilReady(ctx); err != nil {
-		return fmt.Errorf("failed on UDF readiness check, %w", err)
-	}
-	defer func() {
-		err = udfHandler.CloseConn(ctx)
+	var err error
+	var udfHandler mapUDFHandler
+	// udfCircuitBreaker is the circuit breaker of the gRPC UDF calls
+	var udfCircuitBreaker *circuitbreaker.CircuitBreaker
+	if x := u.VertexInstance.Vertex.Spec.UDF.Wasm; x != nil {
+		log = log.With("protocol", "wasm-map-udf")
+		module, err := wasm.NewModule(ctx, x.GetModulePath(), wasm.WithMemoryLimitPages(x.GetMemoryLimitPages()), wasm.WithCallTimeout(u.VertexInstance.Vertex.Spec.Limits.GetUDFTimeout()))
 		if err != nil {
-			log.Warnw("Failed to close gRPC client conn", zap.Error(err))
+			return fmt.Errorf("failed to load the wasm module, %w", err)
+		}
+		defer func() {
+			if err := module.Close(context.Background()); err != nil {
+				log.Warnw("Failed to close the wasm module", zap.Error(err))
+			}
+		}()
+		udfHandler = wasm.NewMapApplier(module)
+	} else {
+		if u.VertexInstance.Vertex.Spec.UDF.Remote != nil {
+			log = log.With("protocol", "remote-grpc-map-udf")
+		} else {
+			log = log.With("protocol", "uds-grpc-map-udf")
 		}
-	}()
+		var c clientsdk.Client
+		c, udfCircuitBreaker, err = newUDFClient(u.VertexInstance.Vertex)
+		if err != nil {
+			return fmt.Errorf("failed to create a new gRPC client: %w", err)
+		}
+		grpcUDF, err := function.NewUDSgRPCBasedUDF(c)
+		if err != nil {
+			return fmt.Errorf("failed to create gRPC client, %w", err)
+		}
+		// Readiness check
+		if err := grpcUDF.WaitUntilReady(ctx); err != nil {
+			return fmt.Errorf("failed on UDF readiness check, %w", err)
+		}
+		defer func() {
+			err = grpcUDF.CloseConn(ctx)
+			if err != nil {
+				log.Warnw("Failed to close gRPC client conn", zap.Error(err))
+			}
+		}()
+		udfHandler = grpcUDF
+	}
 
 	// create readers and writers
 	var readers []isb.BufferReader
@@ -157,14 +188,23 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 			return fmt.Errorf("failed to parse UDF map streaming metadata, %w", err)
 		}
 
+		enableMapUdfBatch, err := u.VertexInstance.Vertex.MapUdfBatchEnabled()
+		if err != nil {
+			return fmt.Errorf("failed to parse UDF map batch metadata, %w", err)
+		}
+
 		opts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeMapUDF), forward.WithLogger(log),
-			forward.WithUDFStreaming(enableMapUdfStream)}
+			forward.WithUDFStreaming(enableMapUdfStream), forward.WithUDFBatch(enableMapUdfBatch),
+			forward.WithPerKeyOrdering(u.VertexInstance.Vertex.Spec.UDF.Ordering == dfv1.OrderingPerKey)}
 		if x := u.VertexInstance.Vertex.Spec.Limits; x != nil {
 			if x.ReadBatchSize != nil {
 				opts = append(opts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
 				opts = append(opts, forward.WithUDFConcurrency(int(*x.ReadBatchSize)))
 			}
 		}
+		if udfCircuitBreaker != nil {
+			opts = append(opts, forward.WithUDFCircuitBreaker(udfCircuitBreaker))
+		}
 		// create a forwarder for each partition
 		forwarder, err := forward.NewInterStepDataForward(u.VertexInstance.Vertex, readers[index], writers, conditionalForwarder, udfHandler, fetchWatermark, publishWatermark, opts...)
 		if err != nil {
@@ -197,6 +237,9 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 		}(bufferPartition, forwarder)
 	}
 	metricsOpts := metrics.NewMetricsOptions(ctx, u.VertexInstance.Vertex, udfHandler, readers)
+	if udfCircuitBreaker != nil {
+		metricsOpts = append(metricsOpts, metrics.WithUDFCircuitBreaker(udfCircuitBreaker))
+	}
 	ms := metrics.NewMetricsServer(u.VertexInstance.Vertex, metricsOpts...)
 	if shutdown, err := ms.Start(ctx); err != nil {
 		return fmt.Errorf("failed to start metrics server, error: %w", err)
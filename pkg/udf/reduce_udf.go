@@ -22,8 +22,6 @@ import (
 	"strings"
 	"sync"
 
-	clientsdk "github.com/numaproj/numaflow/pkg/sdkclient/udf/client"
-
 	"go.uber.org/zap"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
@@ -158,10 +156,13 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 		return result, nil
 	})
 
-	log = log.With("protocol", "uds-grpc-reduce-udf")
+	if u.VertexInstance.Vertex.Spec.UDF.Remote != nil {
+		log = log.With("protocol", "remote-grpc-reduce-udf")
+	} else {
+		log = log.With("protocol", "uds-grpc-reduce-udf")
+	}
 
-	maxMessageSize := sharedutil.LookupEnvIntOr(dfv1.EnvGRPCMaxMessageSize, dfv1.DefaultGRPCMaxMessageSize)
-	c, err := clientsdk.New(clientsdk.WithMaxMessageSize(maxMessageSize))
+	c, udfCircuitBreaker, err := newUDFClient(u.VertexInstance.Vertex)
 	if err != nil {
 		return fmt.Errorf("failed to create a new gRPC client: %w", err)
 	}
@@ -184,6 +185,7 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 
 	// start metrics server
 	metricsOpts := metrics.NewMetricsOptions(ctx, u.VertexInstance.Vertex, udfHandler, readers)
+	metricsOpts = append(metricsOpts, metrics.WithUDFCircuitBreaker(udfCircuitBreaker))
 	ms := metrics.NewMetricsServer(u.VertexInstance.Vertex, metricsOpts...)
 	if shutdown, err := ms.Start(ctx); err != nil {
 		return fmt.Errorf("failed to start metrics server, error: %w", err)
@@ -208,6 +210,8 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 	if allowedLateness := u.VertexInstance.Vertex.Spec.UDF.GroupBy.AllowedLateness; allowedLateness != nil {
 		opts = append(opts, reduce.WithAllowedLateness(allowedLateness.Duration))
 	}
+	// the reading is paused while the circuit breaker of the reduce calls is open.
+	opts = append(opts, reduce.WithUDFCircuitBreaker(udfCircuitBreaker))
 	idleManager := wmb.NewIdleManager(len(writers))
 
 	op := pnf.NewOrderedProcessor(ctx, u.VertexInstance, udfHandler, writers, pbqManager, conditionalForwarder, publishWatermark, idleManager)
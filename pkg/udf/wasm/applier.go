@@ -0,0 +1,101 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wasm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/numaproj/numaflow/pkg/forward/applier"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/udf/function"
+)
+
+// Applier applies the map function of a WebAssembly module on the read messages, as a map UDF or a source
+// transformer.
+type Applier struct {
+	module *Module
+	// transformer is true if the module is used as a source transformer, which supports changing the event time.
+	transformer bool
+}
+
+var _ applier.MapApplier = (*Applier)(nil)
+
+// NewMapApplier returns an Applier of the module used as a map UDF.
+func NewMapApplier(module *Module) *Applier {
+	return &Applier{module: module}
+}
+
+// NewTransformerApplier returns an Applier of the module used as a source transformer.
+func NewTransformerApplier(module *Module) *Applier {
+	return &Applier{module: module, transformer: true}
+}
+
+// IsHealthy always returns nil, because the module is executed in-process.
+func (a *Applier) IsHealthy(_ context.Context) error {
+	return nil
+}
+
+func (a *Applier) ApplyMap(ctx context.Context, readMessage *isb.ReadMessage) ([]*isb.WriteMessage, error) {
+	messages, err := a.module.Apply(ctx, readMessage.Body.Payload)
+	if err != nil {
+		return nil, function.ApplyUDFErr{
+			UserUDFErr: false,
+			Message:    fmt.Sprintf("wasm module map failed, %s", err),
+			InternalErr: function.InternalErr{
+				Flag:        true,
+				MainCarDown: false,
+			},
+		}
+	}
+	writeMessages := make([]*isb.WriteMessage, 0, len(messages))
+	for i, m := range messages {
+		header := isb.Header{
+			MessageInfo: readMessage.MessageInfo,
+			Keys:        m.Keys,
+		}
+		if a.transformer {
+			// Transformer supports changing event time.
+			if !m.EventTime.IsZero() {
+				header.MessageInfo.EventTime = m.EventTime
+			}
+			header.ID = fmt.Sprintf("%s-%d", readMessage.ReadOffset.String(), i)
+		}
+		writeMessages = append(writeMessages, &isb.WriteMessage{
+			Message: isb.Message{
+				Header: header,
+				Body: isb.Body{
+					Payload: m.Value,
+				},
+			},
+			Tags: m.Tags,
+		})
+	}
+	return writeMessages, nil
+}
+
+func (a *Applier) ApplyMapStream(ctx context.Context, readMessage *isb.ReadMessage, writeMessageCh chan<- isb.WriteMessage) error {
+	defer close(writeMessageCh)
+	writeMessages, err := a.ApplyMap(ctx, readMessage)
+	if err != nil {
+		return err
+	}
+	for _, m := range writeMessages {
+		writeMessageCh <- *m
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wasm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+func TestApplier_ApplyMap(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	m, err := NewModule(ctx, "testdata/echo.wasm")
+	assert.NoError(t, err)
+	defer func() { _ = m.Close(ctx) }()
+
+	eventTime := time.Unix(1661169600, 0)
+	readMessage := &isb.ReadMessage{
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: eventTime},
+				ID:          "test_id",
+				Keys:        []string{"test_key"},
+			},
+			Body: isb.Body{Payload: []byte("hello")},
+		},
+		ReadOffset: isb.SimpleStringOffset(func() string { return "0" }),
+	}
+
+	writeMessages, err := NewMapApplier(m).ApplyMap(ctx, readMessage)
+	assert.NoError(t, err)
+	assert.Len(t, writeMessages, 1)
+	assert.Equal(t, []byte("hello"), writeMessages[0].Payload)
+	assert.Equal(t, eventTime, writeMessages[0].EventTime)
+	assert.Empty(t, writeMessages[0].ID)
+
+	writeMessages, err = NewTransformerApplier(m).ApplyMap(ctx, readMessage)
+	assert.NoError(t, err)
+	assert.Len(t, writeMessages, 1)
+	assert.Equal(t, eventTime, writeMessages[0].EventTime)
+	assert.Equal(t, "0-0", writeMessages[0].ID)
+
+	ch := make(chan isb.WriteMessage, 1)
+	assert.NoError(t, NewMapApplier(m).ApplyMapStream(ctx, readMessage, ch))
+	assert.Equal(t, []byte("hello"), (<-ch).Payload)
+
+	readMessage.Body.Payload = nil
+	_, err = NewMapApplier(m).ApplyMap(ctx, readMessage)
+	assert.Error(t, err)
+}
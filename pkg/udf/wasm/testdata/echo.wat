@@ -0,0 +1,66 @@
+;; echo.wat is the source of echo.wasm, which is used by the tests. The map function echoes the input as the value of
+;; one message without keys and tags, and traps if the input is empty.
+(module
+  (memory (export "memory") 1)
+  (global $next (mut i32) (i32.const 1024))
+
+  ;; alloc is a bump allocator, the memory is never freed.
+  (func $alloc (export "alloc") (param $size i32) (result i32)
+    (local $ptr i32)
+    global.get $next
+    local.set $ptr
+    global.get $next
+    local.get $size
+    i32.add
+    global.set $next
+    local.get $ptr)
+
+  (func (export "map") (param $ptr i32) (param $len i32) (result i64)
+    (local $out i32)
+    local.get $len
+    i32.eqz
+    if
+      unreachable
+    end
+    ;; 4 bytes of the number of messages, 4 bytes of the number of keys, 4 bytes of the value length, the value,
+    ;; 4 bytes of the number of tags, and 8 bytes of the event time.
+    local.get $len
+    i32.const 24
+    i32.add
+    call $alloc
+    local.set $out
+    local.get $out
+    i32.const 1
+    i32.store offset=0
+    local.get $out
+    i32.const 0
+    i32.store offset=4
+    local.get $out
+    local.get $len
+    i32.store offset=8
+    local.get $out
+    i32.const 12
+    i32.add
+    local.get $ptr
+    local.get $len
+    memory.copy
+    local.get $out
+    local.get $len
+    i32.add
+    i32.const 0
+    i32.store offset=12
+    local.get $out
+    local.get $len
+    i32.add
+    i64.const 0
+    i64.store offset=16
+    ;; the pointer in the high 32 bits, and the length in the low 32 bits.
+    local.get $out
+    i64.extend_i32_u
+    i64.const 32
+    i64.shl
+    local.get $len
+    i32.const 24
+    i32.add
+    i64.extend_i32_u
+    i64.or))
@@ -0,0 +1,13 @@
+;; loop.wat is the source of loop.wasm, which is used by the tests. The map function never returns, and the memory
+;; starts with 2 pages.
+(module
+  (memory (export "memory") 2)
+
+  (func (export "alloc") (param $size i32) (result i32)
+    i32.const 1024)
+
+  (func (export "map") (param $ptr i32) (param $len i32) (result i64)
+    loop $forever
+      br $forever
+    end
+    unreachable))
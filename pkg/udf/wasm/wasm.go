@@ -0,0 +1,308 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package wasm executes the user defined functions compiled to WebAssembly modules in-process, with a pure Go
+// runtime.
+//
+// The ABI between the host and a module is:
+//
+//   - The module exports its memory as "memory".
+//   - The module exports "alloc(size i32) i32", which allocates "size" bytes in the memory and returns the pointer.
+//   - The module optionally exports "dealloc(ptr i32, size i32)", which frees the memory allocated by "alloc".
+//   - The module exports "map(ptr i32, len i32) i64", which takes the payload of a message written to the memory
+//     allocated by "alloc", and returns the pointer of the output in the high 32 bits and the length of it in the low
+//     32 bits. The output is allocated by the module, and freed by the host with "dealloc" if it's exported.
+//   - The output is encoded in little endian as: the number of messages (u32), and for each message, the number of
+//     keys (u32), each key (u32 length + bytes), the value (u32 length + bytes), the number of tags (u32), each tag
+//     (u32 length + bytes), and the event time in milliseconds since epoch (i64), 0 to keep the event time of the
+//     input. The event time is only used by source transformers.
+//   - A trap of "map" fails the message.
+//
+// WASI is available to the modules, and the "_initialize" function is called once a module is instantiated.
+package wasm
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+)
+
+// Message is a message generated by the map function of a module.
+type Message struct {
+	Keys  []string
+	Value []byte
+	Tags  []string
+	// EventTime is zero if the event time of the input is not changed.
+	EventTime time.Time
+}
+
+// Module is a compiled WebAssembly module. The instances of the module are not safe for concurrent use, so a pool of
+// the instances is kept, and a new instance is created when all the instances are in use.
+type Module struct {
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+	config   wazero.ModuleConfig
+	lock     sync.Mutex
+	// idle instances of the module
+	instances []api.Module
+	// callTimeout is the timeout of each call to the map function, 0 means no timeout.
+	callTimeout time.Duration
+}
+
+type options struct {
+	memoryLimitPages uint32
+	callTimeout      time.Duration
+}
+
+// Option is an option of a Module.
+type Option func(*options)
+
+// WithMemoryLimitPages sets the maximum number of the 64 KiB pages of the memory of an instance, 0 means the limit of
+// the runtime, which is 65536 pages (4 GiB).
+func WithMemoryLimitPages(pages uint32) Option {
+	return func(o *options) {
+		o.memoryLimitPages = pages
+	}
+}
+
+// WithCallTimeout sets the timeout of each call to the map function, the instance is closed if it times out.
+func WithCallTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.callTimeout = timeout
+	}
+}
+
+// NewModule compiles the module at the path.
+func NewModule(ctx context.Context, path string, opts ...Option) (*Module, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	wasmBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the module %q, %w", path, err)
+	}
+	// close the instances running when the context is done, otherwise an infinite loop in a module never exits.
+	rc := wazero.NewRuntimeConfig().WithCloseOnContextDone(true)
+	if o.memoryLimitPages > 0 {
+		rc = rc.WithMemoryLimitPages(o.memoryLimitPages)
+	}
+	r := wazero.NewRuntimeWithConfig(ctx, rc)
+	if _, err = wasi_snapshot_preview1.Instantiate(ctx, r); err != nil {
+		_ = r.Close(ctx)
+		return nil, fmt.Errorf("failed to instantiate WASI, %w", err)
+	}
+	compiled, err := r.CompileModule(ctx, wasmBytes)
+	if err != nil {
+		_ = r.Close(ctx)
+		return nil, fmt.Errorf("failed to compile the module %q, %w", path, err)
+	}
+	for _, f := range []string{"alloc", "map"} {
+		if _, ok := compiled.ExportedFunctions()[f]; !ok {
+			_ = r.Close(ctx)
+			return nil, fmt.Errorf("function %q is not exported by the module %q", f, path)
+		}
+	}
+	if _, ok := compiled.ExportedMemories()["memory"]; !ok {
+		_ = r.Close(ctx)
+		return nil, fmt.Errorf("memory is not exported by the module %q", path)
+	}
+	return &Module{
+		runtime:     r,
+		compiled:    compiled,
+		callTimeout: o.callTimeout,
+		// the instances have no names, so that the module can be instantiated more than once.
+		config: wazero.NewModuleConfig().
+			WithName("").
+			WithStartFunctions("_initialize").
+			WithStdout(os.Stdout).
+			WithStderr(os.Stderr).
+			WithSysWalltime(),
+	}, nil
+}
+
+// Apply applies the map function of the module to the payload.
+func (m *Module) Apply(ctx context.Context, payload []byte) ([]Message, error) {
+	instance, err := m.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	callCtx := ctx
+	if m.callTimeout > 0 {
+		var cancel context.CancelFunc
+		callCtx, cancel = context.WithTimeout(ctx, m.callTimeout)
+		defer cancel()
+	}
+	output, err := apply(callCtx, instance, payload)
+	if err != nil {
+		// the state of the instance is unknown after a trap or a timeout, don't reuse it.
+		_ = instance.Close(context.Background())
+		return nil, err
+	}
+	m.release(instance)
+	return decode(output)
+}
+
+// Close closes the module and all the instances of it.
+func (m *Module) Close(ctx context.Context) error {
+	return m.runtime.Close(ctx)
+}
+
+func (m *Module) acquire(ctx context.Context) (api.Module, error) {
+	m.lock.Lock()
+	if n := len(m.instances); n > 0 {
+		instance := m.instances[n-1]
+		m.instances = m.instances[:n-1]
+		m.lock.Unlock()
+		return instance, nil
+	}
+	m.lock.Unlock()
+	// the instance is not closed with the context it's created with, but with the runtime.
+	instance, err := m.runtime.InstantiateModule(context.Background(), m.compiled, m.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate the module, %w", err)
+	}
+	return instance, nil
+}
+
+func (m *Module) release(instance api.Module) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.instances = append(m.instances, instance)
+}
+
+// apply calls the map function of the instance, and returns a copy of the output.
+func apply(ctx context.Context, instance api.Module, payload []byte) ([]byte, error) {
+	dealloc := instance.ExportedFunction("dealloc")
+	results, err := instance.ExportedFunction("alloc").Call(ctx, uint64(len(payload)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to allocate the memory of the input, %w", err)
+	}
+	ptr := uint32(results[0])
+	if !instance.Memory().Write(ptr, payload) {
+		return nil, fmt.Errorf("failed to write the input, memory out of range")
+	}
+	results, err = instance.ExportedFunction("map").Call(ctx, uint64(ptr), uint64(len(payload)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply the map function, %w", err)
+	}
+	if dealloc != nil {
+		if _, err = dealloc.Call(ctx, uint64(ptr), uint64(len(payload))); err != nil {
+			return nil, fmt.Errorf("failed to free the memory of the input, %w", err)
+		}
+	}
+	outPtr, outLen := uint32(results[0]>>32), uint32(results[0])
+	buf, ok := instance.Memory().Read(outPtr, outLen)
+	if !ok {
+		return nil, fmt.Errorf("failed to read the output, memory out of range")
+	}
+	// the memory read is a view of the memory of the instance, which is overwritten by the following calls.
+	output := make([]byte, len(buf))
+	copy(output, buf)
+	if dealloc != nil {
+		if _, err = dealloc.Call(ctx, uint64(outPtr), uint64(outLen)); err != nil {
+			return nil, fmt.Errorf("failed to free the memory of the output, %w", err)
+		}
+	}
+	return output, nil
+}
+
+// decoder decodes the output of the map function.
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) uint32() uint32 {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.buf) < 4 {
+		d.err = fmt.Errorf("failed to decode the output, unexpected end")
+		return 0
+	}
+	v := binary.LittleEndian.Uint32(d.buf)
+	d.buf = d.buf[4:]
+	return v
+}
+
+func (d *decoder) int64() int64 {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.buf) < 8 {
+		d.err = fmt.Errorf("failed to decode the output, unexpected end")
+		return 0
+	}
+	v := int64(binary.LittleEndian.Uint64(d.buf))
+	d.buf = d.buf[8:]
+	return v
+}
+
+func (d *decoder) bytes() []byte {
+	n := d.uint32()
+	if d.err != nil {
+		return nil
+	}
+	if uint32(len(d.buf)) < n {
+		d.err = fmt.Errorf("failed to decode the output, unexpected end")
+		return nil
+	}
+	v := d.buf[:n:n]
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) strings() []string {
+	n := d.uint32()
+	var v []string
+	for i := uint32(0); i < n && d.err == nil; i++ {
+		v = append(v, string(d.bytes()))
+	}
+	return v
+}
+
+// decode decodes the output of the map function to the messages.
+func decode(output []byte) ([]Message, error) {
+	d := &decoder{buf: output}
+	n := d.uint32()
+	var messages []Message
+	for i := uint32(0); i < n && d.err == nil; i++ {
+		m := Message{
+			Keys:  d.strings(),
+			Value: d.bytes(),
+			Tags:  d.strings(),
+		}
+		if ms := d.int64(); ms != 0 {
+			m.EventTime = time.UnixMilli(ms)
+		}
+		messages = append(messages, m)
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	if len(d.buf) > 0 {
+		return nil, fmt.Errorf("failed to decode the output, %d unexpected bytes at the end", len(d.buf))
+	}
+	return messages, nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wasm
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModule_Apply(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	m, err := NewModule(ctx, "testdata/echo.wasm")
+	assert.NoError(t, err)
+	defer func() { _ = m.Close(ctx) }()
+
+	for i := 0; i < 3; i++ {
+		messages, err := m.Apply(ctx, []byte("hello"))
+		assert.NoError(t, err)
+		assert.Equal(t, []Message{{Value: []byte("hello")}}, messages)
+	}
+	// the echo module traps on an empty payload.
+	_, err = m.Apply(ctx, []byte{})
+	assert.Error(t, err)
+	// a new instance is created after the trap.
+	messages, err := m.Apply(ctx, []byte("world"))
+	assert.NoError(t, err)
+	assert.Equal(t, []Message{{Value: []byte("world")}}, messages)
+}
+
+func TestModule_ApplyTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	m, err := NewModule(ctx, "testdata/loop.wasm", WithCallTimeout(100*time.Millisecond))
+	assert.NoError(t, err)
+	defer func() { _ = m.Close(ctx) }()
+
+	start := time.Now()
+	_, err = m.Apply(ctx, []byte("hello"))
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.NoError(t, ctx.Err())
+}
+
+func TestNewModule(t *testing.T) {
+	_, err := NewModule(context.Background(), "testdata/not-exist.wasm")
+	assert.Error(t, err)
+	_, err = NewModule(context.Background(), "testdata/echo.wat")
+	assert.Error(t, err)
+	// the memory of the loop module starts with 2 pages.
+	_, err = NewModule(context.Background(), "testdata/loop.wasm", WithMemoryLimitPages(1))
+	assert.Error(t, err)
+	m, err := NewModule(context.Background(), "testdata/loop.wasm", WithMemoryLimitPages(2))
+	assert.NoError(t, err)
+	assert.NoError(t, m.Close(context.Background()))
+}
+
+func Test_decode(t *testing.T) {
+	var output []byte
+	u32 := func(v uint32) {
+		output = binary.LittleEndian.AppendUint32(output, v)
+	}
+	str := func(v string) {
+		u32(uint32(len(v)))
+		output = append(output, v...)
+	}
+	u32(2)
+	// message 1
+	u32(2)
+	str("k1")
+	str("k2")
+	str("v1")
+	u32(1)
+	str("t1")
+	output = binary.LittleEndian.AppendUint64(output, 1661169660000)
+	// message 2
+	u32(0)
+	str("")
+	u32(0)
+	output = binary.LittleEndian.AppendUint64(output, 0)
+
+	messages, err := decode(output)
+	assert.NoError(t, err)
+	assert.Equal(t, []Message{
+		{Keys: []string{"k1", "k2"}, Value: []byte("v1"), Tags: []string{"t1"}, EventTime: time.UnixMilli(1661169660000)},
+		{Value: []byte{}},
+	}, messages)
+
+	_, err = decode(output[:len(output)-1])
+	assert.Error(t, err)
+	_, err = decode(append(output, 0))
+	assert.Error(t, err)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fetch
+
+import (
+	"sync"
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+// Recorder wraps the watermark fetcher used by the forwarder, and records the watermark of the messages which have
+// been handed over to the writers, e.g. the sinks. Unlike the head watermark, there's no unread message before the
+// recorded watermark.
+type Recorder struct {
+	Fetcher
+	lock      sync.RWMutex
+	watermark wmb.Watermark
+}
+
+// NewRecorder returns a Recorder which wraps the fetcher. If the fetcher is already a Recorder, it's returned as is, so
+// that the writers of the same forwarder, e.g. the primary and the fallback sinks, share the recorded watermark.
+func NewRecorder(f Fetcher) *Recorder {
+	if r, ok := f.(*Recorder); ok {
+		return r
+	}
+	return &Recorder{Fetcher: f, watermark: wmb.InitialWatermark}
+}
+
+// GetWatermark is called by the forwarder for each batch of messages before writing them.
+func (r *Recorder) GetWatermark(offset isb.Offset, fromPartitionIdx int32) wmb.Watermark {
+	w := r.Fetcher.GetWatermark(offset, fromPartitionIdx)
+	r.update(w)
+	return w
+}
+
+// GetHeadWMB is called by the forwarder when there's nothing to read, the watermark of an idle WMB can be used
+// because there's no message behind it.
+func (r *Recorder) GetHeadWMB(fromPartitionIdx int32) wmb.WMB {
+	w := r.Fetcher.GetHeadWMB(fromPartitionIdx)
+	if w.Idle {
+		r.update(wmb.Watermark(time.UnixMilli(w.Watermark)))
+	}
+	return w
+}
+
+func (r *Recorder) update(w wmb.Watermark) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if w.After(time.Time(r.watermark)) {
+		r.watermark = w
+	}
+}
+
+// Get returns the recorded watermark.
+func (r *Recorder) Get() wmb.Watermark {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.watermark
+}
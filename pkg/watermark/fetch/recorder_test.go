@@ -0,0 +1,69 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fetch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+type testFetcher struct {
+	watermark wmb.Watermark
+	head      wmb.WMB
+}
+
+func (f *testFetcher) GetWatermark(isb.Offset, int32) wmb.Watermark {
+	return f.watermark
+}
+
+func (f *testFetcher) GetHeadWatermark(int32) wmb.Watermark {
+	return wmb.Watermark(time.UnixMilli(f.head.Watermark))
+}
+
+func (f *testFetcher) GetHeadWMB(int32) wmb.WMB {
+	return f.head
+}
+
+func (f *testFetcher) Close() error {
+	return nil
+}
+
+func TestRecorder(t *testing.T) {
+	start := time.Unix(1665109020, 0)
+	f := &testFetcher{watermark: wmb.Watermark(start)}
+	r := NewRecorder(f)
+	assert.Equal(t, wmb.InitialWatermark, r.Get())
+	r.GetWatermark(nil, 0)
+	assert.Equal(t, start.UnixMilli(), r.Get().UnixMilli())
+	// a non-idle head WMB is ignored
+	f.head = wmb.WMB{Watermark: start.Add(time.Hour).UnixMilli()}
+	r.GetHeadWMB(0)
+	assert.Equal(t, start.UnixMilli(), r.Get().UnixMilli())
+	f.head.Idle = true
+	r.GetHeadWMB(0)
+	assert.Equal(t, start.Add(time.Hour).UnixMilli(), r.Get().UnixMilli())
+	// the watermark never goes back
+	r.GetWatermark(nil, 0)
+	assert.Equal(t, start.Add(time.Hour).UnixMilli(), r.Get().UnixMilli())
+	// a recorder is not wrapped again
+	assert.Same(t, r, NewRecorder(r))
+}
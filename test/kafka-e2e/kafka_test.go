@@ -65,9 +65,11 @@ func (ks *KafkaSuite) TestKafkaSink() {
 				{
 					Name: "output",
 					Sink: &dfv1.Sink{
-						Kafka: &dfv1.KafkaSink{
-							Brokers: []string{"kafka-broker:9092"},
-							Topic:   outputTopic,
+						AbstractSink: dfv1.AbstractSink{
+							Kafka: &dfv1.KafkaSink{
+								Brokers: []string{"kafka-broker:9092"},
+								Topic:   outputTopic,
+							},
 						},
 					},
 				},
@@ -121,11 +123,13 @@ func (ks *KafkaSuite) TestKafkaSourceSink() {
 				{
 					Name: "output",
 					Sink: &dfv1.Sink{
-						Kafka: &dfv1.KafkaSink{
-							Brokers: []string{"kafka-broker:9092"},
-							Topic:   outputTopic,
+						AbstractSink: dfv1.AbstractSink{
+							Kafka: &dfv1.KafkaSink{
+								Brokers: []string{"kafka-broker:9092"},
+								Topic:   outputTopic,
+							},
+							//Log: &dfv1.Log{},
 						},
-						//Log: &dfv1.Log{},
 					},
 				},
 			},